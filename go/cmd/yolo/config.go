@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/peterbourgon/ff/v2"
+	"github.com/peterbourgon/ff/v2/fftoml"
+	"github.com/peterbourgon/ff/v2/ffyaml"
+)
+
+// configFileParser returns the parser of the config file at *path, chosen by its extension: TOML (.toml), JSON (.json), YAML otherwise.
+// The keys of the TOML tables are prefixed by the table name, i.e, [buildkite] token sets --buildkite-token.
+func configFileParser(path *string) ff.ConfigFileParser {
+	return func(r io.Reader, set func(name, value string) error) error {
+		set = setUnlessEnv(set)
+		switch strings.ToLower(filepath.Ext(*path)) {
+		case ".toml":
+			return fftoml.New(fftoml.WithTableDelimiter("-")).Parse(r, set)
+		case ".json":
+			return ff.JSONParser(r, set)
+		default:
+			return ffyaml.Parser(r, set)
+		}
+	}
+}
+
+// setUnlessEnv skips the flags set by an environment variable, they take precedence over the config file.
+// The lists are joined with commas as on the command line, and the snake_case keys match the flags.
+func setUnlessEnv(set func(name, value string) error) func(name, value string) error {
+	values := map[string]string{}
+	return func(name, value string) error {
+		name = strings.ReplaceAll(name, "_", "-")
+		if os.Getenv(flagEnvVar(name)) != "" {
+			return nil
+		}
+		if previous, found := values[name]; found {
+			value = previous + "," + value
+		}
+		values[name] = value
+		return set(name, value)
+	}
+}
+
+// flagEnvVar returns the environment variable of a flag, as named by ff
+func flagEnvVar(name string) string {
+	return strings.NewReplacer("-", "_", ".", "_", "/", "_").Replace(strings.ToUpper(name))
+}
+
+// flagRequirement is an invalid combination of flags when missing is true
+type flagRequirement struct {
+	missing bool
+	message string
+}
+
+// checkFlagRequirements returns all the unmet requirements at once
+func checkFlagRequirements(requirements ...flagRequirement) error {
+	messages := []string{}
+	for _, requirement := range requirements {
+		if requirement.missing {
+			messages = append(messages, requirement.message)
+		}
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return fmt.Errorf("invalid configuration: %s", strings.Join(messages, "; "))
+}
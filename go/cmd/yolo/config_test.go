@@ -0,0 +1,131 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/peterbourgon/ff/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// parseTestingConfig parses the flags of the server command with a config file, like on startup
+func parseTestingConfig(t *testing.T, filename, content string, args ...string) (*flag.FlagSet, error) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), filename)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	cmd := serverCommand()
+	err := ff.Parse(cmd.FlagSet, append([]string{"-config", path}, args...), cmd.Options...)
+	return cmd.FlagSet, err
+}
+
+// testingConfigValues returns the values of the flags set by the config files of the tests
+func testingConfigValues(t *testing.T, filename, content string, args ...string) map[string]string {
+	t.Helper()
+	fs, err := parseTestingConfig(t, filename, content, args...)
+	require.NoError(t, err)
+	values := map[string]string{}
+	for _, name := range []string{"buildkite-token", "buildkite-pipelines", "auth-salt", "refresh-interval", "channels", "github-repos", "slack-webhook-url", "slack-mute", "max-builds"} {
+		values[name] = fs.Lookup(name).Value.String()
+	}
+	return values
+}
+
+func TestConfigFile(t *testing.T) {
+	expected := map[string]string{
+		"buildkite-token":     "bk-token",
+		"buildkite-pipelines": "berty,yolo",
+		"auth-salt":           "salt # not a comment",
+		"refresh-interval":    "30s",
+		"channels":            "beta:main:promote,nightly:develop",
+		"github-repos":        "berty/berty,berty/yolo",
+		"slack-webhook-url":   "https://hooks.slack.com/services/T0/B0/X",
+		"slack-mute":          "true",
+		"max-builds":          "100", // default
+	}
+
+	yaml := `---
+# yolo server
+buildkite-token: bk-token
+buildkite-pipelines: [berty, yolo]
+auth-salt: "salt # not a comment"
+refresh-interval: 30s   # with jitter
+channels: beta:main:promote,nightly:develop
+github-repos:
+  - berty/berty
+  - berty/yolo
+slack-webhook-url: https://hooks.slack.com/services/T0/B0/X
+slack-mute: true
+`
+	assert.Equal(t, expected, testingConfigValues(t, "yolo.yaml", yaml))
+
+	toml := `# yolo server
+auth_salt = 'salt # not a comment'
+refresh-interval = "30s"
+channels = "beta:main:promote,nightly:develop"
+github-repos = ["berty/berty", "berty/yolo"]
+
+[buildkite]
+token = "bk-token"
+pipelines = ["berty", "yolo"]
+
+[slack]
+webhook-url = "https://hooks.slack.com/services/T0/B0/X"
+mute = true
+`
+	assert.Equal(t, expected, testingConfigValues(t, "yolo.toml", toml))
+
+	json := `{
+	"buildkite-token": "bk-token",
+	"buildkite-pipelines": "berty,yolo",
+	"auth-salt": "salt # not a comment",
+	"refresh-interval": "30s",
+	"channels": "beta:main:promote,nightly:develop",
+	"github-repos": ["berty/berty", "berty/yolo"],
+	"slack-webhook-url": "https://hooks.slack.com/services/T0/B0/X",
+	"slack-mute": true
+}`
+	assert.Equal(t, expected, testingConfigValues(t, "yolo.json", json))
+}
+
+func TestConfigFilePrecedence(t *testing.T) {
+	content := "buildkite-token: from-file\nauth-salt: from-file\nmax-builds: 10\n"
+
+	t.Setenv("BUILDKITE_TOKEN", "from-env")
+	t.Setenv("AUTH_SALT", "from-env")
+	values := testingConfigValues(t, "yolo.yaml", content, "-auth-salt", "from-args")
+	assert.Equal(t, "from-env", values["buildkite-token"])
+	assert.Equal(t, "from-args", values["auth-salt"])
+	assert.Equal(t, "10", values["max-builds"])
+}
+
+func TestConfigFileErrors(t *testing.T) {
+	for _, tc := range []struct {
+		filename string
+		content  string
+	}{
+		{"yolo.yaml", "unknown-flag: true\n"},
+		{"yolo.yaml", "auth-salt: \"unterminated\n"},
+		{"yolo.yaml", "buildkite:\n  token: bk-token\n"}, // the YAML mappings aren't flattened
+		{"yolo.yaml", "max-builds: many\n"},
+		{"yolo.toml", "[unknown]\nflag = true\n"},
+		{"yolo.toml", "auth-salt = unterminated\n"},
+		{"yolo.json", "{\"auth-salt\": "},
+	} {
+		_, err := parseTestingConfig(t, tc.filename, tc.content)
+		assert.Error(t, err, tc.content)
+	}
+}
+
+func TestCheckFlagRequirements(t *testing.T) {
+	assert.NoError(t, checkFlagRequirements(flagRequirement{false, "unused"}))
+	err := checkFlagRequirements(
+		flagRequirement{true, "--a requires --b"},
+		flagRequirement{false, "unused"},
+		flagRequirement{true, "--c requires --d"},
+	)
+	assert.EqualError(t, err, "invalid configuration: --a requires --b; --c requires --d")
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"log"
 	"math/rand"
 	"net/http"
@@ -11,6 +12,7 @@ import (
 	"time"
 
 	"berty.tech/yolo/v2/go/pkg/bintray"
+	"berty.tech/yolo/v2/go/pkg/yolosvc"
 	"go.uber.org/zap"
 	"golang.org/x/oauth2"
 	"moul.io/hcfilters"
@@ -30,12 +32,16 @@ import (
 )
 
 var (
-	verbose        bool
-	logFormat      string
-	dbStorePath    string
-	withPreloading bool
+	verbose           bool
+	logFormat         string
+	dbStorePath       string
+	dbConnectAttempts int
+	dbConnectBackoff  time.Duration
+	withPreloading    bool
 )
 
+const dbConnectMaxBackoff = 30 * time.Second
+
 func main() {
 	err := yolo(os.Args)
 	if err != nil {
@@ -60,6 +66,7 @@ func yolo(args []string) error {
 			dumpObjectsCommand(),
 			infoCommand(),
 			treeCommand(),
+			gcCommand(),
 		},
 		Options: []ff.Option{ff.WithEnvVarNoPrefix()},
 		Exec: func(_ context.Context, _ []string) error {
@@ -77,7 +84,8 @@ func bintrayClientFromArgs(username, token string, logger *zap.Logger) (*bintray
 
 func circleciClientFromArgs(token string) (*circleci.Client, error) {
 	httpclient := &http.Client{
-		Timeout: time.Second * 1800,
+		Timeout:   time.Second * 1800,
+		Transport: yolosvc.NewRateLimitTransport(nil),
 	}
 	ccc := &circleci.Client{Token: token, HTTPClient: httpclient}
 	return ccc, nil
@@ -118,12 +126,34 @@ func loggerFromArgs(verbose bool, logFormat string) (*zap.Logger, error) {
 	return config.Build()
 }
 
-func dbFromArgs(dbPath string, logger *zap.Logger) (*gorm.DB, error) {
-	db, err := gorm.Open("sqlite3", dbPath)
-	if err != nil {
-		return nil, err
+// dbFromArgs opens the DB, retrying with an exponential backoff until ctx is done
+func dbFromArgs(ctx context.Context, dbPath string, logger *zap.Logger) (*gorm.DB, error) {
+	backoff := dbConnectBackoff
+	for attempt := 1; ; attempt++ {
+		db, err := gorm.Open("sqlite3", dbPath)
+		if err == nil {
+			return db, nil
+		}
+		if attempt >= dbConnectAttempts {
+			return nil, fmt.Errorf("open db: giving up after %d attempt(s): %w", attempt, err)
+		}
+		logger.Warn("open db", zap.Int("attempt", attempt), zap.Duration("retry-in", backoff), zap.Error(err))
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("open db: %w", ctx.Err())
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if backoff > dbConnectMaxBackoff {
+			backoff = dbConnectMaxBackoff
+		}
 	}
-	return db, nil
+}
+
+func dbFlags(fs *flag.FlagSet) {
+	fs.StringVar(&dbStorePath, "db-path", ":memory:", "DB Store path")
+	fs.IntVar(&dbConnectAttempts, "db-connect-attempts", 1, "maximum attempts to open the DB on startup")
+	fs.DurationVar(&dbConnectBackoff, "db-connect-backoff", time.Second, "initial delay between DB open attempts (doubled after each failure)")
 }
 
 func roundTripperFromArgs(ctx context.Context, httpCachePath string, logger *zap.Logger) (http.RoundTripper, func()) {
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestDBFromArgsRetry(t *testing.T) {
+	attempts, backoff := dbConnectAttempts, dbConnectBackoff
+	defer func() { dbConnectAttempts, dbConnectBackoff = attempts, backoff }()
+	unreachable := filepath.Join(t.TempDir(), "missing", "yolo.db") // sqlite doesn't create the directory
+
+	dbConnectAttempts, dbConnectBackoff = 3, time.Millisecond
+	_, err := dbFromArgs(context.Background(), unreachable, zap.NewNop())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "giving up after 3 attempt(s)")
+
+	// the backoff is interrupted by the context
+	dbConnectAttempts, dbConnectBackoff = 3, time.Hour
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err = dbFromArgs(ctx, unreachable, zap.NewNop())
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Minute)
+
+	db, err := dbFromArgs(context.Background(), filepath.Join(t.TempDir(), "yolo.db"), zap.NewNop())
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+}
@@ -2,13 +2,19 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"net/http"
 	"os"
+	"strings"
 	"syscall"
 	"time"
 
+	"berty.tech/yolo/v2/go/pkg/azure"
 	"berty.tech/yolo/v2/go/pkg/bintray"
+	"berty.tech/yolo/v2/go/pkg/firebase"
+	"berty.tech/yolo/v2/go/pkg/jenkins"
+	"berty.tech/yolo/v2/go/pkg/s3"
 	"berty.tech/yolo/v2/go/pkg/yolosvc"
 
 	"github.com/buildkite/go-buildkite/buildkite"
@@ -17,6 +23,7 @@ import (
 	"github.com/peterbourgon/ff/v2"
 	"github.com/peterbourgon/ff/v2/ffcli"
 	"github.com/tevino/abool"
+	"go.uber.org/zap"
 )
 
 func serverCommand() *ffcli.Command {
@@ -25,6 +32,8 @@ func serverCommand() *ffcli.Command {
 	var (
 		devMode            bool
 		withCache          bool
+		withETag           bool
+		withMetrics        bool
 		maxBuilds          int
 		buildkiteToken     string
 		githubToken        string
@@ -46,39 +55,211 @@ func serverCommand() *ffcli.Command {
 		iosPrivkeyPath     string
 		iosProvPath        string
 		iosPrivkeyPass     string
+		gcInterval         time.Duration
+		logExcludeAgents   string
+		logExcludeIPs      string
+		trustedProxies     string
+		staffPassword      string
+		channels           string
+		copyBufferSize     int
+		redactSecrets      string
+		buildConfigKeys    string
+		plistManifestTTL   time.Duration
+		plistURLTTL        time.Duration
+		downloadRateLimit  int64
+		downloadRateTokens string
+		webhookSecret      string
+		webhookPollAfter   time.Duration
+		refreshInterval    time.Duration
+		urlRewrites        string
+		ownerTeams         bool
+		mimeSniffLimit     int
+		sizeBudgets        string
+		sizeBudgetStatus   bool
+		eventRetention     time.Duration
+		buildRetention     time.Duration
+		buildRetentionN    int
+		buildRetentionDry  bool
+		flagsManifest      string
+		s3Bucket           string
+		s3Region           string
+		s3Endpoint         string
+		s3AccessKeyID      string
+		s3SecretKey        string
+		s3Redirect         bool
+		redirectDrivers    string
+		verifyDownloads    bool
+		downloadAuths      string
+		firebaseAccount    string
+		firebaseAppIDs     string
+		azureOrgURL        string
+		azureToken         string
+		azureProjects      string
+		jenkinsURL         string
+		jenkinsUser        string
+		jenkinsToken       string
+		jenkinsJobs        string
+		breakerThreshold   int
+		breakerBackoff     time.Duration
+		breakerMaxBackoff  time.Duration
+		signedURLTTL       time.Duration
+		installURLTTL      time.Duration
+		publicURL          string
+		slackWebhookURL    string
+		slackMute          bool
+		discordWebhookURL  string
+		discordMute        bool
+		telegramBotToken   string
+		telegramChatID     string
+		telegramEnabled    bool
+		readinessDrivers   bool
+		buildkitePipelines string
+		buildkiteBranches  string
+		configFile         string
+		downloadReqRate    int
+		downloadReqBurst   int
+		ascKeyID           string
+		ascIssuerID        string
+		ascKeyPath         string
+		testflightAppIDs   string
+		sparkleKeyPath     string
+		tracingEndpoint    string
+		tracingInsecure    bool
+		tracingService     string
 	)
 
+	fs.StringVar(&configFile, "config", "", "path to a YAML, TOML (.toml) or JSON (.json) config file setting these flags by name, the keys of the TOML tables are prefixed by the table name (i.e, [buildkite] token), the environment variables and the command line take precedence")
 	fs.BoolVar(&devMode, "dev-mode", false, "enable insecure helpers")
 	fs.BoolVar(&withCache, "with-cache", false, "enable API caching")
+	fs.BoolVar(&withETag, "with-etag", false, "enable ETag/If-None-Match on the build list")
+	fs.BoolVar(&withMetrics, "with-metrics", false, "expose Prometheus metrics on /metrics")
+	fs.StringVar(&tracingEndpoint, "tracing-endpoint", "", "export OpenTelemetry traces to this OTLP gRPC collector (i.e, localhost:4317)")
+	fs.BoolVar(&tracingInsecure, "tracing-insecure", false, "connect to the OTLP collector without TLS")
+	fs.StringVar(&tracingService, "tracing-service-name", "yolo", "service name of the exported traces")
 	fs.StringVar(&buildkiteToken, "buildkite-token", "", "BuildKite API Token")
+	fs.StringVar(&buildkitePipelines, "buildkite-pipelines", "", "comma-separated slugs of the Buildkite pipelines to ingest (defaults to all of them)")
+	fs.StringVar(&buildkiteBranches, "buildkite-branches", "", "comma-separated branches of the Buildkite builds to ingest (defaults to all of them)")
 	fs.StringVar(&bintrayUsername, "bintray-username", "", "Bintray username")
 	fs.StringVar(&bintrayToken, "bintray-token", "", "Bintray API Token")
 	fs.StringVar(&circleciToken, "circleci-token", "", "CircleCI API Token")
 	fs.StringVar(&githubToken, "github-token", "", "GitHub API Token")
+	fs.StringVar(&s3Bucket, "s3-bucket", "", "enable the S3 driver, bucket of the artifact keys without an s3://bucket/ prefix")
+	fs.StringVar(&s3Region, "s3-region", "", "S3 region (defaults to $AWS_REGION)")
+	fs.StringVar(&s3Endpoint, "s3-endpoint", "", "S3-compatible endpoint (defaults to AWS)")
+	fs.StringVar(&s3AccessKeyID, "s3-access-key-id", "", "S3 access key ID (defaults to the AWS environment variables and shared credentials)")
+	fs.StringVar(&s3SecretKey, "s3-secret-access-key", "", "S3 secret access key")
+	fs.BoolVar(&s3Redirect, "s3-redirect", false, "redirect the S3 artifact downloads to presigned URLs instead of proxying them (same as adding s3 to --download-redirect)")
+	fs.StringVar(&firebaseAccount, "firebase-service-account", "", "Firebase App Distribution: path to a service account key file (JSON)")
+	fs.StringVar(&firebaseAppIDs, "firebase-app-ids", "", "Firebase App Distribution: comma-separated app IDs whose releases are fetched")
+	fs.StringVar(&azureOrgURL, "azure-org-url", "", "Azure Pipelines: URL of the Azure DevOps organization (i.e, https://dev.azure.com/berty)")
+	fs.StringVar(&azureToken, "azure-token", "", "Azure Pipelines: personal access token with the Build (read) scope")
+	fs.StringVar(&azureProjects, "azure-projects", "", "Azure Pipelines: comma-separated names of the projects whose pipeline runs are fetched")
+	fs.StringVar(&jenkinsURL, "jenkins-url", "", "Jenkins: base URL of the Jenkins instance (i.e, https://jenkins.berty.tech)")
+	fs.StringVar(&jenkinsUser, "jenkins-user", "", "Jenkins: user of the API token")
+	fs.StringVar(&jenkinsToken, "jenkins-token", "", "Jenkins: API token of --jenkins-user")
+	fs.StringVar(&jenkinsJobs, "jenkins-jobs", "", "Jenkins: comma-separated jobs whose builds and archived artifacts are fetched, the jobs of a folder are separated by slashes (i.e, berty/android)")
+	fs.StringVar(&ascKeyID, "appstoreconnect-key-id", "", "TestFlight: ID of the App Store Connect API key")
+	fs.StringVar(&ascIssuerID, "appstoreconnect-issuer-id", "", "TestFlight: issuer ID of the App Store Connect API key")
+	fs.StringVar(&ascKeyPath, "appstoreconnect-key", "", "TestFlight: path to the private key of the App Store Connect API key (AuthKey_<key-id>.p8)")
+	fs.StringVar(&testflightAppIDs, "testflight-app-ids", "", "TestFlight: comma-separated Apple IDs of the apps whose builds and beta states are fetched")
 	fs.StringVar(&githubRepos, "github-repos", "berty/berty", "GitHub repositories to watch")
-	fs.StringVar(&dbStorePath, "db-path", ":memory:", "DB Store path")
+	fs.StringVar(&webhookSecret, "github-webhook-secret", "", "enable the GitHub webhook receiver (/api/webhooks/github), the drivers are then refreshed on push and check events")
+	fs.DurationVar(&refreshInterval, "refresh-interval", 0, "interval between the refreshes of the CI drivers, with a 10% jitter (defaults to 10s for Buildkite and CircleCI, 30s for GitHub)")
+	fs.DurationVar(&webhookPollAfter, "webhook-poll-interval", 15*time.Minute, "when webhooks are enabled, interval of the safety-net periodic refresh")
+	fs.IntVar(&breakerThreshold, "circuit-breaker-threshold", 5, "consecutive failed refreshes of a driver pausing its refreshes, the other drivers keep being refreshed (0 to disable)")
+	fs.DurationVar(&breakerBackoff, "circuit-breaker-backoff", time.Minute, "pause of the refreshes of a failing driver before probing it again, doubled after each failed probe")
+	fs.DurationVar(&breakerMaxBackoff, "circuit-breaker-max-backoff", 30*time.Minute, "maximum pause of the refreshes of a failing driver")
+	dbFlags(fs)
 	fs.StringVar(&artifactsCachePath, "artifacts-cache-path", "", "Artifacts caching path")
 	fs.IntVar(&maxBuilds, "max-builds", 100, "maximum builds to fetch from external services (pagination)")
 	fs.StringVar(&httpBind, "http-bind", ":8000", "HTTP bind address")
 	fs.StringVar(&grpcBind, "grpc-bind", ":9000", "gRPC bind address")
 	fs.StringVar(&corsAllowedOrigins, "cors-allowed-origins", "", "CORS allowed origins (*.domain.tld)")
 	fs.DurationVar(&requestTimeout, "request-timeout", 5*time.Second, "request timeout")
-	fs.DurationVar(&shutdownTimeout, "shutdown-timeout", 6*time.Second, "server shutdown timeout")
+	fs.DurationVar(&shutdownTimeout, "shutdown-timeout", 6*time.Second, "on SIGTERM or SIGINT, time to wait for the in-flight requests (i.e, downloads) before closing them")
 	fs.StringVar(&basicAuth, "basic-auth-password", "", "if set, enables basic authentication")
+	fs.StringVar(&staffPassword, "staff-password", "", "basic authentication password granting staff permissions (i.e., build promotion)")
+	fs.StringVar(&channels, "channels", "", "release channels (name:branch[:promote],...), builds of channels with the promote option are only listed once promoted")
 	fs.StringVar(&realm, "realm", "Yolo", "authentication Realm")
-	fs.StringVar(&authSalt, "auth-salt", "", "salt used to generate authentication tokens at the end of the URLs")
+	fs.StringVar(&publicURL, "public-url", "", "address of the server, used in the links of the notifications (i.e, https://yolo.example.com)")
+	fs.StringVar(&slackWebhookURL, "slack-webhook-url", "", "Slack incoming webhook URL, announces the new installable artifacts (IPA, APK, DMG, EXE and MSI)")
+	fs.BoolVar(&slackMute, "slack-mute", false, "disable the Slack notifications")
+	fs.StringVar(&discordWebhookURL, "discord-webhook-url", "", "Discord webhook URL, announces the new installable artifacts (IPA, APK, DMG, EXE and MSI)")
+	fs.BoolVar(&discordMute, "discord-mute", false, "disable the Discord notifications")
+	fs.StringVar(&telegramBotToken, "telegram-bot-token", "", "Telegram bot token, announces the new installable artifacts (IPA, APK, DMG, EXE and MSI) with --telegram-enabled")
+	fs.StringVar(&telegramChatID, "telegram-chat-id", "", "Telegram chat the bot posts to (i.e, -1001234567890 or @channel)")
+	fs.BoolVar(&telegramEnabled, "telegram-enabled", false, "enable the Telegram notifications")
+	fs.BoolVar(&readinessDrivers, "readiness-check-drivers", false, "report the reachability of the CI provider APIs on /readyz, only the database makes the server unready")
+	fs.DurationVar(&signedURLTTL, "signed-url-ttl", 24*time.Hour, "validity of the artifact download links of the API responses (0 for links that never expire)")
+	fs.DurationVar(&installURLTTL, "install-url-ttl", 7*24*time.Hour, "validity of the install callback links of the API responses")
+	fs.StringVar(&authSalt, "auth-salt", "", "comma-separated salts used to generate authentication tokens at the end of the URLs, the first one signs the new URLs and the next ones are still accepted (i.e, during a rotation), a random salt is generated and persisted in the DB if unset")
 	fs.StringVar(&httpCachePath, "http-cache-path", "", "if set, will cache http client requests")
 	fs.BoolVar(&once, "once", false, "just run workers once")
 	fs.StringVar(&iosPrivkeyPath, "ios-privkey", "", "iOS signing: path to private key or p12 file (PEM or DER format)")
 	fs.StringVar(&iosProvPath, "ios-prov", "", "iOS signing: path to mobile provisioning profile")
 	fs.StringVar(&iosPrivkeyPass, "ios-pass", "", "iOS signing: password for private key or p12 file")
+	fs.StringVar(&sparkleKeyPath, "sparkle-key", "", "Sparkle appcasts: path to the EdDSA private key exported by generate_keys, signing the mirrored .dmg artifacts")
+	fs.StringVar(&buildConfigKeys, "build-config-keys", "", "comma-separated build environment variables stored as the build config (i.e, API_ENV,FLAVOR)")
+	fs.DurationVar(&plistManifestTTL, "plist-manifest-ttl", time.Hour, "how long an iOS install manifest can be used before being refreshed")
+	fs.DurationVar(&plistURLTTL, "plist-url-ttl", 2*time.Hour, "validity of the download URLs embedded in the iOS install manifests, should be longer than the manifest TTL")
+	fs.Int64Var(&downloadRateLimit, "download-rate-limit", 0, "per-connection artifact download bandwidth cap in bytes per second (0 for unlimited)")
+	fs.IntVar(&downloadReqRate, "download-requests-per-minute", 0, "per-IP rate of the artifact download and iOS manifest requests, exceeding it returns a 429 (0 for unlimited)")
+	fs.IntVar(&downloadReqBurst, "download-request-burst", 10, "number of download and iOS manifest requests allowed at once before --download-requests-per-minute applies")
+	fs.StringVar(&downloadRateTokens, "download-rate-limit-overrides", "", "comma-separated per-token download bandwidth caps (token=bytes-per-second, 0 for unlimited)")
+	fs.StringVar(&urlRewrites, "download-url-rewrites", "", "comma-separated rewrite rules of the artifact download URLs ([driver|]prefix=>replacement)")
+	fs.StringVar(&redirectDrivers, "download-redirect", "", "comma-separated drivers whose artifact downloads are redirected to short-lived upstream URLs instead of proxied when possible (s3, buildkite), except the single-use ones, some installers don't follow the redirections")
+	fs.StringVar(&downloadAuths, "download-auth", "", "comma-separated credentials of the artifact downloads from plain URLs per driver (http=bearer:token or http=basic:username:password), the Bintray artifacts are downloaded the same way")
+	fs.BoolVar(&verifyDownloads, "verify-downloads", false, "check the size and the SHA-256 checksum of the proxied artifact downloads, a mismatch (i.e, a flaky upstream) is logged and counted in the metrics")
+	fs.BoolVar(&ownerTeams, "resolve-owner-teams", false, "resolve the teams owning the builds from the CODEOWNERS of their GitHub repo (requires a GitHub token)")
+	fs.StringVar(&sizeBudgets, "size-budgets", "", "comma-separated maximum artifact sizes per project ([kind|]project=bytes)")
+	fs.BoolVar(&sizeBudgetStatus, "size-budget-status", false, "post a failing GitHub commit status for the artifacts over their size budget")
+	fs.StringVar(&flagsManifest, "flags-manifest", "yolo-flags.json", "name of the GitHub artifact listing the feature flags enabled in a build (JSON object)")
+	fs.IntVar(&mimeSniffLimit, "mime-sniff-limit", 512, "number of bytes read to guess the mimetype of artifacts with an unknown extension")
+	fs.IntVar(&copyBufferSize, "copy-buffer-size", 32*1024, "size in bytes of the buffers used to stream artifacts from the CI providers")
+	fs.DurationVar(&gcInterval, "gc-interval", 0, "if set, periodically remove orphan objects")
+	fs.DurationVar(&eventRetention, "event-retention", 0, "if set, periodically remove the download and install events older than this, keeping their totals")
+	fs.DurationVar(&buildRetention, "build-retention", 0, "if set, periodically remove the builds older than this and their artifacts, except the promoted ones")
+	fs.IntVar(&buildRetentionN, "build-retention-count", 0, "if set, periodically remove the builds beyond the most recent ones of each project, except the promoted ones")
+	fs.BoolVar(&buildRetentionDry, "build-retention-dry-run", false, "only log the builds that would be removed by --build-retention and --build-retention-count")
+	fs.StringVar(&logExcludeAgents, "log-exclude-agents", "", "comma-separated user-agent patterns only logged in verbose mode (health checks, bots)")
+	fs.StringVar(&logExcludeIPs, "log-exclude-ips", "", "comma-separated IP ranges only logged in verbose mode (CIDR notation)")
+	fs.StringVar(&trustedProxies, "trusted-proxies", "", "comma-separated IP ranges of the reverse proxies whose X-Forwarded-For header is trusted (CIDR notation)")
+	fs.StringVar(&redactSecrets, "redact-secrets", "", "comma-separated additional values to scrub from the logs and error responses (tokens, passwords are always scrubbed)")
 
 	return &ffcli.Command{
 		Name:      `server`,
 		ShortHelp: `Start a Yolo Server`,
 		FlagSet:   fs,
-		Options:   []ff.Option{ff.WithEnvVarNoPrefix()},
+		Options:   []ff.Option{ff.WithEnvVarNoPrefix(), ff.WithConfigFileFlag("config"), ff.WithConfigFileParser(configFileParser(&configFile))},
 		Exec: func(ctx context.Context, _ []string) error {
+			// the options depending on each other are checked before connecting to anything
+			err := checkFlagRequirements(
+				flagRequirement{sizeBudgetStatus && githubToken == "", "--size-budget-status requires --github-token to post the commit statuses"},
+				flagRequirement{ownerTeams && githubToken == "", "--resolve-owner-teams requires --github-token to read the CODEOWNERS"},
+				flagRequirement{(bintrayToken == "") != (bintrayUsername == ""), "--bintray-token and --bintray-username should be set together"},
+				flagRequirement{(buildkitePipelines != "" || buildkiteBranches != "") && buildkiteToken == "", "--buildkite-pipelines and --buildkite-branches require --buildkite-token"},
+				flagRequirement{(iosPrivkeyPath == "") != (iosProvPath == ""), "--ios-privkey and --ios-prov should be set together to sign the IPAs"},
+				flagRequirement{s3Bucket == "" && (s3Region != "" || s3Endpoint != "" || s3AccessKeyID != "" || s3SecretKey != "" || s3Redirect), "the S3 options require --s3-bucket"},
+				flagRequirement{firebaseAppIDs != "" && firebaseAccount == "", "--firebase-app-ids requires --firebase-service-account"},
+				flagRequirement{(azureOrgURL == "") != (azureToken == ""), "--azure-org-url and --azure-token should be set together"},
+				flagRequirement{azureProjects != "" && azureToken == "", "--azure-projects requires --azure-org-url and --azure-token"},
+				flagRequirement{jenkinsURL != "" && (jenkinsUser == "" || jenkinsToken == ""), "--jenkins-url requires --jenkins-user and --jenkins-token"},
+				flagRequirement{jenkinsJobs != "" && jenkinsURL == "", "--jenkins-jobs requires --jenkins-url"},
+				flagRequirement{testflightAppIDs != "" && ascKeyID == "", "--testflight-app-ids requires an App Store Connect API key (--appstoreconnect-key-id)"},
+				flagRequirement{ascKeyID != "" && (ascIssuerID == "" || ascKeyPath == ""), "--appstoreconnect-key-id requires --appstoreconnect-issuer-id and --appstoreconnect-key"},
+				flagRequirement{sparkleKeyPath != "" && artifactsCachePath == "", "--sparkle-key requires --artifacts-cache-path, the .dmg artifacts are signed once mirrored"},
+				flagRequirement{buildRetentionDry && buildRetention == 0 && buildRetentionN == 0, "--build-retention-dry-run requires --build-retention or --build-retention-count"},
+				flagRequirement{telegramEnabled && (telegramBotToken == "" || telegramChatID == ""), "--telegram-enabled requires --telegram-bot-token and --telegram-chat-id"},
+				flagRequirement{breakerBackoff > breakerMaxBackoff, "--circuit-breaker-backoff should not exceed --circuit-breaker-max-backoff"},
+			)
+			if err != nil {
+				return err
+			}
+			httpAuths, err := yolosvc.ParseHTTPAuths(downloadAuths)
+			if err != nil {
+				return err
+			}
+
 			logger, err := loggerFromArgs(verbose, logFormat)
 			if err != nil {
 				return err
@@ -86,18 +267,41 @@ func serverCommand() *ffcli.Command {
 			ctx, cancel := context.WithCancel(ctx)
 			defer cancel()
 
-			roundTripper, rtCloser := roundTripperFromArgs(ctx, httpCachePath, logger)
-			defer rtCloser()
-			http.DefaultTransport = roundTripper
-
-			db, err := dbFromArgs(dbStorePath, logger)
+			db, err := dbFromArgs(ctx, dbStorePath, logger)
 			if err != nil {
 				return err
 			}
 			defer db.Close()
 
+			authSalts := []string{}
+			for _, salt := range strings.Split(authSalt, ",") {
+				if salt = strings.TrimSpace(salt); salt != "" {
+					authSalts = append(authSalts, salt)
+				}
+			}
+			// without a configured salt, reuse the one of the previous runs so the signed URLs survive restarts
+			if len(authSalts) == 0 {
+				salt, err := yolosvc.LoadAuthSalt(db, logger)
+				if err != nil {
+					return err
+				}
+				authSalts = append(authSalts, salt)
+			}
+
+			secrets := []string{buildkiteToken, githubToken, bintrayToken, circleciToken, basicAuth, staffPassword, iosPrivkeyPass, webhookSecret, s3SecretKey, slackWebhookURL, discordWebhookURL, telegramBotToken, azureToken, jenkinsToken}
+			secrets = append(secrets, authSalts...)
+			for _, auth := range httpAuths {
+				secrets = append(secrets, auth.Secrets()...)
+			}
+			redactor := yolosvc.NewRedactor(append(secrets, strings.Split(redactSecrets, ",")...)...)
+			logger = logger.WithOptions(redactor.WrapCore())
+
+			roundTripper, rtCloser := roundTripperFromArgs(ctx, httpCachePath, logger)
+			defer rtCloser()
+			http.DefaultTransport = roundTripper
+
 			gr := run.Group{}
-			gr.Add(run.SignalHandler(ctx, syscall.SIGKILL, syscall.SIGTERM))
+			gr.Add(run.SignalHandler(ctx, syscall.SIGTERM, os.Interrupt))
 
 			cc := abool.New() // clear cache signal
 
@@ -127,6 +331,44 @@ func serverCommand() *ffcli.Command {
 			if err != nil {
 				return err
 			}
+			var s3c *s3.Client
+			if s3Bucket != "" {
+				s3c, err = s3.New(s3.Config{
+					Bucket:          s3Bucket,
+					Region:          s3Region,
+					Endpoint:        s3Endpoint,
+					AccessKeyID:     s3AccessKeyID,
+					SecretAccessKey: s3SecretKey,
+				})
+				if err != nil {
+					return err
+				}
+			}
+			var fbc *firebase.Client
+			if firebaseAccount != "" {
+				account, err := os.ReadFile(firebaseAccount)
+				if err != nil {
+					return err
+				}
+				fbc, err = firebase.New(account)
+				if err != nil {
+					return err
+				}
+			}
+			var azc *azure.Client
+			if azureToken != "" {
+				azc, err = azure.New(azureOrgURL, azureToken)
+				if err != nil {
+					return err
+				}
+			}
+			var jkc *jenkins.Client
+			if jenkinsURL != "" {
+				jkc, err = jenkins.New(jenkinsURL, jenkinsUser, jenkinsToken)
+				if err != nil {
+					return err
+				}
+			}
 
 			if devMode {
 				logger.Warn("--dev-mode: insecure helpers are enabled")
@@ -138,67 +380,192 @@ func serverCommand() *ffcli.Command {
 				}
 			}
 
+			releaseChannels, err := yolosvc.ParseChannels(channels)
+			if err != nil {
+				return err
+			}
+			downloadRateOverrides, err := yolosvc.ParseRateLimitOverrides(downloadRateTokens)
+			if err != nil {
+				return err
+			}
+			downloadURLRewrites, err := yolosvc.ParseURLRewrites(urlRewrites)
+			if err != nil {
+				return err
+			}
+			artifactSizeBudgets, err := yolosvc.ParseSizeBudgets(sizeBudgets)
+			if err != nil {
+				return err
+			}
+			preferRedirect, err := yolosvc.ParseDrivers(redirectDrivers)
+			if err != nil {
+				return err
+			}
+
+			var metrics *yolosvc.Metrics
+			if withMetrics {
+				metrics = yolosvc.NewMetrics()
+			}
+
 			// service
 			svc, err := yolosvc.NewService(db, yolosvc.ServiceOpts{
-				Logger:             logger,
-				BuildkiteClient:    bkc,
-				CircleciClient:     ccc,
-				BintrayClient:      btc,
-				GithubClient:       ghc,
-				AuthSalt:           authSalt,
-				DevMode:            devMode,
-				ArtifactsCachePath: artifactsCachePath,
-				IOSPrivkeyPath:     iosPrivkeyPath,
-				IOSProvPath:        iosProvPath,
-				IOSPrivkeyPass:     iosPrivkeyPass,
+				Logger:                   logger,
+				BuildkiteClient:          bkc,
+				CircleciClient:           ccc,
+				BintrayClient:            btc,
+				GithubClient:             ghc,
+				S3Client:                 s3c,
+				FirebaseClient:           fbc,
+				AzureClient:              azc,
+				JenkinsClient:            jkc,
+				AuthSalts:                authSalts,
+				DevMode:                  devMode,
+				ArtifactsCachePath:       artifactsCachePath,
+				IOSPrivkeyPath:           iosPrivkeyPath,
+				IOSProvPath:              iosProvPath,
+				IOSPrivkeyPass:           iosPrivkeyPass,
+				Channels:                 releaseChannels,
+				StaffPassword:            staffPassword,
+				CopyBufferSize:           copyBufferSize,
+				BuildConfigKeys:          strings.Split(buildConfigKeys, ","),
+				PlistManifestTTL:         plistManifestTTL,
+				PlistURLTTL:              plistURLTTL,
+				DownloadRateLimit:        downloadRateLimit,
+				DownloadRateOverrides:    downloadRateOverrides,
+				GithubWebhookSecret:      webhookSecret,
+				URLRewrites:              downloadURLRewrites,
+				ResolveOwnerTeams:        ownerTeams,
+				MimeSniffLimit:           mimeSniffLimit,
+				SizeBudgets:              artifactSizeBudgets,
+				SizeBudgetStatus:         sizeBudgetStatus,
+				EventRetention:           eventRetention,
+				BuildRetention:           buildRetention,
+				BuildRetentionCount:      buildRetentionN,
+				BuildRetentionDryRun:     buildRetentionDry,
+				FlagsManifest:            flagsManifest,
+				S3Redirect:               s3Redirect,
+				PreferRedirect:           preferRedirect,
+				BuildkiteToken:           buildkiteToken,
+				VerifyDownloads:          verifyDownloads,
+				HTTPAuths:                httpAuths,
+				Metrics:                  metrics,
+				SignedURLTTL:             signedURLTTL,
+				InstallURLTTL:            installURLTTL,
+				PublicURL:                publicURL,
+				SlackWebhookURL:          slackWebhookURL,
+				SlackMute:                slackMute,
+				DiscordWebhookURL:        discordWebhookURL,
+				DiscordMute:              discordMute,
+				TelegramBotToken:         telegramBotToken,
+				TelegramChatID:           telegramChatID,
+				TelegramEnabled:          telegramEnabled,
+				ReadinessCheckDrivers:    readinessDrivers,
+				AppStoreConnectKeyID:     ascKeyID,
+				AppStoreConnectIssuerID:  ascIssuerID,
+				AppStoreConnectKeyPath:   ascKeyPath,
+				BuildkitePipelines:       strings.Split(buildkitePipelines, ","),
+				BuildkiteBranches:        strings.Split(buildkiteBranches, ","),
+				CircuitBreakerThreshold:  breakerThreshold,
+				CircuitBreakerBackoff:    breakerBackoff,
+				CircuitBreakerMaxBackoff: breakerMaxBackoff,
+				SparkleKeyPath:           sparkleKeyPath,
+				TracingEndpoint:          tracingEndpoint,
+				TracingInsecure:          tracingInsecure,
+				TracingServiceName:       tracingService,
 			})
 			if err != nil {
 				return err
 			}
+			defer func() {
+				ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+				defer cancel()
+				if err := svc.Shutdown(ctx); err != nil {
+					logger.Warn("flush the traces", zap.Error(err))
+				}
+			}()
 
 			// service workers
+			loopAfter := refreshInterval // 0 for the drivers' defaults
+			if webhookSecret != "" {
+				loopAfter = webhookPollAfter
+			}
 			if bkc != nil {
-				opts := yolosvc.BuildkiteWorkerOpts{Logger: logger, MaxBuilds: maxBuilds, ClearCache: cc, Once: once}
+				opts := yolosvc.BuildkiteWorkerOpts{Logger: logger, MaxBuilds: maxBuilds, LoopAfter: loopAfter, ClearCache: cc, Once: once}
 				gr.Add(func() error { return svc.BuildkiteWorker(ctx, opts) }, func(_ error) { cancel() })
 			}
 			if ccc != nil {
-				opts := yolosvc.CircleciWorkerOpts{Logger: logger, MaxBuilds: maxBuilds, ClearCache: cc, Once: once}
+				opts := yolosvc.CircleciWorkerOpts{Logger: logger, MaxBuilds: maxBuilds, LoopAfter: loopAfter, ClearCache: cc, Once: once}
 				gr.Add(func() error { return svc.CircleciWorker(ctx, opts) }, func(_ error) { cancel() })
 			}
+			if fbc != nil && firebaseAppIDs != "" {
+				opts := yolosvc.FirebaseWorkerOpts{Logger: logger, MaxBuilds: maxBuilds, LoopAfter: refreshInterval, ClearCache: cc, Once: once, AppIDs: strings.Split(firebaseAppIDs, ",")}
+				gr.Add(func() error { return svc.FirebaseWorker(ctx, opts) }, func(_ error) { cancel() })
+			}
+			if azc != nil && azureProjects != "" {
+				opts := yolosvc.AzurePipelinesWorkerOpts{Logger: logger, MaxBuilds: maxBuilds, LoopAfter: refreshInterval, ClearCache: cc, Once: once, Projects: strings.Split(azureProjects, ",")}
+				gr.Add(func() error { return svc.AzurePipelinesWorker(ctx, opts) }, func(_ error) { cancel() })
+			}
+			if jkc != nil && jenkinsJobs != "" {
+				opts := yolosvc.JenkinsWorkerOpts{Logger: logger, MaxBuilds: maxBuilds, LoopAfter: refreshInterval, ClearCache: cc, Once: once, Jobs: strings.Split(jenkinsJobs, ",")}
+				gr.Add(func() error { return svc.JenkinsWorker(ctx, opts) }, func(_ error) { cancel() })
+			}
+			if testflightAppIDs != "" {
+				opts := yolosvc.TestflightWorkerOpts{Logger: logger, MaxBuilds: maxBuilds, LoopAfter: refreshInterval, ClearCache: cc, Once: once, AppIDs: strings.Split(testflightAppIDs, ",")}
+				gr.Add(func() error { return svc.TestflightWorker(ctx, opts) }, func(_ error) { cancel() })
+			}
 			if btc != nil {
-				opts := yolosvc.BintrayWorkerOpts{Logger: logger, ClearCache: cc, Once: once}
+				opts := yolosvc.BintrayWorkerOpts{Logger: logger, LoopAfter: refreshInterval, ClearCache: cc, Once: once}
 				gr.Add(func() error { return svc.BintrayWorker(ctx, opts) }, func(_ error) { cancel() })
 			}
 			if !once { // disable pkgman when running with --once
 				opts := yolosvc.PkgmanWorkerOpts{Logger: logger, ClearCache: cc, Once: once}
 				gr.Add(func() error { return svc.PkgmanWorker(ctx, opts) }, func(_ error) { cancel() })
 			}
+			if (gcInterval > 0 || eventRetention > 0 || buildRetention > 0 || buildRetentionN > 0) && !once {
+				opts := yolosvc.GCWorkerOpts{Logger: logger, LoopAfter: gcInterval, ClearCache: cc}
+				gr.Add(func() error { return svc.GCWorker(ctx, opts) }, func(_ error) { cancel() })
+			}
 			if githubToken != "" {
-				opts := yolosvc.GithubWorkerOpts{Logger: logger, MaxBuilds: maxBuilds, ClearCache: cc, Once: once, ReposFilter: githubRepos, Token: githubToken}
+				opts := yolosvc.GithubWorkerOpts{Logger: logger, MaxBuilds: maxBuilds, LoopAfter: loopAfter, ClearCache: cc, Once: once, ReposFilter: githubRepos, Token: githubToken}
 				gr.Add(func() error { return svc.GitHubWorker(ctx, opts) }, func(_ error) { cancel() })
 			}
 
 			// server/API
 			server, err := yolosvc.NewServer(ctx, svc, yolosvc.ServerOpts{
-				Logger:             logger,
-				GRPCBind:           grpcBind,
-				HTTPBind:           httpBind,
-				RequestTimeout:     requestTimeout,
-				ShutdownTimeout:    shutdownTimeout,
-				CORSAllowedOrigins: corsAllowedOrigins,
-				BasicAuth:          basicAuth,
-				Realm:              realm,
-				AuthSalt:           authSalt,
-				DevMode:            devMode,
-				WithCache:          withCache,
-				ClearCache:         cc,
+				Logger:                    logger,
+				GRPCBind:                  grpcBind,
+				HTTPBind:                  httpBind,
+				RequestTimeout:            requestTimeout,
+				ShutdownTimeout:           shutdownTimeout,
+				CORSAllowedOrigins:        corsAllowedOrigins,
+				BasicAuth:                 basicAuth,
+				StaffPassword:             staffPassword,
+				Realm:                     realm,
+				AuthSalts:                 authSalts,
+				DevMode:                   devMode,
+				WithCache:                 withCache,
+				ClearCache:                cc,
+				LogExcludeAgents:          logExcludeAgents,
+				LogExcludeIPs:             logExcludeIPs,
+				TrustedProxies:            trustedProxies,
+				Redactor:                  redactor,
+				WithETag:                  withETag,
+				Metrics:                   metrics,
+				DownloadRequestsPerMinute: downloadReqRate,
+				DownloadRequestBurst:      downloadReqBurst,
 			})
 			if err != nil {
 				return err
 			}
 			gr.Add(func() error { return server.Start() }, func(_ error) { server.Stop() })
 
-			return gr.Run()
+			// the workers and the server are stopped and drained before the DB is closed
+			err = gr.Run()
+			var signal run.SignalError
+			if errors.As(err, &signal) {
+				logger.Info("shutdown complete", zap.Stringer("signal", signal.Signal))
+				return nil
+			}
+			return err
 		},
 	}
 }
@@ -16,7 +16,7 @@ import (
 func storeFlagSet() *flag.FlagSet {
 	fs := flag.NewFlagSet("store", flag.ExitOnError)
 
-	fs.StringVar(&dbStorePath, "db-path", ":memory:", "DB Store path")
+	dbFlags(fs)
 	fs.BoolVar(&withPreloading, "with-preloading", false, "with auto DB preloading")
 
 	return fs
@@ -27,12 +27,12 @@ func dumpObjectsCommand() *ffcli.Command {
 		Name:    `dump-objects`,
 		FlagSet: storeFlagSet(),
 		Options: []ff.Option{ff.WithEnvVarNoPrefix()},
-		Exec: func(_ context.Context, _ []string) error {
+		Exec: func(ctx context.Context, _ []string) error {
 			logger, err := loggerFromArgs(verbose, logFormat)
 			if err != nil {
 				return err
 			}
-			db, err := dbFromArgs(dbStorePath, logger)
+			db, err := dbFromArgs(ctx, dbStorePath, logger)
 			if err != nil {
 				return err
 			}
@@ -46,7 +46,6 @@ func dumpObjectsCommand() *ffcli.Command {
 				return err
 			}
 
-			ctx := context.Background()
 			input := &yolopb.DevDumpObjects_Request{
 				WithPreloading: true,
 			}
@@ -71,7 +70,7 @@ func treeCommand() *ffcli.Command {
 			if err != nil {
 				return err
 			}
-			db, err := dbFromArgs(dbStorePath, logger)
+			db, err := dbFromArgs(ctx, dbStorePath, logger)
 			if err != nil {
 				return err
 			}
@@ -104,12 +103,12 @@ func infoCommand() *ffcli.Command {
 		Name:    `info`,
 		FlagSet: storeFlagSet(),
 		Options: []ff.Option{ff.WithEnvVarNoPrefix()},
-		Exec: func(_ context.Context, _ []string) error {
+		Exec: func(ctx context.Context, _ []string) error {
 			logger, err := loggerFromArgs(verbose, logFormat)
 			if err != nil {
 				return err
 			}
-			db, err := dbFromArgs(dbStorePath, logger)
+			db, err := dbFromArgs(ctx, dbStorePath, logger)
 			if err != nil {
 				return err
 			}
@@ -123,7 +122,6 @@ func infoCommand() *ffcli.Command {
 				return err
 			}
 
-			ctx := context.Background()
 			ret, err := svc.Status(ctx, nil)
 			if err != nil {
 				return err
@@ -134,3 +132,37 @@ func infoCommand() *ffcli.Command {
 		},
 	}
 }
+
+func gcCommand() *ffcli.Command {
+	fs := storeFlagSet()
+	var artifactsCachePath string
+	fs.StringVar(&artifactsCachePath, "artifacts-cache-path", "", "Artifacts caching path")
+
+	return &ffcli.Command{
+		Name:      `gc`,
+		ShortHelp: `Remove orphan objects from the store`,
+		FlagSet:   fs,
+		Options:   []ff.Option{ff.WithEnvVarNoPrefix()},
+		Exec: func(ctx context.Context, _ []string) error {
+			logger, err := loggerFromArgs(verbose, logFormat)
+			if err != nil {
+				return err
+			}
+			db, err := dbFromArgs(ctx, dbStorePath, logger)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			svc, err := yolosvc.NewService(db, yolosvc.ServiceOpts{
+				Logger:             logger,
+				ArtifactsCachePath: artifactsCachePath,
+			})
+			if err != nil {
+				return err
+			}
+
+			return svc.GCWorker(ctx, yolosvc.GCWorkerOpts{Logger: logger, Once: true})
+		},
+	}
+}
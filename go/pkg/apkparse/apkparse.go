@@ -0,0 +1,293 @@
+// Package apkparse reads the metadata of Android application packages (.apk),
+// decoding their binary AndroidManifest.xml without aapt.
+package apkparse
+
+import (
+	"archive/zip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"unicode/utf16"
+)
+
+const (
+	manifestPath    = "AndroidManifest.xml"
+	maxManifestSize = 8 << 20
+
+	// chunk types, see ResourceTypes.h in the Android framework
+	chunkStringPool   = 0x0001
+	chunkXML          = 0x0003
+	chunkResourceMap  = 0x0180
+	chunkStartElement = 0x0102
+
+	stringPoolUTF8 = 1 << 8
+	noEntry        = 0xffffffff
+
+	// typed values
+	typeReference = 0x01
+	typeString    = 0x03
+	typeIntDec    = 0x10
+	typeIntHex    = 0x11
+
+	// attribute resource IDs, the names can be stripped by obfuscators
+	attrVersionCode = 0x0101021b
+	attrVersionName = 0x0101021c
+)
+
+var (
+	ErrNoManifest      = errors.New("apkparse: no AndroidManifest.xml")
+	ErrInvalidManifest = errors.New("apkparse: invalid binary AndroidManifest.xml")
+)
+
+type Info struct {
+	// PackageName is the application ID (i.e, tech.berty.android)
+	PackageName string
+	// VersionCode is the internal version number
+	VersionCode int64
+	// VersionName is the version displayed to the users, "@0x7f..." if it is a resource reference
+	VersionName string
+}
+
+// ParseFile returns the metadata of the APK at path
+func ParseFile(path string) (*Info, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	stat, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return Parse(f, stat.Size())
+}
+
+// Parse returns the metadata of an APK, read from its binary manifest
+func Parse(r io.ReaderAt, size int64) (*Info, error) {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("apkparse: invalid archive: %w", err)
+	}
+
+	for _, file := range archive.File {
+		if file.Name != manifestPath {
+			continue
+		}
+		rc, err := file.Open()
+		if err != nil {
+			return nil, fmt.Errorf("apkparse: open %s: %w", file.Name, err)
+		}
+		data, err := ioutil.ReadAll(io.LimitReader(rc, maxManifestSize))
+		rc.Close()
+		if err != nil {
+			return nil, fmt.Errorf("apkparse: read %s: %w", file.Name, err)
+		}
+		return ParseManifest(data)
+	}
+	return nil, ErrNoManifest
+}
+
+// ParseManifest decodes the attributes of the <manifest> element of a binary AndroidManifest.xml
+func ParseManifest(data []byte) (*Info, error) {
+	if len(data) < 8 || binary.LittleEndian.Uint16(data) != chunkXML {
+		return nil, ErrInvalidManifest
+	}
+
+	var (
+		pool        []string
+		resourceIDs []uint32
+	)
+	offset := int(binary.LittleEndian.Uint16(data[2:]))
+	for offset+8 <= len(data) {
+		chunkType := binary.LittleEndian.Uint16(data[offset:])
+		chunkSize := int(binary.LittleEndian.Uint32(data[offset+4:]))
+		if chunkSize < 8 || offset+chunkSize > len(data) {
+			return nil, ErrInvalidManifest
+		}
+		chunk := data[offset : offset+chunkSize]
+
+		switch chunkType {
+		case chunkStringPool:
+			var err error
+			if pool, err = parseStringPool(chunk); err != nil {
+				return nil, err
+			}
+		case chunkResourceMap:
+			headerSize := int(binary.LittleEndian.Uint16(chunk[2:]))
+			for i := headerSize; i+4 <= len(chunk); i += 4 {
+				resourceIDs = append(resourceIDs, binary.LittleEndian.Uint32(chunk[i:]))
+			}
+		case chunkStartElement:
+			// the first element is the root <manifest>
+			return parseManifestElement(chunk, pool, resourceIDs)
+		}
+		offset += chunkSize
+	}
+	return nil, ErrInvalidManifest
+}
+
+func parseManifestElement(chunk []byte, pool []string, resourceIDs []uint32) (*Info, error) {
+	headerSize := int(binary.LittleEndian.Uint16(chunk[2:]))
+	if headerSize+20 > len(chunk) {
+		return nil, ErrInvalidManifest
+	}
+	ext := chunk[headerSize:]
+	if name := poolString(pool, binary.LittleEndian.Uint32(ext[4:])); name != "manifest" {
+		return nil, fmt.Errorf("apkparse: unexpected root element: %q", name)
+	}
+	attributeStart := int(binary.LittleEndian.Uint16(ext[8:]))
+	attributeSize := int(binary.LittleEndian.Uint16(ext[10:]))
+	attributeCount := int(binary.LittleEndian.Uint16(ext[12:]))
+	if attributeSize < 20 || attributeStart+attributeCount*attributeSize > len(ext) {
+		return nil, ErrInvalidManifest
+	}
+
+	info := Info{}
+	for i := 0; i < attributeCount; i++ {
+		attr := ext[attributeStart+i*attributeSize:]
+		nameIndex := binary.LittleEndian.Uint32(attr[4:])
+		rawValue := binary.LittleEndian.Uint32(attr[8:])
+		dataType := attr[15]
+		value := binary.LittleEndian.Uint32(attr[16:])
+
+		name := poolString(pool, nameIndex)
+		if int(nameIndex) < len(resourceIDs) {
+			switch resourceIDs[nameIndex] {
+			case attrVersionCode:
+				name = "versionCode"
+			case attrVersionName:
+				name = "versionName"
+			}
+		}
+
+		switch name {
+		case "package":
+			info.PackageName = attributeString(pool, rawValue, dataType, value)
+		case "versionName":
+			info.VersionName = attributeString(pool, rawValue, dataType, value)
+		case "versionCode":
+			switch dataType {
+			case typeIntDec, typeIntHex:
+				info.VersionCode = int64(value)
+			default:
+				code, err := strconv.ParseInt(attributeString(pool, rawValue, dataType, value), 10, 64)
+				if err != nil {
+					return nil, fmt.Errorf("apkparse: invalid versionCode: %w", err)
+				}
+				info.VersionCode = code
+			}
+		}
+	}
+	if info.PackageName == "" {
+		return nil, fmt.Errorf("apkparse: missing package name")
+	}
+	return &info, nil
+}
+
+func attributeString(pool []string, rawValue uint32, dataType uint8, value uint32) string {
+	switch {
+	case rawValue != noEntry:
+		return poolString(pool, rawValue)
+	case dataType == typeString:
+		return poolString(pool, value)
+	case dataType == typeReference:
+		return fmt.Sprintf("@0x%08x", value)
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+func poolString(pool []string, index uint32) string {
+	if index == noEntry || int(index) >= len(pool) {
+		return ""
+	}
+	return pool[index]
+}
+
+func parseStringPool(chunk []byte) ([]string, error) {
+	if len(chunk) < 28 {
+		return nil, ErrInvalidManifest
+	}
+	headerSize := int(binary.LittleEndian.Uint16(chunk[2:]))
+	count := int(binary.LittleEndian.Uint32(chunk[8:]))
+	flags := binary.LittleEndian.Uint32(chunk[16:])
+	stringsStart := int(binary.LittleEndian.Uint32(chunk[20:]))
+	if headerSize+count*4 > len(chunk) || stringsStart > len(chunk) {
+		return nil, ErrInvalidManifest
+	}
+
+	pool := make([]string, count)
+	for i := range pool {
+		offset := stringsStart + int(binary.LittleEndian.Uint32(chunk[headerSize+i*4:]))
+		var (
+			value string
+			ok    bool
+		)
+		if flags&stringPoolUTF8 != 0 {
+			value, ok = decodeUTF8String(chunk, offset)
+		} else {
+			value, ok = decodeUTF16String(chunk, offset)
+		}
+		if !ok {
+			return nil, ErrInvalidManifest
+		}
+		pool[i] = value
+	}
+	return pool, nil
+}
+
+// decodeUTF8String reads a string prefixed by its UTF-16 length then its UTF-8 length, on 1 or 2 bytes each
+func decodeUTF8String(chunk []byte, offset int) (string, bool) {
+	_, offset, ok := decodeUTF8Length(chunk, offset) // UTF-16 length, unused
+	if !ok {
+		return "", false
+	}
+	length, offset, ok := decodeUTF8Length(chunk, offset)
+	if !ok || offset+length > len(chunk) {
+		return "", false
+	}
+	return string(chunk[offset : offset+length]), true
+}
+
+func decodeUTF8Length(chunk []byte, offset int) (int, int, bool) {
+	if offset >= len(chunk) {
+		return 0, 0, false
+	}
+	length := int(chunk[offset])
+	if length&0x80 == 0 {
+		return length, offset + 1, true
+	}
+	if offset+1 >= len(chunk) {
+		return 0, 0, false
+	}
+	return (length&0x7f)<<8 | int(chunk[offset+1]), offset + 2, true
+}
+
+// decodeUTF16String reads a string prefixed by its length in UTF-16 units, on 1 or 2 units
+func decodeUTF16String(chunk []byte, offset int) (string, bool) {
+	if offset+2 > len(chunk) {
+		return "", false
+	}
+	length := int(binary.LittleEndian.Uint16(chunk[offset:]))
+	offset += 2
+	if length&0x8000 != 0 {
+		if offset+2 > len(chunk) {
+			return "", false
+		}
+		length = (length&0x7fff)<<16 | int(binary.LittleEndian.Uint16(chunk[offset:]))
+		offset += 2
+	}
+	if offset+length*2 > len(chunk) {
+		return "", false
+	}
+	units := make([]uint16, length)
+	for i := range units {
+		units[i] = binary.LittleEndian.Uint16(chunk[offset+i*2:])
+	}
+	return string(utf16.Decode(units)), true
+}
@@ -0,0 +1,32 @@
+package apkparse
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFile(t *testing.T) {
+	expected := &Info{PackageName: "tech.berty.android", VersionCode: 42, VersionName: "1.2.3"}
+
+	// the string pool of the manifests is either UTF-16 (aapt) or UTF-8 (aapt2)
+	for _, path := range []string{"testdata/sample.apk", "testdata/sample-utf8.apk"} {
+		info, err := ParseFile(path)
+		require.NoError(t, err, path)
+		assert.Equal(t, expected, info, path)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	_, err := ParseManifest([]byte("<manifest package=\"tech.berty.android\"/>"))
+	assert.Equal(t, ErrInvalidManifest, err)
+
+	_, err = ParseManifest([]byte{0x03, 0x00, 0x08, 0x00, 0xff, 0xff, 0x00, 0x00})
+	assert.Error(t, err)
+
+	notZip := bytes.NewReader([]byte("not a zip"))
+	_, err = Parse(notZip, notZip.Size())
+	assert.Error(t, err)
+}
@@ -0,0 +1,97 @@
+// Package appcast generates the Sparkle appcast feeds, used by the macOS apps to update themselves
+package appcast
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+const SparkleNamespace = "http://www.andymatuschak.org/xml-namespaces/sparkle"
+
+// Feed returns an appcast with a release per item, the most recent ones should be first
+func Feed(title, link string, items []*Item) Appcast {
+	return Appcast{
+		Version:   "2.0",
+		SparkleNS: SparkleNamespace,
+		Channel: Channel{
+			Title: title,
+			Link:  link,
+			Items: items,
+		},
+	}
+}
+
+type Appcast struct {
+	XMLName   xml.Name `xml:"rss"`
+	Version   string   `xml:"version,attr"`
+	SparkleNS string   `xml:"xmlns:sparkle,attr"`
+	Channel   Channel  `xml:"channel"`
+}
+
+func (a *Appcast) Marshal() ([]byte, error) {
+	out, err := xml.MarshalIndent(a, "", "\t")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), out...), nil
+}
+
+type Channel struct {
+	Title string  `xml:"title"`
+	Link  string  `xml:"link,omitempty"`
+	Items []*Item `xml:"item"`
+}
+
+type Item struct {
+	Title   string `xml:"title"`
+	PubDate string `xml:"pubDate,omitempty"`
+	// Version is the build number (CFBundleVersion) compared by Sparkle to find the updates
+	Version string `xml:"sparkle:version"`
+	// ShortVersionString is the version displayed to the users (CFBundleShortVersionString)
+	ShortVersionString string    `xml:"sparkle:shortVersionString,omitempty"`
+	Description        string    `xml:"description,omitempty"`
+	Enclosure          Enclosure `xml:"enclosure"`
+}
+
+// SetPubDate formats the publication date of a release as expected by Sparkle (RFC 1123)
+func (i *Item) SetPubDate(date time.Time) {
+	i.PubDate = date.UTC().Format(time.RFC1123Z)
+}
+
+type Enclosure struct {
+	URL    string `xml:"url,attr"`
+	Length int64  `xml:"length,attr"`
+	Type   string `xml:"type,attr"`
+	// EdSignature is the EdDSA (ed25519) signature of the file, required by Sparkle 2 unless the app is code signed
+	EdSignature string `xml:"sparkle:edSignature,attr,omitempty"`
+}
+
+// ParsePrivateKey decodes an ed25519 private key exported by the generate_keys tool of Sparkle (base64 seed)
+func ParsePrivateKey(encoded string) (ed25519.PrivateKey, error) {
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("appcast: invalid private key: %w", err)
+	}
+	switch len(raw) {
+	case ed25519.SeedSize:
+		return ed25519.NewKeyFromSeed(raw), nil
+	case ed25519.PrivateKeySize:
+		return ed25519.PrivateKey(raw), nil
+	}
+	return nil, fmt.Errorf("appcast: invalid private key: expected %d or %d bytes, got %d", ed25519.SeedSize, ed25519.PrivateKeySize, len(raw))
+}
+
+// Sign returns the EdDSA signature of a release file, like the sign_update tool of Sparkle.
+// ed25519 signs the whole content, it is read in memory.
+func Sign(key ed25519.PrivateKey, r io.Reader) (string, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return "", fmt.Errorf("appcast: read file: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(ed25519.Sign(key, content)), nil
+}
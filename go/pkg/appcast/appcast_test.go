@@ -0,0 +1,89 @@
+package appcast
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeed(t *testing.T) {
+	item := &Item{
+		Title:              "Berty 2.3.0",
+		Version:            "1234",
+		ShortVersionString: "2.3.0",
+		Description:        "fix: <crash> & leaks",
+		Enclosure: Enclosure{
+			URL:         "https://yolo.example.com/api/artifact-dl/dmg?sign=abc&expires=1",
+			Length:      42,
+			Type:        "application/x-apple-diskimage",
+			EdSignature: "c2lnbmF0dXJl",
+		},
+	}
+	item.SetPubDate(time.Date(2022, 9, 1, 12, 0, 0, 0, time.FixedZone("CEST", 2*60*60)))
+	assert.Equal(t, "Thu, 01 Sep 2022 10:00:00 +0000", item.PubDate)
+
+	feed := Feed("Berty", "https://yolo.example.com/api/appcast/p:berty.xml", []*Item{item, {Title: "Berty 2.2.0", Version: "1200"}})
+	out, err := feed.Marshal()
+	require.NoError(t, err)
+	content := string(out)
+	assert.True(t, strings.HasPrefix(content, `<?xml version="1.0" encoding="UTF-8"?>`))
+	assert.Contains(t, content, `<rss version="2.0" xmlns:sparkle="http://www.andymatuschak.org/xml-namespaces/sparkle">`)
+	assert.Contains(t, content, `<sparkle:version>1234</sparkle:version>`)
+	assert.Contains(t, content, `<sparkle:shortVersionString>2.3.0</sparkle:shortVersionString>`)
+	assert.Contains(t, content, `<enclosure url="https://yolo.example.com/api/artifact-dl/dmg?sign=abc&amp;expires=1" length="42" type="application/x-apple-diskimage" sparkle:edSignature="c2lnbmF0dXJl"></enclosure>`)
+	assert.Contains(t, content, `<description>fix: &lt;crash&gt; &amp; leaks</description>`)
+	assert.Equal(t, 1, strings.Count(content, "sparkle:edSignature"))
+
+	// well-formed, with the sparkle namespace resolved
+	var parsed struct {
+		Items []struct {
+			Version   string `xml:"http://www.andymatuschak.org/xml-namespaces/sparkle version"`
+			Enclosure struct {
+				URL         string `xml:"url,attr"`
+				EdSignature string `xml:"http://www.andymatuschak.org/xml-namespaces/sparkle edSignature,attr"`
+			} `xml:"enclosure"`
+		} `xml:"channel>item"`
+	}
+	require.NoError(t, xml.Unmarshal(out, &parsed))
+	require.Len(t, parsed.Items, 2)
+	assert.Equal(t, "1234", parsed.Items[0].Version)
+	assert.Equal(t, item.Enclosure.URL, parsed.Items[0].Enclosure.URL)
+	assert.Equal(t, "c2lnbmF0dXJl", parsed.Items[0].Enclosure.EdSignature)
+	assert.Equal(t, "1200", parsed.Items[1].Version)
+}
+
+func TestSign(t *testing.T) {
+	seed := bytes.Repeat([]byte{7}, ed25519.SeedSize)
+	key, err := ParsePrivateKey(base64.StdEncoding.EncodeToString(seed) + "\n")
+	require.NoError(t, err)
+	full, err := ParsePrivateKey(base64.StdEncoding.EncodeToString(key))
+	require.NoError(t, err)
+	assert.Equal(t, key, full)
+
+	_, err = ParsePrivateKey(base64.StdEncoding.EncodeToString([]byte("too short")))
+	assert.Error(t, err)
+	_, err = ParsePrivateKey("not base64!")
+	assert.Error(t, err)
+
+	content := "dmg content"
+	signature, err := Sign(key, strings.NewReader(content))
+	require.NoError(t, err)
+	raw, err := base64.StdEncoding.DecodeString(signature)
+	require.NoError(t, err)
+	assert.True(t, ed25519.Verify(key.Public().(ed25519.PublicKey), []byte(content), raw))
+
+	_, err = Sign(key, io.MultiReader(strings.NewReader(content), errReader{}))
+	assert.Error(t, err)
+}
+
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) { return 0, io.ErrUnexpectedEOF }
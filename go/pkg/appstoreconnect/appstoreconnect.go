@@ -0,0 +1,214 @@
+// Package appstoreconnect is a minimal, read-only App Store Connect API client, authenticated with an API key
+package appstoreconnect
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultBaseAPI = "https://api.appstoreconnect.apple.com/v1"
+	audience       = "appstoreconnect-v1"
+	// tokenTTL is the validity of the signed tokens, App Store Connect rejects the ones valid for more than 20 minutes
+	tokenTTL = 20 * time.Minute
+)
+
+type Client struct {
+	baseAPI    string
+	httpClient *http.Client
+	keyID      string
+	issuerID   string
+	privateKey *ecdsa.PrivateKey
+	now        func() time.Time
+
+	tokenMutex  sync.Mutex
+	token       string
+	tokenExpiry time.Time
+}
+
+// New returns a client authenticated with an API key, the private key is the content of the AuthKey_<keyID>.p8 file
+func New(keyID, issuerID string, privateKeyPEM []byte) (*Client, error) {
+	if keyID == "" || issuerID == "" {
+		return nil, fmt.Errorf("appstoreconnect: key ID and issuer ID are required")
+	}
+	block, _ := pem.Decode(privateKeyPEM)
+	if block == nil {
+		return nil, fmt.Errorf("appstoreconnect: invalid private key: no PEM data")
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("appstoreconnect: invalid private key: %w", err)
+	}
+	privateKey, ok := parsed.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("appstoreconnect: invalid private key: expected an ECDSA key")
+	}
+	return &Client{
+		baseAPI:    defaultBaseAPI,
+		httpClient: &http.Client{},
+		keyID:      keyID,
+		issuerID:   issuerID,
+		privateKey: privateKey,
+		now:        time.Now,
+	}, nil
+}
+
+// ListBuilds returns a page of builds of an app with their beta details, most recently uploaded first.
+// The next pages are fetched with the Links.Next URL of the previous response.
+func (c *Client) ListBuilds(ctx context.Context, appID string, limit int, next string) (*ListBuildsResponse, error) {
+	endpoint := next
+	if endpoint == "" {
+		query := url.Values{}
+		query.Set("filter[app]", appID)
+		query.Set("include", "buildBetaDetail,preReleaseVersion")
+		query.Set("sort", "-uploadedDate")
+		if limit > 0 {
+			query.Set("limit", fmt.Sprint(limit))
+		}
+		endpoint = c.baseAPI + "/builds?" + query.Encode()
+	}
+
+	var result ListBuildsResponse
+	err := c.doGet(ctx, endpoint, &result)
+	return &result, err
+}
+
+func (c *Client) doGet(ctx context.Context, endpoint string, dest interface{}) error {
+	token, err := c.bearerToken()
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("appstoreconnect: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("appstoreconnect: GET %s: %w", endpoint, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("appstoreconnect: GET %s: %s: %s", endpoint, resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(dest); err != nil {
+		return fmt.Errorf("appstoreconnect: GET %s: %w", endpoint, err)
+	}
+	return nil
+}
+
+// bearerToken returns a signed token (ES256 JWT), reused until shortly before its expiry
+func (c *Client) bearerToken() (string, error) {
+	c.tokenMutex.Lock()
+	defer c.tokenMutex.Unlock()
+
+	now := c.now()
+	if c.token != "" && now.Add(time.Minute).Before(c.tokenExpiry) {
+		return c.token, nil
+	}
+
+	expiry := now.Add(tokenTTL)
+	header, _ := json.Marshal(map[string]string{"alg": "ES256", "kid": c.keyID, "typ": "JWT"})
+	claims, _ := json.Marshal(map[string]interface{}{
+		"iss": c.issuerID,
+		"iat": now.Unix(),
+		"exp": expiry.Unix(),
+		"aud": audience,
+	})
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+
+	digest := sha256.Sum256([]byte(unsigned))
+	r, s, err := ecdsa.Sign(rand.Reader, c.privateKey, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("appstoreconnect: sign token: %w", err)
+	}
+	// JWS signatures are the fixed-size concatenation of r and s
+	signature := make([]byte, 64)
+	r.FillBytes(signature[:32])
+	s.FillBytes(signature[32:])
+
+	c.token = unsigned + "." + base64.RawURLEncoding.EncodeToString(signature)
+	c.tokenExpiry = expiry
+	return c.token, nil
+}
+
+// ListBuildsResponse is a JSON:API document, the beta details and versions of the builds are in Included
+type ListBuildsResponse struct {
+	Data     []*Build   `json:"data"`
+	Included []*Related `json:"included"`
+	Links    struct {
+		Next string `json:"next"`
+	} `json:"links"`
+}
+
+type Build struct {
+	ID         string `json:"id"`
+	Attributes struct {
+		// Version is the build number (CFBundleVersion)
+		Version         string    `json:"version"`
+		UploadedDate    time.Time `json:"uploadedDate"`
+		ExpirationDate  time.Time `json:"expirationDate"`
+		Expired         bool      `json:"expired"`
+		ProcessingState string    `json:"processingState"`
+		MinOsVersion    string    `json:"minOsVersion"`
+	} `json:"attributes"`
+	Relationships struct {
+		BuildBetaDetail   Relationship `json:"buildBetaDetail"`
+		PreReleaseVersion Relationship `json:"preReleaseVersion"`
+	} `json:"relationships"`
+}
+
+type Relationship struct {
+	Data *struct {
+		Type string `json:"type"`
+		ID   string `json:"id"`
+	} `json:"data"`
+}
+
+// Related is an included buildBetaDetails or preReleaseVersions resource, only the attributes of its type are set
+type Related struct {
+	Type       string `json:"type"`
+	ID         string `json:"id"`
+	Attributes struct {
+		// buildBetaDetails
+		InternalBuildState string `json:"internalBuildState"`
+		ExternalBuildState string `json:"externalBuildState"`
+		// preReleaseVersions, Version is the marketing version (CFBundleShortVersionString)
+		Version  string `json:"version"`
+		Platform string `json:"platform"`
+	} `json:"attributes"`
+}
+
+// Related returns the included resource of a relationship of the response, nil if missing
+func (r *ListBuildsResponse) Related(relationship Relationship) *Related {
+	if relationship.Data == nil {
+		return nil
+	}
+	for _, related := range r.Included {
+		if related.Type == relationship.Data.Type && related.ID == relationship.Data.ID {
+			return related
+		}
+	}
+	return nil
+}
+
+// BuildURL returns the address of a build on the App Store Connect website
+func BuildURL(appID, buildID string) string {
+	return fmt.Sprintf("https://appstoreconnect.apple.com/apps/%s/testflight/ios/%s", url.PathEscape(appID), url.PathEscape(buildID))
+}
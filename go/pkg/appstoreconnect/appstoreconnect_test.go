@@ -0,0 +1,126 @@
+package appstoreconnect
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testingKey(t *testing.T) (*ecdsa.PrivateKey, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return key, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func TestNew(t *testing.T) {
+	_, p8 := testingKey(t)
+	_, err := New("KEY123", "issuer", p8)
+	require.NoError(t, err)
+
+	_, err = New("", "issuer", p8)
+	assert.Error(t, err)
+	_, err = New("KEY123", "issuer", []byte("not a key"))
+	assert.Error(t, err)
+}
+
+func TestBearerToken(t *testing.T) {
+	key, p8 := testingKey(t)
+	c, err := New("KEY123", "issuer", p8)
+	require.NoError(t, err)
+	now := time.Unix(1600000000, 0)
+	c.now = func() time.Time { return now }
+
+	token, err := c.bearerToken()
+	require.NoError(t, err)
+	parts := strings.Split(token, ".")
+	require.Len(t, parts, 3)
+
+	var header, claims map[string]interface{}
+	raw, err := base64.RawURLEncoding.DecodeString(parts[0])
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(raw, &header))
+	assert.Equal(t, map[string]interface{}{"alg": "ES256", "kid": "KEY123", "typ": "JWT"}, header)
+	raw, err = base64.RawURLEncoding.DecodeString(parts[1])
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(raw, &claims))
+	assert.Equal(t, "issuer", claims["iss"])
+	assert.Equal(t, "appstoreconnect-v1", claims["aud"])
+	assert.Equal(t, float64(now.Add(20*time.Minute).Unix()), claims["exp"])
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	require.NoError(t, err)
+	require.Len(t, signature, 64)
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	assert.True(t, ecdsa.Verify(&key.PublicKey, digest[:], new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:])))
+
+	// the token is reused until shortly before its expiry
+	now = now.Add(10 * time.Minute)
+	again, err := c.bearerToken()
+	require.NoError(t, err)
+	assert.Equal(t, token, again)
+	now = now.Add(10 * time.Minute)
+	again, err = c.bearerToken()
+	require.NoError(t, err)
+	assert.NotEqual(t, token, again)
+}
+
+func TestListBuilds(t *testing.T) {
+	_, p8 := testingKey(t)
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	mux.HandleFunc("/v1/builds", func(w http.ResponseWriter, r *http.Request) {
+		assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "Bearer "))
+		assert.Equal(t, "1234", r.URL.Query().Get("filter[app]"))
+		assert.Equal(t, "-uploadedDate", r.URL.Query().Get("sort"))
+		assert.Equal(t, "10", r.URL.Query().Get("limit"))
+		_, _ = w.Write([]byte(`{
+			"data": [{
+				"type": "builds", "id": "b1",
+				"attributes": {"version": "45", "uploadedDate": "2021-03-04T05:06:07-08:00", "processingState": "VALID"},
+				"relationships": {
+					"buildBetaDetail": {"data": {"type": "buildBetaDetails", "id": "d1"}},
+					"preReleaseVersion": {"data": {"type": "preReleaseVersions", "id": "v1"}}
+				}
+			}],
+			"included": [
+				{"type": "buildBetaDetails", "id": "d1", "attributes": {"externalBuildState": "READY_FOR_BETA_TESTING"}},
+				{"type": "preReleaseVersions", "id": "v1", "attributes": {"version": "1.2.3", "platform": "IOS"}}
+			],
+			"links": {"next": "` + "https://api.appstoreconnect.apple.com/v1/builds?cursor=next" + `"}
+		}`))
+	})
+	c, err := New("KEY123", "issuer", p8)
+	require.NoError(t, err)
+	c.baseAPI = server.URL + "/v1"
+	c.httpClient = server.Client()
+
+	resp, err := c.ListBuilds(context.Background(), "1234", 10, "")
+	require.NoError(t, err)
+	require.Len(t, resp.Data, 1)
+	build := resp.Data[0]
+	assert.Equal(t, "45", build.Attributes.Version)
+	assert.Equal(t, "VALID", build.Attributes.ProcessingState)
+	assert.Equal(t, "READY_FOR_BETA_TESTING", resp.Related(build.Relationships.BuildBetaDetail).Attributes.ExternalBuildState)
+	assert.Equal(t, "1.2.3", resp.Related(build.Relationships.PreReleaseVersion).Attributes.Version)
+	assert.Nil(t, resp.Related(Relationship{}))
+	assert.Equal(t, "https://api.appstoreconnect.apple.com/v1/builds?cursor=next", resp.Links.Next)
+}
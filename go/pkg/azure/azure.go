@@ -0,0 +1,184 @@
+// Package azure is a minimal Azure DevOps (Azure Pipelines) client, authenticated with a personal access token
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const apiVersion = "7.0"
+
+type Client struct {
+	orgURL     string // i.e, https://dev.azure.com/berty
+	pat        string
+	httpClient *http.Client
+}
+
+// New returns a client of an organization (i.e, https://dev.azure.com/berty) authenticated with a personal access token
+func New(orgURL, pat string) (*Client, error) {
+	u, err := url.Parse(orgURL)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("azure: invalid organization URL: %q", orgURL)
+	}
+	if pat == "" {
+		return nil, fmt.Errorf("azure: missing personal access token")
+	}
+	return &Client{
+		orgURL:     strings.TrimSuffix(orgURL, "/"),
+		pat:        pat,
+		httpClient: &http.Client{},
+	}, nil
+}
+
+// OrgURL returns the URL of the organization, without trailing slash
+func (c *Client) OrgURL() string { return c.orgURL }
+
+type ListBuildsOptions struct {
+	Top int
+	// MinTime only returns the builds finished after it, most recently finished first
+	MinTime time.Time
+	// StatusFilter only returns the builds of these statuses (i.e, "inProgress,notStarted")
+	StatusFilter string
+}
+
+// ListBuilds returns the builds of a project (name or ID), most recently queued first
+func (c *Client) ListBuilds(ctx context.Context, project string, opts ListBuildsOptions) ([]*Build, error) {
+	query := url.Values{}
+	query.Set("queryOrder", "queueTimeDescending")
+	if !opts.MinTime.IsZero() {
+		query.Set("queryOrder", "finishTimeDescending")
+		query.Set("minTime", opts.MinTime.UTC().Format(time.RFC3339))
+	}
+	if opts.Top > 0 {
+		query.Set("$top", fmt.Sprint(opts.Top))
+	}
+	if opts.StatusFilter != "" {
+		query.Set("statusFilter", opts.StatusFilter)
+	}
+
+	var result struct {
+		Value []*Build `json:"value"`
+	}
+	err := c.doGet(ctx, "/"+url.PathEscape(project)+"/_apis/build/builds", query, &result)
+	return result.Value, err
+}
+
+// ListBuildArtifacts returns the artifacts published by a build
+func (c *Client) ListBuildArtifacts(ctx context.Context, project string, buildID int) ([]*BuildArtifact, error) {
+	var result struct {
+		Value []*BuildArtifact `json:"value"`
+	}
+	err := c.doGet(ctx, fmt.Sprintf("/%s/_apis/build/builds/%d/artifacts", url.PathEscape(project), buildID), url.Values{}, &result)
+	return result.Value, err
+}
+
+// Download streams the archive (.zip) of an artifact to w, from its download URL
+func (c *Client) Download(ctx context.Context, downloadURL string, w io.Writer) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, downloadURL, nil)
+	if err != nil {
+		return fmt.Errorf("azure: %w", err)
+	}
+	req.SetBasicAuth("", c.pat)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("azure: download %s: %w", downloadURL, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || strings.HasPrefix(resp.Header.Get("Content-Type"), "text/html") {
+		return fmt.Errorf("azure: download %s: %s", downloadURL, resp.Status)
+	}
+
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
+func (c *Client) doGet(ctx context.Context, path string, query url.Values, dest interface{}) error {
+	query.Set("api-version", apiVersion)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.orgURL+path+"?"+query.Encode(), nil)
+	if err != nil {
+		return fmt.Errorf("azure: %w", err)
+	}
+	req.SetBasicAuth("", c.pat)
+	req.Header.Set("Accept", "application/json")
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("azure: GET %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+	// an invalid token is redirected to the sign-in page instead of a 401
+	if resp.StatusCode != http.StatusOK || !strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("azure: GET %s: %s: %s", path, resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(dest); err != nil {
+		return fmt.Errorf("azure: GET %s: %w", path, err)
+	}
+	return nil
+}
+
+type Build struct {
+	ID            int               `json:"id"`
+	BuildNumber   string            `json:"buildNumber"`
+	Status        string            `json:"status"` // notStarted, postponed, inProgress, cancelling, completed
+	Result        string            `json:"result"` // succeeded, partiallySucceeded, failed, canceled
+	QueueTime     *time.Time        `json:"queueTime"`
+	StartTime     *time.Time        `json:"startTime"`
+	FinishTime    *time.Time        `json:"finishTime"`
+	SourceBranch  string            `json:"sourceBranch"`
+	SourceVersion string            `json:"sourceVersion"`
+	Definition    Definition        `json:"definition"`
+	Project       Project           `json:"project"`
+	Repository    Repository        `json:"repository"`
+	RequestedFor  Identity          `json:"requestedFor"`
+	TriggerInfo   map[string]string `json:"triggerInfo"`
+	Links         Links             `json:"_links"`
+}
+
+type Definition struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type Project struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type Repository struct {
+	ID   string `json:"id"` // "owner/repo" for the GitHub repositories
+	Type string `json:"type"`
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+type Identity struct {
+	DisplayName string `json:"displayName"`
+	UniqueName  string `json:"uniqueName"`
+}
+
+type Links struct {
+	Web Link `json:"web"`
+}
+
+type Link struct {
+	Href string `json:"href"`
+}
+
+type BuildArtifact struct {
+	ID       int              `json:"id"`
+	Name     string           `json:"name"`
+	Resource ArtifactResource `json:"resource"`
+}
+
+type ArtifactResource struct {
+	Type        string `json:"type"` // Container or PipelineArtifact
+	DownloadURL string `json:"downloadUrl"`
+}
@@ -0,0 +1,92 @@
+package azure
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	c, err := New("https://dev.azure.com/berty/", "pat")
+	require.NoError(t, err)
+	assert.Equal(t, "https://dev.azure.com/berty", c.OrgURL())
+
+	_, err = New("berty", "pat")
+	assert.Error(t, err)
+	_, err = New("https://dev.azure.com/berty", "")
+	assert.Error(t, err)
+}
+
+func TestClient(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	authorized := func(w http.ResponseWriter, r *http.Request) bool {
+		_, pat, ok := r.BasicAuth()
+		if !ok || pat != "pat" {
+			// the way Azure DevOps rejects an invalid token
+			http.Redirect(w, r, "/_signin", http.StatusFound)
+			return false
+		}
+		return true
+	}
+	writeJSON := func(w http.ResponseWriter, value interface{}) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8; api-version=7.0")
+		_ = json.NewEncoder(w).Encode(value)
+	}
+	mux.HandleFunc("/berty/yolo app/_apis/build/builds", func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(w, r) {
+			return
+		}
+		assert.Equal(t, "7.0", r.URL.Query().Get("api-version"))
+		assert.Equal(t, "finishTimeDescending", r.URL.Query().Get("queryOrder"))
+		assert.Equal(t, "2022-09-01T00:00:00Z", r.URL.Query().Get("minTime"))
+		assert.Equal(t, "10", r.URL.Query().Get("$top"))
+		writeJSON(w, map[string]interface{}{"count": 1, "value": []*Build{{ID: 42, BuildNumber: "20220901.1"}}})
+	})
+	mux.HandleFunc("/berty/yolo app/_apis/build/builds/42/artifacts", func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(w, r) {
+			return
+		}
+		writeJSON(w, map[string]interface{}{"count": 1, "value": []*BuildArtifact{{ID: 1, Name: "yolo.apk", Resource: ArtifactResource{Type: "PipelineArtifact", DownloadURL: server.URL + "/berty/download"}}}})
+	})
+	mux.HandleFunc("/berty/download", func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(w, r) {
+			return
+		}
+		w.Header().Set("Content-Type", "application/zip")
+		_, _ = w.Write([]byte("zip content"))
+	})
+	mux.HandleFunc("/_signin", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("<html>sign in</html>"))
+	})
+	c, err := New(server.URL+"/berty", "pat")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	builds, err := c.ListBuilds(ctx, "yolo app", ListBuildsOptions{Top: 10, MinTime: time.Date(2022, 9, 1, 0, 0, 0, 0, time.UTC)})
+	require.NoError(t, err)
+	require.Len(t, builds, 1)
+	assert.Equal(t, "20220901.1", builds[0].BuildNumber)
+
+	artifacts, err := c.ListBuildArtifacts(ctx, "yolo app", 42)
+	require.NoError(t, err)
+	require.Len(t, artifacts, 1)
+	assert.Equal(t, "yolo.apk", artifacts[0].Name)
+
+	var buf bytes.Buffer
+	require.NoError(t, c.Download(ctx, artifacts[0].Resource.DownloadURL, &buf))
+	assert.Equal(t, "zip content", buf.String())
+
+	c.pat = "invalid"
+	_, err = c.ListBuildArtifacts(ctx, "yolo app", 42)
+	assert.Error(t, err)
+	assert.Error(t, c.Download(ctx, artifacts[0].Resource.DownloadURL, &buf))
+}
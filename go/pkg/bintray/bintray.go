@@ -81,6 +81,9 @@ func (c Client) GetVersion(subject, repo, pkg, version string) (GetVersionRespon
 	return result, err
 }
 
+// DownloadContent copies the content of a public Bintray file
+//
+// Deprecated: Bintray is sunset, the stored Bintray artifacts are downloaded by the HTTP driver of yolosvc.
 func DownloadContent(url string, w io.Writer) error {
 	resp, err := http.Get(url)
 	if err != nil {
@@ -0,0 +1,45 @@
+// Package buildinfo describes the yolo binary, its variables are set at link time:
+//
+//	go build -ldflags "-X berty.tech/yolo/v2/go/pkg/buildinfo.Version=v2.3.0 -X berty.tech/yolo/v2/go/pkg/buildinfo.Commit=$(git rev-parse HEAD)"
+package buildinfo
+
+import (
+	"runtime"
+	"runtime/debug"
+)
+
+var (
+	// Version is the semantic version of the binary, i.e, the output of "git describe --tags"
+	Version = ""
+	// Commit is the git commit the binary is built from
+	Commit = ""
+	// BuildDate is the date of the build, in RFC 3339
+	BuildDate = ""
+)
+
+type Info struct {
+	Version   string
+	Commit    string
+	BuildDate string
+	GoVersion string
+}
+
+// Get returns the build info, the version falls back to the module version when the binary is
+// installed with "go install berty.tech/yolo/v2/go/cmd/yolo@<version>", and to "dev" otherwise
+func Get() Info {
+	info := Info{
+		Version:   Version,
+		Commit:    Commit,
+		BuildDate: BuildDate,
+		GoVersion: runtime.Version(),
+	}
+	if info.Version == "" {
+		if bi, ok := debug.ReadBuildInfo(); ok && bi.Main.Version != "" && bi.Main.Version != "(devel)" {
+			info.Version = bi.Main.Version
+		}
+	}
+	if info.Version == "" {
+		info.Version = "dev"
+	}
+	return info
+}
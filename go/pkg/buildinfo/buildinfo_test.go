@@ -0,0 +1,25 @@
+package buildinfo
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGet(t *testing.T) {
+	defer func(version, commit, date string) {
+		Version, Commit, BuildDate = version, commit, date
+	}(Version, Commit, BuildDate)
+
+	Version, Commit, BuildDate = "", "", ""
+	info := Get()
+	assert.Equal(t, "dev", info.Version)
+	assert.Empty(t, info.Commit)
+	assert.Empty(t, info.BuildDate)
+	assert.Equal(t, runtime.Version(), info.GoVersion)
+
+	Version, Commit, BuildDate = "v2.3.0", "c7c2062", "2022-09-01T12:00:00Z"
+	info = Get()
+	assert.Equal(t, Info{Version: "v2.3.0", Commit: "c7c2062", BuildDate: "2022-09-01T12:00:00Z", GoVersion: runtime.Version()}, info)
+}
@@ -0,0 +1,178 @@
+// Package firebase is a minimal Firebase App Distribution client, authenticated with a service account
+package firebase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2/jwt"
+)
+
+const (
+	defaultBaseAPI  = "https://firebaseappdistribution.googleapis.com/v1"
+	defaultTokenURL = "https://oauth2.googleapis.com/token"
+	cloudScope      = "https://www.googleapis.com/auth/cloud-platform"
+)
+
+type Client struct {
+	baseAPI    string
+	httpClient *http.Client // authenticated, for the API calls
+	dlClient   *http.Client // anonymous, for the signed binary download links
+}
+
+// serviceAccount holds the fields of interest of a service account key file
+type serviceAccount struct {
+	Type         string `json:"type"`
+	ClientEmail  string `json:"client_email"`
+	PrivateKey   string `json:"private_key"`
+	PrivateKeyID string `json:"private_key_id"`
+	TokenURI     string `json:"token_uri"`
+}
+
+// New returns a client authenticated with the content of a service account key file (JSON)
+func New(serviceAccountJSON []byte) (*Client, error) {
+	var account serviceAccount
+	if err := json.Unmarshal(serviceAccountJSON, &account); err != nil {
+		return nil, fmt.Errorf("firebase: invalid service account: %w", err)
+	}
+	if account.Type != "service_account" || account.ClientEmail == "" || account.PrivateKey == "" {
+		return nil, fmt.Errorf("firebase: invalid service account: missing fields")
+	}
+	if account.TokenURI == "" {
+		account.TokenURI = defaultTokenURL
+	}
+
+	config := jwt.Config{
+		Email:        account.ClientEmail,
+		PrivateKey:   []byte(account.PrivateKey),
+		PrivateKeyID: account.PrivateKeyID,
+		TokenURL:     account.TokenURI,
+		Scopes:       []string{cloudScope},
+	}
+	return &Client{
+		baseAPI:    defaultBaseAPI,
+		httpClient: config.Client(context.Background()),
+		dlClient:   &http.Client{},
+	}, nil
+}
+
+// ListReleases returns a page of releases of an app, most recent first
+func (c *Client) ListReleases(ctx context.Context, appID string, pageSize int, pageToken string) (*ListReleasesResponse, error) {
+	projectNumber, err := ProjectNumber(appID)
+	if err != nil {
+		return nil, err
+	}
+	query := url.Values{}
+	query.Set("orderBy", "createTime desc")
+	if pageSize > 0 {
+		query.Set("pageSize", fmt.Sprint(pageSize))
+	}
+	if pageToken != "" {
+		query.Set("pageToken", pageToken)
+	}
+
+	var result ListReleasesResponse
+	err = c.doGet(ctx, "/projects/"+projectNumber+"/apps/"+appID+"/releases?"+query.Encode(), &result)
+	return &result, err
+}
+
+// GetRelease returns a release by its resource name (projects/*/apps/*/releases/*),
+// its binary download URI is freshly signed and valid for one hour.
+func (c *Client) GetRelease(ctx context.Context, name string) (*Release, error) {
+	var result Release
+	err := c.doGet(ctx, "/"+strings.TrimPrefix(name, "/"), &result)
+	return &result, err
+}
+
+// Download streams the binary of a release to w
+func (c *Client) Download(ctx context.Context, name string, w io.Writer) error {
+	release, err := c.GetRelease(ctx, name)
+	if err != nil {
+		return err
+	}
+	if release.BinaryDownloadURI == "" {
+		return fmt.Errorf("firebase: no binary for release %q", name)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, release.BinaryDownloadURI, nil)
+	if err != nil {
+		return fmt.Errorf("firebase: %w", err)
+	}
+	resp, err := c.dlClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("firebase: download %s: %w", name, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("firebase: download %s: %s", name, resp.Status)
+	}
+
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
+// ProjectNumber extracts the project number of an app ID (i.e, 1:1234567890:android:0a1b2c3d4e5f67890)
+func ProjectNumber(appID string) (string, error) {
+	parts := strings.Split(appID, ":")
+	if len(parts) != 4 || parts[1] == "" {
+		return "", fmt.Errorf("firebase: invalid app ID: %q", appID)
+	}
+	return parts[1], nil
+}
+
+// Platform returns the platform of an app ID, "android" or "ios"
+func Platform(appID string) string {
+	parts := strings.Split(appID, ":")
+	if len(parts) != 4 {
+		return ""
+	}
+	return parts[2]
+}
+
+func (c *Client) doGet(ctx context.Context, path string, dest interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseAPI+path, nil)
+	if err != nil {
+		return fmt.Errorf("firebase: %w", err)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("firebase: GET %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("firebase: GET %s: %s: %s", path, resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(dest); err != nil {
+		return fmt.Errorf("firebase: GET %s: %w", path, err)
+	}
+	return nil
+}
+
+type ListReleasesResponse struct {
+	Releases      []*Release `json:"releases"`
+	NextPageToken string     `json:"nextPageToken"`
+}
+
+type Release struct {
+	Name               string       `json:"name"`
+	ReleaseNotes       ReleaseNotes `json:"releaseNotes"`
+	DisplayVersion     string       `json:"displayVersion"`
+	BuildVersion       string       `json:"buildVersion"`
+	CreateTime         time.Time    `json:"createTime"`
+	FirebaseConsoleURI string       `json:"firebaseConsoleUri"`
+	TestingURI         string       `json:"testingUri"`
+	BinaryDownloadURI  string       `json:"binaryDownloadUri"`
+}
+
+type ReleaseNotes struct {
+	Text string `json:"text"`
+}
@@ -0,0 +1,71 @@
+package firebase
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	_, err := New([]byte(`{"type": "service_account", "client_email": "yolo@project.iam.gserviceaccount.com", "private_key": "key"}`))
+	require.NoError(t, err)
+
+	_, err = New([]byte(`{"type": "authorized_user"}`))
+	assert.Error(t, err)
+	_, err = New([]byte(`not json`))
+	assert.Error(t, err)
+}
+
+func TestAppID(t *testing.T) {
+	number, err := ProjectNumber("1:1234567890:android:0a1b2c3d4e5f67890")
+	require.NoError(t, err)
+	assert.Equal(t, "1234567890", number)
+	assert.Equal(t, "ios", Platform("1:1234567890:ios:0a1b2c3d4e5f67890"))
+
+	_, err = ProjectNumber("com.example.app")
+	assert.Error(t, err)
+	assert.Equal(t, "", Platform("com.example.app"))
+}
+
+func TestClient(t *testing.T) {
+	const (
+		appID   = "1:42:android:abc"
+		release = "projects/42/apps/1:42:android:abc/releases/r1"
+	)
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	mux.HandleFunc("/v1/projects/42/apps/1:42:android:abc/releases", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "createTime desc", r.URL.Query().Get("orderBy"))
+		assert.Equal(t, "10", r.URL.Query().Get("pageSize"))
+		_ = json.NewEncoder(w).Encode(ListReleasesResponse{
+			Releases:      []*Release{{Name: release, DisplayVersion: "1.2.3", BuildVersion: "45"}},
+			NextPageToken: "next",
+		})
+	})
+	mux.HandleFunc("/v1/"+release, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(Release{Name: release, BinaryDownloadURI: server.URL + "/binary"})
+	})
+	mux.HandleFunc("/binary", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("apk content"))
+	})
+	c := &Client{baseAPI: server.URL + "/v1", httpClient: server.Client(), dlClient: server.Client()}
+	ctx := context.Background()
+
+	releases, err := c.ListReleases(ctx, appID, 10, "")
+	require.NoError(t, err)
+	require.Len(t, releases.Releases, 1)
+	assert.Equal(t, "1.2.3", releases.Releases[0].DisplayVersion)
+	assert.Equal(t, "next", releases.NextPageToken)
+
+	var buf bytes.Buffer
+	require.NoError(t, c.Download(ctx, release, &buf))
+	assert.Equal(t, "apk content", buf.String())
+	assert.Error(t, c.Download(ctx, "projects/42/apps/1:42:android:abc/releases/missing", &buf))
+}
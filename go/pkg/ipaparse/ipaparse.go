@@ -0,0 +1,112 @@
+// Package ipaparse reads the metadata of iOS application archives (.ipa)
+package ipaparse
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"howett.net/plist"
+)
+
+// maxInfoPlistSize protects against oversized entries, an Info.plist is usually a few KB
+const maxInfoPlistSize = 1 << 20
+
+var ErrNoInfoPlist = errors.New("ipaparse: no Info.plist in Payload/*.app/")
+
+type Info struct {
+	// BundleID is the CFBundleIdentifier (i.e, tech.berty.ios)
+	BundleID string
+	// BundleVersion is the CFBundleVersion, the build number
+	BundleVersion string
+	// ShortVersion is the CFBundleShortVersionString, the version displayed to the users
+	ShortVersion string
+	// DisplayName is the CFBundleDisplayName, or the CFBundleName if unset
+	DisplayName string
+}
+
+type infoPlist struct {
+	CFBundleIdentifier         string `plist:"CFBundleIdentifier"`
+	CFBundleVersion            string `plist:"CFBundleVersion"`
+	CFBundleShortVersionString string `plist:"CFBundleShortVersionString"`
+	CFBundleDisplayName        string `plist:"CFBundleDisplayName"`
+	CFBundleName               string `plist:"CFBundleName"`
+}
+
+// ParseFile returns the metadata of the IPA at path
+func ParseFile(path string) (*Info, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	stat, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return Parse(f, stat.Size())
+}
+
+// Parse returns the metadata of an IPA, read from the Info.plist of its main app
+func Parse(r io.ReaderAt, size int64) (*Info, error) {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("ipaparse: invalid archive: %w", err)
+	}
+
+	for _, file := range archive.File {
+		if !isAppInfoPlist(file.Name) {
+			continue
+		}
+		rc, err := file.Open()
+		if err != nil {
+			return nil, fmt.Errorf("ipaparse: open %s: %w", file.Name, err)
+		}
+		data, err := ioutil.ReadAll(io.LimitReader(rc, maxInfoPlistSize))
+		rc.Close()
+		if err != nil {
+			return nil, fmt.Errorf("ipaparse: read %s: %w", file.Name, err)
+		}
+		return parseInfoPlist(data)
+	}
+	return nil, ErrNoInfoPlist
+}
+
+// isAppInfoPlist matches Payload/<name>.app/Info.plist, not the plists of the embedded frameworks and extensions
+func isAppInfoPlist(name string) bool {
+	parts := strings.Split(name, "/")
+	return len(parts) == 3 && parts[0] == "Payload" && strings.HasSuffix(parts[1], ".app") && parts[2] == "Info.plist"
+}
+
+// parseInfoPlist decodes the XML plists as well as the binary ones ("bplist00" magic) produced by Xcode
+func parseInfoPlist(data []byte) (*Info, error) {
+	format := "XML"
+	if bytes.HasPrefix(data, []byte("bplist")) {
+		format = "binary"
+	}
+
+	var raw infoPlist
+	if _, err := plist.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("ipaparse: invalid %s Info.plist: %w", format, err)
+	}
+	if raw.CFBundleIdentifier == "" {
+		return nil, fmt.Errorf("ipaparse: missing CFBundleIdentifier in %s Info.plist", format)
+	}
+
+	info := Info{
+		BundleID:      raw.CFBundleIdentifier,
+		BundleVersion: raw.CFBundleVersion,
+		ShortVersion:  raw.CFBundleShortVersionString,
+		DisplayName:   raw.CFBundleDisplayName,
+	}
+	if info.DisplayName == "" {
+		info.DisplayName = raw.CFBundleName
+	}
+	return &info, nil
+}
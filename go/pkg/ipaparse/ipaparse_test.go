@@ -0,0 +1,80 @@
+package ipaparse
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"howett.net/plist"
+)
+
+func testingIPA(t *testing.T, files map[string][]byte) *bytes.Reader {
+	t.Helper()
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := archive.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write(content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, archive.Close())
+	return bytes.NewReader(buf.Bytes())
+}
+
+func testingInfoPlist(t *testing.T, format int, info infoPlist) []byte {
+	t.Helper()
+	data, err := plist.Marshal(info, format)
+	require.NoError(t, err)
+	return data
+}
+
+func TestParse(t *testing.T) {
+	info := infoPlist{
+		CFBundleIdentifier:         "tech.berty.ios",
+		CFBundleVersion:            "1234",
+		CFBundleShortVersionString: "2.3.4",
+		CFBundleName:               "Berty",
+	}
+	expected := &Info{BundleID: "tech.berty.ios", BundleVersion: "1234", ShortVersion: "2.3.4", DisplayName: "Berty"}
+
+	for name, format := range map[string]int{"xml": plist.XMLFormat, "binary": plist.BinaryFormat} {
+		t.Run(name, func(t *testing.T) {
+			extension := infoPlist{CFBundleIdentifier: "tech.berty.ios.notifications"}
+			ipa := testingIPA(t, map[string][]byte{
+				"Payload/Berty.app/Frameworks/Lib.framework/Info.plist": testingInfoPlist(t, format, infoPlist{CFBundleIdentifier: "lib"}),
+				"Payload/Berty.app/PlugIns/Notif.appex/Info.plist":      testingInfoPlist(t, format, extension),
+				"Payload/Berty.app/Info.plist":                          testingInfoPlist(t, format, info),
+			})
+			parsed, err := Parse(ipa, ipa.Size())
+			require.NoError(t, err)
+			assert.Equal(t, expected, parsed)
+		})
+	}
+
+	info.CFBundleDisplayName = "Berty Dev"
+	ipa := testingIPA(t, map[string][]byte{"Payload/Berty.app/Info.plist": testingInfoPlist(t, plist.BinaryFormat, info)})
+	parsed, err := Parse(ipa, ipa.Size())
+	require.NoError(t, err)
+	assert.Equal(t, "Berty Dev", parsed.DisplayName)
+}
+
+func TestParseInvalid(t *testing.T) {
+	ipa := testingIPA(t, map[string][]byte{"Payload/Berty.app/Berty": []byte("binary")})
+	_, err := Parse(ipa, ipa.Size())
+	assert.Equal(t, ErrNoInfoPlist, err)
+
+	ipa = testingIPA(t, map[string][]byte{"Payload/Berty.app/Info.plist": []byte("bplist00garbage")})
+	_, err = Parse(ipa, ipa.Size())
+	assert.Error(t, err)
+
+	ipa = testingIPA(t, map[string][]byte{"Payload/Berty.app/Info.plist": testingInfoPlist(t, plist.XMLFormat, infoPlist{CFBundleName: "Berty"})})
+	_, err = Parse(ipa, ipa.Size())
+	assert.Error(t, err)
+
+	notZip := bytes.NewReader([]byte("not a zip"))
+	_, err = Parse(notZip, notZip.Size())
+	assert.Error(t, err)
+}
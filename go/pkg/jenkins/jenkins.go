@@ -0,0 +1,192 @@
+// Package jenkins is a minimal Jenkins client, authenticated with a user and an API token
+package jenkins
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// buildTree selects the fields of the builds returned by the JSON API
+const buildTree = "number,url,displayName,description,result,building,timestamp,duration," +
+	"artifacts[fileName,relativePath]," +
+	"actions[_class,lastBuiltRevision[SHA1,branch[SHA1,name]],remoteUrls]," +
+	"changeSets[items[commitId,msg,author[fullName]]]"
+
+type Client struct {
+	baseURL    string // i.e, https://jenkins.berty.tech
+	user       string
+	token      string
+	httpClient *http.Client
+}
+
+// New returns a client of a Jenkins instance (i.e, https://jenkins.berty.tech) authenticated with a user and its API token
+func New(baseURL, user, token string) (*Client, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("jenkins: invalid base URL: %q", baseURL)
+	}
+	if user == "" || token == "" {
+		return nil, fmt.Errorf("jenkins: missing user or API token")
+	}
+	return &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		user:       user,
+		token:      token,
+		httpClient: &http.Client{},
+	}, nil
+}
+
+// BaseURL returns the URL of the Jenkins instance, without trailing slash
+func (c *Client) BaseURL() string { return c.baseURL }
+
+// JobURL returns the URL of a job, the jobs of a folder are separated by slashes (i.e, "berty/android")
+func (c *Client) JobURL(job string) string {
+	var b strings.Builder
+	b.WriteString(c.baseURL)
+	for _, name := range strings.Split(strings.Trim(job, "/"), "/") {
+		b.WriteString("/job/")
+		b.WriteString(url.PathEscape(name))
+	}
+	return b.String() + "/"
+}
+
+// ListBuilds returns the last builds of a job, most recent first, with their archived artifacts
+func (c *Client) ListBuilds(ctx context.Context, job string, limit int) ([]*Build, error) {
+	tree := "builds[" + buildTree + "]"
+	if limit > 0 {
+		tree += fmt.Sprintf("{0,%d}", limit)
+	}
+	query := url.Values{}
+	query.Set("tree", tree)
+
+	var result struct {
+		Builds []*Build `json:"builds"`
+	}
+	err := c.doGet(ctx, c.JobURL(job)+"api/json?"+query.Encode(), &result)
+	return result.Builds, err
+}
+
+// Download streams an archived artifact to w, from its download URL
+func (c *Client) Download(ctx context.Context, downloadURL string, w io.Writer) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, downloadURL, nil)
+	if err != nil {
+		return fmt.Errorf("jenkins: %w", err)
+	}
+	req.SetBasicAuth(c.user, c.token)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("jenkins: download %s: %w", downloadURL, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("jenkins: download %s: %s", downloadURL, resp.Status)
+	}
+
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
+func (c *Client) doGet(ctx context.Context, rawURL string, dest interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return fmt.Errorf("jenkins: %w", err)
+	}
+	req.SetBasicAuth(c.user, c.token)
+	req.Header.Set("Accept", "application/json")
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("jenkins: GET %s: %w", rawURL, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("jenkins: GET %s: %s: %s", rawURL, resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(dest); err != nil {
+		return fmt.Errorf("jenkins: GET %s: %w", rawURL, err)
+	}
+	return nil
+}
+
+type Build struct {
+	Number      int          `json:"number"`
+	URL         string       `json:"url"`
+	DisplayName string       `json:"displayName"`
+	Description string       `json:"description"`
+	Result      string       `json:"result"` // SUCCESS, UNSTABLE, FAILURE, ABORTED, NOT_BUILT, empty while building
+	Building    bool         `json:"building"`
+	Timestamp   int64        `json:"timestamp"` // start, in milliseconds
+	Duration    int64        `json:"duration"`  // in milliseconds, 0 while building
+	Artifacts   []*Artifact  `json:"artifacts"`
+	Actions     []*Action    `json:"actions"`
+	ChangeSets  []*ChangeSet `json:"changeSets"`
+}
+
+// StartedAt returns the start time of the build
+func (b *Build) StartedAt() time.Time {
+	return time.Unix(0, b.Timestamp*int64(time.Millisecond)).UTC()
+}
+
+// ArtifactURL returns the download URL of an archived artifact, i.e, <build URL>/artifact/<relative path>
+func (b *Build) ArtifactURL(artifact *Artifact) string {
+	parts := strings.Split(artifact.RelativePath, "/")
+	for i, part := range parts {
+		parts[i] = url.PathEscape(part)
+	}
+	return strings.TrimSuffix(b.URL, "/") + "/artifact/" + strings.Join(parts, "/")
+}
+
+// Git returns the git revision of the build and the remote URL of its repository, if checked out with the Git plugin
+func (b *Build) Git() (revision *Revision, remoteURL string) {
+	for _, action := range b.Actions {
+		if action == nil || action.LastBuiltRevision == nil {
+			continue
+		}
+		if len(action.RemoteURLs) > 0 {
+			remoteURL = action.RemoteURLs[0]
+		}
+		return action.LastBuiltRevision, remoteURL
+	}
+	return nil, ""
+}
+
+type Artifact struct {
+	FileName     string `json:"fileName"`
+	RelativePath string `json:"relativePath"`
+}
+
+type Action struct {
+	Class             string    `json:"_class"`
+	LastBuiltRevision *Revision `json:"lastBuiltRevision"`
+	RemoteURLs        []string  `json:"remoteUrls"`
+}
+
+type Revision struct {
+	SHA1   string    `json:"SHA1"`
+	Branch []*Branch `json:"branch"`
+}
+
+type Branch struct {
+	SHA1 string `json:"SHA1"`
+	Name string `json:"name"` // i.e, "origin/main" or "refs/remotes/origin/main"
+}
+
+type ChangeSet struct {
+	Items []*ChangeSetItem `json:"items"`
+}
+
+type ChangeSetItem struct {
+	CommitID string `json:"commitId"`
+	Msg      string `json:"msg"`
+	Author   struct {
+		FullName string `json:"fullName"`
+	} `json:"author"`
+}
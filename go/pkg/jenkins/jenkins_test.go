@@ -0,0 +1,99 @@
+package jenkins
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	c, err := New("https://jenkins.berty.tech/", "yolo", "token")
+	require.NoError(t, err)
+	assert.Equal(t, "https://jenkins.berty.tech", c.BaseURL())
+	assert.Equal(t, "https://jenkins.berty.tech/job/berty/job/android%20release/", c.JobURL("berty/android release"))
+
+	_, err = New("jenkins", "yolo", "token")
+	assert.Error(t, err)
+	_, err = New("https://jenkins.berty.tech", "yolo", "")
+	assert.Error(t, err)
+}
+
+func TestBuild(t *testing.T) {
+	build := Build{
+		URL:       "https://jenkins.berty.tech/job/berty/job/android/42/",
+		Timestamp: 1661990400000,
+		Actions: []*Action{
+			{Class: "hudson.model.CauseAction"},
+			{Class: "hudson.plugins.git.util.BuildData", LastBuiltRevision: &Revision{SHA1: "0123abcdef", Branch: []*Branch{{Name: "origin/main"}}}, RemoteURLs: []string{"https://github.com/berty/berty.git"}},
+		},
+	}
+	assert.Equal(t, time.Date(2022, 9, 1, 0, 0, 0, 0, time.UTC), build.StartedAt())
+	assert.Equal(t, "https://jenkins.berty.tech/job/berty/job/android/42/artifact/build/outputs/berty%20app.apk", build.ArtifactURL(&Artifact{FileName: "berty app.apk", RelativePath: "build/outputs/berty app.apk"}))
+
+	revision, remoteURL := build.Git()
+	require.NotNil(t, revision)
+	assert.Equal(t, "0123abcdef", revision.SHA1)
+	assert.Equal(t, "https://github.com/berty/berty.git", remoteURL)
+
+	build.Actions = nil
+	revision, remoteURL = build.Git()
+	assert.Nil(t, revision)
+	assert.Empty(t, remoteURL)
+}
+
+func TestClient(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	authorized := func(w http.ResponseWriter, r *http.Request) bool {
+		if user, token, ok := r.BasicAuth(); !ok || user != "yolo" || token != "token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return false
+		}
+		return true
+	}
+	mux.HandleFunc("/job/berty/job/android/api/json", func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(w, r) {
+			return
+		}
+		assert.Equal(t, "builds["+buildTree+"]{0,10}", r.URL.Query().Get("tree"))
+		w.Header().Set("Content-Type", "application/json;charset=utf-8")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"builds": []*Build{{
+			Number:    42,
+			URL:       server.URL + "/job/berty/job/android/42/",
+			Result:    "SUCCESS",
+			Artifacts: []*Artifact{{FileName: "berty.apk", RelativePath: "out/berty.apk"}},
+		}}})
+	})
+	mux.HandleFunc("/job/berty/job/android/42/artifact/out/berty.apk", func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(w, r) {
+			return
+		}
+		_, _ = w.Write([]byte("apk content"))
+	})
+	c, err := New(server.URL, "yolo", "token")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	builds, err := c.ListBuilds(ctx, "berty/android", 10)
+	require.NoError(t, err)
+	require.Len(t, builds, 1)
+	assert.Equal(t, 42, builds[0].Number)
+	require.Len(t, builds[0].Artifacts, 1)
+
+	var buf bytes.Buffer
+	require.NoError(t, c.Download(ctx, builds[0].ArtifactURL(builds[0].Artifacts[0]), &buf))
+	assert.Equal(t, "apk content", buf.String())
+
+	c.token = "invalid"
+	_, err = c.ListBuilds(ctx, "berty/android", 10)
+	assert.Error(t, err)
+	assert.Error(t, c.Download(ctx, builds[0].ArtifactURL(builds[0].Artifacts[0]), &buf))
+}
@@ -11,8 +11,22 @@ const (
 	KindFullSizeImage   = "full-size-image"
 )
 
-func Release(bundleID, ipaURL string) ApplePlistRelease {
-	return ApplePlistRelease{
+// ReleaseOptions customizes the install UI of a release, the zero value only sets the package and default titles
+type ReleaseOptions struct {
+	// DisplayImageURL is the 57x57 icon shown while the app is downloading
+	DisplayImageURL string
+	// FullSizeImageURL is the 512x512 image shown in the install UI
+	FullSizeImageURL string
+	// Subtitle defaults to "YOLO"
+	Subtitle string
+}
+
+func Release(bundleID, ipaURL string, opts ReleaseOptions) ApplePlistRelease {
+	subtitle := opts.Subtitle
+	if subtitle == "" {
+		subtitle = "YOLO"
+	}
+	release := ApplePlistRelease{
 		Items: []*ApplePlistItem{
 			{
 				Assets: []*ApplePlistAsset{
@@ -25,11 +39,18 @@ func Release(bundleID, ipaURL string) ApplePlistRelease {
 					BundleIdentifier: bundleID,
 					Kind:             KindSoftware,
 					Title:            "YOLO",
-					Subtitle:         "YOLO",
+					Subtitle:         subtitle,
 				},
 			},
 		},
 	}
+	if opts.DisplayImageURL != "" {
+		release.SetDisplayImage(opts.DisplayImageURL, false)
+	}
+	if opts.FullSizeImageURL != "" {
+		release.SetFullSizeImage(opts.FullSizeImageURL, false)
+	}
+	return release
 }
 
 type ApplePlistRelease struct {
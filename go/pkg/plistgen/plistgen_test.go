@@ -0,0 +1,33 @@
+package plistgen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelease(t *testing.T) {
+	release := Release("tech.berty.ios", "https://yolo.example.com/app.ipa", ReleaseOptions{})
+	assert.Equal(t, ApplePlistRelease{
+		Items: []*ApplePlistItem{{
+			Assets:   []*ApplePlistAsset{{Kind: KindSoftwarePackage, URL: "https://yolo.example.com/app.ipa"}},
+			Metadata: &ApplePlistMetadata{BundleIdentifier: "tech.berty.ios", Kind: KindSoftware, Title: "YOLO", Subtitle: "YOLO"},
+		}},
+	}, release)
+
+	release = Release("tech.berty.ios", "https://yolo.example.com/app.ipa", ReleaseOptions{
+		DisplayImageURL:  "https://yolo.example.com/icon.png",
+		FullSizeImageURL: "https://yolo.example.com/full.png",
+		Subtitle:         "Berty",
+	})
+	assets := release.Items[0].Assets
+	require.Len(t, assets, 3)
+	assert.Equal(t, &ApplePlistAsset{Kind: KindDisplayImage, URL: "https://yolo.example.com/icon.png"}, assets[1])
+	assert.Equal(t, &ApplePlistAsset{Kind: KindFullSizeImage, URL: "https://yolo.example.com/full.png"}, assets[2])
+	assert.Equal(t, "Berty", release.Items[0].Metadata.Subtitle)
+
+	out, err := release.Marshal()
+	require.NoError(t, err)
+	assert.Contains(t, string(out), "<string>full-size-image</string>")
+}
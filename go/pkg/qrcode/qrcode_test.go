@@ -0,0 +1,146 @@
+package qrcode
+
+import (
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReedSolomon(t *testing.T) {
+	// "HELLO WORLD" in version 1-M, from the QR code specification examples
+	data := []byte{32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236, 17, 236, 17}
+	expected := []byte{196, 35, 39, 119, 235, 215, 231, 226, 93, 23}
+	assert.Equal(t, expected, reedSolomonRemainder(data, reedSolomonDivisor(10)))
+}
+
+func TestFormatAndVersionBits(t *testing.T) {
+	expected := []int{
+		0b101010000010010, 0b101000100100101, 0b101111001111100, 0b101101101001011,
+		0b100010111111001, 0b100000011001110, 0b100111110010111, 0b100101010100000,
+	}
+	for mask, bits := range expected {
+		assert.Equal(t, bits, formatBits(mask), mask)
+	}
+	assert.Equal(t, 0b000111110010010100, versionBits(7))
+	assert.Equal(t, 0b101000110001101001, versionBits(40))
+}
+
+func TestNumDataCodewords(t *testing.T) {
+	for version, expected := range map[int]int{1: 16, 2: 28, 5: 86, 7: 124, 10: 216, 21: 714, 40: 2334} {
+		assert.Equal(t, expected, numDataCodewords(version), version)
+	}
+}
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		length  int
+		version int
+	}{
+		{14, 1},
+		{15, 2},
+		{213, 10},
+		{2331, 40},
+	}
+	for _, tt := range tests {
+		code, err := Encode([]byte(strings.Repeat("a", tt.length)))
+		require.NoError(t, err, tt.length)
+		assert.Equal(t, tt.version, code.Version, tt.length)
+		assert.Equal(t, tt.version*4+17, code.Size)
+
+		// finder patterns
+		for _, corner := range [][2]int{{0, 0}, {code.Size - 7, 0}, {0, code.Size - 7}} {
+			assert.True(t, code.Dark(corner[0], corner[1]))
+			assert.False(t, code.Dark(corner[0]+1, corner[1]+1))
+			assert.True(t, code.Dark(corner[0]+3, corner[1]+3))
+		}
+		// dark module
+		assert.True(t, code.Dark(8, code.Size-8))
+	}
+
+	_, err := Encode([]byte(strings.Repeat("a", 2332)))
+	assert.Error(t, err)
+}
+
+// the data codewords can be read back by unmasking the data modules in the zigzag order
+func TestEncodeReadBack(t *testing.T) {
+	content := []byte("https://yolo.berty.io/api/artifact-dl/1")
+	code, err := Encode(content)
+	require.NoError(t, err)
+
+	// second copy of the format bits, below the top-right and right of the bottom-left finders
+	format := 0
+	for i := 0; i < 8; i++ {
+		if code.Dark(code.Size-1-i, 8) {
+			format |= 1 << uint(i)
+		}
+	}
+	for i := 8; i < 15; i++ {
+		if code.Dark(8, code.Size-15+i) {
+			format |= 1 << uint(i)
+		}
+	}
+	mask := -1
+	for candidate := 0; candidate < 8; candidate++ {
+		if formatBits(candidate) == format {
+			mask = candidate
+		}
+	}
+	require.NotEqual(t, -1, mask)
+	code.applyMask(mask)
+
+	read := []byte{}
+	i := 0
+	for right := code.Size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vert := 0; vert < code.Size; vert++ {
+			y := vert
+			if (right+1)&2 == 0 {
+				y = code.Size - 1 - vert
+			}
+			for j := 0; j < 2; j++ {
+				if code.function[y][right-j] || i >= numRawDataModules(code.Version)/8*8 {
+					continue
+				}
+				if i%8 == 0 {
+					read = append(read, 0)
+				}
+				if code.modules[y][right-j] {
+					read[i/8] |= 1 << uint(7-i%8)
+				}
+				i++
+			}
+		}
+	}
+
+	// single block version: the data codewords come first
+	require.Equal(t, 1, eccBlocks[code.Version])
+	assert.Equal(t, byte(0x40|len(content)>>4), read[0])
+	header := bitBuffer{}
+	header.append(0x4, 4)
+	header.append(len(content), 8)
+	for _, b := range content {
+		header.append(int(b), 8)
+	}
+	header.append(0, 4)
+	assert.Equal(t, header.bytes(), read[:len(content)+2])
+}
+
+func TestImage(t *testing.T) {
+	code, err := Encode([]byte("yolo"))
+	require.NoError(t, err)
+
+	img, err := code.Image(290) // 29 modules with the quiet zone, 10px per module
+	require.NoError(t, err)
+	assert.Equal(t, 290, img.Bounds().Dx())
+	assert.Equal(t, color.Gray{Y: 0xFF}, img.At(0, 0))
+	assert.Equal(t, color.Gray{Y: 0}, img.At(40, 40))
+	assert.Equal(t, color.Gray{Y: 0xFF}, img.At(50, 50))
+
+	_, err = code.Image(20)
+	assert.Error(t, err)
+}
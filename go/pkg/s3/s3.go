@@ -0,0 +1,349 @@
+// Package s3 is a minimal S3 client, downloading objects and generating presigned URLs (AWS signature v4)
+package s3
+
+import (
+	"bufio"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultRegion    = "us-east-1"
+	signingAlgorithm = "AWS4-HMAC-SHA256"
+	unsignedPayload  = "UNSIGNED-PAYLOAD"
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855" // sha256("")
+	maxPresignTTL    = 7 * 24 * time.Hour
+)
+
+type Config struct {
+	// Bucket is used for the object keys without an s3://bucket/ prefix
+	Bucket string
+	// Region defaults to $AWS_REGION, $AWS_DEFAULT_REGION then us-east-1
+	Region string
+	// Endpoint defaults to the AWS endpoint of the region, i.e, http://minio.lan:9000 for a S3-compatible server
+	Endpoint string
+	// AccessKeyID, SecretAccessKey and SessionToken default to the AWS environment variables, then to the shared credentials file
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+}
+
+type Client struct {
+	config     Config
+	endpoint   *url.URL
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+func New(config Config) (*Client, error) {
+	if err := config.applyDefaults(); err != nil {
+		return nil, err
+	}
+	if config.AccessKeyID == "" || config.SecretAccessKey == "" {
+		return nil, fmt.Errorf("s3: missing credentials")
+	}
+
+	endpoint, err := url.Parse(config.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("s3: invalid endpoint: %w", err)
+	}
+	if endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("s3: invalid endpoint: %q", config.Endpoint)
+	}
+
+	return &Client{
+		config:     config,
+		endpoint:   endpoint,
+		httpClient: &http.Client{},
+		now:        time.Now,
+	}, nil
+}
+
+func (c *Config) applyDefaults() error {
+	if c.Region == "" {
+		c.Region = firstNonEmpty(os.Getenv("AWS_REGION"), os.Getenv("AWS_DEFAULT_REGION"), defaultRegion)
+	}
+	if c.Endpoint == "" {
+		c.Endpoint = "https://s3." + c.Region + ".amazonaws.com"
+	}
+	if c.AccessKeyID != "" || c.SecretAccessKey != "" {
+		return nil
+	}
+
+	// standard AWS credentials chain: environment variables, then shared credentials file
+	c.AccessKeyID = os.Getenv("AWS_ACCESS_KEY_ID")
+	c.SecretAccessKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
+	c.SessionToken = os.Getenv("AWS_SESSION_TOKEN")
+	if c.AccessKeyID != "" {
+		return nil
+	}
+	return c.loadSharedCredentials()
+}
+
+// loadSharedCredentials reads the credentials of $AWS_PROFILE (or "default") in ~/.aws/credentials
+func (c *Config) loadSharedCredentials() error {
+	path := os.Getenv("AWS_SHARED_CREDENTIALS_FILE")
+	if path == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil
+		}
+		path = filepath.Join(home, ".aws", "credentials")
+	}
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("s3: read shared credentials: %w", err)
+	}
+	defer f.Close()
+
+	profile := firstNonEmpty(os.Getenv("AWS_PROFILE"), "default")
+	current := ""
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";"):
+			continue
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			current = strings.TrimSpace(line[1 : len(line)-1])
+			continue
+		case current != profile:
+			continue
+		}
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		value := strings.TrimSpace(parts[1])
+		switch strings.TrimSpace(parts[0]) {
+		case "aws_access_key_id":
+			c.AccessKeyID = value
+		case "aws_secret_access_key":
+			c.SecretAccessKey = value
+		case "aws_session_token":
+			c.SessionToken = value
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("s3: read shared credentials: %w", err)
+	}
+	return nil
+}
+
+// Download streams an object to w, object is either a key of the configured bucket or an s3://bucket/key URL
+func (c *Client) Download(ctx context.Context, object string, w io.Writer) error {
+	u, err := c.objectURL(object)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return fmt.Errorf("s3: %w", err)
+	}
+	c.signRequest(req, c.now().UTC())
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("s3: GET %s: %w", object, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("s3: GET %s: %s: %s", object, resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
+// PresignGet returns a URL granting a temporary access to an object, params can override the response headers
+// (i.e, response-content-type)
+func (c *Client) PresignGet(object string, ttl time.Duration, params url.Values) (string, error) {
+	if ttl <= 0 || ttl > maxPresignTTL {
+		return "", fmt.Errorf("s3: invalid presign TTL: %s", ttl)
+	}
+	u, err := c.objectURL(object)
+	if err != nil {
+		return "", err
+	}
+	query := u.Query()
+	for key, values := range params {
+		query[key] = values
+	}
+	u.RawQuery = query.Encode()
+	c.presign(u, c.now().UTC(), ttl)
+	return u.String(), nil
+}
+
+// objectURL returns the path-style URL of an object
+func (c *Client) objectURL(object string) (*url.URL, error) {
+	bucket, key := c.config.Bucket, object
+	if strings.HasPrefix(object, "s3://") {
+		parts := strings.SplitN(strings.TrimPrefix(object, "s3://"), "/", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("s3: invalid object URL: %q", object)
+		}
+		bucket, key = parts[0], parts[1]
+	}
+	key = strings.TrimPrefix(key, "/")
+	if bucket == "" || key == "" {
+		return nil, fmt.Errorf("s3: invalid object: %q", object)
+	}
+
+	u := *c.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + bucket + "/" + key
+	u.RawPath = uriEncode(u.Path, false)
+	u.RawQuery = ""
+	return &u, nil
+}
+
+func (c *Client) signRequest(req *http.Request, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", emptyPayloadHash)
+	if c.config.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", c.config.SessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for key := range req.Header {
+		headers[strings.ToLower(key)] = strings.TrimSpace(req.Header.Get(key))
+	}
+	signedHeaders, canonicalHeaders := canonicalizeHeaders(headers)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders,
+		signedHeaders,
+		emptyPayloadHash,
+	}, "\n")
+
+	scope := c.scope(now)
+	signature := c.signature(now, amzDate, scope, canonicalRequest)
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		signingAlgorithm, c.config.AccessKeyID, scope, signedHeaders, signature))
+}
+
+func (c *Client) presign(u *url.URL, now time.Time, ttl time.Duration) {
+	amzDate := now.Format("20060102T150405Z")
+	scope := c.scope(now)
+	query := u.Query()
+	query.Set("X-Amz-Algorithm", signingAlgorithm)
+	query.Set("X-Amz-Credential", c.config.AccessKeyID+"/"+scope)
+	query.Set("X-Amz-Date", amzDate)
+	query.Set("X-Amz-Expires", strconv.Itoa(int(ttl.Seconds())))
+	query.Set("X-Amz-SignedHeaders", "host")
+	if c.config.SessionToken != "" {
+		query.Set("X-Amz-Security-Token", c.config.SessionToken)
+	}
+
+	canonicalRequest := strings.Join([]string{
+		http.MethodGet,
+		u.EscapedPath(),
+		canonicalQuery(query),
+		"host:" + u.Host + "\n",
+		"host",
+		unsignedPayload,
+	}, "\n")
+	query.Set("X-Amz-Signature", c.signature(now, amzDate, scope, canonicalRequest))
+	u.RawQuery = canonicalQuery(query)
+}
+
+func (c *Client) scope(now time.Time) string {
+	return now.Format("20060102") + "/" + c.config.Region + "/s3/aws4_request"
+}
+
+func (c *Client) signature(now time.Time, amzDate, scope, canonicalRequest string) string {
+	hash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{signingAlgorithm, amzDate, scope, hex.EncodeToString(hash[:])}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+c.config.SecretAccessKey), now.Format("20060102"))
+	key = hmacSHA256(key, c.config.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+func canonicalizeHeaders(headers map[string]string) (signed string, canonical string) {
+	keys := make([]string, 0, len(headers))
+	for key := range headers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	for _, key := range keys {
+		b.WriteString(key + ":" + headers[key] + "\n")
+	}
+	return strings.Join(keys, ";"), b.String()
+}
+
+func canonicalQuery(query url.Values) string {
+	type pair struct{ key, value string }
+	pairs := []pair{}
+	for key, values := range query {
+		for _, value := range values {
+			pairs = append(pairs, pair{uriEncode(key, true), uriEncode(value, true)})
+		}
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].key != pairs[j].key {
+			return pairs[i].key < pairs[j].key
+		}
+		return pairs[i].value < pairs[j].value
+	})
+	encoded := make([]string, len(pairs))
+	for i, p := range pairs {
+		encoded[i] = p.key + "=" + p.value
+	}
+	return strings.Join(encoded, "&")
+}
+
+// uriEncode escapes everything but the unreserved characters, as expected by the signature v4
+func uriEncode(input string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(input); i++ {
+		char := input[i]
+		switch {
+		case 'A' <= char && char <= 'Z', 'a' <= char && char <= 'z', '0' <= char && char <= '9',
+			char == '-', char == '_', char == '.', char == '~':
+			b.WriteByte(char)
+		case char == '/' && !encodeSlash:
+			b.WriteByte(char)
+		default:
+			fmt.Fprintf(&b, "%%%02X", char)
+		}
+	}
+	return b.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
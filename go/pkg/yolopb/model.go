@@ -13,5 +13,10 @@ func AllModels() []interface{} {
 
 		// internal
 		&Download{},
+		&Promotion{},
+		&Install{},
+		&SpentSignature{},
+		&EventCounter{},
+		&Setting{},
 	}
 }
@@ -1,7 +1,11 @@
 package yolopb
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/url"
+	"strings"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/signature"
@@ -9,12 +13,32 @@ import (
 
 // PrepareOutput adds new fields containing URLs with a signature and filters sensitive/useless data
 func (b *Build) PrepareOutput(salt string) error {
+	return b.PrepareExpiringOutput(salt, time.Time{})
+}
+
+// PrepareExpiringOutput is like PrepareOutput, the artifact URLs are only valid until expiresAt if it is set
+func (b *Build) PrepareExpiringOutput(salt string, expiresAt time.Time) error {
 	for _, artifact := range b.HasArtifacts {
-		if err := artifact.AddSignedURLs(salt); err != nil {
+		var err error
+		if expiresAt.IsZero() {
+			err = artifact.AddSignedURLs(salt)
+		} else {
+			err = artifact.AddExpiringSignedURLs(salt, expiresAt)
+		}
+		if err != nil {
 			return err
 		}
 	}
 
+	if err := b.AddInstallSignedURL(salt, expiresAt); err != nil {
+		return err
+	}
+
+	b.BuildConfigJSON = "" // already exposed as BuildConfig
+	b.OwnerTeamsJSON = ""  // already exposed as OwnerTeams
+	b.FlagsJSON = ""       // already exposed as Flags
+	b.VersionKey = ""      // already exposed as VCSTag
+
 	// cleanup messages
 	b.Message = cleanupCommitMessage(b.Message)
 	if b.HasMergerequest != nil {
@@ -26,13 +50,64 @@ func (b *Build) PrepareOutput(salt string) error {
 
 // AddSignedURLs adds new fields containing URLs with a signature
 func (a *Artifact) AddSignedURLs(key string) error {
+	return a.addSignedURLs(key, "")
+}
+
+// AddExpiringSignedURLs adds new fields containing URLs with a signature, only valid until expiresAt
+func (a *Artifact) AddExpiringSignedURLs(key string, expiresAt time.Time) error {
+	return a.addSignedURLs(key, fmt.Sprintf("?expires=%d", expiresAt.Unix()))
+}
+
+// AddSingleUseSignedURLs adds new fields containing URLs with a signature, only valid until expiresAt.
+// the download URL is rejected once it has been fully downloaded.
+func (a *Artifact) AddSingleUseSignedURLs(key string, expiresAt time.Time) error {
+	return a.addSignedURLs(key, fmt.Sprintf("?expires=%d&once=1", expiresAt.Unix()))
+}
+
+// AddDeviceSignedPListURL replaces the plist URL with one also signing the device model,
+// the manifest then points to the variant of the build matching the device. it does nothing for other kinds or without device.
+func (a *Artifact) AddDeviceSignedPListURL(key, device string, expiresAt time.Time, singleUse bool) error {
+	if a.Kind != Artifact_IPA || device == "" {
+		return nil
+	}
+	query := fmt.Sprintf("?device=%s&expires=%d", url.QueryEscape(device), expiresAt.Unix())
+	if singleUse {
+		query += "&once=1"
+	}
+	signedURL, err := signature.GetSignedURL("GET", "/api/plist-gen/"+a.ID+".plist"+query, "", key)
+	if err != nil {
+		return err
+	}
+	a.PListSignedURL = url.QueryEscape(signedURL)
+	return nil
+}
+
+// AddSignedDownloadRate re-signs the download URL with a bandwidth cap in bytes per second, overriding the default one
+func (a *Artifact) AddSignedDownloadRate(key string, rate int64) error {
+	unsigned := a.DLArtifactSignedURL
+	if idx := strings.Index(unsigned, "sign="); idx > 0 {
+		unsigned = unsigned[:idx-1]
+	}
+	separator := "?"
+	if strings.Contains(unsigned, "?") {
+		separator = "&"
+	}
+	signedURL, err := signature.GetSignedURL("GET", fmt.Sprintf("%s%srate=%d", unsigned, separator, rate), "", key)
+	if err != nil {
+		return err
+	}
+	a.DLArtifactSignedURL = signedURL
+	return nil
+}
+
+func (a *Artifact) addSignedURLs(key, query string) error {
 	var err error
-	a.DLArtifactSignedURL, err = signature.GetSignedURL("GET", "/api/artifact-dl/"+a.ID, "", key)
+	a.DLArtifactSignedURL, err = signature.GetSignedURL("GET", "/api/artifact-dl/"+a.ID+query, "", key)
 	if err != nil {
 		return err
 	}
 	if a.Kind == Artifact_IPA {
-		a.PListSignedURL, err = signature.GetSignedURL("GET", "/api/plist-gen/"+a.ID+".plist", "", key)
+		a.PListSignedURL, err = signature.GetSignedURL("GET", "/api/plist-gen/"+a.ID+".plist"+query, "", key)
 		if err != nil {
 			return nil
 		}
@@ -41,6 +116,21 @@ func (a *Artifact) AddSignedURLs(key string) error {
 	return nil
 }
 
+// AddInstallSignedURL sets the URL of the install callback, only valid until expiresAt if it is set
+func (b *Build) AddInstallSignedURL(salt string, expiresAt time.Time) error {
+	id := b.YoloID // build IDs are usually URLs, the yolo_id can be used in a single path segment
+	if id == "" {
+		id = b.ID
+	}
+	query := ""
+	if !expiresAt.IsZero() {
+		query = fmt.Sprintf("?expires=%d", expiresAt.Unix())
+	}
+	var err error
+	b.InstallSignedURL, err = signature.GetSignedURL("POST", "/api/installed/"+id+query, "", salt)
+	return err
+}
+
 func (b *Build) ApplyMetadataOverride(override *MetadataOverride) {
 	bytes, err := override.Marshal()
 	if err != nil {
@@ -53,3 +143,79 @@ func (b *Build) ApplyMetadataOverride(override *MetadataOverride) {
 	}
 	proto.Merge(b, o)
 }
+
+// BeforeSave is a gorm hook storing the BuildConfig and Flags maps and the OwnerTeams list as JSON
+func (b *Build) BeforeSave() error {
+	b.BuildConfigJSON = ""
+	if len(b.BuildConfig) > 0 {
+		// json.Marshal sorts the map keys, the output can be matched with BuildConfigFilter
+		out, err := json.Marshal(b.BuildConfig)
+		if err != nil {
+			return fmt.Errorf("marshal build config: %w", err)
+		}
+		b.BuildConfigJSON = string(out)
+	}
+	b.OwnerTeamsJSON = ""
+	if len(b.OwnerTeams) > 0 {
+		out, err := json.Marshal(b.OwnerTeams)
+		if err != nil {
+			return fmt.Errorf("marshal owner teams: %w", err)
+		}
+		b.OwnerTeamsJSON = string(out)
+	}
+	b.FlagsJSON = ""
+	if len(b.Flags) > 0 {
+		out, err := json.Marshal(b.Flags)
+		if err != nil {
+			return fmt.Errorf("marshal flags: %w", err)
+		}
+		b.FlagsJSON = string(out)
+	}
+	b.setDuration()
+	b.setVersionKey()
+	return nil
+}
+
+// setDuration computes the duration of a finished build from its start and finish times, it is 0 while running
+func (b *Build) setDuration() {
+	b.Duration = 0
+	if b.StartedAt != nil && b.FinishedAt != nil && b.FinishedAt.After(*b.StartedAt) {
+		b.Duration = int64(b.FinishedAt.Sub(*b.StartedAt).Seconds())
+	}
+}
+
+// AfterFind is a gorm hook loading the BuildConfig and Flags maps and the OwnerTeams list from their JSON representation
+func (b *Build) AfterFind() error {
+	if b.Duration == 0 { // stored before the durations were
+		b.setDuration()
+	}
+	if b.BuildConfigJSON != "" {
+		if err := json.Unmarshal([]byte(b.BuildConfigJSON), &b.BuildConfig); err != nil {
+			return fmt.Errorf("unmarshal build config: %w", err)
+		}
+	}
+	if b.OwnerTeamsJSON != "" {
+		if err := json.Unmarshal([]byte(b.OwnerTeamsJSON), &b.OwnerTeams); err != nil {
+			return fmt.Errorf("unmarshal owner teams: %w", err)
+		}
+	}
+	if b.FlagsJSON != "" {
+		if err := json.Unmarshal([]byte(b.FlagsJSON), &b.Flags); err != nil {
+			return fmt.Errorf("unmarshal flags: %w", err)
+		}
+	}
+	return nil
+}
+
+// BuildConfigFilter returns the JSON fragment matching a build config entry in BuildConfigJSON
+func BuildConfigFilter(key, value string) string {
+	k, _ := json.Marshal(key)
+	v, _ := json.Marshal(value)
+	return string(k) + ":" + string(v)
+}
+
+// OwnerTeamFilter returns the JSON fragment matching a team in OwnerTeamsJSON
+func OwnerTeamFilter(team string) string {
+	t, _ := json.Marshal(team)
+	return string(t)
+}
@@ -2,6 +2,7 @@ package yolopb
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -33,3 +34,22 @@ func TestBuildPrepareOutput(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildDuration(t *testing.T) {
+	startedAt := time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)
+	finishedAt := startedAt.Add(90 * time.Second)
+
+	build := Build{StartedAt: &startedAt, FinishedAt: &finishedAt}
+	require.NoError(t, build.BeforeSave())
+	assert.Equal(t, int64(90), build.Duration)
+
+	// running
+	build = Build{StartedAt: &startedAt, Duration: 42}
+	require.NoError(t, build.BeforeSave())
+	assert.Equal(t, int64(0), build.Duration)
+
+	// stored before the durations
+	build = Build{StartedAt: &startedAt, FinishedAt: &finishedAt}
+	require.NoError(t, build.AfterFind())
+	assert.Equal(t, int64(90), build.Duration)
+}
@@ -0,0 +1,66 @@
+package yolopb
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var semverTag = regexp.MustCompile(`^v?(\d+)\.(\d+)(?:\.(\d+))?(?:-([0-9A-Za-z.-]+))?(?:\+[0-9A-Za-z.-]+)?$`)
+
+// versionKeyDigits is the width of the zero-padded numbers of the version keys
+const versionKeyDigits = 10
+
+// VersionSortKey returns a sortable form of a semantic version tag (i.e, v1.2.3, 1.2 or 1.2.3-rc.1), empty if it isn't one.
+// the keys can be compared as strings: the numbers are zero-padded, and the pre-releases are lower than their release.
+func VersionSortKey(tag string) string {
+	matches := semverTag.FindStringSubmatch(tag)
+	if matches == nil {
+		return ""
+	}
+	if matches[3] == "" {
+		matches[3] = "0"
+	}
+	parts := make([]string, 3)
+	for i, number := range matches[1:4] {
+		padded, ok := padVersionNumber(number)
+		if !ok {
+			return ""
+		}
+		parts[i] = padded
+	}
+	key := strings.Join(parts, ".")
+	if matches[4] == "" {
+		return key + "~" // after the pre-releases
+	}
+
+	// the numeric identifiers are lower than the alphanumeric ones, the separator is lower than the identifier characters
+	identifiers := strings.Split(matches[4], ".")
+	for i, identifier := range identifiers {
+		if _, err := strconv.ParseUint(identifier, 10, 64); err == nil {
+			padded, ok := padVersionNumber(identifier)
+			if !ok {
+				return ""
+			}
+			identifiers[i] = "0" + padded
+		} else {
+			identifiers[i] = "1" + identifier
+		}
+	}
+	return key + "!" + strings.Join(identifiers, ",")
+}
+
+func padVersionNumber(number string) (string, bool) {
+	n, err := strconv.ParseUint(number, 10, 64)
+	if err != nil {
+		return "", false
+	}
+	padded := fmt.Sprintf("%0*d", versionKeyDigits, n)
+	return padded, len(padded) == versionKeyDigits
+}
+
+// setVersionKey computes the sort key of the version tag
+func (b *Build) setVersionKey() {
+	b.VersionKey = VersionSortKey(b.VCSTag)
+}
@@ -0,0 +1,38 @@
+package yolopb
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionSortKey(t *testing.T) {
+	assert.Equal(t, "0000000001.0000000002.0000000003~", VersionSortKey("v1.2.3"))
+	assert.Equal(t, VersionSortKey("v1.2.3"), VersionSortKey("1.2.3"))
+	assert.Equal(t, VersionSortKey("1.2.0"), VersionSortKey("v1.2"))
+	assert.Equal(t, VersionSortKey("1.2.3"), VersionSortKey("1.2.3+build.42"))
+	for _, tag := range []string{"", "main", "v1", "release-1.2.3", "v1.2.3.4", "1.2.3-", "v99999999999.0.0"} {
+		assert.Empty(t, VersionSortKey(tag), tag)
+	}
+
+	// ascending precedence, from the semver specification
+	ordered := []string{"v0.9.0", "1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta", "1.0.0-beta.2", "1.0.0-beta.11", "1.0.0-rc.1", "v1.0.0", "v1.2.0", "v1.10.0", "v2.0.0"}
+	keys := make([]string, len(ordered))
+	for i, tag := range ordered {
+		keys[i] = VersionSortKey(tag)
+		require.NotEmpty(t, keys[i], tag)
+	}
+	assert.True(t, sort.StringsAreSorted(keys), keys)
+}
+
+func TestBuildVersionKey(t *testing.T) {
+	build := Build{VCSTag: "v1.2.3"}
+	require.NoError(t, build.BeforeSave())
+	assert.Equal(t, "0000000001.0000000002.0000000003~", build.VersionKey)
+
+	build.VCSTag = ""
+	require.NoError(t, build.BeforeSave())
+	assert.Empty(t, build.VersionKey)
+}
@@ -5,6 +5,7 @@ package yolopb
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
@@ -36,27 +37,46 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Driver int32
 
 const (
-	Driver_UnknownDriver Driver = 0
-	Driver_Buildkite     Driver = 1
-	Driver_CircleCI      Driver = 2
-	Driver_Bintray       Driver = 3
-	Driver_GitHub        Driver = 4
+	Driver_UnknownDriver           Driver = 0
+	Driver_Buildkite               Driver = 1
+	Driver_CircleCI                Driver = 2
+	Driver_Bintray                 Driver = 3
+	Driver_GitHub                  Driver = 4
+	Driver_S3                      Driver = 5
+	Driver_FirebaseAppDistribution Driver = 6
+	Driver_TestFlight              Driver = 7
+	Driver_AzurePipelines          Driver = 8
+	Driver_Jenkins                 Driver = 9
+	// artifacts downloaded from their absolute URL, for the CIs only giving a plain artifact URL
+	Driver_HTTP Driver = 10
 )
 
 var Driver_name = map[int32]string{
-	0: "UnknownDriver",
-	1: "Buildkite",
-	2: "CircleCI",
-	3: "Bintray",
-	4: "GitHub",
+	0:  "UnknownDriver",
+	1:  "Buildkite",
+	2:  "CircleCI",
+	3:  "Bintray",
+	4:  "GitHub",
+	5:  "S3",
+	6:  "FirebaseAppDistribution",
+	7:  "TestFlight",
+	8:  "AzurePipelines",
+	9:  "Jenkins",
+	10: "HTTP",
 }
 
 var Driver_value = map[string]int32{
-	"UnknownDriver": 0,
-	"Buildkite":     1,
-	"CircleCI":      2,
-	"Bintray":       3,
-	"GitHub":        4,
+	"UnknownDriver":           0,
+	"Buildkite":               1,
+	"CircleCI":                2,
+	"Bintray":                 3,
+	"GitHub":                  4,
+	"S3":                      5,
+	"FirebaseAppDistribution": 6,
+	"TestFlight":              7,
+	"AzurePipelines":          8,
+	"Jenkins":                 9,
+	"HTTP":                    10,
 }
 
 func (x Driver) String() string {
@@ -67,6 +87,95 @@ func (Driver) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_a62788fcb176084a, []int{0}
 }
 
+type Status_CircuitState int32
+
+const (
+	Status_Closed Status_CircuitState = 0
+	// the refreshes are paused after consecutive failures
+	Status_Open Status_CircuitState = 1
+	// a single refresh probes the driver
+	Status_HalfOpen Status_CircuitState = 2
+)
+
+var Status_CircuitState_name = map[int32]string{
+	0: "Closed",
+	1: "Open",
+	2: "HalfOpen",
+}
+
+var Status_CircuitState_value = map[string]int32{
+	"Closed":   0,
+	"Open":     1,
+	"HalfOpen": 2,
+}
+
+func (x Status_CircuitState) String() string {
+	return proto.EnumName(Status_CircuitState_name, int32(x))
+}
+
+func (Status_CircuitState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{2, 0}
+}
+
+type BuildList_SortBy int32
+
+const (
+	BuildList_CreatedAt BuildList_SortBy = 0
+	// the build number of the CI provider
+	BuildList_BuildNum BuildList_SortBy = 1
+	// the time between the start and the end of the build
+	BuildList_Duration BuildList_SortBy = 2
+	// the semantic version of the tag, the builds without a version tag are listed last
+	BuildList_Version BuildList_SortBy = 3
+)
+
+var BuildList_SortBy_name = map[int32]string{
+	0: "CreatedAt",
+	1: "BuildNum",
+	2: "Duration",
+	3: "Version",
+}
+
+var BuildList_SortBy_value = map[string]int32{
+	"CreatedAt": 0,
+	"BuildNum":  1,
+	"Duration":  2,
+	"Version":   3,
+}
+
+func (x BuildList_SortBy) String() string {
+	return proto.EnumName(BuildList_SortBy_name, int32(x))
+}
+
+func (BuildList_SortBy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{4, 0}
+}
+
+type BuildList_SortOrder int32
+
+const (
+	BuildList_Desc BuildList_SortOrder = 0
+	BuildList_Asc  BuildList_SortOrder = 1
+)
+
+var BuildList_SortOrder_name = map[int32]string{
+	0: "Desc",
+	1: "Asc",
+}
+
+var BuildList_SortOrder_value = map[string]int32{
+	"Desc": 0,
+	"Asc":  1,
+}
+
+func (x BuildList_SortOrder) String() string {
+	return proto.EnumName(BuildList_SortOrder_name, int32(x))
+}
+
+func (BuildList_SortOrder) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{4, 1}
+}
+
 type Build_State int32
 
 const (
@@ -110,7 +219,7 @@ func (x Build_State) String() string {
 }
 
 func (Build_State) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_a62788fcb176084a, []int{6, 0}
+	return fileDescriptor_a62788fcb176084a, []int{16, 0}
 }
 
 type MergeRequest_State int32
@@ -141,7 +250,7 @@ func (x MergeRequest_State) String() string {
 }
 
 func (MergeRequest_State) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_a62788fcb176084a, []int{9, 0}
+	return fileDescriptor_a62788fcb176084a, []int{19, 0}
 }
 
 type Entity_Kind int32
@@ -172,7 +281,7 @@ func (x Entity_Kind) String() string {
 }
 
 func (Entity_Kind) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_a62788fcb176084a, []int{11, 0}
+	return fileDescriptor_a62788fcb176084a, []int{21, 0}
 }
 
 type Artifact_State int32
@@ -206,7 +315,7 @@ func (x Artifact_State) String() string {
 }
 
 func (Artifact_State) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_a62788fcb176084a, []int{12, 0}
+	return fileDescriptor_a62788fcb176084a, []int{22, 0}
 }
 
 type Artifact_Kind int32
@@ -216,6 +325,9 @@ const (
 	Artifact_IPA         Artifact_Kind = 1
 	Artifact_APK         Artifact_Kind = 2
 	Artifact_DMG         Artifact_Kind = 3
+	// Windows installers
+	Artifact_EXE Artifact_Kind = 4
+	Artifact_MSI Artifact_Kind = 5
 )
 
 var Artifact_Kind_name = map[int32]string{
@@ -223,6 +335,8 @@ var Artifact_Kind_name = map[int32]string{
 	1: "IPA",
 	2: "APK",
 	3: "DMG",
+	4: "EXE",
+	5: "MSI",
 }
 
 var Artifact_Kind_value = map[string]int32{
@@ -230,6 +344,8 @@ var Artifact_Kind_value = map[string]int32{
 	"IPA":         1,
 	"APK":         2,
 	"DMG":         3,
+	"EXE":         4,
+	"MSI":         5,
 }
 
 func (x Artifact_Kind) String() string {
@@ -237,7 +353,7 @@ func (x Artifact_Kind) String() string {
 }
 
 func (Artifact_Kind) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_a62788fcb176084a, []int{12, 1}
+	return fileDescriptor_a62788fcb176084a, []int{22, 1}
 }
 
 type Ping struct {
@@ -553,14 +669,19 @@ func (m *Status_Request) XXX_DiscardUnknown() {
 var xxx_messageInfo_Status_Request proto.InternalMessageInfo
 
 type Status_Response struct {
-	Uptime          int32  `protobuf:"varint,1,opt,name=uptime,proto3" json:"uptime,omitempty"`
-	DbErr           string `protobuf:"bytes,2,opt,name=db_err,json=dbErr,proto3" json:"db_err,omitempty"`
-	NbEntities      int32  `protobuf:"varint,10,opt,name=nb_entities,json=nbEntities,proto3" json:"nb_entities,omitempty"`
-	NbProjects      int32  `protobuf:"varint,11,opt,name=nb_projects,json=nbProjects,proto3" json:"nb_projects,omitempty"`
-	NbCommits       int32  `protobuf:"varint,12,opt,name=nb_commits,json=nbCommits,proto3" json:"nb_commits,omitempty"`
-	NbReleases      int32  `protobuf:"varint,13,opt,name=nb_releases,json=nbReleases,proto3" json:"nb_releases,omitempty"`
-	NbBuilds        int32  `protobuf:"varint,14,opt,name=nb_builds,json=nbBuilds,proto3" json:"nb_builds,omitempty"`
-	NbMergeRequests int32  `protobuf:"varint,15,opt,name=nb_merge_requests,json=nbMergeRequests,proto3" json:"nb_merge_requests,omitempty"`
+	Uptime int32  `protobuf:"varint,1,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	DbErr  string `protobuf:"bytes,2,opt,name=db_err,json=dbErr,proto3" json:"db_err,omitempty"`
+	// download and install events older than this are trimmed, their totals are kept; 0 means unlimited
+	EventRetentionSeconds int64 `protobuf:"varint,3,opt,name=event_retention_seconds,json=eventRetentionSeconds,proto3" json:"event_retention_seconds,omitempty"`
+	// circuit breakers of the driver workers, sorted by driver
+	Drivers         []*Status_DriverStatus `protobuf:"bytes,4,rep,name=drivers,proto3" json:"drivers,omitempty"`
+	Version         *Version_Response      `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+	NbEntities      int32                  `protobuf:"varint,10,opt,name=nb_entities,json=nbEntities,proto3" json:"nb_entities,omitempty"`
+	NbProjects      int32                  `protobuf:"varint,11,opt,name=nb_projects,json=nbProjects,proto3" json:"nb_projects,omitempty"`
+	NbCommits       int32                  `protobuf:"varint,12,opt,name=nb_commits,json=nbCommits,proto3" json:"nb_commits,omitempty"`
+	NbReleases      int32                  `protobuf:"varint,13,opt,name=nb_releases,json=nbReleases,proto3" json:"nb_releases,omitempty"`
+	NbBuilds        int32                  `protobuf:"varint,14,opt,name=nb_builds,json=nbBuilds,proto3" json:"nb_builds,omitempty"`
+	NbMergeRequests int32                  `protobuf:"varint,15,opt,name=nb_merge_requests,json=nbMergeRequests,proto3" json:"nb_merge_requests,omitempty"`
 }
 
 func (m *Status_Response) Reset()         { *m = Status_Response{} }
@@ -610,6 +731,27 @@ func (m *Status_Response) GetDbErr() string {
 	return ""
 }
 
+func (m *Status_Response) GetEventRetentionSeconds() int64 {
+	if m != nil {
+		return m.EventRetentionSeconds
+	}
+	return 0
+}
+
+func (m *Status_Response) GetDrivers() []*Status_DriverStatus {
+	if m != nil {
+		return m.Drivers
+	}
+	return nil
+}
+
+func (m *Status_Response) GetVersion() *Version_Response {
+	if m != nil {
+		return m.Version
+	}
+	return nil
+}
+
 func (m *Status_Response) GetNbEntities() int32 {
 	if m != nil {
 		return m.NbEntities
@@ -652,6 +794,217 @@ func (m *Status_Response) GetNbMergeRequests() int32 {
 	return 0
 }
 
+type Status_DriverStatus struct {
+	Driver              Driver              `protobuf:"varint,1,opt,name=driver,proto3,enum=yolo.Driver" json:"driver,omitempty"`
+	Circuit             Status_CircuitState `protobuf:"varint,2,opt,name=circuit,proto3,enum=yolo.Status_CircuitState" json:"circuit,omitempty"`
+	ConsecutiveFailures int32               `protobuf:"varint,3,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	// next refresh attempt of an open circuit
+	RetryAt *time.Time `protobuf:"bytes,4,opt,name=retry_at,json=retryAt,proto3,stdtime" json:"retry_at,omitempty"`
+}
+
+func (m *Status_DriverStatus) Reset()         { *m = Status_DriverStatus{} }
+func (m *Status_DriverStatus) String() string { return proto.CompactTextString(m) }
+func (*Status_DriverStatus) ProtoMessage()    {}
+func (*Status_DriverStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{2, 2}
+}
+func (m *Status_DriverStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Status_DriverStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Status_DriverStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Status_DriverStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Status_DriverStatus.Merge(m, src)
+}
+func (m *Status_DriverStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *Status_DriverStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_Status_DriverStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Status_DriverStatus proto.InternalMessageInfo
+
+func (m *Status_DriverStatus) GetDriver() Driver {
+	if m != nil {
+		return m.Driver
+	}
+	return Driver_UnknownDriver
+}
+
+func (m *Status_DriverStatus) GetCircuit() Status_CircuitState {
+	if m != nil {
+		return m.Circuit
+	}
+	return Status_Closed
+}
+
+func (m *Status_DriverStatus) GetConsecutiveFailures() int32 {
+	if m != nil {
+		return m.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (m *Status_DriverStatus) GetRetryAt() *time.Time {
+	if m != nil {
+		return m.RetryAt
+	}
+	return nil
+}
+
+type Version struct {
+}
+
+func (m *Version) Reset()         { *m = Version{} }
+func (m *Version) String() string { return proto.CompactTextString(m) }
+func (*Version) ProtoMessage()    {}
+func (*Version) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{3}
+}
+func (m *Version) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Version) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Version.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Version) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Version.Merge(m, src)
+}
+func (m *Version) XXX_Size() int {
+	return m.Size()
+}
+func (m *Version) XXX_DiscardUnknown() {
+	xxx_messageInfo_Version.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Version proto.InternalMessageInfo
+
+type Version_Request struct {
+}
+
+func (m *Version_Request) Reset()         { *m = Version_Request{} }
+func (m *Version_Request) String() string { return proto.CompactTextString(m) }
+func (*Version_Request) ProtoMessage()    {}
+func (*Version_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{3, 0}
+}
+func (m *Version_Request) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Version_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Version_Request.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Version_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Version_Request.Merge(m, src)
+}
+func (m *Version_Request) XXX_Size() int {
+	return m.Size()
+}
+func (m *Version_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_Version_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Version_Request proto.InternalMessageInfo
+
+type Version_Response struct {
+	// semantic version of the yolo binary, "dev" if unknown
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Commit  string `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	// RFC 3339
+	BuildDate string `protobuf:"bytes,3,opt,name=build_date,json=buildDate,proto3" json:"build_date,omitempty"`
+	GoVersion string `protobuf:"bytes,4,opt,name=go_version,json=goVersion,proto3" json:"go_version,omitempty"`
+}
+
+func (m *Version_Response) Reset()         { *m = Version_Response{} }
+func (m *Version_Response) String() string { return proto.CompactTextString(m) }
+func (*Version_Response) ProtoMessage()    {}
+func (*Version_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{3, 1}
+}
+func (m *Version_Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Version_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Version_Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Version_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Version_Response.Merge(m, src)
+}
+func (m *Version_Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *Version_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_Version_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Version_Response proto.InternalMessageInfo
+
+func (m *Version_Response) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *Version_Response) GetCommit() string {
+	if m != nil {
+		return m.Commit
+	}
+	return ""
+}
+
+func (m *Version_Response) GetBuildDate() string {
+	if m != nil {
+		return m.BuildDate
+	}
+	return ""
+}
+
+func (m *Version_Response) GetGoVersion() string {
+	if m != nil {
+		return m.GoVersion
+	}
+	return ""
+}
+
 type BuildList struct {
 }
 
@@ -659,7 +1012,7 @@ func (m *BuildList) Reset()         { *m = BuildList{} }
 func (m *BuildList) String() string { return proto.CompactTextString(m) }
 func (*BuildList) ProtoMessage()    {}
 func (*BuildList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a62788fcb176084a, []int{3}
+	return fileDescriptor_a62788fcb176084a, []int{4}
 }
 func (m *BuildList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -689,11 +1042,11 @@ func (m *BuildList) XXX_DiscardUnknown() {
 var xxx_messageInfo_BuildList proto.InternalMessageInfo
 
 type BuildList_Request struct {
-	// max amount of builds
+	// max amount of builds, defaults to 50 when unset and is clamped to 1000
 	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	// filter on artifact kinds
+	// filter on artifact kinds, builds without an artifact of these kinds are filtered out
 	ArtifactKinds []Artifact_Kind `protobuf:"varint,2,rep,packed,name=artifact_kinds,json=artifactKinds,proto3,enum=yolo.Artifact_Kind" json:"artifact_kinds,omitempty"`
-	// filter builds without any artifacts
+	// filter out builds without any artifacts (i.e, test-only CI runs)
 	WithArtifacts bool `protobuf:"varint,3,opt,name=with_artifacts,json=withArtifacts,proto3" json:"with_artifacts,omitempty"`
 	// only a specific build by its ID or yolo_id
 	BuildID []string `protobuf:"bytes,4,rep,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
@@ -719,13 +1072,35 @@ type BuildList_Request struct {
 	WithNoMergerequest bool `protobuf:"varint,14,opt,name=with_no_mergerequest,json=withNoMergerequest,proto3" json:"with_no_mergerequest,omitempty"`
 	// sort by commit date
 	SortByCommitDate bool `protobuf:"varint,15,opt,name=sort_by_commit_date,json=sortByCommitDate,proto3" json:"sort_by_commit_date,omitempty"`
+	// filter on builds of a release channel, builds of channels requiring a promotion are only listed once promoted
+	Channel string `protobuf:"bytes,16,opt,name=channel,proto3" json:"channel,omitempty"`
+	// filter on build configuration entries, formatted as "KEY=value"
+	BuildConfig []string `protobuf:"bytes,17,rep,name=build_config,json=buildConfig,proto3" json:"build_config,omitempty"`
+	// filter on builds owned by any of these teams (i.e, @berty/core)
+	OwnerTeam []string `protobuf:"bytes,18,rep,name=owner_team,json=ownerTeam,proto3" json:"owner_team,omitempty"`
+	// filter on builds with an artifact exceeding the size budget of their project
+	OverBudget bool `protobuf:"varint,19,opt,name=over_budget,json=overBudget,proto3" json:"over_budget,omitempty"`
+	// amount of builds to skip, to be used with limit to paginate over the build history
+	Offset int32 `protobuf:"varint,20,opt,name=offset,proto3" json:"offset,omitempty"`
+	// filter on artifact architectures (arm64, amd64, universal), the artifacts of other architectures are omitted
+	ArtifactArch []string `protobuf:"bytes,21,rep,name=artifact_arch,json=artifactArch,proto3" json:"artifact_arch,omitempty"`
+	// opaque cursor returned as next_page_token by a previous call, to list the following builds
+	PageToken string `protobuf:"bytes,22,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// sort key of the builds, defaults to the creation date, the builds without a value are listed last
+	SortBy BuildList_SortBy `protobuf:"varint,23,opt,name=sort_by,json=sortBy,proto3,enum=yolo.BuildList_SortBy" json:"sort_by,omitempty"`
+	// sort order of the builds, defaults to the most recent or greatest first
+	SortOrder BuildList_SortOrder `protobuf:"varint,24,opt,name=sort_order,json=sortOrder,proto3,enum=yolo.BuildList_SortOrder" json:"sort_order,omitempty"`
+	// filter by state of the build on a distribution service (i.e, READY_FOR_BETA_TESTING for TestFlight)
+	ExternalState []string `protobuf:"bytes,25,rep,name=external_state,json=externalState,proto3" json:"external_state,omitempty"`
+	// filter on tagged builds (i.e, the releases), they are listed even without merge request
+	TaggedOnly bool `protobuf:"varint,26,opt,name=tagged_only,json=taggedOnly,proto3" json:"tagged_only,omitempty"`
 }
 
 func (m *BuildList_Request) Reset()         { *m = BuildList_Request{} }
 func (m *BuildList_Request) String() string { return proto.CompactTextString(m) }
 func (*BuildList_Request) ProtoMessage()    {}
 func (*BuildList_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a62788fcb176084a, []int{3, 0}
+	return fileDescriptor_a62788fcb176084a, []int{4, 0}
 }
 func (m *BuildList_Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -859,15 +1234,94 @@ func (m *BuildList_Request) GetSortByCommitDate() bool {
 	return false
 }
 
+func (m *BuildList_Request) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *BuildList_Request) GetBuildConfig() []string {
+	if m != nil {
+		return m.BuildConfig
+	}
+	return nil
+}
+
+func (m *BuildList_Request) GetOwnerTeam() []string {
+	if m != nil {
+		return m.OwnerTeam
+	}
+	return nil
+}
+
+func (m *BuildList_Request) GetOverBudget() bool {
+	if m != nil {
+		return m.OverBudget
+	}
+	return false
+}
+
+func (m *BuildList_Request) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *BuildList_Request) GetArtifactArch() []string {
+	if m != nil {
+		return m.ArtifactArch
+	}
+	return nil
+}
+
+func (m *BuildList_Request) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *BuildList_Request) GetSortBy() BuildList_SortBy {
+	if m != nil {
+		return m.SortBy
+	}
+	return BuildList_CreatedAt
+}
+
+func (m *BuildList_Request) GetSortOrder() BuildList_SortOrder {
+	if m != nil {
+		return m.SortOrder
+	}
+	return BuildList_Desc
+}
+
+func (m *BuildList_Request) GetExternalState() []string {
+	if m != nil {
+		return m.ExternalState
+	}
+	return nil
+}
+
+func (m *BuildList_Request) GetTaggedOnly() bool {
+	if m != nil {
+		return m.TaggedOnly
+	}
+	return false
+}
+
 type BuildList_Response struct {
 	Builds []*Build `protobuf:"bytes,1,rep,name=builds,proto3" json:"builds,omitempty"`
+	// cursor of the next page, empty when there are no more builds
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
 func (m *BuildList_Response) Reset()         { *m = BuildList_Response{} }
 func (m *BuildList_Response) String() string { return proto.CompactTextString(m) }
 func (*BuildList_Response) ProtoMessage()    {}
 func (*BuildList_Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a62788fcb176084a, []int{3, 1}
+	return fileDescriptor_a62788fcb176084a, []int{4, 1}
 }
 func (m *BuildList_Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -903,21 +1357,28 @@ func (m *BuildList_Response) GetBuilds() []*Build {
 	return nil
 }
 
-type BuildListFilters struct {
+func (m *BuildList_Response) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
 }
 
-func (m *BuildListFilters) Reset()         { *m = BuildListFilters{} }
-func (m *BuildListFilters) String() string { return proto.CompactTextString(m) }
-func (*BuildListFilters) ProtoMessage()    {}
-func (*BuildListFilters) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a62788fcb176084a, []int{4}
+type PromoteBuild struct {
 }
-func (m *BuildListFilters) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *PromoteBuild) Reset()         { *m = PromoteBuild{} }
+func (m *PromoteBuild) String() string { return proto.CompactTextString(m) }
+func (*PromoteBuild) ProtoMessage()    {}
+func (*PromoteBuild) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{5}
 }
-func (m *BuildListFilters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PromoteBuild) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PromoteBuild) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BuildListFilters.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PromoteBuild.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -927,33 +1388,36 @@ func (m *BuildListFilters) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *BuildListFilters) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BuildListFilters.Merge(m, src)
+func (m *PromoteBuild) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PromoteBuild.Merge(m, src)
 }
-func (m *BuildListFilters) XXX_Size() int {
+func (m *PromoteBuild) XXX_Size() int {
 	return m.Size()
 }
-func (m *BuildListFilters) XXX_DiscardUnknown() {
-	xxx_messageInfo_BuildListFilters.DiscardUnknown(m)
+func (m *PromoteBuild) XXX_DiscardUnknown() {
+	xxx_messageInfo_PromoteBuild.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BuildListFilters proto.InternalMessageInfo
+var xxx_messageInfo_PromoteBuild proto.InternalMessageInfo
 
-type BuildListFilters_Request struct {
+type PromoteBuild_Request struct {
+	// build ID or yolo_id
+	BuildID string `protobuf:"bytes,1,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
 }
 
-func (m *BuildListFilters_Request) Reset()         { *m = BuildListFilters_Request{} }
-func (m *BuildListFilters_Request) String() string { return proto.CompactTextString(m) }
-func (*BuildListFilters_Request) ProtoMessage()    {}
-func (*BuildListFilters_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a62788fcb176084a, []int{4, 0}
+func (m *PromoteBuild_Request) Reset()         { *m = PromoteBuild_Request{} }
+func (m *PromoteBuild_Request) String() string { return proto.CompactTextString(m) }
+func (*PromoteBuild_Request) ProtoMessage()    {}
+func (*PromoteBuild_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{5, 0}
 }
-func (m *BuildListFilters_Request) XXX_Unmarshal(b []byte) error {
+func (m *PromoteBuild_Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BuildListFilters_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PromoteBuild_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BuildListFilters_Request.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PromoteBuild_Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -963,35 +1427,48 @@ func (m *BuildListFilters_Request) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *BuildListFilters_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BuildListFilters_Request.Merge(m, src)
+func (m *PromoteBuild_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PromoteBuild_Request.Merge(m, src)
 }
-func (m *BuildListFilters_Request) XXX_Size() int {
+func (m *PromoteBuild_Request) XXX_Size() int {
 	return m.Size()
 }
-func (m *BuildListFilters_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_BuildListFilters_Request.DiscardUnknown(m)
+func (m *PromoteBuild_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_PromoteBuild_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BuildListFilters_Request proto.InternalMessageInfo
+var xxx_messageInfo_PromoteBuild_Request proto.InternalMessageInfo
 
-type BuildListFilters_Response struct {
-	Entities []*Entity  `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty"`
-	Projects []*Project `protobuf:"bytes,2,rep,name=projects,proto3" json:"projects,omitempty"`
+func (m *PromoteBuild_Request) GetBuildID() string {
+	if m != nil {
+		return m.BuildID
+	}
+	return ""
 }
 
-func (m *BuildListFilters_Response) Reset()         { *m = BuildListFilters_Response{} }
-func (m *BuildListFilters_Response) String() string { return proto.CompactTextString(m) }
-func (*BuildListFilters_Response) ProtoMessage()    {}
-func (*BuildListFilters_Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a62788fcb176084a, []int{4, 1}
+func (m *PromoteBuild_Request) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
 }
-func (m *BuildListFilters_Response) XXX_Unmarshal(b []byte) error {
+
+type PromoteBuild_Response struct {
+	Build *Build `protobuf:"bytes,1,opt,name=build,proto3" json:"build,omitempty"`
+}
+
+func (m *PromoteBuild_Response) Reset()         { *m = PromoteBuild_Response{} }
+func (m *PromoteBuild_Response) String() string { return proto.CompactTextString(m) }
+func (*PromoteBuild_Response) ProtoMessage()    {}
+func (*PromoteBuild_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{5, 1}
+}
+func (m *PromoteBuild_Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BuildListFilters_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PromoteBuild_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BuildListFilters_Response.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PromoteBuild_Response.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1001,51 +1478,40 @@ func (m *BuildListFilters_Response) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *BuildListFilters_Response) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BuildListFilters_Response.Merge(m, src)
+func (m *PromoteBuild_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PromoteBuild_Response.Merge(m, src)
 }
-func (m *BuildListFilters_Response) XXX_Size() int {
+func (m *PromoteBuild_Response) XXX_Size() int {
 	return m.Size()
 }
-func (m *BuildListFilters_Response) XXX_DiscardUnknown() {
-	xxx_messageInfo_BuildListFilters_Response.DiscardUnknown(m)
+func (m *PromoteBuild_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_PromoteBuild_Response.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BuildListFilters_Response proto.InternalMessageInfo
-
-func (m *BuildListFilters_Response) GetEntities() []*Entity {
-	if m != nil {
-		return m.Entities
-	}
-	return nil
-}
+var xxx_messageInfo_PromoteBuild_Response proto.InternalMessageInfo
 
-func (m *BuildListFilters_Response) GetProjects() []*Project {
+func (m *PromoteBuild_Response) GetBuild() *Build {
 	if m != nil {
-		return m.Projects
+		return m.Build
 	}
 	return nil
 }
 
-type MetadataOverride struct {
-	Branch            string `protobuf:"bytes,11,opt,name=branch,proto3" json:"branch,omitempty"`
-	HasCommitID       string `protobuf:"bytes,103,opt,name=has_commit_id,json=hasCommitId,proto3" json:"has_commit_id,omitempty"`
-	HasProjectID      string `protobuf:"bytes,105,opt,name=has_project_id,json=hasProjectId,proto3" json:"has_project_id,omitempty"`
-	HasMergeRequestID string `protobuf:"bytes,107,opt,name=has_mergerequest_id,json=hasMergerequestId,proto3" json:"has_mergerequest_id,omitempty"`
+type GetBuild struct {
 }
 
-func (m *MetadataOverride) Reset()         { *m = MetadataOverride{} }
-func (m *MetadataOverride) String() string { return proto.CompactTextString(m) }
-func (*MetadataOverride) ProtoMessage()    {}
-func (*MetadataOverride) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a62788fcb176084a, []int{5}
+func (m *GetBuild) Reset()         { *m = GetBuild{} }
+func (m *GetBuild) String() string { return proto.CompactTextString(m) }
+func (*GetBuild) ProtoMessage()    {}
+func (*GetBuild) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{6}
 }
-func (m *MetadataOverride) XXX_Unmarshal(b []byte) error {
+func (m *GetBuild) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MetadataOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetBuild) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MetadataOverride.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetBuild.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1055,90 +1521,79 @@ func (m *MetadataOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *MetadataOverride) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MetadataOverride.Merge(m, src)
+func (m *GetBuild) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBuild.Merge(m, src)
 }
-func (m *MetadataOverride) XXX_Size() int {
+func (m *GetBuild) XXX_Size() int {
 	return m.Size()
 }
-func (m *MetadataOverride) XXX_DiscardUnknown() {
-	xxx_messageInfo_MetadataOverride.DiscardUnknown(m)
+func (m *GetBuild) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBuild.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MetadataOverride proto.InternalMessageInfo
+var xxx_messageInfo_GetBuild proto.InternalMessageInfo
 
-func (m *MetadataOverride) GetBranch() string {
-	if m != nil {
-		return m.Branch
-	}
-	return ""
+type GetBuild_Request struct {
+	// build ID or yolo_id
+	BuildID string `protobuf:"bytes,1,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
 }
 
-func (m *MetadataOverride) GetHasCommitID() string {
-	if m != nil {
-		return m.HasCommitID
-	}
-	return ""
+func (m *GetBuild_Request) Reset()         { *m = GetBuild_Request{} }
+func (m *GetBuild_Request) String() string { return proto.CompactTextString(m) }
+func (*GetBuild_Request) ProtoMessage()    {}
+func (*GetBuild_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{6, 0}
 }
-
-func (m *MetadataOverride) GetHasProjectID() string {
-	if m != nil {
-		return m.HasProjectID
+func (m *GetBuild_Request) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBuild_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBuild_Request.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *GetBuild_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBuild_Request.Merge(m, src)
+}
+func (m *GetBuild_Request) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBuild_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBuild_Request.DiscardUnknown(m)
 }
 
-func (m *MetadataOverride) GetHasMergeRequestID() string {
+var xxx_messageInfo_GetBuild_Request proto.InternalMessageInfo
+
+func (m *GetBuild_Request) GetBuildID() string {
 	if m != nil {
-		return m.HasMergeRequestID
+		return m.BuildID
 	}
 	return ""
 }
 
-type Build struct {
-	ID                   string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primary_key"`
-	YoloID               string        `protobuf:"bytes,2,opt,name=yolo_id,json=yoloId,proto3" json:"yolo_id,omitempty"`
-	CreatedAt            *time.Time    `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty"`
-	UpdatedAt            *time.Time    `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at,omitempty"`
-	State                Build_State   `protobuf:"varint,5,opt,name=state,proto3,enum=yolo.Build_State" json:"state,omitempty"`
-	CompletedAt          *time.Time    `protobuf:"bytes,6,opt,name=completed_at,json=completedAt,proto3,stdtime" json:"completed_at,omitempty"`
-	Message              string        `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
-	StartedAt            *time.Time    `protobuf:"bytes,8,opt,name=started_at,json=startedAt,proto3,stdtime" json:"started_at,omitempty"`
-	FinishedAt           *time.Time    `protobuf:"bytes,9,opt,name=finished_at,json=finishedAt,proto3,stdtime" json:"finished_at,omitempty"`
-	CommitURL            string        `protobuf:"bytes,10,opt,name=commit_url,json=commitUrl,proto3" json:"commit_url,omitempty"`
-	Branch               string        `protobuf:"bytes,11,opt,name=branch,proto3" json:"branch,omitempty"`
-	Driver               Driver        `protobuf:"varint,12,opt,name=driver,proto3,enum=yolo.Driver" json:"driver,omitempty"`
-	ShortID              string        `protobuf:"bytes,13,opt,name=short_id,json=shortId,proto3" json:"short_id,omitempty"`
-	VCSTag               string        `protobuf:"bytes,14,opt,name=vcs_tag,json=vcsTag,proto3" json:"vcs_tag,omitempty"`
-	VCSTagURL            string        `protobuf:"bytes,15,opt,name=vcs_tag_url,json=vcsTagUrl,proto3" json:"vcs_tag_url,omitempty"`
-	RawBranch            string        `protobuf:"bytes,21,opt,name=raw_branch,json=rawBranch,proto3" json:"raw_branch,omitempty"`
-	HasRawCommit         *Commit       `protobuf:"bytes,22,opt,name=has_raw_commit,json=hasRawCommit,proto3" json:"has_raw_commit,omitempty"`
-	HasRawProject        *Project      `protobuf:"bytes,23,opt,name=has_raw_project,json=hasRawProject,proto3" json:"has_raw_project,omitempty"`
-	HasRawMergerequest   *MergeRequest `protobuf:"bytes,24,opt,name=has_raw_mergerequest,json=hasRawMergerequest,proto3" json:"has_raw_mergerequest,omitempty"`
-	HasRawCommitID       string        `protobuf:"bytes,25,opt,name=has_raw_commit_id,json=hasRawCommitId,proto3" json:"has_raw_commit_id,omitempty"`
-	HasRawProjectID      string        `protobuf:"bytes,26,opt,name=has_raw_project_id,json=hasRawProjectId,proto3" json:"has_raw_project_id,omitempty"`
-	HasRawMergerequestID string        `protobuf:"bytes,27,opt,name=has_raw_mergerequest_id,json=hasRawMergerequestId,proto3" json:"has_raw_mergerequest_id,omitempty"`
-	HasArtifacts         []*Artifact   `protobuf:"bytes,101,rep,name=has_artifacts,json=hasArtifacts,proto3" json:"has_artifacts,omitempty" gorm:"foreignkey:HasBuildID"`
-	HasCommit            *Commit       `protobuf:"bytes,102,opt,name=has_commit,json=hasCommit,proto3" json:"has_commit,omitempty"`
-	HasCommitID          string        `protobuf:"bytes,103,opt,name=has_commit_id,json=hasCommitId,proto3" json:"has_commit_id,omitempty"`
-	HasProject           *Project      `protobuf:"bytes,104,opt,name=has_project,json=hasProject,proto3" json:"has_project,omitempty"`
-	HasProjectID         string        `protobuf:"bytes,105,opt,name=has_project_id,json=hasProjectId,proto3" json:"has_project_id,omitempty"`
-	HasMergerequest      *MergeRequest `protobuf:"bytes,106,opt,name=has_mergerequest,json=hasMergerequest,proto3" json:"has_mergerequest,omitempty"`
-	HasMergerequestID    string        `protobuf:"bytes,107,opt,name=has_mergerequest_id,json=hasMergerequestId,proto3" json:"has_mergerequest_id,omitempty"`
+type GetBuild_Response struct {
+	Build *Build `protobuf:"bytes,1,opt,name=build,proto3" json:"build,omitempty"`
 }
 
-func (m *Build) Reset()         { *m = Build{} }
-func (m *Build) String() string { return proto.CompactTextString(m) }
-func (*Build) ProtoMessage()    {}
-func (*Build) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a62788fcb176084a, []int{6}
+func (m *GetBuild_Response) Reset()         { *m = GetBuild_Response{} }
+func (m *GetBuild_Response) String() string { return proto.CompactTextString(m) }
+func (*GetBuild_Response) ProtoMessage()    {}
+func (*GetBuild_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{6, 1}
 }
-func (m *Build) XXX_Unmarshal(b []byte) error {
+func (m *GetBuild_Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Build) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetBuild_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Build.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetBuild_Response.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1148,248 +1603,405 @@ func (m *Build) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Build) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Build.Merge(m, src)
+func (m *GetBuild_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBuild_Response.Merge(m, src)
 }
-func (m *Build) XXX_Size() int {
+func (m *GetBuild_Response) XXX_Size() int {
 	return m.Size()
 }
-func (m *Build) XXX_DiscardUnknown() {
-	xxx_messageInfo_Build.DiscardUnknown(m)
+func (m *GetBuild_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBuild_Response.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Build proto.InternalMessageInfo
+var xxx_messageInfo_GetBuild_Response proto.InternalMessageInfo
 
-func (m *Build) GetID() string {
+func (m *GetBuild_Response) GetBuild() *Build {
 	if m != nil {
-		return m.ID
+		return m.Build
 	}
-	return ""
+	return nil
 }
 
-func (m *Build) GetYoloID() string {
-	if m != nil {
-		return m.YoloID
-	}
-	return ""
+type SearchBuilds struct {
 }
 
-func (m *Build) GetCreatedAt() *time.Time {
-	if m != nil {
-		return m.CreatedAt
-	}
-	return nil
+func (m *SearchBuilds) Reset()         { *m = SearchBuilds{} }
+func (m *SearchBuilds) String() string { return proto.CompactTextString(m) }
+func (*SearchBuilds) ProtoMessage()    {}
+func (*SearchBuilds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{7}
 }
-
-func (m *Build) GetUpdatedAt() *time.Time {
-	if m != nil {
-		return m.UpdatedAt
+func (m *SearchBuilds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SearchBuilds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchBuilds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *SearchBuilds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchBuilds.Merge(m, src)
+}
+func (m *SearchBuilds) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchBuilds) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchBuilds.DiscardUnknown(m)
 }
 
-func (m *Build) GetState() Build_State {
-	if m != nil {
-		return m.State
-	}
-	return Build_UnknownState
+var xxx_messageInfo_SearchBuilds proto.InternalMessageInfo
+
+type SearchBuilds_Request struct {
+	// case-insensitive free text, matched against the prefix of the commit SHA, the commit message and the branch
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// max amount of builds, defaults to 20, capped to 100
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (m *Build) GetCompletedAt() *time.Time {
-	if m != nil {
-		return m.CompletedAt
+func (m *SearchBuilds_Request) Reset()         { *m = SearchBuilds_Request{} }
+func (m *SearchBuilds_Request) String() string { return proto.CompactTextString(m) }
+func (*SearchBuilds_Request) ProtoMessage()    {}
+func (*SearchBuilds_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{7, 0}
+}
+func (m *SearchBuilds_Request) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SearchBuilds_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchBuilds_Request.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *SearchBuilds_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchBuilds_Request.Merge(m, src)
+}
+func (m *SearchBuilds_Request) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchBuilds_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchBuilds_Request.DiscardUnknown(m)
 }
 
-func (m *Build) GetMessage() string {
+var xxx_messageInfo_SearchBuilds_Request proto.InternalMessageInfo
+
+func (m *SearchBuilds_Request) GetQuery() string {
 	if m != nil {
-		return m.Message
+		return m.Query
 	}
 	return ""
 }
 
-func (m *Build) GetStartedAt() *time.Time {
+func (m *SearchBuilds_Request) GetLimit() int32 {
 	if m != nil {
-		return m.StartedAt
+		return m.Limit
 	}
-	return nil
+	return 0
 }
 
-func (m *Build) GetFinishedAt() *time.Time {
-	if m != nil {
-		return m.FinishedAt
-	}
-	return nil
+type SearchBuilds_Response struct {
+	// commit SHA matches first, then branch and commit message matches, most recent first
+	Builds []*Build `protobuf:"bytes,1,rep,name=builds,proto3" json:"builds,omitempty"`
 }
 
-func (m *Build) GetCommitURL() string {
-	if m != nil {
-		return m.CommitURL
-	}
-	return ""
+func (m *SearchBuilds_Response) Reset()         { *m = SearchBuilds_Response{} }
+func (m *SearchBuilds_Response) String() string { return proto.CompactTextString(m) }
+func (*SearchBuilds_Response) ProtoMessage()    {}
+func (*SearchBuilds_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{7, 1}
 }
-
-func (m *Build) GetBranch() string {
-	if m != nil {
-		return m.Branch
-	}
-	return ""
+func (m *SearchBuilds_Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Build) GetDriver() Driver {
-	if m != nil {
-		return m.Driver
+func (m *SearchBuilds_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchBuilds_Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return Driver_UnknownDriver
+}
+func (m *SearchBuilds_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchBuilds_Response.Merge(m, src)
+}
+func (m *SearchBuilds_Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchBuilds_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchBuilds_Response.DiscardUnknown(m)
 }
 
-func (m *Build) GetShortID() string {
+var xxx_messageInfo_SearchBuilds_Response proto.InternalMessageInfo
+
+func (m *SearchBuilds_Response) GetBuilds() []*Build {
 	if m != nil {
-		return m.ShortID
+		return m.Builds
 	}
-	return ""
+	return nil
 }
 
-func (m *Build) GetVCSTag() string {
-	if m != nil {
-		return m.VCSTag
-	}
-	return ""
+type Summary struct {
 }
 
-func (m *Build) GetVCSTagURL() string {
-	if m != nil {
-		return m.VCSTagURL
+func (m *Summary) Reset()         { *m = Summary{} }
+func (m *Summary) String() string { return proto.CompactTextString(m) }
+func (*Summary) ProtoMessage()    {}
+func (*Summary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{8}
+}
+func (m *Summary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Summary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Summary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *Summary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Summary.Merge(m, src)
+}
+func (m *Summary) XXX_Size() int {
+	return m.Size()
+}
+func (m *Summary) XXX_DiscardUnknown() {
+	xxx_messageInfo_Summary.DiscardUnknown(m)
 }
 
-func (m *Build) GetRawBranch() string {
-	if m != nil {
-		return m.RawBranch
+var xxx_messageInfo_Summary proto.InternalMessageInfo
+
+type Summary_Request struct {
+	// only the builds of specific projects by their ID (or "owner/repo" for GitHub), defaults to all
+	ProjectID []string `protobuf:"bytes,1,rep,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+}
+
+func (m *Summary_Request) Reset()         { *m = Summary_Request{} }
+func (m *Summary_Request) String() string { return proto.CompactTextString(m) }
+func (*Summary_Request) ProtoMessage()    {}
+func (*Summary_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{8, 0}
+}
+func (m *Summary_Request) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Summary_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Summary_Request.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *Summary_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Summary_Request.Merge(m, src)
+}
+func (m *Summary_Request) XXX_Size() int {
+	return m.Size()
+}
+func (m *Summary_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_Summary_Request.DiscardUnknown(m)
 }
 
-func (m *Build) GetHasRawCommit() *Commit {
+var xxx_messageInfo_Summary_Request proto.InternalMessageInfo
+
+func (m *Summary_Request) GetProjectID() []string {
 	if m != nil {
-		return m.HasRawCommit
+		return m.ProjectID
 	}
 	return nil
 }
 
-func (m *Build) GetHasRawProject() *Project {
-	if m != nil {
-		return m.HasRawProject
+type Summary_Response struct {
+	// sorted by project, then by artifact kind
+	Entries []*Summary_Entry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (m *Summary_Response) Reset()         { *m = Summary_Response{} }
+func (m *Summary_Response) String() string { return proto.CompactTextString(m) }
+func (*Summary_Response) ProtoMessage()    {}
+func (*Summary_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{8, 1}
+}
+func (m *Summary_Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Summary_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Summary_Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *Summary_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Summary_Response.Merge(m, src)
+}
+func (m *Summary_Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *Summary_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_Summary_Response.DiscardUnknown(m)
 }
 
-func (m *Build) GetHasRawMergerequest() *MergeRequest {
+var xxx_messageInfo_Summary_Response proto.InternalMessageInfo
+
+func (m *Summary_Response) GetEntries() []*Summary_Entry {
 	if m != nil {
-		return m.HasRawMergerequest
+		return m.Entries
 	}
 	return nil
 }
 
-func (m *Build) GetHasRawCommitID() string {
-	if m != nil {
-		return m.HasRawCommitID
+type Summary_Entry struct {
+	ProjectID string        `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Kind      Artifact_Kind `protobuf:"varint,2,opt,name=kind,proto3,enum=yolo.Artifact_Kind" json:"kind,omitempty"`
+	// builds with a finished artifact of this kind
+	BuildsCount   int64      `protobuf:"varint,3,opt,name=builds_count,json=buildsCount,proto3" json:"builds_count,omitempty"`
+	LatestBuildAt *time.Time `protobuf:"bytes,4,opt,name=latest_build_at,json=latestBuildAt,proto3,stdtime" json:"latest_build_at,omitempty"`
+	LatestBuildID string     `protobuf:"bytes,5,opt,name=latest_build_id,json=latestBuildId,proto3" json:"latest_build_id,omitempty"`
+	// bundle_version of the latest artifact, or the tag of its build
+	LatestVersion string `protobuf:"bytes,6,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	// latest artifact of this kind, with its signed URLs
+	LatestArtifact *Artifact `protobuf:"bytes,7,opt,name=latest_artifact,json=latestArtifact,proto3" json:"latest_artifact,omitempty"`
+	// itms-services link of the latest IPA, download link of the other kinds; empty without a public URL
+	InstallURL string `protobuf:"bytes,8,opt,name=install_url,json=installUrl,proto3" json:"install_url,omitempty"`
+}
+
+func (m *Summary_Entry) Reset()         { *m = Summary_Entry{} }
+func (m *Summary_Entry) String() string { return proto.CompactTextString(m) }
+func (*Summary_Entry) ProtoMessage()    {}
+func (*Summary_Entry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{8, 2}
+}
+func (m *Summary_Entry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Summary_Entry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Summary_Entry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *Summary_Entry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Summary_Entry.Merge(m, src)
+}
+func (m *Summary_Entry) XXX_Size() int {
+	return m.Size()
+}
+func (m *Summary_Entry) XXX_DiscardUnknown() {
+	xxx_messageInfo_Summary_Entry.DiscardUnknown(m)
 }
 
-func (m *Build) GetHasRawProjectID() string {
+var xxx_messageInfo_Summary_Entry proto.InternalMessageInfo
+
+func (m *Summary_Entry) GetProjectID() string {
 	if m != nil {
-		return m.HasRawProjectID
+		return m.ProjectID
 	}
 	return ""
 }
 
-func (m *Build) GetHasRawMergerequestID() string {
+func (m *Summary_Entry) GetKind() Artifact_Kind {
 	if m != nil {
-		return m.HasRawMergerequestID
+		return m.Kind
 	}
-	return ""
+	return Artifact_UnknownKind
 }
 
-func (m *Build) GetHasArtifacts() []*Artifact {
+func (m *Summary_Entry) GetBuildsCount() int64 {
 	if m != nil {
-		return m.HasArtifacts
+		return m.BuildsCount
 	}
-	return nil
+	return 0
 }
 
-func (m *Build) GetHasCommit() *Commit {
+func (m *Summary_Entry) GetLatestBuildAt() *time.Time {
 	if m != nil {
-		return m.HasCommit
+		return m.LatestBuildAt
 	}
 	return nil
 }
 
-func (m *Build) GetHasCommitID() string {
+func (m *Summary_Entry) GetLatestBuildID() string {
 	if m != nil {
-		return m.HasCommitID
+		return m.LatestBuildID
 	}
 	return ""
 }
 
-func (m *Build) GetHasProject() *Project {
-	if m != nil {
-		return m.HasProject
-	}
-	return nil
-}
-
-func (m *Build) GetHasProjectID() string {
+func (m *Summary_Entry) GetLatestVersion() string {
 	if m != nil {
-		return m.HasProjectID
+		return m.LatestVersion
 	}
 	return ""
 }
 
-func (m *Build) GetHasMergerequest() *MergeRequest {
+func (m *Summary_Entry) GetLatestArtifact() *Artifact {
 	if m != nil {
-		return m.HasMergerequest
+		return m.LatestArtifact
 	}
 	return nil
 }
 
-func (m *Build) GetHasMergerequestID() string {
+func (m *Summary_Entry) GetInstallURL() string {
 	if m != nil {
-		return m.HasMergerequestID
+		return m.InstallURL
 	}
 	return ""
 }
 
-type Release struct {
-	ID              string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primary_key"`
-	YoloID          string        `protobuf:"bytes,2,opt,name=yolo_id,json=yoloId,proto3" json:"yolo_id,omitempty"`
-	CreatedAt       *time.Time    `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty"`
-	UpdatedAt       *time.Time    `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at,omitempty"`
-	Message         string        `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
-	Driver          Driver        `protobuf:"varint,6,opt,name=driver,proto3,enum=yolo.Driver" json:"driver,omitempty"`
-	CommitURL       string        `protobuf:"bytes,7,opt,name=commit_url,json=commitUrl,proto3" json:"commit_url,omitempty"`
-	ShortID         string        `protobuf:"bytes,8,opt,name=short_id,json=shortId,proto3" json:"short_id,omitempty"`
-	HasArtifacts    []*Artifact   `protobuf:"bytes,101,rep,name=has_artifacts,json=hasArtifacts,proto3" json:"has_artifacts,omitempty"`
-	HasCommit       *Commit       `protobuf:"bytes,102,opt,name=has_commit,json=hasCommit,proto3" json:"has_commit,omitempty"`
-	HasProject      *Project      `protobuf:"bytes,103,opt,name=has_project,json=hasProject,proto3" json:"has_project,omitempty"`
-	HasMergerequest *MergeRequest `protobuf:"bytes,104,opt,name=has_mergerequest,json=hasMergerequest,proto3" json:"has_mergerequest,omitempty"`
+type RefreshBuilds struct {
 }
 
-func (m *Release) Reset()         { *m = Release{} }
-func (m *Release) String() string { return proto.CompactTextString(m) }
-func (*Release) ProtoMessage()    {}
-func (*Release) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a62788fcb176084a, []int{7}
+func (m *RefreshBuilds) Reset()         { *m = RefreshBuilds{} }
+func (m *RefreshBuilds) String() string { return proto.CompactTextString(m) }
+func (*RefreshBuilds) ProtoMessage()    {}
+func (*RefreshBuilds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{9}
 }
-func (m *Release) XXX_Unmarshal(b []byte) error {
+func (m *RefreshBuilds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Release) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RefreshBuilds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Release.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RefreshBuilds.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1399,129 +2011,132 @@ func (m *Release) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Release) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Release.Merge(m, src)
+func (m *RefreshBuilds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshBuilds.Merge(m, src)
 }
-func (m *Release) XXX_Size() int {
+func (m *RefreshBuilds) XXX_Size() int {
 	return m.Size()
 }
-func (m *Release) XXX_DiscardUnknown() {
-	xxx_messageInfo_Release.DiscardUnknown(m)
+func (m *RefreshBuilds) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshBuilds.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Release proto.InternalMessageInfo
+var xxx_messageInfo_RefreshBuilds proto.InternalMessageInfo
 
-func (m *Release) GetID() string {
-	if m != nil {
-		return m.ID
-	}
-	return ""
+type RefreshBuilds_Request struct {
+	// only refresh a project ("owner/repo"), defaults to all
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
 }
 
-func (m *Release) GetYoloID() string {
-	if m != nil {
-		return m.YoloID
-	}
-	return ""
+func (m *RefreshBuilds_Request) Reset()         { *m = RefreshBuilds_Request{} }
+func (m *RefreshBuilds_Request) String() string { return proto.CompactTextString(m) }
+func (*RefreshBuilds_Request) ProtoMessage()    {}
+func (*RefreshBuilds_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{9, 0}
 }
-
-func (m *Release) GetCreatedAt() *time.Time {
-	if m != nil {
-		return m.CreatedAt
-	}
-	return nil
+func (m *RefreshBuilds_Request) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Release) GetUpdatedAt() *time.Time {
-	if m != nil {
-		return m.UpdatedAt
+func (m *RefreshBuilds_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefreshBuilds_Request.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
 }
-
-func (m *Release) GetMessage() string {
-	if m != nil {
-		return m.Message
-	}
-	return ""
+func (m *RefreshBuilds_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshBuilds_Request.Merge(m, src)
 }
-
-func (m *Release) GetDriver() Driver {
-	if m != nil {
-		return m.Driver
-	}
-	return Driver_UnknownDriver
+func (m *RefreshBuilds_Request) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *Release) GetCommitURL() string {
-	if m != nil {
-		return m.CommitURL
-	}
-	return ""
+func (m *RefreshBuilds_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshBuilds_Request.DiscardUnknown(m)
 }
 
-func (m *Release) GetShortID() string {
+var xxx_messageInfo_RefreshBuilds_Request proto.InternalMessageInfo
+
+func (m *RefreshBuilds_Request) GetProject() string {
 	if m != nil {
-		return m.ShortID
+		return m.Project
 	}
 	return ""
 }
 
-func (m *Release) GetHasArtifacts() []*Artifact {
-	if m != nil {
-		return m.HasArtifacts
-	}
-	return nil
+type RefreshBuilds_Response struct {
+	// builds created or updated by the refresh
+	UpdatedBuilds int64 `protobuf:"varint,1,opt,name=updated_builds,json=updatedBuilds,proto3" json:"updated_builds,omitempty"`
+	// drivers whose worker was running and refreshed
+	Drivers []Driver `protobuf:"varint,2,rep,packed,name=drivers,proto3,enum=yolo.Driver" json:"drivers,omitempty"`
 }
 
-func (m *Release) GetHasCommit() *Commit {
-	if m != nil {
-		return m.HasCommit
+func (m *RefreshBuilds_Response) Reset()         { *m = RefreshBuilds_Response{} }
+func (m *RefreshBuilds_Response) String() string { return proto.CompactTextString(m) }
+func (*RefreshBuilds_Response) ProtoMessage()    {}
+func (*RefreshBuilds_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{9, 1}
+}
+func (m *RefreshBuilds_Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefreshBuilds_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefreshBuilds_Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *RefreshBuilds_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshBuilds_Response.Merge(m, src)
+}
+func (m *RefreshBuilds_Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefreshBuilds_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshBuilds_Response.DiscardUnknown(m)
 }
 
-func (m *Release) GetHasProject() *Project {
+var xxx_messageInfo_RefreshBuilds_Response proto.InternalMessageInfo
+
+func (m *RefreshBuilds_Response) GetUpdatedBuilds() int64 {
 	if m != nil {
-		return m.HasProject
+		return m.UpdatedBuilds
 	}
-	return nil
+	return 0
 }
 
-func (m *Release) GetHasMergerequest() *MergeRequest {
+func (m *RefreshBuilds_Response) GetDrivers() []Driver {
 	if m != nil {
-		return m.HasMergerequest
+		return m.Drivers
 	}
 	return nil
 }
 
-type Commit struct {
-	ID              string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primary_key"`
-	YoloID          string        `protobuf:"bytes,2,opt,name=yolo_id,json=yoloId,proto3" json:"yolo_id,omitempty"`
-	CreatedAt       *time.Time    `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty"`
-	UpdatedAt       *time.Time    `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at,omitempty"`
-	Message         string        `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
-	Driver          Driver        `protobuf:"varint,6,opt,name=driver,proto3,enum=yolo.Driver" json:"driver,omitempty"`
-	Branch          string        `protobuf:"bytes,7,opt,name=branch,proto3" json:"branch,omitempty"`
-	HasReleases     []*Release    `protobuf:"bytes,101,rep,name=has_releases,json=hasReleases,proto3" json:"has_releases,omitempty"`
-	HasBuilds       []*Build      `protobuf:"bytes,102,rep,name=has_builds,json=hasBuilds,proto3" json:"has_builds,omitempty"`
-	HasProject      *Project      `protobuf:"bytes,103,opt,name=has_project,json=hasProject,proto3" json:"has_project,omitempty"`
-	HasAuthor       *Entity       `protobuf:"bytes,104,opt,name=has_author,json=hasAuthor,proto3" json:"has_author,omitempty"`
-	HasMergerequest *MergeRequest `protobuf:"bytes,105,opt,name=has_mergerequest,json=hasMergerequest,proto3" json:"has_mergerequest,omitempty"`
+type StreamBuildUpdates struct {
 }
 
-func (m *Commit) Reset()         { *m = Commit{} }
-func (m *Commit) String() string { return proto.CompactTextString(m) }
-func (*Commit) ProtoMessage()    {}
-func (*Commit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a62788fcb176084a, []int{8}
+func (m *StreamBuildUpdates) Reset()         { *m = StreamBuildUpdates{} }
+func (m *StreamBuildUpdates) String() string { return proto.CompactTextString(m) }
+func (*StreamBuildUpdates) ProtoMessage()    {}
+func (*StreamBuildUpdates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{10}
 }
-func (m *Commit) XXX_Unmarshal(b []byte) error {
+func (m *StreamBuildUpdates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Commit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StreamBuildUpdates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Commit.Marshal(b, m, deterministic)
+		return xxx_messageInfo_StreamBuildUpdates.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1531,142 +2146,182 @@ func (m *Commit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Commit) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Commit.Merge(m, src)
+func (m *StreamBuildUpdates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamBuildUpdates.Merge(m, src)
 }
-func (m *Commit) XXX_Size() int {
+func (m *StreamBuildUpdates) XXX_Size() int {
 	return m.Size()
 }
-func (m *Commit) XXX_DiscardUnknown() {
-	xxx_messageInfo_Commit.DiscardUnknown(m)
+func (m *StreamBuildUpdates) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamBuildUpdates.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Commit proto.InternalMessageInfo
+var xxx_messageInfo_StreamBuildUpdates proto.InternalMessageInfo
 
-func (m *Commit) GetID() string {
-	if m != nil {
-		return m.ID
-	}
-	return ""
+type StreamBuildUpdates_Request struct {
+	// builds of specific projects by their ID or yolo_id
+	ProjectID []string `protobuf:"bytes,1,rep,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// filter on artifact kinds, builds without an artifact of these kinds are filtered out
+	ArtifactKinds []Artifact_Kind `protobuf:"varint,2,rep,packed,name=artifact_kinds,json=artifactKinds,proto3,enum=yolo.Artifact_Kind" json:"artifact_kinds,omitempty"`
+	// amount of recent builds sent when the stream is opened, defaults to 20
+	SnapshotSize int32 `protobuf:"varint,3,opt,name=snapshot_size,json=snapshotSize,proto3" json:"snapshot_size,omitempty"`
 }
 
-func (m *Commit) GetYoloID() string {
-	if m != nil {
-		return m.YoloID
+func (m *StreamBuildUpdates_Request) Reset()         { *m = StreamBuildUpdates_Request{} }
+func (m *StreamBuildUpdates_Request) String() string { return proto.CompactTextString(m) }
+func (*StreamBuildUpdates_Request) ProtoMessage()    {}
+func (*StreamBuildUpdates_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{10, 0}
+}
+func (m *StreamBuildUpdates_Request) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamBuildUpdates_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamBuildUpdates_Request.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *StreamBuildUpdates_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamBuildUpdates_Request.Merge(m, src)
+}
+func (m *StreamBuildUpdates_Request) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamBuildUpdates_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamBuildUpdates_Request.DiscardUnknown(m)
 }
 
-func (m *Commit) GetCreatedAt() *time.Time {
+var xxx_messageInfo_StreamBuildUpdates_Request proto.InternalMessageInfo
+
+func (m *StreamBuildUpdates_Request) GetProjectID() []string {
 	if m != nil {
-		return m.CreatedAt
+		return m.ProjectID
 	}
 	return nil
 }
 
-func (m *Commit) GetUpdatedAt() *time.Time {
+func (m *StreamBuildUpdates_Request) GetArtifactKinds() []Artifact_Kind {
 	if m != nil {
-		return m.UpdatedAt
+		return m.ArtifactKinds
 	}
 	return nil
 }
 
-func (m *Commit) GetMessage() string {
+func (m *StreamBuildUpdates_Request) GetSnapshotSize() int32 {
 	if m != nil {
-		return m.Message
+		return m.SnapshotSize
 	}
-	return ""
+	return 0
 }
 
-func (m *Commit) GetDriver() Driver {
-	if m != nil {
-		return m.Driver
-	}
-	return Driver_UnknownDriver
+type StreamBuildUpdates_Response struct {
+	Build *Build `protobuf:"bytes,1,opt,name=build,proto3" json:"build,omitempty"`
 }
 
-func (m *Commit) GetBranch() string {
-	if m != nil {
-		return m.Branch
-	}
-	return ""
+func (m *StreamBuildUpdates_Response) Reset()         { *m = StreamBuildUpdates_Response{} }
+func (m *StreamBuildUpdates_Response) String() string { return proto.CompactTextString(m) }
+func (*StreamBuildUpdates_Response) ProtoMessage()    {}
+func (*StreamBuildUpdates_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{10, 1}
 }
-
-func (m *Commit) GetHasReleases() []*Release {
-	if m != nil {
-		return m.HasReleases
-	}
-	return nil
+func (m *StreamBuildUpdates_Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Commit) GetHasBuilds() []*Build {
-	if m != nil {
-		return m.HasBuilds
+func (m *StreamBuildUpdates_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamBuildUpdates_Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *StreamBuildUpdates_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamBuildUpdates_Response.Merge(m, src)
+}
+func (m *StreamBuildUpdates_Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamBuildUpdates_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamBuildUpdates_Response.DiscardUnknown(m)
 }
 
-func (m *Commit) GetHasProject() *Project {
+var xxx_messageInfo_StreamBuildUpdates_Response proto.InternalMessageInfo
+
+func (m *StreamBuildUpdates_Response) GetBuild() *Build {
 	if m != nil {
-		return m.HasProject
+		return m.Build
 	}
 	return nil
 }
 
-func (m *Commit) GetHasAuthor() *Entity {
-	if m != nil {
-		return m.HasAuthor
-	}
-	return nil
+type WhatsNew struct {
 }
 
-func (m *Commit) GetHasMergerequest() *MergeRequest {
-	if m != nil {
-		return m.HasMergerequest
+func (m *WhatsNew) Reset()         { *m = WhatsNew{} }
+func (m *WhatsNew) String() string { return proto.CompactTextString(m) }
+func (*WhatsNew) ProtoMessage()    {}
+func (*WhatsNew) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{11}
+}
+func (m *WhatsNew) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WhatsNew) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WhatsNew.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *WhatsNew) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WhatsNew.Merge(m, src)
+}
+func (m *WhatsNew) XXX_Size() int {
+	return m.Size()
+}
+func (m *WhatsNew) XXX_DiscardUnknown() {
+	xxx_messageInfo_WhatsNew.DiscardUnknown(m)
 }
 
-type MergeRequest struct {
-	ID        string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primary_key"`
-	YoloID    string             `protobuf:"bytes,2,opt,name=yolo_id,json=yoloId,proto3" json:"yolo_id,omitempty"`
-	CreatedAt *time.Time         `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty"`
-	UpdatedAt *time.Time         `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at,omitempty"`
-	MergedAt  *time.Time         `protobuf:"bytes,5,opt,name=merged_at,json=mergedAt,proto3,stdtime" json:"merged_at,omitempty"`
-	Title     string             `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
-	Message   string             `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
-	Driver    Driver             `protobuf:"varint,8,opt,name=driver,proto3,enum=yolo.Driver" json:"driver,omitempty"`
-	Branch    string             `protobuf:"bytes,9,opt,name=branch,proto3" json:"branch,omitempty"`
-	State     MergeRequest_State `protobuf:"varint,10,opt,name=state,proto3,enum=yolo.MergeRequest_State" json:"state,omitempty"`
-	CommitURL string             `protobuf:"bytes,11,opt,name=commit_url,json=commitUrl,proto3" json:"commit_url,omitempty"`
-	BranchURL string             `protobuf:"bytes,12,opt,name=branch_url,json=branchUrl,proto3" json:"branch_url,omitempty"`
-	ShortID   string             `protobuf:"bytes,13,opt,name=short_id,json=shortId,proto3" json:"short_id,omitempty"`
-	// is WIP or Draft
-	IsWIP        bool       `protobuf:"varint,14,opt,name=is_wip,json=isWip,proto3" json:"is_wip,omitempty"`
-	HasReleases  []*Release `protobuf:"bytes,101,rep,name=has_releases,json=hasReleases,proto3" json:"has_releases,omitempty"`
-	HasBuilds    []*Build   `protobuf:"bytes,102,rep,name=has_builds,json=hasBuilds,proto3" json:"has_builds,omitempty"`
-	HasAssignees []*Entity  `protobuf:"bytes,103,rep,name=has_assignees,json=hasAssignees,proto3" json:"has_assignees,omitempty" gorm:"many2many:mr_assignees"`
-	HasReviewers []*Entity  `protobuf:"bytes,104,rep,name=has_reviewers,json=hasReviewers,proto3" json:"has_reviewers,omitempty" gorm:"many2many:mr_reviewers"`
-	HasProject   *Project   `protobuf:"bytes,105,opt,name=has_project,json=hasProject,proto3" json:"has_project,omitempty"`
-	HasProjectID string     `protobuf:"bytes,106,opt,name=has_project_id,json=hasProjectId,proto3" json:"has_project_id,omitempty"`
-	HasAuthor    *Entity    `protobuf:"bytes,107,opt,name=has_author,json=hasAuthor,proto3" json:"has_author,omitempty"`
-	HasAuthorID  string     `protobuf:"bytes,108,opt,name=has_author_id,json=hasAuthorId,proto3" json:"has_author_id,omitempty"`
-	HasCommit    *Commit    `protobuf:"bytes,109,opt,name=has_commit,json=hasCommit,proto3" json:"has_commit,omitempty"`
-	HasCommitID  string     `protobuf:"bytes,110,opt,name=has_commit_id,json=hasCommitId,proto3" json:"has_commit_id,omitempty"`
+var xxx_messageInfo_WhatsNew proto.InternalMessageInfo
+
+type WhatsNew_Request struct {
+	// build ID or yolo_id currently installed by the tester
+	BuildID string `protobuf:"bytes,1,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+	// release channel, defaults to the branch of the current build
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	// max amount of newer builds, the ones following the current build, defaults to 20, capped to 50
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (m *MergeRequest) Reset()         { *m = MergeRequest{} }
-func (m *MergeRequest) String() string { return proto.CompactTextString(m) }
-func (*MergeRequest) ProtoMessage()    {}
-func (*MergeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a62788fcb176084a, []int{9}
+func (m *WhatsNew_Request) Reset()         { *m = WhatsNew_Request{} }
+func (m *WhatsNew_Request) String() string { return proto.CompactTextString(m) }
+func (*WhatsNew_Request) ProtoMessage()    {}
+func (*WhatsNew_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{11, 0}
 }
-func (m *MergeRequest) XXX_Unmarshal(b []byte) error {
+func (m *WhatsNew_Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MergeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WhatsNew_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MergeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WhatsNew_Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1676,215 +2331,283 @@ func (m *MergeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *MergeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MergeRequest.Merge(m, src)
+func (m *WhatsNew_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WhatsNew_Request.Merge(m, src)
 }
-func (m *MergeRequest) XXX_Size() int {
+func (m *WhatsNew_Request) XXX_Size() int {
 	return m.Size()
 }
-func (m *MergeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MergeRequest.DiscardUnknown(m)
+func (m *WhatsNew_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_WhatsNew_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MergeRequest proto.InternalMessageInfo
+var xxx_messageInfo_WhatsNew_Request proto.InternalMessageInfo
 
-func (m *MergeRequest) GetID() string {
+func (m *WhatsNew_Request) GetBuildID() string {
 	if m != nil {
-		return m.ID
+		return m.BuildID
 	}
 	return ""
 }
 
-func (m *MergeRequest) GetYoloID() string {
+func (m *WhatsNew_Request) GetChannel() string {
 	if m != nil {
-		return m.YoloID
+		return m.Channel
 	}
 	return ""
 }
 
-func (m *MergeRequest) GetCreatedAt() *time.Time {
+func (m *WhatsNew_Request) GetLimit() int32 {
 	if m != nil {
-		return m.CreatedAt
+		return m.Limit
 	}
-	return nil
+	return 0
 }
 
-func (m *MergeRequest) GetUpdatedAt() *time.Time {
-	if m != nil {
-		return m.UpdatedAt
-	}
-	return nil
+type WhatsNew_Response struct {
+	// newer builds, most recent first
+	Builds       []*Build  `protobuf:"bytes,1,rep,name=builds,proto3" json:"builds,omitempty"`
+	ReleaseNotes string    `protobuf:"bytes,2,opt,name=release_notes,json=releaseNotes,proto3" json:"release_notes,omitempty"`
+	Commits      []*Commit `protobuf:"bytes,3,rep,name=commits,proto3" json:"commits,omitempty"`
+	CompareURL   string    `protobuf:"bytes,4,opt,name=compare_url,json=compareUrl,proto3" json:"compare_url,omitempty"`
 }
 
-func (m *MergeRequest) GetMergedAt() *time.Time {
-	if m != nil {
-		return m.MergedAt
+func (m *WhatsNew_Response) Reset()         { *m = WhatsNew_Response{} }
+func (m *WhatsNew_Response) String() string { return proto.CompactTextString(m) }
+func (*WhatsNew_Response) ProtoMessage()    {}
+func (*WhatsNew_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{11, 1}
+}
+func (m *WhatsNew_Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WhatsNew_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WhatsNew_Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *WhatsNew_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WhatsNew_Response.Merge(m, src)
+}
+func (m *WhatsNew_Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *WhatsNew_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_WhatsNew_Response.DiscardUnknown(m)
 }
 
-func (m *MergeRequest) GetTitle() string {
+var xxx_messageInfo_WhatsNew_Response proto.InternalMessageInfo
+
+func (m *WhatsNew_Response) GetBuilds() []*Build {
 	if m != nil {
-		return m.Title
+		return m.Builds
 	}
-	return ""
+	return nil
 }
 
-func (m *MergeRequest) GetMessage() string {
+func (m *WhatsNew_Response) GetReleaseNotes() string {
 	if m != nil {
-		return m.Message
+		return m.ReleaseNotes
 	}
 	return ""
 }
 
-func (m *MergeRequest) GetDriver() Driver {
+func (m *WhatsNew_Response) GetCommits() []*Commit {
 	if m != nil {
-		return m.Driver
+		return m.Commits
 	}
-	return Driver_UnknownDriver
+	return nil
 }
 
-func (m *MergeRequest) GetBranch() string {
+func (m *WhatsNew_Response) GetCompareURL() string {
 	if m != nil {
-		return m.Branch
+		return m.CompareURL
 	}
 	return ""
 }
 
-func (m *MergeRequest) GetState() MergeRequest_State {
-	if m != nil {
-		return m.State
+type SignArtifact struct {
+}
+
+func (m *SignArtifact) Reset()         { *m = SignArtifact{} }
+func (m *SignArtifact) String() string { return proto.CompactTextString(m) }
+func (*SignArtifact) ProtoMessage()    {}
+func (*SignArtifact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{12}
+}
+func (m *SignArtifact) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignArtifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignArtifact.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return MergeRequest_UnknownState
+}
+func (m *SignArtifact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignArtifact.Merge(m, src)
+}
+func (m *SignArtifact) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignArtifact) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignArtifact.DiscardUnknown(m)
 }
 
-func (m *MergeRequest) GetCommitURL() string {
-	if m != nil {
-		return m.CommitURL
+var xxx_messageInfo_SignArtifact proto.InternalMessageInfo
+
+type SignArtifact_Request struct {
+	ArtifactID string `protobuf:"bytes,1,opt,name=artifact_id,json=artifactId,proto3" json:"artifact_id,omitempty"`
+	// validity of the new URLs, defaults to the plist URL TTL, capped to 7 days
+	TTLSeconds int64 `protobuf:"varint,2,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	// the download URL can only be used once, a partial download doesn't consume it
+	SingleUse bool `protobuf:"varint,3,opt,name=single_use,json=singleUse,proto3" json:"single_use,omitempty"`
+	// device model (i.e, iPhone10,3), the plist URL then installs the matching variant of the build
+	Device string `protobuf:"bytes,4,opt,name=device,proto3" json:"device,omitempty"`
+}
+
+func (m *SignArtifact_Request) Reset()         { *m = SignArtifact_Request{} }
+func (m *SignArtifact_Request) String() string { return proto.CompactTextString(m) }
+func (*SignArtifact_Request) ProtoMessage()    {}
+func (*SignArtifact_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{12, 0}
+}
+func (m *SignArtifact_Request) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignArtifact_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignArtifact_Request.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *SignArtifact_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignArtifact_Request.Merge(m, src)
+}
+func (m *SignArtifact_Request) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignArtifact_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignArtifact_Request.DiscardUnknown(m)
 }
 
-func (m *MergeRequest) GetBranchURL() string {
+var xxx_messageInfo_SignArtifact_Request proto.InternalMessageInfo
+
+func (m *SignArtifact_Request) GetArtifactID() string {
 	if m != nil {
-		return m.BranchURL
+		return m.ArtifactID
 	}
 	return ""
 }
 
-func (m *MergeRequest) GetShortID() string {
+func (m *SignArtifact_Request) GetTTLSeconds() int64 {
 	if m != nil {
-		return m.ShortID
+		return m.TTLSeconds
 	}
-	return ""
+	return 0
 }
 
-func (m *MergeRequest) GetIsWIP() bool {
+func (m *SignArtifact_Request) GetSingleUse() bool {
 	if m != nil {
-		return m.IsWIP
+		return m.SingleUse
 	}
 	return false
 }
 
-func (m *MergeRequest) GetHasReleases() []*Release {
+func (m *SignArtifact_Request) GetDevice() string {
 	if m != nil {
-		return m.HasReleases
+		return m.Device
 	}
-	return nil
+	return ""
 }
 
-func (m *MergeRequest) GetHasBuilds() []*Build {
-	if m != nil {
-		return m.HasBuilds
-	}
-	return nil
+type SignArtifact_Response struct {
+	// artifact with fresh dl_artifact_signed_url and plist_signed_url
+	Artifact  *Artifact  `protobuf:"bytes,1,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	ExpiresAt *time.Time `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
 }
 
-func (m *MergeRequest) GetHasAssignees() []*Entity {
-	if m != nil {
-		return m.HasAssignees
-	}
-	return nil
+func (m *SignArtifact_Response) Reset()         { *m = SignArtifact_Response{} }
+func (m *SignArtifact_Response) String() string { return proto.CompactTextString(m) }
+func (*SignArtifact_Response) ProtoMessage()    {}
+func (*SignArtifact_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{12, 1}
 }
-
-func (m *MergeRequest) GetHasReviewers() []*Entity {
-	if m != nil {
-		return m.HasReviewers
-	}
-	return nil
+func (m *SignArtifact_Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MergeRequest) GetHasProject() *Project {
-	if m != nil {
-		return m.HasProject
+func (m *SignArtifact_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignArtifact_Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
 }
-
-func (m *MergeRequest) GetHasProjectID() string {
-	if m != nil {
-		return m.HasProjectID
-	}
-	return ""
+func (m *SignArtifact_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignArtifact_Response.Merge(m, src)
 }
-
-func (m *MergeRequest) GetHasAuthor() *Entity {
-	if m != nil {
-		return m.HasAuthor
-	}
-	return nil
+func (m *SignArtifact_Response) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MergeRequest) GetHasAuthorID() string {
-	if m != nil {
-		return m.HasAuthorID
-	}
-	return ""
+func (m *SignArtifact_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignArtifact_Response.DiscardUnknown(m)
 }
 
-func (m *MergeRequest) GetHasCommit() *Commit {
+var xxx_messageInfo_SignArtifact_Response proto.InternalMessageInfo
+
+func (m *SignArtifact_Response) GetArtifact() *Artifact {
 	if m != nil {
-		return m.HasCommit
+		return m.Artifact
 	}
 	return nil
 }
 
-func (m *MergeRequest) GetHasCommitID() string {
+func (m *SignArtifact_Response) GetExpiresAt() *time.Time {
 	if m != nil {
-		return m.HasCommitID
+		return m.ExpiresAt
 	}
-	return ""
+	return nil
 }
 
-type Project struct {
-	ID               string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primary_key"`
-	YoloID           string          `protobuf:"bytes,2,opt,name=yolo_id,json=yoloId,proto3" json:"yolo_id,omitempty"`
-	CreatedAt        *time.Time      `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty"`
-	UpdatedAt        *time.Time      `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at,omitempty"`
-	Driver           Driver          `protobuf:"varint,5,opt,name=driver,proto3,enum=yolo.Driver" json:"driver,omitempty"`
-	Name             string          `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
-	Description      string          `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
-	HasArtifacts     []*Artifact     `protobuf:"bytes,101,rep,name=has_artifacts,json=hasArtifacts,proto3" json:"has_artifacts,omitempty"`
-	HasBuilds        []*Build        `protobuf:"bytes,102,rep,name=has_builds,json=hasBuilds,proto3" json:"has_builds,omitempty"`
-	HasCommits       []*Commit       `protobuf:"bytes,103,rep,name=has_commits,json=hasCommits,proto3" json:"has_commits,omitempty"`
-	HasReleases      []*Release      `protobuf:"bytes,104,rep,name=has_releases,json=hasReleases,proto3" json:"has_releases,omitempty"`
-	HasMergerequests []*MergeRequest `protobuf:"bytes,105,rep,name=has_mergerequests,json=hasMergerequests,proto3" json:"has_mergerequests,omitempty"`
-	HasOwner         *Entity         `protobuf:"bytes,106,opt,name=has_owner,json=hasOwner,proto3" json:"has_owner,omitempty"`
-	HasOwnerID       string          `protobuf:"bytes,107,opt,name=has_owner_id,json=hasOwnerId,proto3" json:"has_owner_id,omitempty"`
+type BranchStats struct {
 }
 
-func (m *Project) Reset()         { *m = Project{} }
-func (m *Project) String() string { return proto.CompactTextString(m) }
-func (*Project) ProtoMessage()    {}
-func (*Project) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a62788fcb176084a, []int{10}
+func (m *BranchStats) Reset()         { *m = BranchStats{} }
+func (m *BranchStats) String() string { return proto.CompactTextString(m) }
+func (*BranchStats) ProtoMessage()    {}
+func (*BranchStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{13}
 }
-func (m *Project) XXX_Unmarshal(b []byte) error {
+func (m *BranchStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Project) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BranchStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Project.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BranchStats.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1894,143 +2617,106 @@ func (m *Project) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Project) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Project.Merge(m, src)
+func (m *BranchStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BranchStats.Merge(m, src)
 }
-func (m *Project) XXX_Size() int {
+func (m *BranchStats) XXX_Size() int {
 	return m.Size()
 }
-func (m *Project) XXX_DiscardUnknown() {
-	xxx_messageInfo_Project.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Project proto.InternalMessageInfo
-
-func (m *Project) GetID() string {
-	if m != nil {
-		return m.ID
-	}
-	return ""
+func (m *BranchStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_BranchStats.DiscardUnknown(m)
 }
 
-func (m *Project) GetYoloID() string {
-	if m != nil {
-		return m.YoloID
-	}
-	return ""
-}
+var xxx_messageInfo_BranchStats proto.InternalMessageInfo
 
-func (m *Project) GetCreatedAt() *time.Time {
-	if m != nil {
-		return m.CreatedAt
-	}
-	return nil
+type BranchStats_Request struct {
+	// only these branches, defaults to all
+	Branch []string `protobuf:"bytes,1,rep,name=branch,proto3" json:"branch,omitempty"`
+	// only the builds of a specific project by its ID (or "owner/repo" for GitHub)
+	ProjectID string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// time range (RFC 3339), defaults to the last 30 days
+	Since string `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
+	Until string `protobuf:"bytes,4,opt,name=until,proto3" json:"until,omitempty"`
 }
 
-func (m *Project) GetUpdatedAt() *time.Time {
-	if m != nil {
-		return m.UpdatedAt
-	}
-	return nil
+func (m *BranchStats_Request) Reset()         { *m = BranchStats_Request{} }
+func (m *BranchStats_Request) String() string { return proto.CompactTextString(m) }
+func (*BranchStats_Request) ProtoMessage()    {}
+func (*BranchStats_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{13, 0}
 }
-
-func (m *Project) GetDriver() Driver {
-	if m != nil {
-		return m.Driver
-	}
-	return Driver_UnknownDriver
+func (m *BranchStats_Request) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Project) GetName() string {
-	if m != nil {
-		return m.Name
+func (m *BranchStats_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BranchStats_Request.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
 }
-
-func (m *Project) GetDescription() string {
-	if m != nil {
-		return m.Description
-	}
-	return ""
+func (m *BranchStats_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BranchStats_Request.Merge(m, src)
 }
-
-func (m *Project) GetHasArtifacts() []*Artifact {
-	if m != nil {
-		return m.HasArtifacts
-	}
-	return nil
+func (m *BranchStats_Request) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *Project) GetHasBuilds() []*Build {
-	if m != nil {
-		return m.HasBuilds
-	}
-	return nil
+func (m *BranchStats_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_BranchStats_Request.DiscardUnknown(m)
 }
 
-func (m *Project) GetHasCommits() []*Commit {
-	if m != nil {
-		return m.HasCommits
-	}
-	return nil
-}
+var xxx_messageInfo_BranchStats_Request proto.InternalMessageInfo
 
-func (m *Project) GetHasReleases() []*Release {
+func (m *BranchStats_Request) GetBranch() []string {
 	if m != nil {
-		return m.HasReleases
+		return m.Branch
 	}
 	return nil
 }
 
-func (m *Project) GetHasMergerequests() []*MergeRequest {
+func (m *BranchStats_Request) GetProjectID() string {
 	if m != nil {
-		return m.HasMergerequests
+		return m.ProjectID
 	}
-	return nil
+	return ""
 }
 
-func (m *Project) GetHasOwner() *Entity {
+func (m *BranchStats_Request) GetSince() string {
 	if m != nil {
-		return m.HasOwner
+		return m.Since
 	}
-	return nil
+	return ""
 }
 
-func (m *Project) GetHasOwnerID() string {
+func (m *BranchStats_Request) GetUntil() string {
 	if m != nil {
-		return m.HasOwnerID
+		return m.Until
 	}
 	return ""
 }
 
-type Entity struct {
-	ID               string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primary_key"`
-	YoloID           string          `protobuf:"bytes,2,opt,name=yolo_id,json=yoloId,proto3" json:"yolo_id,omitempty"`
-	CreatedAt        *time.Time      `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty"`
-	UpdatedAt        *time.Time      `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at,omitempty"`
-	Name             string          `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
-	Driver           Driver          `protobuf:"varint,6,opt,name=driver,proto3,enum=yolo.Driver" json:"driver,omitempty"`
-	AvatarURL        string          `protobuf:"bytes,7,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
-	Kind             Entity_Kind     `protobuf:"varint,8,opt,name=kind,proto3,enum=yolo.Entity_Kind" json:"kind,omitempty"`
-	Description      string          `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`
-	HasProjects      []*Project      `protobuf:"bytes,101,rep,name=has_projects,json=hasProjects,proto3" json:"has_projects,omitempty"`
-	HasCommits       []*Commit       `protobuf:"bytes,102,rep,name=has_commits,json=hasCommits,proto3" json:"has_commits,omitempty"`
-	HasMergerequests []*MergeRequest `protobuf:"bytes,103,rep,name=has_mergerequests,json=hasMergerequests,proto3" json:"has_mergerequests,omitempty"`
+type BranchStats_Response struct {
+	// most recently built branches first
+	Branches []*BranchStats_Entry `protobuf:"bytes,1,rep,name=branches,proto3" json:"branches,omitempty"`
 }
 
-func (m *Entity) Reset()         { *m = Entity{} }
-func (m *Entity) String() string { return proto.CompactTextString(m) }
-func (*Entity) ProtoMessage()    {}
-func (*Entity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a62788fcb176084a, []int{11}
+func (m *BranchStats_Response) Reset()         { *m = BranchStats_Response{} }
+func (m *BranchStats_Response) String() string { return proto.CompactTextString(m) }
+func (*BranchStats_Response) ProtoMessage()    {}
+func (*BranchStats_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{13, 1}
 }
-func (m *Entity) XXX_Unmarshal(b []byte) error {
+func (m *BranchStats_Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Entity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BranchStats_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Entity.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BranchStats_Response.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2040,142 +2726,171 @@ func (m *Entity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Entity) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Entity.Merge(m, src)
+func (m *BranchStats_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BranchStats_Response.Merge(m, src)
 }
-func (m *Entity) XXX_Size() int {
+func (m *BranchStats_Response) XXX_Size() int {
 	return m.Size()
 }
-func (m *Entity) XXX_DiscardUnknown() {
-	xxx_messageInfo_Entity.DiscardUnknown(m)
+func (m *BranchStats_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_BranchStats_Response.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Entity proto.InternalMessageInfo
+var xxx_messageInfo_BranchStats_Response proto.InternalMessageInfo
 
-func (m *Entity) GetID() string {
+func (m *BranchStats_Response) GetBranches() []*BranchStats_Entry {
 	if m != nil {
-		return m.ID
+		return m.Branches
 	}
-	return ""
+	return nil
 }
 
-func (m *Entity) GetYoloID() string {
-	if m != nil {
-		return m.YoloID
-	}
-	return ""
+type BranchStats_Entry struct {
+	Branch string `protobuf:"bytes,1,opt,name=branch,proto3" json:"branch,omitempty"`
+	Total  int64  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Passed int64  `protobuf:"varint,3,opt,name=passed,proto3" json:"passed,omitempty"`
+	// failed and timed out builds
+	Failed int64 `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	// passed / (passed + failed)
+	PassRate float64 `protobuf:"fixed64,5,opt,name=pass_rate,json=passRate,proto3" json:"pass_rate,omitempty"`
+	// average duration of the finished builds
+	AverageDurationSeconds float64    `protobuf:"fixed64,6,opt,name=average_duration_seconds,json=averageDurationSeconds,proto3" json:"average_duration_seconds,omitempty"`
+	LastBuildAt            *time.Time `protobuf:"bytes,7,opt,name=last_build_at,json=lastBuildAt,proto3,stdtime" json:"last_build_at,omitempty"`
 }
 
-func (m *Entity) GetCreatedAt() *time.Time {
-	if m != nil {
-		return m.CreatedAt
-	}
-	return nil
+func (m *BranchStats_Entry) Reset()         { *m = BranchStats_Entry{} }
+func (m *BranchStats_Entry) String() string { return proto.CompactTextString(m) }
+func (*BranchStats_Entry) ProtoMessage()    {}
+func (*BranchStats_Entry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{13, 2}
 }
-
-func (m *Entity) GetUpdatedAt() *time.Time {
-	if m != nil {
-		return m.UpdatedAt
+func (m *BranchStats_Entry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BranchStats_Entry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BranchStats_Entry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *BranchStats_Entry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BranchStats_Entry.Merge(m, src)
+}
+func (m *BranchStats_Entry) XXX_Size() int {
+	return m.Size()
+}
+func (m *BranchStats_Entry) XXX_DiscardUnknown() {
+	xxx_messageInfo_BranchStats_Entry.DiscardUnknown(m)
 }
 
-func (m *Entity) GetName() string {
+var xxx_messageInfo_BranchStats_Entry proto.InternalMessageInfo
+
+func (m *BranchStats_Entry) GetBranch() string {
 	if m != nil {
-		return m.Name
+		return m.Branch
 	}
 	return ""
 }
 
-func (m *Entity) GetDriver() Driver {
+func (m *BranchStats_Entry) GetTotal() int64 {
 	if m != nil {
-		return m.Driver
+		return m.Total
 	}
-	return Driver_UnknownDriver
+	return 0
 }
 
-func (m *Entity) GetAvatarURL() string {
+func (m *BranchStats_Entry) GetPassed() int64 {
 	if m != nil {
-		return m.AvatarURL
+		return m.Passed
 	}
-	return ""
+	return 0
 }
 
-func (m *Entity) GetKind() Entity_Kind {
+func (m *BranchStats_Entry) GetFailed() int64 {
 	if m != nil {
-		return m.Kind
+		return m.Failed
 	}
-	return Entity_UnknownKind
+	return 0
 }
 
-func (m *Entity) GetDescription() string {
+func (m *BranchStats_Entry) GetPassRate() float64 {
 	if m != nil {
-		return m.Description
+		return m.PassRate
 	}
-	return ""
+	return 0
 }
 
-func (m *Entity) GetHasProjects() []*Project {
+func (m *BranchStats_Entry) GetAverageDurationSeconds() float64 {
 	if m != nil {
-		return m.HasProjects
+		return m.AverageDurationSeconds
 	}
-	return nil
+	return 0
 }
 
-func (m *Entity) GetHasCommits() []*Commit {
+func (m *BranchStats_Entry) GetLastBuildAt() *time.Time {
 	if m != nil {
-		return m.HasCommits
+		return m.LastBuildAt
 	}
 	return nil
 }
 
-func (m *Entity) GetHasMergerequests() []*MergeRequest {
-	if m != nil {
-		return m.HasMergerequests
-	}
-	return nil
+type BuildListFilters struct {
 }
 
-type Artifact struct {
-	ID                  string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primary_key"`
-	YoloID              string         `protobuf:"bytes,2,opt,name=yolo_id,json=yoloId,proto3" json:"yolo_id,omitempty"`
-	CreatedAt           *time.Time     `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty"`
-	UpdatedAt           *time.Time     `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at,omitempty"`
-	FileSize            int64          `protobuf:"varint,5,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
-	LocalPath           string         `protobuf:"bytes,6,opt,name=local_path,json=localPath,proto3" json:"local_path,omitempty"`
-	DownloadURL         string         `protobuf:"bytes,7,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
-	MimeType            string         `protobuf:"bytes,8,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
-	Sha1Sum             string         `protobuf:"bytes,9,opt,name=sha1_sum,json=sha1Sum,proto3" json:"sha1_sum,omitempty"`
-	Sha256Sum           string         `protobuf:"bytes,10,opt,name=sha256_sum,json=sha256Sum,proto3" json:"sha256_sum,omitempty"`
-	State               Artifact_State `protobuf:"varint,11,opt,name=state,proto3,enum=yolo.Artifact_State" json:"state,omitempty"`
-	Kind                Artifact_Kind  `protobuf:"varint,12,opt,name=kind,proto3,enum=yolo.Artifact_Kind" json:"kind,omitempty"`
-	Driver              Driver         `protobuf:"varint,13,opt,name=driver,proto3,enum=yolo.Driver" json:"driver,omitempty"`
-	BundleName          string         `protobuf:"bytes,14,opt,name=bundle_name,json=bundleName,proto3" json:"bundle_name,omitempty"`
-	BundleVersion       string         `protobuf:"bytes,15,opt,name=bundle_version,json=bundleVersion,proto3" json:"bundle_version,omitempty"`
-	BundleID            string         `protobuf:"bytes,16,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
-	BundleIcon          string         `protobuf:"bytes,17,opt,name=bundle_icon,json=bundleIcon,proto3" json:"bundle_icon,omitempty"`
-	HasBuild            *Build         `protobuf:"bytes,101,opt,name=has_build,json=hasBuild,proto3" json:"has_build,omitempty"`
-	HasBuildID          string         `protobuf:"bytes,102,opt,name=has_build_id,json=hasBuildId,proto3" json:"has_build_id,omitempty"`
-	HasRelease          *Release       `protobuf:"bytes,103,opt,name=has_release,json=hasRelease,proto3" json:"has_release,omitempty"`
-	HasReleaseID        string         `protobuf:"bytes,104,opt,name=has_release_id,json=hasReleaseId,proto3" json:"has_release_id,omitempty"`
-	Downloads           []*Download    `protobuf:"bytes,105,rep,name=downloads,proto3" json:"downloads,omitempty"`
-	DownloadsCount      int64          `protobuf:"varint,106,opt,name=downloads_count,json=downloadsCount,proto3" json:"downloads_count,omitempty" sql:"-"`
-	DLArtifactSignedURL string         `protobuf:"bytes,201,opt,name=dl_artifact_signed_url,json=dlArtifactSignedUrl,proto3" json:"dl_artifact_signed_url,omitempty"`
-	PListSignedURL      string         `protobuf:"bytes,202,opt,name=plist_signed_url,json=plistSignedUrl,proto3" json:"plist_signed_url,omitempty"`
+func (m *BuildListFilters) Reset()         { *m = BuildListFilters{} }
+func (m *BuildListFilters) String() string { return proto.CompactTextString(m) }
+func (*BuildListFilters) ProtoMessage()    {}
+func (*BuildListFilters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{14}
+}
+func (m *BuildListFilters) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BuildListFilters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BuildListFilters.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BuildListFilters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildListFilters.Merge(m, src)
+}
+func (m *BuildListFilters) XXX_Size() int {
+	return m.Size()
+}
+func (m *BuildListFilters) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildListFilters.DiscardUnknown(m)
 }
 
-func (m *Artifact) Reset()         { *m = Artifact{} }
-func (m *Artifact) String() string { return proto.CompactTextString(m) }
-func (*Artifact) ProtoMessage()    {}
-func (*Artifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a62788fcb176084a, []int{12}
+var xxx_messageInfo_BuildListFilters proto.InternalMessageInfo
+
+type BuildListFilters_Request struct {
 }
-func (m *Artifact) XXX_Unmarshal(b []byte) error {
+
+func (m *BuildListFilters_Request) Reset()         { *m = BuildListFilters_Request{} }
+func (m *BuildListFilters_Request) String() string { return proto.CompactTextString(m) }
+func (*BuildListFilters_Request) ProtoMessage()    {}
+func (*BuildListFilters_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{14, 0}
+}
+func (m *BuildListFilters_Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Artifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BuildListFilters_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Artifact.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BuildListFilters_Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2185,4065 +2900,14594 @@ func (m *Artifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Artifact) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Artifact.Merge(m, src)
+func (m *BuildListFilters_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildListFilters_Request.Merge(m, src)
 }
-func (m *Artifact) XXX_Size() int {
+func (m *BuildListFilters_Request) XXX_Size() int {
 	return m.Size()
 }
-func (m *Artifact) XXX_DiscardUnknown() {
-	xxx_messageInfo_Artifact.DiscardUnknown(m)
+func (m *BuildListFilters_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildListFilters_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Artifact proto.InternalMessageInfo
+var xxx_messageInfo_BuildListFilters_Request proto.InternalMessageInfo
 
-func (m *Artifact) GetID() string {
-	if m != nil {
-		return m.ID
-	}
-	return ""
+type BuildListFilters_Response struct {
+	Entities []*Entity  `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty"`
+	Projects []*Project `protobuf:"bytes,2,rep,name=projects,proto3" json:"projects,omitempty"`
 }
 
-func (m *Artifact) GetYoloID() string {
-	if m != nil {
-		return m.YoloID
+func (m *BuildListFilters_Response) Reset()         { *m = BuildListFilters_Response{} }
+func (m *BuildListFilters_Response) String() string { return proto.CompactTextString(m) }
+func (*BuildListFilters_Response) ProtoMessage()    {}
+func (*BuildListFilters_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{14, 1}
+}
+func (m *BuildListFilters_Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BuildListFilters_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BuildListFilters_Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *BuildListFilters_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildListFilters_Response.Merge(m, src)
+}
+func (m *BuildListFilters_Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *BuildListFilters_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildListFilters_Response.DiscardUnknown(m)
 }
 
-func (m *Artifact) GetCreatedAt() *time.Time {
+var xxx_messageInfo_BuildListFilters_Response proto.InternalMessageInfo
+
+func (m *BuildListFilters_Response) GetEntities() []*Entity {
 	if m != nil {
-		return m.CreatedAt
+		return m.Entities
 	}
 	return nil
 }
 
-func (m *Artifact) GetUpdatedAt() *time.Time {
+func (m *BuildListFilters_Response) GetProjects() []*Project {
 	if m != nil {
-		return m.UpdatedAt
+		return m.Projects
 	}
 	return nil
 }
 
-func (m *Artifact) GetFileSize() int64 {
-	if m != nil {
-		return m.FileSize
-	}
-	return 0
+type MetadataOverride struct {
+	Branch            string            `protobuf:"bytes,11,opt,name=branch,proto3" json:"branch,omitempty"`
+	HasCommitID       string            `protobuf:"bytes,103,opt,name=has_commit_id,json=hasCommitId,proto3" json:"has_commit_id,omitempty"`
+	HasProjectID      string            `protobuf:"bytes,105,opt,name=has_project_id,json=hasProjectId,proto3" json:"has_project_id,omitempty"`
+	HasMergeRequestID string            `protobuf:"bytes,107,opt,name=has_mergerequest_id,json=hasMergerequestId,proto3" json:"has_mergerequest_id,omitempty"`
+	ReleaseNotes      string            `protobuf:"bytes,16,opt,name=release_notes,json=releaseNotes,proto3" json:"release_notes,omitempty"`
+	BuildConfig       map[string]string `protobuf:"bytes,205,rep,name=build_config,json=buildConfig,proto3" json:"build_config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (m *Artifact) GetLocalPath() string {
-	if m != nil {
-		return m.LocalPath
+func (m *MetadataOverride) Reset()         { *m = MetadataOverride{} }
+func (m *MetadataOverride) String() string { return proto.CompactTextString(m) }
+func (*MetadataOverride) ProtoMessage()    {}
+func (*MetadataOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{15}
+}
+func (m *MetadataOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetadataOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MetadataOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *MetadataOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetadataOverride.Merge(m, src)
+}
+func (m *MetadataOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetadataOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetadataOverride.DiscardUnknown(m)
 }
 
-func (m *Artifact) GetDownloadURL() string {
+var xxx_messageInfo_MetadataOverride proto.InternalMessageInfo
+
+func (m *MetadataOverride) GetBranch() string {
 	if m != nil {
-		return m.DownloadURL
+		return m.Branch
 	}
 	return ""
 }
 
-func (m *Artifact) GetMimeType() string {
+func (m *MetadataOverride) GetHasCommitID() string {
 	if m != nil {
-		return m.MimeType
+		return m.HasCommitID
 	}
 	return ""
 }
 
-func (m *Artifact) GetSha1Sum() string {
+func (m *MetadataOverride) GetHasProjectID() string {
 	if m != nil {
-		return m.Sha1Sum
+		return m.HasProjectID
 	}
 	return ""
 }
 
-func (m *Artifact) GetSha256Sum() string {
+func (m *MetadataOverride) GetHasMergeRequestID() string {
 	if m != nil {
-		return m.Sha256Sum
+		return m.HasMergeRequestID
 	}
 	return ""
 }
 
-func (m *Artifact) GetState() Artifact_State {
+func (m *MetadataOverride) GetReleaseNotes() string {
 	if m != nil {
-		return m.State
+		return m.ReleaseNotes
 	}
-	return Artifact_UnknownState
+	return ""
 }
 
-func (m *Artifact) GetKind() Artifact_Kind {
+func (m *MetadataOverride) GetBuildConfig() map[string]string {
 	if m != nil {
-		return m.Kind
+		return m.BuildConfig
 	}
-	return Artifact_UnknownKind
+	return nil
 }
 
-func (m *Artifact) GetDriver() Driver {
-	if m != nil {
-		return m.Driver
-	}
-	return Driver_UnknownDriver
+type Build struct {
+	ID           string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primary_key"`
+	YoloID       string      `protobuf:"bytes,2,opt,name=yolo_id,json=yoloId,proto3" json:"yolo_id,omitempty"`
+	CreatedAt    *time.Time  `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty"`
+	UpdatedAt    *time.Time  `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at,omitempty"`
+	State        Build_State `protobuf:"varint,5,opt,name=state,proto3,enum=yolo.Build_State" json:"state,omitempty"`
+	CompletedAt  *time.Time  `protobuf:"bytes,6,opt,name=completed_at,json=completedAt,proto3,stdtime" json:"completed_at,omitempty"`
+	Message      string      `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	StartedAt    *time.Time  `protobuf:"bytes,8,opt,name=started_at,json=startedAt,proto3,stdtime" json:"started_at,omitempty"`
+	FinishedAt   *time.Time  `protobuf:"bytes,9,opt,name=finished_at,json=finishedAt,proto3,stdtime" json:"finished_at,omitempty"`
+	CommitURL    string      `protobuf:"bytes,10,opt,name=commit_url,json=commitUrl,proto3" json:"commit_url,omitempty"`
+	Branch       string      `protobuf:"bytes,11,opt,name=branch,proto3" json:"branch,omitempty"`
+	Driver       Driver      `protobuf:"varint,12,opt,name=driver,proto3,enum=yolo.Driver" json:"driver,omitempty"`
+	ShortID      string      `protobuf:"bytes,13,opt,name=short_id,json=shortId,proto3" json:"short_id,omitempty"`
+	VCSTag       string      `protobuf:"bytes,14,opt,name=vcs_tag,json=vcsTag,proto3" json:"vcs_tag,omitempty"`
+	VCSTagURL    string      `protobuf:"bytes,15,opt,name=vcs_tag_url,json=vcsTagUrl,proto3" json:"vcs_tag_url,omitempty"`
+	ReleaseNotes string      `protobuf:"bytes,16,opt,name=release_notes,json=releaseNotes,proto3" json:"release_notes,omitempty"`
+	// JSON-encoded build_config, used for storage and filtering
+	BuildConfigJSON string `protobuf:"bytes,17,opt,name=build_config_json,json=buildConfigJson,proto3" json:"build_config_json,omitempty"`
+	// JSON-encoded owner_teams, used for storage and filtering
+	OwnerTeamsJSON string `protobuf:"bytes,18,opt,name=owner_teams_json,json=ownerTeamsJson,proto3" json:"owner_teams_json,omitempty"`
+	// at least one artifact exceeds the size budget of the project
+	OverBudget bool `protobuf:"varint,19,opt,name=over_budget,json=overBudget,proto3" json:"over_budget,omitempty"`
+	// JSON-encoded flags, used for storage
+	FlagsJSON string `protobuf:"bytes,20,opt,name=flags_json,json=flagsJson,proto3" json:"flags_json,omitempty"`
+	// author of the build commit, as reported by the driver
+	CommitAuthor          string `protobuf:"bytes,28,opt,name=commit_author,json=commitAuthor,proto3" json:"commit_author,omitempty"`
+	CommitEmail           string `protobuf:"bytes,29,opt,name=commit_email,json=commitEmail,proto3" json:"commit_email,omitempty"`
+	CommitAuthorAvatarURL string `protobuf:"bytes,30,opt,name=commit_author_avatar_url,json=commitAuthorAvatarUrl,proto3" json:"commit_author_avatar_url,omitempty"`
+	// seconds between the start and the finish of the build, 0 while running or if unknown
+	Duration int64 `protobuf:"varint,31,opt,name=duration,proto3" json:"duration,omitempty"`
+	// state of the build on a distribution service (i.e, the TestFlight beta state READY_FOR_BETA_TESTING), empty for the CI builds
+	ExternalState string `protobuf:"bytes,32,opt,name=external_state,json=externalState,proto3" json:"external_state,omitempty"`
+	// sortable form of the semantic version of vcs_tag, empty if it isn't one
+	VersionKey           string        `protobuf:"bytes,33,opt,name=version_key,json=versionKey,proto3" json:"version_key,omitempty"`
+	RawBranch            string        `protobuf:"bytes,21,opt,name=raw_branch,json=rawBranch,proto3" json:"raw_branch,omitempty"`
+	HasRawCommit         *Commit       `protobuf:"bytes,22,opt,name=has_raw_commit,json=hasRawCommit,proto3" json:"has_raw_commit,omitempty"`
+	HasRawProject        *Project      `protobuf:"bytes,23,opt,name=has_raw_project,json=hasRawProject,proto3" json:"has_raw_project,omitempty"`
+	HasRawMergerequest   *MergeRequest `protobuf:"bytes,24,opt,name=has_raw_mergerequest,json=hasRawMergerequest,proto3" json:"has_raw_mergerequest,omitempty"`
+	HasRawCommitID       string        `protobuf:"bytes,25,opt,name=has_raw_commit_id,json=hasRawCommitId,proto3" json:"has_raw_commit_id,omitempty"`
+	HasRawProjectID      string        `protobuf:"bytes,26,opt,name=has_raw_project_id,json=hasRawProjectId,proto3" json:"has_raw_project_id,omitempty"`
+	HasRawMergerequestID string        `protobuf:"bytes,27,opt,name=has_raw_mergerequest_id,json=hasRawMergerequestId,proto3" json:"has_raw_mergerequest_id,omitempty"`
+	HasArtifacts         []*Artifact   `protobuf:"bytes,101,rep,name=has_artifacts,json=hasArtifacts,proto3" json:"has_artifacts,omitempty" gorm:"foreignkey:HasBuildID"`
+	HasCommit            *Commit       `protobuf:"bytes,102,opt,name=has_commit,json=hasCommit,proto3" json:"has_commit,omitempty"`
+	HasCommitID          string        `protobuf:"bytes,103,opt,name=has_commit_id,json=hasCommitId,proto3" json:"has_commit_id,omitempty"`
+	HasProject           *Project      `protobuf:"bytes,104,opt,name=has_project,json=hasProject,proto3" json:"has_project,omitempty"`
+	HasProjectID         string        `protobuf:"bytes,105,opt,name=has_project_id,json=hasProjectId,proto3" json:"has_project_id,omitempty"`
+	HasMergerequest      *MergeRequest `protobuf:"bytes,106,opt,name=has_mergerequest,json=hasMergerequest,proto3" json:"has_mergerequest,omitempty"`
+	HasMergerequestID    string        `protobuf:"bytes,107,opt,name=has_mergerequest_id,json=hasMergerequestId,proto3" json:"has_mergerequest_id,omitempty"`
+	// release channels the build was promoted to
+	Channels       []string `protobuf:"bytes,201,rep,name=channels,proto3" json:"channels,omitempty" sql:"-"`
+	DownloadsCount int64    `protobuf:"varint,202,opt,name=downloads_count,json=downloadsCount,proto3" json:"downloads_count,omitempty" sql:"-"`
+	// number of confirmed installs reported by the installed apps
+	InstallsCount int64 `protobuf:"varint,203,opt,name=installs_count,json=installsCount,proto3" json:"installs_count,omitempty" sql:"-"`
+	// signed URL the installed app should POST to on first launch
+	InstallSignedURL string `protobuf:"bytes,204,opt,name=install_signed_url,json=installSignedUrl,proto3" json:"install_signed_url,omitempty" sql:"-"`
+	// build-time flags and environment of interest (i.e, API_ENV=production)
+	BuildConfig map[string]string `protobuf:"bytes,205,rep,name=build_config,json=buildConfig,proto3" json:"build_config,omitempty" sql:"-" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// teams owning the files changed by the build commit, resolved from the CODEOWNERS file
+	OwnerTeams []string `protobuf:"bytes,206,rep,name=owner_teams,json=ownerTeams,proto3" json:"owner_teams,omitempty" sql:"-"`
+	// experiments and feature flags enabled in the build, read from its flags manifest artifact
+	Flags map[string]string `protobuf:"bytes,207,rep,name=flags,proto3" json:"flags,omitempty" sql:"-" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (m *Artifact) GetBundleName() string {
-	if m != nil {
-		return m.BundleName
+func (m *Build) Reset()         { *m = Build{} }
+func (m *Build) String() string { return proto.CompactTextString(m) }
+func (*Build) ProtoMessage()    {}
+func (*Build) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{16}
+}
+func (m *Build) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Build) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Build.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *Build) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Build.Merge(m, src)
+}
+func (m *Build) XXX_Size() int {
+	return m.Size()
+}
+func (m *Build) XXX_DiscardUnknown() {
+	xxx_messageInfo_Build.DiscardUnknown(m)
 }
 
-func (m *Artifact) GetBundleVersion() string {
+var xxx_messageInfo_Build proto.InternalMessageInfo
+
+func (m *Build) GetID() string {
 	if m != nil {
-		return m.BundleVersion
+		return m.ID
 	}
 	return ""
 }
 
-func (m *Artifact) GetBundleID() string {
+func (m *Build) GetYoloID() string {
 	if m != nil {
-		return m.BundleID
+		return m.YoloID
 	}
 	return ""
 }
 
-func (m *Artifact) GetBundleIcon() string {
+func (m *Build) GetCreatedAt() *time.Time {
 	if m != nil {
-		return m.BundleIcon
+		return m.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (m *Artifact) GetHasBuild() *Build {
+func (m *Build) GetUpdatedAt() *time.Time {
 	if m != nil {
-		return m.HasBuild
+		return m.UpdatedAt
 	}
 	return nil
 }
 
-func (m *Artifact) GetHasBuildID() string {
+func (m *Build) GetState() Build_State {
 	if m != nil {
-		return m.HasBuildID
+		return m.State
 	}
-	return ""
+	return Build_UnknownState
 }
 
-func (m *Artifact) GetHasRelease() *Release {
+func (m *Build) GetCompletedAt() *time.Time {
 	if m != nil {
-		return m.HasRelease
+		return m.CompletedAt
 	}
 	return nil
 }
 
-func (m *Artifact) GetHasReleaseID() string {
+func (m *Build) GetMessage() string {
 	if m != nil {
-		return m.HasReleaseID
+		return m.Message
 	}
 	return ""
 }
 
-func (m *Artifact) GetDownloads() []*Download {
+func (m *Build) GetStartedAt() *time.Time {
 	if m != nil {
-		return m.Downloads
+		return m.StartedAt
 	}
 	return nil
 }
 
-func (m *Artifact) GetDownloadsCount() int64 {
+func (m *Build) GetFinishedAt() *time.Time {
 	if m != nil {
-		return m.DownloadsCount
+		return m.FinishedAt
 	}
-	return 0
+	return nil
 }
 
-func (m *Artifact) GetDLArtifactSignedURL() string {
+func (m *Build) GetCommitURL() string {
 	if m != nil {
-		return m.DLArtifactSignedURL
+		return m.CommitURL
 	}
 	return ""
 }
 
-func (m *Artifact) GetPListSignedURL() string {
+func (m *Build) GetBranch() string {
 	if m != nil {
-		return m.PListSignedURL
+		return m.Branch
 	}
 	return ""
 }
 
-type Download struct {
-	ID            int64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" gorm:"PRIMARY_KEY;AUTO_INCREMENT"`
-	CreatedAt     *time.Time `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty"`
-	HasArtifact   *Artifact  `protobuf:"bytes,101,opt,name=has_artifact,json=hasArtifact,proto3" json:"has_artifact,omitempty"`
-	HasArtifactID string     `protobuf:"bytes,102,opt,name=has_artifact_id,json=hasArtifactId,proto3" json:"has_artifact_id,omitempty"`
+func (m *Build) GetDriver() Driver {
+	if m != nil {
+		return m.Driver
+	}
+	return Driver_UnknownDriver
 }
 
-func (m *Download) Reset()         { *m = Download{} }
-func (m *Download) String() string { return proto.CompactTextString(m) }
-func (*Download) ProtoMessage()    {}
-func (*Download) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a62788fcb176084a, []int{13}
+func (m *Build) GetShortID() string {
+	if m != nil {
+		return m.ShortID
+	}
+	return ""
 }
-func (m *Download) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *Build) GetVCSTag() string {
+	if m != nil {
+		return m.VCSTag
+	}
+	return ""
 }
-func (m *Download) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_Download.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *Build) GetVCSTagURL() string {
+	if m != nil {
+		return m.VCSTagURL
 	}
+	return ""
 }
-func (m *Download) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Download.Merge(m, src)
+
+func (m *Build) GetReleaseNotes() string {
+	if m != nil {
+		return m.ReleaseNotes
+	}
+	return ""
 }
-func (m *Download) XXX_Size() int {
-	return m.Size()
+
+func (m *Build) GetBuildConfigJSON() string {
+	if m != nil {
+		return m.BuildConfigJSON
+	}
+	return ""
 }
-func (m *Download) XXX_DiscardUnknown() {
-	xxx_messageInfo_Download.DiscardUnknown(m)
+
+func (m *Build) GetOwnerTeamsJSON() string {
+	if m != nil {
+		return m.OwnerTeamsJSON
+	}
+	return ""
 }
 
-var xxx_messageInfo_Download proto.InternalMessageInfo
+func (m *Build) GetOverBudget() bool {
+	if m != nil {
+		return m.OverBudget
+	}
+	return false
+}
 
-func (m *Download) GetID() int64 {
+func (m *Build) GetFlagsJSON() string {
 	if m != nil {
-		return m.ID
+		return m.FlagsJSON
 	}
-	return 0
+	return ""
 }
 
-func (m *Download) GetCreatedAt() *time.Time {
+func (m *Build) GetCommitAuthor() string {
 	if m != nil {
-		return m.CreatedAt
+		return m.CommitAuthor
 	}
-	return nil
+	return ""
 }
 
-func (m *Download) GetHasArtifact() *Artifact {
+func (m *Build) GetCommitEmail() string {
 	if m != nil {
-		return m.HasArtifact
+		return m.CommitEmail
 	}
-	return nil
+	return ""
 }
 
-func (m *Download) GetHasArtifactID() string {
+func (m *Build) GetCommitAuthorAvatarURL() string {
 	if m != nil {
-		return m.HasArtifactID
+		return m.CommitAuthorAvatarURL
 	}
 	return ""
 }
 
-type Batch struct {
-	Builds        []*Build        `protobuf:"bytes,1,rep,name=builds,proto3" json:"builds,omitempty"`
-	Artifacts     []*Artifact     `protobuf:"bytes,2,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
-	Projects      []*Project      `protobuf:"bytes,3,rep,name=projects,proto3" json:"projects,omitempty"`
-	Entities      []*Entity       `protobuf:"bytes,4,rep,name=entities,proto3" json:"entities,omitempty"`
-	Releases      []*Release      `protobuf:"bytes,5,rep,name=releases,proto3" json:"releases,omitempty"`
-	Commits       []*Commit       `protobuf:"bytes,6,rep,name=commits,proto3" json:"commits,omitempty"`
-	MergeRequests []*MergeRequest `protobuf:"bytes,7,rep,name=merge_requests,json=mergeRequests,proto3" json:"merge_requests,omitempty"`
+func (m *Build) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
 }
 
-func (m *Batch) Reset()         { *m = Batch{} }
-func (m *Batch) String() string { return proto.CompactTextString(m) }
-func (*Batch) ProtoMessage()    {}
-func (*Batch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a62788fcb176084a, []int{14}
+func (m *Build) GetExternalState() string {
+	if m != nil {
+		return m.ExternalState
+	}
+	return ""
 }
-func (m *Batch) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *Build) GetVersionKey() string {
+	if m != nil {
+		return m.VersionKey
+	}
+	return ""
 }
-func (m *Batch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_Batch.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *Build) GetRawBranch() string {
+	if m != nil {
+		return m.RawBranch
 	}
+	return ""
 }
-func (m *Batch) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Batch.Merge(m, src)
+
+func (m *Build) GetHasRawCommit() *Commit {
+	if m != nil {
+		return m.HasRawCommit
+	}
+	return nil
 }
-func (m *Batch) XXX_Size() int {
-	return m.Size()
+
+func (m *Build) GetHasRawProject() *Project {
+	if m != nil {
+		return m.HasRawProject
+	}
+	return nil
 }
-func (m *Batch) XXX_DiscardUnknown() {
-	xxx_messageInfo_Batch.DiscardUnknown(m)
+
+func (m *Build) GetHasRawMergerequest() *MergeRequest {
+	if m != nil {
+		return m.HasRawMergerequest
+	}
+	return nil
 }
 
-var xxx_messageInfo_Batch proto.InternalMessageInfo
+func (m *Build) GetHasRawCommitID() string {
+	if m != nil {
+		return m.HasRawCommitID
+	}
+	return ""
+}
 
-func (m *Batch) GetBuilds() []*Build {
+func (m *Build) GetHasRawProjectID() string {
 	if m != nil {
-		return m.Builds
+		return m.HasRawProjectID
 	}
-	return nil
+	return ""
 }
 
-func (m *Batch) GetArtifacts() []*Artifact {
+func (m *Build) GetHasRawMergerequestID() string {
 	if m != nil {
-		return m.Artifacts
+		return m.HasRawMergerequestID
 	}
-	return nil
+	return ""
 }
 
-func (m *Batch) GetProjects() []*Project {
+func (m *Build) GetHasArtifacts() []*Artifact {
 	if m != nil {
-		return m.Projects
+		return m.HasArtifacts
 	}
 	return nil
 }
 
-func (m *Batch) GetEntities() []*Entity {
+func (m *Build) GetHasCommit() *Commit {
 	if m != nil {
-		return m.Entities
+		return m.HasCommit
 	}
 	return nil
 }
 
-func (m *Batch) GetReleases() []*Release {
+func (m *Build) GetHasCommitID() string {
 	if m != nil {
-		return m.Releases
+		return m.HasCommitID
 	}
-	return nil
+	return ""
 }
 
-func (m *Batch) GetCommits() []*Commit {
+func (m *Build) GetHasProject() *Project {
 	if m != nil {
-		return m.Commits
+		return m.HasProject
 	}
 	return nil
 }
 
-func (m *Batch) GetMergeRequests() []*MergeRequest {
+func (m *Build) GetHasProjectID() string {
 	if m != nil {
-		return m.MergeRequests
+		return m.HasProjectID
 	}
-	return nil
+	return ""
 }
 
-func init() {
-	proto.RegisterEnum("yolo.Driver", Driver_name, Driver_value)
-	proto.RegisterEnum("yolo.Build_State", Build_State_name, Build_State_value)
-	proto.RegisterEnum("yolo.MergeRequest_State", MergeRequest_State_name, MergeRequest_State_value)
-	proto.RegisterEnum("yolo.Entity_Kind", Entity_Kind_name, Entity_Kind_value)
-	proto.RegisterEnum("yolo.Artifact_State", Artifact_State_name, Artifact_State_value)
-	proto.RegisterEnum("yolo.Artifact_Kind", Artifact_Kind_name, Artifact_Kind_value)
-	proto.RegisterType((*Ping)(nil), "yolo.Ping")
-	proto.RegisterType((*Ping_Request)(nil), "yolo.Ping.Request")
-	proto.RegisterType((*Ping_Response)(nil), "yolo.Ping.Response")
-	proto.RegisterType((*DevDumpObjects)(nil), "yolo.DevDumpObjects")
-	proto.RegisterType((*DevDumpObjects_Request)(nil), "yolo.DevDumpObjects.Request")
-	proto.RegisterType((*DevDumpObjects_Response)(nil), "yolo.DevDumpObjects.Response")
-	proto.RegisterType((*Status)(nil), "yolo.Status")
-	proto.RegisterType((*Status_Request)(nil), "yolo.Status.Request")
-	proto.RegisterType((*Status_Response)(nil), "yolo.Status.Response")
-	proto.RegisterType((*BuildList)(nil), "yolo.BuildList")
-	proto.RegisterType((*BuildList_Request)(nil), "yolo.BuildList.Request")
-	proto.RegisterType((*BuildList_Response)(nil), "yolo.BuildList.Response")
-	proto.RegisterType((*BuildListFilters)(nil), "yolo.BuildListFilters")
-	proto.RegisterType((*BuildListFilters_Request)(nil), "yolo.BuildListFilters.Request")
-	proto.RegisterType((*BuildListFilters_Response)(nil), "yolo.BuildListFilters.Response")
-	proto.RegisterType((*MetadataOverride)(nil), "yolo.MetadataOverride")
-	proto.RegisterType((*Build)(nil), "yolo.Build")
-	proto.RegisterType((*Release)(nil), "yolo.Release")
-	proto.RegisterType((*Commit)(nil), "yolo.Commit")
-	proto.RegisterType((*MergeRequest)(nil), "yolo.MergeRequest")
-	proto.RegisterType((*Project)(nil), "yolo.Project")
-	proto.RegisterType((*Entity)(nil), "yolo.Entity")
-	proto.RegisterType((*Artifact)(nil), "yolo.Artifact")
-	proto.RegisterType((*Download)(nil), "yolo.Download")
-	proto.RegisterType((*Batch)(nil), "yolo.Batch")
+func (m *Build) GetHasMergerequest() *MergeRequest {
+	if m != nil {
+		return m.HasMergerequest
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("yolopb.proto", fileDescriptor_a62788fcb176084a) }
+func (m *Build) GetHasMergerequestID() string {
+	if m != nil {
+		return m.HasMergerequestID
+	}
+	return ""
+}
 
-var fileDescriptor_a62788fcb176084a = []byte{
-	// 3036 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xc9, 0x6f, 0x23, 0xc7,
-	0xd5, 0x1f, 0xee, 0xec, 0xd7, 0x5c, 0x5a, 0xa5, 0x59, 0xda, 0x9a, 0x85, 0x32, 0xfd, 0xd9, 0x1e,
-	0x8f, 0x47, 0xa2, 0x2d, 0x7f, 0xf6, 0x07, 0x8f, 0x3f, 0x27, 0x21, 0x45, 0xcd, 0xa8, 0xe1, 0x59,
-	0x94, 0x96, 0xc6, 0x86, 0x93, 0x43, 0xa3, 0xc9, 0x2e, 0x91, 0x65, 0x91, 0xdd, 0x74, 0x57, 0x53,
-	0x82, 0x7c, 0xc8, 0xc1, 0xc8, 0x1f, 0x60, 0x20, 0xb7, 0xdc, 0x92, 0x5b, 0xae, 0xb9, 0xe4, 0x94,
-	0x6b, 0xe0, 0x04, 0x08, 0x60, 0x20, 0x97, 0x9c, 0x98, 0x80, 0x0e, 0x10, 0x20, 0xb7, 0xcc, 0x21,
-	0x87, 0x9c, 0x82, 0x5a, 0xba, 0xd9, 0x4d, 0xed, 0x13, 0xe4, 0x32, 0xc8, 0x45, 0x50, 0xbd, 0x7a,
-	0xef, 0xd5, 0xd2, 0xbf, 0xf7, 0x7b, 0xaf, 0xaa, 0x08, 0xa5, 0x43, 0x6f, 0xe0, 0x8d, 0x3a, 0xab,
-	0x23, 0xdf, 0x0b, 0x3c, 0x94, 0x65, 0xad, 0xa5, 0x1b, 0x3d, 0xcf, 0xeb, 0x0d, 0x70, 0xc3, 0x1e,
-	0x91, 0x86, 0xed, 0xba, 0x5e, 0x60, 0x07, 0xc4, 0x73, 0xa9, 0xd0, 0x59, 0x5a, 0xe9, 0x91, 0xa0,
-	0x3f, 0xee, 0xac, 0x76, 0xbd, 0x61, 0xa3, 0xe7, 0xf5, 0xbc, 0x06, 0x17, 0x77, 0xc6, 0xbb, 0xbc,
-	0xc5, 0x1b, 0xfc, 0x3f, 0xa9, 0x5e, 0x93, 0xce, 0x22, 0xad, 0x80, 0x0c, 0x31, 0x0d, 0xec, 0xe1,
-	0x48, 0x28, 0xd4, 0x6f, 0x42, 0x76, 0x8b, 0xb8, 0xbd, 0x25, 0x05, 0x0a, 0x26, 0xfe, 0x7c, 0x8c,
-	0x69, 0xb0, 0x04, 0x50, 0x34, 0x31, 0x1d, 0x79, 0x2e, 0xc5, 0xf5, 0x9f, 0xa5, 0xa0, 0xd2, 0xc6,
-	0xfb, 0xed, 0xf1, 0x70, 0xf4, 0xa4, 0xf3, 0x19, 0xee, 0x06, 0x74, 0x69, 0x2d, 0xd2, 0x44, 0xaf,
-	0x43, 0xf5, 0x80, 0x04, 0x7d, 0x6b, 0xe4, 0xe3, 0x81, 0x67, 0x3b, 0xc4, 0xed, 0xe9, 0xa9, 0xe5,
-	0xd4, 0xed, 0xa2, 0x59, 0x61, 0xe2, 0xad, 0x48, 0xba, 0xf4, 0xc3, 0x99, 0x4b, 0xf4, 0x32, 0xe4,
-	0x3a, 0x76, 0xd0, 0xed, 0x73, 0x55, 0x75, 0x4d, 0x5d, 0x65, 0xab, 0x5e, 0x6d, 0x31, 0x91, 0x29,
-	0x7a, 0xd0, 0x5d, 0x50, 0x1c, 0xef, 0xc0, 0x65, 0xd6, 0x54, 0x4f, 0x2f, 0x67, 0x6e, 0xab, 0x6b,
-	0x15, 0xa1, 0xd6, 0x96, 0x62, 0x73, 0xa6, 0x50, 0xff, 0x69, 0x1a, 0xf2, 0xdb, 0x81, 0x1d, 0x8c,
-	0x69, 0x7c, 0x15, 0x3f, 0x4e, 0xc7, 0xc6, 0xbc, 0x0a, 0xf9, 0xf1, 0x88, 0x2d, 0x9d, 0x0f, 0x9a,
-	0x33, 0x65, 0x0b, 0x5d, 0x81, 0xbc, 0xd3, 0xb1, 0xb0, 0xef, 0xeb, 0xe9, 0xe5, 0xd4, 0x6d, 0xc5,
-	0xcc, 0x39, 0x9d, 0x0d, 0xdf, 0x47, 0x35, 0x50, 0xdd, 0x8e, 0x85, 0xdd, 0x80, 0x04, 0x04, 0x53,
-	0x1d, 0xb8, 0x0d, 0xb8, 0x9d, 0x0d, 0x29, 0x91, 0x0a, 0x23, 0xdf, 0xe3, 0x5b, 0xa2, 0xab, 0xa1,
-	0xc2, 0x96, 0x94, 0xa0, 0x9b, 0x00, 0x6e, 0xc7, 0xea, 0x7a, 0xc3, 0x21, 0x09, 0xa8, 0x5e, 0xe2,
-	0xfd, 0x8a, 0xdb, 0x59, 0x17, 0x02, 0x69, 0xef, 0xe3, 0x01, 0xb6, 0x29, 0xa6, 0x7a, 0x39, 0xb4,
-	0x37, 0xa5, 0x04, 0x5d, 0x07, 0xc5, 0xed, 0x58, 0x9d, 0x31, 0x19, 0x38, 0x54, 0xaf, 0xf0, 0xee,
-	0xa2, 0xdb, 0x69, 0xf1, 0x36, 0xba, 0x03, 0x0b, 0x6e, 0xc7, 0x1a, 0x62, 0xbf, 0x87, 0x2d, 0x5f,
-	0x2c, 0x97, 0xea, 0x55, 0xae, 0x54, 0x75, 0x3b, 0x8f, 0x98, 0x5c, 0xee, 0x02, 0xad, 0xff, 0x22,
-	0x0f, 0x0a, 0x37, 0x7b, 0x48, 0x68, 0xb0, 0xf4, 0xb7, 0xdc, 0xec, 0xe3, 0x5d, 0x86, 0xdc, 0x80,
-	0x0c, 0x49, 0x20, 0xb7, 0x44, 0x34, 0xd0, 0x3d, 0xa8, 0xd8, 0x7e, 0x40, 0x76, 0xed, 0x6e, 0x60,
-	0xed, 0x11, 0x57, 0xee, 0x7f, 0x65, 0x6d, 0x51, 0xec, 0x7f, 0x53, 0xf6, 0xad, 0x7e, 0x44, 0x5c,
-	0xc7, 0x2c, 0x87, 0xaa, 0xac, 0x45, 0xd1, 0xab, 0xc0, 0xbf, 0xbb, 0x15, 0x4a, 0xa9, 0x9e, 0xe1,
-	0x68, 0x28, 0x33, 0x69, 0x68, 0x49, 0xd1, 0x6b, 0x50, 0xe4, 0x0b, 0xb3, 0x88, 0xa3, 0x67, 0x97,
-	0x33, 0xb7, 0x95, 0x96, 0x3a, 0x9d, 0xd4, 0x0a, 0x7c, 0x96, 0x46, 0xdb, 0x2c, 0xf0, 0x4e, 0xc3,
-	0x41, 0x77, 0x01, 0xe4, 0x0e, 0x33, 0xcd, 0x1c, 0xd7, 0x2c, 0x4f, 0x27, 0x35, 0x45, 0xee, 0xb2,
-	0xd1, 0x36, 0x15, 0xa9, 0x60, 0x38, 0xa8, 0x01, 0x6a, 0x34, 0x71, 0xe2, 0xe8, 0x79, 0xae, 0x5e,
-	0x99, 0x4e, 0x6a, 0x10, 0x8e, 0x6c, 0xb4, 0x4d, 0x08, 0x55, 0xb8, 0x41, 0x49, 0x4c, 0xc3, 0xf1,
-	0xc9, 0x3e, 0xf6, 0xf5, 0x02, 0x5f, 0x67, 0x49, 0xe2, 0x8c, 0xcb, 0x4c, 0x95, 0x6b, 0x88, 0x06,
-	0x5a, 0x03, 0xd1, 0xb4, 0x68, 0x60, 0x07, 0x58, 0x2f, 0x72, 0xfd, 0x05, 0x09, 0x5f, 0xd6, 0xb1,
-	0xca, 0x50, 0x88, 0x4d, 0xe0, 0x5a, 0xfc, 0x7f, 0xf4, 0x01, 0x54, 0xf9, 0x77, 0x92, 0x9f, 0x89,
-	0xcd, 0x4c, 0xe1, 0x33, 0x43, 0xd3, 0x49, 0xad, 0x12, 0xff, 0x54, 0x46, 0xdb, 0xac, 0xc4, 0x55,
-	0x0d, 0x07, 0x3d, 0x86, 0xab, 0x09, 0x63, 0x7b, 0x1c, 0xf4, 0x3d, 0x9f, 0xf9, 0x00, 0xee, 0x43,
-	0x9f, 0x4e, 0x6a, 0x97, 0xe3, 0x3e, 0x9a, 0x5c, 0xc1, 0x68, 0x9b, 0x97, 0xe3, 0x76, 0x52, 0xea,
-	0xa0, 0x37, 0x61, 0x81, 0x7f, 0x9f, 0x78, 0x27, 0xc7, 0x6e, 0xd1, 0xd4, 0x58, 0xc7, 0xa3, 0x98,
-	0x1c, 0x3d, 0x00, 0x94, 0x18, 0x5c, 0x2c, 0xba, 0xc4, 0x17, 0xad, 0x8b, 0x45, 0xc7, 0x87, 0x96,
-	0x6b, 0x5f, 0x88, 0xdb, 0x88, 0x2d, 0xb8, 0x0a, 0xf9, 0x8e, 0x6f, 0xbb, 0xdd, 0xbe, 0x5e, 0x66,
-	0xb3, 0x36, 0x65, 0x0b, 0xbd, 0x05, 0x97, 0xf9, 0x6c, 0x5c, 0x2f, 0x39, 0xa1, 0x0a, 0x9f, 0x10,
-	0x62, 0x7d, 0x8f, 0xbd, 0xc4, 0x94, 0x56, 0x60, 0x91, 0x7a, 0x7e, 0x60, 0x75, 0x0e, 0x65, 0x64,
-	0x59, 0x0e, 0x9b, 0x53, 0x55, 0xac, 0x80, 0x75, 0xb5, 0x0e, 0x45, 0x84, 0xb5, 0xed, 0x00, 0x2f,
-	0x35, 0x62, 0x04, 0xf0, 0x0a, 0xe4, 0x65, 0x30, 0xa5, 0x38, 0x9d, 0xa8, 0xb1, 0xcf, 0x66, 0xca,
-	0xae, 0xfa, 0x8f, 0x40, 0x8b, 0x42, 0xe5, 0x3e, 0x19, 0x04, 0xd8, 0x4f, 0x30, 0x8a, 0x15, 0xf3,
-	0x77, 0x1b, 0x8a, 0x11, 0x3d, 0x08, 0x8f, 0x12, 0x38, 0x9c, 0x22, 0x0e, 0xcd, 0xa8, 0x17, 0xbd,
-	0x01, 0xc5, 0x88, 0x27, 0x04, 0x95, 0x95, 0x85, 0xa6, 0x44, 0xb1, 0x19, 0x75, 0xd7, 0x27, 0x29,
-	0xd0, 0x1e, 0xe1, 0xc0, 0x76, 0xec, 0xc0, 0x7e, 0xb2, 0x8f, 0x7d, 0x9f, 0x38, 0xf1, 0xed, 0x53,
-	0x39, 0x45, 0x85, 0xdb, 0xf7, 0x0e, 0x94, 0xfb, 0x36, 0x0d, 0x37, 0x82, 0x38, 0x7a, 0x8f, 0x75,
-	0xb7, 0xaa, 0xd3, 0x49, 0x4d, 0xdd, 0xb4, 0xa9, 0xd8, 0x07, 0xa3, 0x6d, 0xaa, 0xfd, 0xa8, 0xe1,
-	0xa0, 0xf7, 0xa0, 0xc2, 0x8c, 0x62, 0x61, 0x45, 0xb8, 0x95, 0x36, 0x9d, 0xd4, 0x4a, 0x9b, 0x36,
-	0x9d, 0x45, 0x56, 0xa9, 0x3f, 0x6b, 0x39, 0x68, 0x03, 0x16, 0x99, 0xdd, 0x3c, 0x94, 0xf7, 0xb8,
-	0xf1, 0x95, 0xe9, 0xa4, 0xb6, 0xb0, 0x69, 0xd3, 0x39, 0x34, 0x2f, 0xf4, 0xa5, 0x28, 0x02, 0x74,
-	0xfd, 0x97, 0x65, 0xc8, 0xf1, 0x1d, 0x46, 0x77, 0x21, 0x4d, 0x1c, 0xce, 0x3c, 0x4a, 0xeb, 0xc6,
-	0x74, 0x52, 0x4b, 0x1b, 0xed, 0x67, 0x93, 0x1a, 0xea, 0x79, 0xfe, 0xf0, 0x5e, 0x7d, 0xe4, 0x93,
-	0xa1, 0xed, 0x1f, 0x5a, 0x7b, 0xf8, 0xb0, 0x6e, 0xa6, 0x89, 0x83, 0x5e, 0x81, 0x02, 0xdb, 0x32,
-	0x36, 0x24, 0xe7, 0xe9, 0x16, 0x4c, 0x27, 0xb5, 0xfc, 0xa7, 0xde, 0xc0, 0x33, 0xda, 0x66, 0x9e,
-	0x75, 0x19, 0x0e, 0x5a, 0x07, 0xe8, 0xfa, 0xd8, 0x0e, 0xb0, 0x63, 0xd9, 0x01, 0x67, 0x1e, 0x75,
-	0x6d, 0x69, 0x55, 0xe4, 0xbf, 0xd5, 0x30, 0xff, 0xad, 0xee, 0x84, 0xf9, 0xaf, 0x55, 0xfc, 0x7a,
-	0x52, 0x4b, 0x7d, 0xf5, 0xa7, 0x5a, 0xca, 0x54, 0xa4, 0x5d, 0x33, 0x60, 0x4e, 0xc6, 0x23, 0x27,
-	0x74, 0x92, 0xbd, 0x88, 0x13, 0x69, 0xd7, 0x64, 0x69, 0x31, 0x27, 0xa2, 0x25, 0xb7, 0x9c, 0x3a,
-	0x9e, 0x22, 0x44, 0x3f, 0x7a, 0x00, 0xa5, 0xae, 0x37, 0x1c, 0x0d, 0xb0, 0x1c, 0x2f, 0x7f, 0x81,
-	0xf1, 0xd4, 0xc8, 0xb2, 0x19, 0x20, 0x1d, 0x0a, 0x43, 0x4c, 0xa9, 0xdd, 0xc3, 0x7a, 0x81, 0xa3,
-	0x24, 0x6c, 0xb2, 0x05, 0xd1, 0xc0, 0xf6, 0xe5, 0x00, 0xc5, 0x8b, 0x2c, 0x48, 0xda, 0x35, 0x03,
-	0xb4, 0x01, 0xea, 0x2e, 0x71, 0x09, 0xed, 0x0b, 0x2f, 0xca, 0x05, 0xbc, 0x40, 0x68, 0xd8, 0x0c,
-	0x18, 0xa1, 0x4b, 0xb8, 0x8e, 0xfd, 0x01, 0xcf, 0xaa, 0x92, 0xd0, 0x05, 0x3e, 0x9f, 0x9a, 0x0f,
-	0x4d, 0x45, 0x28, 0x3c, 0xf5, 0x07, 0x27, 0x02, 0xff, 0x7f, 0x20, 0x2f, 0x19, 0xbb, 0xc4, 0xb7,
-	0x37, 0xc9, 0xd8, 0xb2, 0x8f, 0x25, 0x19, 0xda, 0x67, 0x64, 0x41, 0x1c, 0x9e, 0x5e, 0x65, 0x92,
-	0xd9, 0x66, 0x32, 0x96, 0x64, 0x78, 0xa7, 0xc1, 0xa1, 0xb5, 0xdf, 0xa5, 0x56, 0x60, 0xf7, 0x38,
-	0xf1, 0x48, 0x68, 0x7d, 0xbc, 0xbe, 0xbd, 0x63, 0xf7, 0xcc, 0xfc, 0x7e, 0x97, 0xee, 0xd8, 0x3d,
-	0xb4, 0x02, 0xaa, 0x54, 0xe2, 0x33, 0xaf, 0xce, 0x66, 0x2e, 0x14, 0xf9, 0xcc, 0x85, 0x2e, 0x9b,
-	0xf9, 0x4d, 0x00, 0xdf, 0x3e, 0xb0, 0xe4, 0xec, 0xaf, 0xf0, 0xd9, 0x2b, 0xbe, 0x7d, 0xd0, 0x12,
-	0x0b, 0x58, 0x13, 0x41, 0xc8, 0x54, 0xc4, 0x6a, 0xf5, 0xab, 0x7c, 0x43, 0xe5, 0x42, 0xc4, 0x66,
-	0xf0, 0x00, 0x34, 0xed, 0x03, 0xd1, 0x42, 0xef, 0x42, 0x35, 0xb4, 0x91, 0xc1, 0xab, 0x5f, 0xe3,
-	0x46, 0x73, 0x64, 0x52, 0x16, 0x56, 0xb2, 0x89, 0xda, 0x70, 0x39, 0x34, 0x4b, 0x70, 0xac, 0xce,
-	0x6d, 0xd1, 0x51, 0x1a, 0x37, 0x91, 0x70, 0x90, 0xe0, 0xdd, 0x0f, 0x61, 0x21, 0x39, 0x61, 0xb6,
-	0xa9, 0x2f, 0xf1, 0x4d, 0xe0, 0x69, 0x6c, 0x33, 0x36, 0x53, 0x96, 0xc6, 0xe2, 0x33, 0x37, 0x1c,
-	0xf4, 0x3d, 0x40, 0x73, 0x73, 0x67, 0xf6, 0x4b, 0xdc, 0x7e, 0x71, 0x3a, 0xa9, 0x55, 0x37, 0xe3,
-	0x73, 0x36, 0xda, 0x66, 0x35, 0xb1, 0x08, 0xc3, 0x41, 0x4f, 0xe0, 0xda, 0x71, 0xcb, 0x60, 0x6e,
-	0xae, 0x73, 0x37, 0x3c, 0x13, 0x6e, 0x1e, 0x99, 0x39, 0xcb, 0x84, 0x47, 0xd7, 0x63, 0x38, 0xe8,
-	0xa9, 0x20, 0xcf, 0x59, 0xa1, 0x82, 0xe3, 0x45, 0x66, 0x58, 0x30, 0xb4, 0x96, 0x9f, 0x4d, 0x6a,
-	0x37, 0x04, 0x27, 0xed, 0x7a, 0x3e, 0x26, 0x3d, 0x77, 0x0f, 0x1f, 0xde, 0xdb, 0xb4, 0xa9, 0xac,
-	0x55, 0xea, 0xfc, 0x2b, 0xcd, 0x2a, 0x9b, 0x37, 0x01, 0x66, 0x9c, 0xac, 0xef, 0x1e, 0xf3, 0x55,
-	0x95, 0x88, 0x8d, 0x9f, 0x8f, 0xc0, 0x57, 0x41, 0x8d, 0x11, 0xb8, 0xde, 0x3f, 0x0e, 0x03, 0x30,
-	0xa3, 0xee, 0xe7, 0x26, 0xfc, 0x0f, 0x41, 0x9b, 0x27, 0x7c, 0xfd, 0xb3, 0x13, 0x41, 0x53, 0x9d,
-	0xa3, 0xfa, 0x0b, 0xe4, 0x0b, 0xff, 0xb4, 0x7c, 0xf1, 0x65, 0x0a, 0x72, 0xa2, 0x88, 0xd0, 0xa0,
-	0xf4, 0xd4, 0xdd, 0x73, 0xbd, 0x03, 0x97, 0xb7, 0xb5, 0x4b, 0x48, 0x85, 0x82, 0x39, 0x76, 0x5d,
-	0xe2, 0xf6, 0xb4, 0x14, 0x02, 0xc8, 0xdf, 0xb7, 0xc9, 0x00, 0x3b, 0x5a, 0x9a, 0xfd, 0xbf, 0x65,
-	0x53, 0x8a, 0x1d, 0x2d, 0x83, 0x4a, 0x50, 0x5c, 0xb7, 0xdd, 0x2e, 0x66, 0x3d, 0x59, 0x54, 0x06,
-	0x65, 0xbb, 0xdb, 0xc7, 0xce, 0x98, 0x35, 0x73, 0xcc, 0xc3, 0xf6, 0x1e, 0x19, 0x8d, 0xb0, 0xa3,
-	0xe5, 0x99, 0xd5, 0x63, 0x2f, 0x30, 0xc7, 0xae, 0x56, 0x60, 0x56, 0x8c, 0xca, 0x1c, 0x6f, 0x1c,
-	0x68, 0xc5, 0xfa, 0xef, 0xb3, 0xac, 0x04, 0xe0, 0x75, 0xf9, 0x8b, 0x9d, 0xb6, 0x62, 0x49, 0x24,
-	0x97, 0x4c, 0x22, 0x33, 0xca, 0xcd, 0x9f, 0x42, 0xb9, 0x49, 0x7a, 0x2f, 0x9c, 0x41, 0xef, 0x71,
-	0x82, 0x2e, 0x9e, 0x42, 0xd0, 0xef, 0x9c, 0x2b, 0x54, 0xff, 0x9d, 0x40, 0x9c, 0x8b, 0xa9, 0xde,
-	0x59, 0x31, 0x75, 0x5c, 0x6c, 0xf4, 0xcf, 0x1d, 0x1b, 0xf5, 0x5f, 0x65, 0x21, 0x2f, 0x47, 0xfe,
-	0x2f, 0x9c, 0x4e, 0x81, 0xd3, 0x2c, 0xff, 0x17, 0x12, 0xf9, 0xff, 0x2d, 0x28, 0xf1, 0x64, 0x10,
-	0x1e, 0x9e, 0x71, 0xbc, 0xa8, 0x96, 0x81, 0xca, 0x49, 0x33, 0x3a, 0x4c, 0xdf, 0x11, 0x68, 0x90,
-	0x07, 0x80, 0xdd, 0xa3, 0x07, 0x00, 0x06, 0x06, 0x79, 0xb6, 0xbe, 0x28, 0x18, 0x24, 0xd2, 0xc4,
-	0xd1, 0x4c, 0xc2, 0x20, 0x79, 0x14, 0x60, 0xce, 0xc5, 0x11, 0xec, 0x58, 0xe4, 0x90, 0xf3, 0x23,
-	0xe7, 0xaf, 0x0a, 0x94, 0xe2, 0x1a, 0x2f, 0x36, 0x7e, 0x9a, 0xa0, 0xf0, 0x8d, 0xe2, 0x3e, 0x72,
-	0x17, 0xf0, 0x51, 0x14, 0x66, 0x4d, 0x7e, 0xc5, 0x11, 0x90, 0x60, 0x80, 0x39, 0xce, 0x14, 0x53,
-	0x34, 0x4e, 0x29, 0x96, 0x67, 0xc0, 0x2c, 0x9e, 0x0b, 0x98, 0x4a, 0x02, 0x98, 0xab, 0x61, 0xd9,
-	0x0f, 0xdc, 0xf8, 0xe4, 0x43, 0xb2, 0xac, 0xfe, 0x93, 0x7c, 0xa9, 0x9e, 0xc1, 0x97, 0x77, 0x01,
-	0xc4, 0x38, 0x5c, 0xbb, 0x34, 0xd3, 0x16, 0x55, 0x25, 0xd7, 0x16, 0x0a, 0xf3, 0xec, 0x7a, 0x5a,
-	0xf9, 0xbb, 0x0c, 0x79, 0x42, 0xad, 0x03, 0x32, 0x12, 0xc7, 0xee, 0x96, 0x32, 0x9d, 0xd4, 0x72,
-	0x06, 0xfd, 0xc4, 0xd8, 0x32, 0x73, 0x84, 0x7e, 0x42, 0x46, 0xff, 0xe1, 0x70, 0xdb, 0x91, 0xec,
-	0x4e, 0x29, 0xe9, 0xb9, 0x18, 0x53, 0xbd, 0x77, 0xf4, 0x30, 0xdd, 0x7a, 0xf9, 0xd9, 0xa4, 0x76,
-	0x53, 0x80, 0x7a, 0x68, 0xbb, 0x87, 0x6b, 0xec, 0xcf, 0xbd, 0xa1, 0x3f, 0xb3, 0x92, 0x75, 0x58,
-	0xd8, 0x0c, 0xbd, 0xfa, 0x78, 0x9f, 0xe0, 0x03, 0xec, 0x53, 0xbd, 0x7f, 0x01, 0xaf, 0x91, 0x95,
-	0xf0, 0x6a, 0x86, 0xcd, 0x79, 0x6a, 0x20, 0x17, 0xaf, 0xbd, 0x3e, 0x3b, 0x57, 0xed, 0x95, 0xa4,
-	0x94, 0xbd, 0xd3, 0x29, 0x25, 0x4c, 0x8f, 0xd1, 0xd5, 0xd0, 0x20, 0x51, 0x45, 0x46, 0x37, 0x42,
-	0x6a, 0x64, 0x32, 0x1b, 0x41, 0xa6, 0xc7, 0xe1, 0x05, 0xeb, 0x54, 0xf7, 0xec, 0x3a, 0xb5, 0xfe,
-	0xe1, 0xc9, 0x85, 0x1b, 0x40, 0xfe, 0xc9, 0x08, 0xbb, 0xd8, 0x11, 0x75, 0xdb, 0xfa, 0xc0, 0xa3,
-	0x61, 0xdd, 0xc6, 0x63, 0xc5, 0xd1, 0x32, 0xf5, 0x9f, 0xe7, 0xa0, 0x10, 0x6e, 0xe3, 0x0b, 0x4d,
-	0x72, 0x33, 0xc6, 0xc9, 0x9d, 0xc2, 0x38, 0x08, 0xb2, 0xae, 0x3d, 0x0c, 0x69, 0x8c, 0xff, 0x8f,
-	0x96, 0x41, 0x75, 0x30, 0xed, 0xfa, 0x64, 0x14, 0x10, 0xcf, 0x95, 0x4c, 0x16, 0x17, 0x3d, 0x5f,
-	0xe5, 0x74, 0x91, 0xe0, 0x5d, 0x11, 0x01, 0x11, 0xde, 0x72, 0x27, 0x42, 0x57, 0xe2, 0x08, 0x22,
-	0x50, 0xd0, 0x23, 0x4c, 0xd2, 0x3f, 0x93, 0x49, 0xbe, 0x2b, 0x0e, 0x9e, 0xf1, 0x7c, 0x49, 0x75,
-	0xc2, 0xcd, 0x8e, 0x4b, 0x98, 0xda, 0x5c, 0xc2, 0xa4, 0xe8, 0x0d, 0x60, 0xd3, 0xb5, 0xbc, 0x03,
-	0x17, 0xfb, 0xf2, 0xfc, 0x32, 0x77, 0x4f, 0xd7, 0xb7, 0xe9, 0x13, 0xd6, 0x1b, 0xce, 0x8e, 0xab,
-	0xce, 0xce, 0x2a, 0xfc, 0x02, 0x79, 0x53, 0xea, 0x18, 0x6d, 0xbe, 0x1e, 0xf1, 0xbf, 0x53, 0xff,
-	0x47, 0x16, 0xf2, 0xc2, 0xcd, 0x8b, 0x8d, 0xd1, 0x10, 0x7d, 0xb9, 0x18, 0xfa, 0xce, 0x7d, 0x22,
-	0xb0, 0xf7, 0xed, 0xc0, 0xf6, 0xe7, 0x4f, 0x04, 0x4d, 0x2e, 0xe5, 0x39, 0x4b, 0x28, 0xb0, 0x9c,
-	0xf5, 0x2a, 0x64, 0xf7, 0x88, 0xeb, 0xc8, 0xdc, 0xbb, 0x10, 0xff, 0x4e, 0xe2, 0xb9, 0x81, 0x77,
-	0xcf, 0x03, 0x5f, 0x39, 0x0a, 0x7c, 0xf9, 0x29, 0xa3, 0x6b, 0x57, 0x7c, 0xdc, 0xb5, 0xab, 0x3a,
-	0xe3, 0xdc, 0x23, 0x48, 0xde, 0x3d, 0x03, 0xc9, 0xc7, 0xe2, 0xb2, 0x77, 0x7e, 0x5c, 0xd6, 0xff,
-	0x1f, 0xb2, 0x6c, 0x45, 0xa8, 0x0a, 0xaa, 0x64, 0x47, 0xd6, 0xd4, 0x2e, 0xa1, 0x22, 0x64, 0x9f,
-	0x52, 0xec, 0x6b, 0x29, 0x46, 0x9c, 0x4f, 0xfc, 0x9e, 0xed, 0x92, 0x2f, 0xf8, 0xfb, 0x9f, 0x96,
-	0x46, 0x05, 0xc8, 0xb4, 0xbc, 0x40, 0xcb, 0xd4, 0xff, 0xae, 0x40, 0x31, 0x8c, 0xd8, 0x17, 0x1b,
-	0x7a, 0xd7, 0x41, 0xd9, 0x25, 0x03, 0x6c, 0x51, 0xf2, 0x85, 0xc0, 0x5f, 0xc6, 0x2c, 0x32, 0xc1,
-	0x36, 0xf9, 0x02, 0xa3, 0x9b, 0x00, 0x03, 0xaf, 0x6b, 0x0f, 0xac, 0x91, 0x1d, 0xf4, 0x25, 0x37,
-	0x2a, 0x5c, 0xb2, 0x65, 0x07, 0x7d, 0xb4, 0x06, 0xa5, 0xf0, 0x8d, 0x30, 0x06, 0x3f, 0x9e, 0xb6,
-	0xc2, 0x57, 0x44, 0x06, 0x40, 0x35, 0x54, 0x62, 0x10, 0xbc, 0x0e, 0xca, 0x90, 0x0c, 0xb1, 0x15,
-	0x1c, 0x8e, 0xb0, 0x38, 0x95, 0x9a, 0x45, 0x26, 0xd8, 0x39, 0x1c, 0x61, 0xf4, 0x12, 0xab, 0xa9,
-	0xec, 0xb7, 0x2d, 0x3a, 0x1e, 0x4a, 0xd4, 0x15, 0x58, 0x7b, 0x7b, 0x3c, 0x64, 0x53, 0xa1, 0x7d,
-	0x7b, 0xed, 0xdd, 0xf7, 0x78, 0x27, 0x88, 0xa9, 0x08, 0x09, 0xeb, 0xbe, 0x13, 0x56, 0x86, 0x2a,
-	0x87, 0xf6, 0xe5, 0xb9, 0xb7, 0xb4, 0x44, 0x55, 0xf8, 0xba, 0x8c, 0x02, 0x71, 0xb9, 0x79, 0xec,
-	0xb3, 0x9b, 0x88, 0x83, 0x59, 0x08, 0x96, 0x4f, 0x09, 0xc1, 0x1a, 0xa8, 0x9d, 0xb1, 0xeb, 0x0c,
-	0xb0, 0xc5, 0x63, 0x98, 0xdf, 0x71, 0x9a, 0x20, 0x44, 0x8f, 0x59, 0x24, 0xbf, 0x0a, 0x15, 0xa9,
-	0xb0, 0x8f, 0x7d, 0xca, 0x22, 0x8a, 0x5f, 0x6f, 0x9a, 0x65, 0x21, 0xfd, 0x58, 0x08, 0x19, 0x93,
-	0x4a, 0x35, 0xe2, 0xe8, 0x1a, 0xdf, 0xca, 0xd2, 0x74, 0x52, 0x2b, 0xb6, 0xb8, 0xd0, 0x68, 0x9b,
-	0x45, 0xd1, 0x6d, 0x38, 0xb1, 0x21, 0x49, 0xd7, 0x73, 0xf5, 0x85, 0xf8, 0x90, 0x46, 0xd7, 0x73,
-	0xd1, 0x6d, 0xc1, 0xca, 0x3c, 0xc7, 0xe8, 0x38, 0xf1, 0x0a, 0xcc, 0x53, 0x4c, 0x31, 0x4c, 0x31,
-	0x61, 0x24, 0x47, 0xcf, 0x85, 0xbb, 0x09, 0x52, 0x0e, 0x5f, 0x0c, 0x21, 0xd4, 0x9f, 0x5d, 0x90,
-	0xc9, 0x24, 0x93, 0x3c, 0xbf, 0x85, 0x39, 0x06, 0x66, 0x39, 0x26, 0x2c, 0xd2, 0xa4, 0x3e, 0x1b,
-	0xa3, 0x9f, 0x28, 0xd2, 0xa4, 0x9e, 0x2c, 0xd2, 0xc2, 0x96, 0x93, 0x7c, 0xa2, 0x26, 0x67, 0x3c,
-	0x51, 0xa3, 0xff, 0x85, 0x6a, 0xd4, 0xb0, 0xba, 0xde, 0xd8, 0x15, 0xb7, 0x69, 0x99, 0x96, 0xfa,
-	0x6c, 0x52, 0x2b, 0xd0, 0xcf, 0x07, 0xf7, 0xea, 0x2b, 0x75, 0xb3, 0x12, 0xe9, 0xac, 0x33, 0x15,
-	0xf4, 0x08, 0xae, 0x3a, 0x83, 0x28, 0x7f, 0x5b, 0xbc, 0xba, 0x15, 0x58, 0xfe, 0xad, 0x88, 0xf7,
-	0x6b, 0xd3, 0x49, 0x6d, 0xb1, 0xfd, 0x30, 0x44, 0xc7, 0x36, 0x57, 0x60, 0xa0, 0x5e, 0x74, 0x06,
-	0x73, 0x42, 0x7f, 0xc0, 0x4e, 0x9f, 0xa3, 0x01, 0xa1, 0x09, 0x47, 0xbf, 0x4b, 0xcd, 0xae, 0x71,
-	0xb7, 0x1e, 0x12, 0x1a, 0xf3, 0x51, 0xe1, 0xca, 0x91, 0x79, 0x7d, 0xf3, 0xe4, 0x92, 0xae, 0x04,
-	0xc5, 0xfb, 0xf2, 0x9a, 0x5f, 0x4b, 0x31, 0x9e, 0x7a, 0x8c, 0x0f, 0xb4, 0x34, 0x52, 0x20, 0xb7,
-	0xe1, 0xfb, 0x9e, 0xaf, 0x65, 0x90, 0x0a, 0x85, 0x36, 0xe6, 0xaf, 0x15, 0x5a, 0xb6, 0xbe, 0x76,
-	0x12, 0xfb, 0x15, 0x20, 0x63, 0x6c, 0x35, 0x85, 0x8b, 0xe6, 0xd6, 0x47, 0x82, 0xf3, 0xda, 0x8f,
-	0x1e, 0x68, 0x99, 0xfa, 0x3f, 0x53, 0x50, 0x0c, 0x77, 0x16, 0x7d, 0x10, 0x71, 0x5e, 0xa6, 0xf5,
-	0x66, 0xc4, 0x79, 0x2f, 0x0b, 0xce, 0xdb, 0x32, 0x8d, 0x47, 0x4d, 0xf3, 0x53, 0xeb, 0xa3, 0x8d,
-	0x4f, 0x3f, 0x68, 0x3e, 0xdd, 0x79, 0x62, 0x19, 0x8f, 0xd7, 0xcd, 0x8d, 0x47, 0x1b, 0x8f, 0x77,
-	0x04, 0x05, 0x26, 0xd9, 0x2d, 0xfd, 0x7c, 0xec, 0xf6, 0xb6, 0x00, 0x66, 0xf8, 0x6d, 0x24, 0x8a,
-	0xe7, 0x4b, 0x2b, 0x35, 0x56, 0x5a, 0xa1, 0xf7, 0xc5, 0x15, 0x7e, 0xfc, 0x91, 0x5a, 0xc0, 0x79,
-	0x61, 0x3a, 0xa9, 0x95, 0x37, 0x67, 0x9a, 0x46, 0x9b, 0x5f, 0xe3, 0x47, 0x4d, 0xa7, 0xfe, 0xeb,
-	0x34, 0xe4, 0xf8, 0x0f, 0x24, 0xce, 0xf5, 0x8e, 0xc9, 0xb0, 0x39, 0x2b, 0xfa, 0xd2, 0xc7, 0x16,
-	0x7d, 0x33, 0x85, 0xc4, 0x03, 0x65, 0xe6, 0xd4, 0x07, 0xca, 0xc4, 0xab, 0x67, 0xf6, 0xac, 0x57,
-	0xcf, 0xa8, 0xce, 0xcb, 0x1d, 0x57, 0xe7, 0x45, 0xdd, 0xe8, 0x35, 0x28, 0x84, 0x79, 0x37, 0x7f,
-	0x4c, 0xde, 0x0d, 0x3b, 0xd1, 0xfb, 0x50, 0x99, 0xfb, 0xc9, 0x43, 0xe1, 0xc4, 0x8c, 0x5b, 0x1e,
-	0xc6, 0x7f, 0x04, 0x71, 0xe7, 0xfb, 0x90, 0x97, 0x6f, 0xf8, 0x0b, 0x50, 0x96, 0x90, 0x13, 0x02,
-	0xed, 0x12, 0x2a, 0xcb, 0x1f, 0x48, 0xec, 0x91, 0x00, 0x6b, 0x29, 0x7e, 0x65, 0x4c, 0xfc, 0xee,
-	0x00, 0xaf, 0x1b, 0x5a, 0x9a, 0xe1, 0xb6, 0x45, 0xdc, 0xc0, 0xb7, 0x0f, 0xb5, 0x0c, 0x3b, 0xa1,
-	0x3c, 0x20, 0xc1, 0xe6, 0xb8, 0xa3, 0x65, 0xd7, 0x7e, 0x93, 0x01, 0x95, 0xa5, 0xcd, 0x6d, 0xec,
-	0xef, 0x93, 0x2e, 0x46, 0xdf, 0x11, 0xbf, 0xa3, 0x41, 0x72, 0x36, 0xec, 0xff, 0xd5, 0xf0, 0xe1,
-	0x78, 0x31, 0x21, 0x93, 0xbf, 0xac, 0x29, 0x7f, 0xf9, 0x87, 0xbf, 0xfc, 0x24, 0x5d, 0x40, 0xb9,
-	0xc6, 0x88, 0xd9, 0xdd, 0x0f, 0x7f, 0xc3, 0x82, 0x64, 0x76, 0x10, 0xad, 0xc8, 0xc7, 0x95, 0x39,
-	0xa9, 0xf4, 0x52, 0xe5, 0x5e, 0x14, 0x54, 0x68, 0x50, 0x61, 0xbd, 0x1d, 0xfb, 0xb9, 0x07, 0xba,
-	0x16, 0x43, 0x07, 0x13, 0x44, 0xde, 0xf4, 0xa3, 0x1d, 0xd2, 0xe1, 0x22, 0x77, 0x58, 0x46, 0x6a,
-	0x83, 0x83, 0x69, 0x85, 0x71, 0x00, 0x1a, 0x1d, 0x7d, 0x18, 0x47, 0xb7, 0xe6, 0x5c, 0x48, 0x79,
-	0x34, 0x44, 0xed, 0xc4, 0x7e, 0x39, 0xd2, 0x75, 0x3e, 0xd2, 0x15, 0xb4, 0x18, 0x1b, 0x69, 0x65,
-	0x57, 0x7a, 0xef, 0xcf, 0xff, 0xec, 0x08, 0xdd, 0x90, 0xec, 0x9a, 0x90, 0x46, 0xa3, 0xdd, 0x3c,
-	0xa1, 0x57, 0x8e, 0xf5, 0x12, 0x1f, 0x6b, 0x11, 0x2d, 0x34, 0x1c, 0xbc, 0xbf, 0xe2, 0x8c, 0x87,
-	0xa3, 0x15, 0x4f, 0xe8, 0xb4, 0xfe, 0xef, 0xeb, 0xe9, 0xad, 0xd4, 0x37, 0xd3, 0x5b, 0xa9, 0x3f,
-	0x4f, 0x6f, 0xa5, 0xbe, 0xfa, 0xf6, 0xd6, 0xa5, 0x6f, 0xbe, 0xbd, 0x75, 0xe9, 0x8f, 0xdf, 0xde,
-	0xba, 0xf4, 0x83, 0x9b, 0x1d, 0xec, 0x07, 0x87, 0xab, 0x01, 0xee, 0xf6, 0x1b, 0xcc, 0x7b, 0xa3,
-	0xe7, 0x35, 0x46, 0x7b, 0xbd, 0x86, 0xf8, 0xcd, 0x56, 0x27, 0xcf, 0xb9, 0xe2, 0x9d, 0x7f, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x7f, 0xc3, 0x6f, 0x89, 0xc4, 0x25, 0x00, 0x00,
+func (m *Build) GetChannels() []string {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// YoloServiceClient is the client API for YoloService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type YoloServiceClient interface {
-	Ping(ctx context.Context, in *Ping_Request, opts ...grpc.CallOption) (*Ping_Response, error)
-	Status(ctx context.Context, in *Status_Request, opts ...grpc.CallOption) (*Status_Response, error)
-	BuildList(ctx context.Context, in *BuildList_Request, opts ...grpc.CallOption) (*BuildList_Response, error)
-	BuildListFilters(ctx context.Context, in *BuildListFilters_Request, opts ...grpc.CallOption) (*BuildListFilters_Response, error)
-	DevDumpObjects(ctx context.Context, in *DevDumpObjects_Request, opts ...grpc.CallOption) (*DevDumpObjects_Response, error)
-}
-
-type yoloServiceClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewYoloServiceClient(cc *grpc.ClientConn) YoloServiceClient {
-	return &yoloServiceClient{cc}
+func (m *Build) GetDownloadsCount() int64 {
+	if m != nil {
+		return m.DownloadsCount
+	}
+	return 0
 }
 
-func (c *yoloServiceClient) Ping(ctx context.Context, in *Ping_Request, opts ...grpc.CallOption) (*Ping_Response, error) {
-	out := new(Ping_Response)
-	err := c.cc.Invoke(ctx, "/yolo.YoloService/Ping", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Build) GetInstallsCount() int64 {
+	if m != nil {
+		return m.InstallsCount
 	}
-	return out, nil
+	return 0
 }
 
-func (c *yoloServiceClient) Status(ctx context.Context, in *Status_Request, opts ...grpc.CallOption) (*Status_Response, error) {
-	out := new(Status_Response)
-	err := c.cc.Invoke(ctx, "/yolo.YoloService/Status", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Build) GetInstallSignedURL() string {
+	if m != nil {
+		return m.InstallSignedURL
 	}
-	return out, nil
+	return ""
 }
 
-func (c *yoloServiceClient) BuildList(ctx context.Context, in *BuildList_Request, opts ...grpc.CallOption) (*BuildList_Response, error) {
-	out := new(BuildList_Response)
-	err := c.cc.Invoke(ctx, "/yolo.YoloService/BuildList", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Build) GetBuildConfig() map[string]string {
+	if m != nil {
+		return m.BuildConfig
 	}
-	return out, nil
+	return nil
 }
 
-func (c *yoloServiceClient) BuildListFilters(ctx context.Context, in *BuildListFilters_Request, opts ...grpc.CallOption) (*BuildListFilters_Response, error) {
-	out := new(BuildListFilters_Response)
-	err := c.cc.Invoke(ctx, "/yolo.YoloService/BuildListFilters", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Build) GetOwnerTeams() []string {
+	if m != nil {
+		return m.OwnerTeams
 	}
-	return out, nil
+	return nil
 }
 
-func (c *yoloServiceClient) DevDumpObjects(ctx context.Context, in *DevDumpObjects_Request, opts ...grpc.CallOption) (*DevDumpObjects_Response, error) {
-	out := new(DevDumpObjects_Response)
-	err := c.cc.Invoke(ctx, "/yolo.YoloService/DevDumpObjects", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Build) GetFlags() map[string]string {
+	if m != nil {
+		return m.Flags
 	}
-	return out, nil
+	return nil
 }
 
-// YoloServiceServer is the server API for YoloService service.
-type YoloServiceServer interface {
-	Ping(context.Context, *Ping_Request) (*Ping_Response, error)
-	Status(context.Context, *Status_Request) (*Status_Response, error)
-	BuildList(context.Context, *BuildList_Request) (*BuildList_Response, error)
-	BuildListFilters(context.Context, *BuildListFilters_Request) (*BuildListFilters_Response, error)
-	DevDumpObjects(context.Context, *DevDumpObjects_Request) (*DevDumpObjects_Response, error)
+type Release struct {
+	ID              string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primary_key"`
+	YoloID          string        `protobuf:"bytes,2,opt,name=yolo_id,json=yoloId,proto3" json:"yolo_id,omitempty"`
+	CreatedAt       *time.Time    `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty"`
+	UpdatedAt       *time.Time    `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at,omitempty"`
+	Message         string        `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Driver          Driver        `protobuf:"varint,6,opt,name=driver,proto3,enum=yolo.Driver" json:"driver,omitempty"`
+	CommitURL       string        `protobuf:"bytes,7,opt,name=commit_url,json=commitUrl,proto3" json:"commit_url,omitempty"`
+	ShortID         string        `protobuf:"bytes,8,opt,name=short_id,json=shortId,proto3" json:"short_id,omitempty"`
+	HasArtifacts    []*Artifact   `protobuf:"bytes,101,rep,name=has_artifacts,json=hasArtifacts,proto3" json:"has_artifacts,omitempty"`
+	HasCommit       *Commit       `protobuf:"bytes,102,opt,name=has_commit,json=hasCommit,proto3" json:"has_commit,omitempty"`
+	HasProject      *Project      `protobuf:"bytes,103,opt,name=has_project,json=hasProject,proto3" json:"has_project,omitempty"`
+	HasMergerequest *MergeRequest `protobuf:"bytes,104,opt,name=has_mergerequest,json=hasMergerequest,proto3" json:"has_mergerequest,omitempty"`
 }
 
-// UnimplementedYoloServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedYoloServiceServer struct {
+func (m *Release) Reset()         { *m = Release{} }
+func (m *Release) String() string { return proto.CompactTextString(m) }
+func (*Release) ProtoMessage()    {}
+func (*Release) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{17}
 }
-
-func (*UnimplementedYoloServiceServer) Ping(ctx context.Context, req *Ping_Request) (*Ping_Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+func (m *Release) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedYoloServiceServer) Status(ctx context.Context, req *Status_Request) (*Status_Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+func (m *Release) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Release.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedYoloServiceServer) BuildList(ctx context.Context, req *BuildList_Request) (*BuildList_Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BuildList not implemented")
+func (m *Release) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Release.Merge(m, src)
 }
-func (*UnimplementedYoloServiceServer) BuildListFilters(ctx context.Context, req *BuildListFilters_Request) (*BuildListFilters_Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BuildListFilters not implemented")
+func (m *Release) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedYoloServiceServer) DevDumpObjects(ctx context.Context, req *DevDumpObjects_Request) (*DevDumpObjects_Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DevDumpObjects not implemented")
+func (m *Release) XXX_DiscardUnknown() {
+	xxx_messageInfo_Release.DiscardUnknown(m)
 }
 
-func RegisterYoloServiceServer(s *grpc.Server, srv YoloServiceServer) {
-	s.RegisterService(&_YoloService_serviceDesc, srv)
-}
+var xxx_messageInfo_Release proto.InternalMessageInfo
 
-func _YoloService_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Ping_Request)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(YoloServiceServer).Ping(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/yolo.YoloService/Ping",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(YoloServiceServer).Ping(ctx, req.(*Ping_Request))
+func (m *Release) GetID() string {
+	if m != nil {
+		return m.ID
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _YoloService_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Status_Request)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(YoloServiceServer).Status(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/yolo.YoloService/Status",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(YoloServiceServer).Status(ctx, req.(*Status_Request))
+func (m *Release) GetYoloID() string {
+	if m != nil {
+		return m.YoloID
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _YoloService_BuildList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BuildList_Request)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(YoloServiceServer).BuildList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/yolo.YoloService/BuildList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(YoloServiceServer).BuildList(ctx, req.(*BuildList_Request))
+func (m *Release) GetCreatedAt() *time.Time {
+	if m != nil {
+		return m.CreatedAt
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _YoloService_BuildListFilters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BuildListFilters_Request)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *Release) GetUpdatedAt() *time.Time {
+	if m != nil {
+		return m.UpdatedAt
 	}
-	if interceptor == nil {
-		return srv.(YoloServiceServer).BuildListFilters(ctx, in)
+	return nil
+}
+
+func (m *Release) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/yolo.YoloService/BuildListFilters",
+	return ""
+}
+
+func (m *Release) GetDriver() Driver {
+	if m != nil {
+		return m.Driver
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(YoloServiceServer).BuildListFilters(ctx, req.(*BuildListFilters_Request))
+	return Driver_UnknownDriver
+}
+
+func (m *Release) GetCommitURL() string {
+	if m != nil {
+		return m.CommitURL
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _YoloService_DevDumpObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DevDumpObjects_Request)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *Release) GetShortID() string {
+	if m != nil {
+		return m.ShortID
 	}
-	if interceptor == nil {
-		return srv.(YoloServiceServer).DevDumpObjects(ctx, in)
+	return ""
+}
+
+func (m *Release) GetHasArtifacts() []*Artifact {
+	if m != nil {
+		return m.HasArtifacts
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/yolo.YoloService/DevDumpObjects",
+	return nil
+}
+
+func (m *Release) GetHasCommit() *Commit {
+	if m != nil {
+		return m.HasCommit
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(YoloServiceServer).DevDumpObjects(ctx, req.(*DevDumpObjects_Request))
+	return nil
+}
+
+func (m *Release) GetHasProject() *Project {
+	if m != nil {
+		return m.HasProject
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _YoloService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "yolo.YoloService",
-	HandlerType: (*YoloServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Ping",
-			Handler:    _YoloService_Ping_Handler,
-		},
-		{
-			MethodName: "Status",
-			Handler:    _YoloService_Status_Handler,
-		},
-		{
-			MethodName: "BuildList",
-			Handler:    _YoloService_BuildList_Handler,
-		},
-		{
-			MethodName: "BuildListFilters",
-			Handler:    _YoloService_BuildListFilters_Handler,
-		},
-		{
-			MethodName: "DevDumpObjects",
-			Handler:    _YoloService_DevDumpObjects_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "yolopb.proto",
-}
-
-func (m *Ping) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Release) GetHasMergerequest() *MergeRequest {
+	if m != nil {
+		return m.HasMergerequest
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Ping) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Commit struct {
+	ID              string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primary_key"`
+	YoloID          string        `protobuf:"bytes,2,opt,name=yolo_id,json=yoloId,proto3" json:"yolo_id,omitempty"`
+	CreatedAt       *time.Time    `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty"`
+	UpdatedAt       *time.Time    `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at,omitempty"`
+	Message         string        `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Driver          Driver        `protobuf:"varint,6,opt,name=driver,proto3,enum=yolo.Driver" json:"driver,omitempty"`
+	Branch          string        `protobuf:"bytes,7,opt,name=branch,proto3" json:"branch,omitempty"`
+	HasReleases     []*Release    `protobuf:"bytes,101,rep,name=has_releases,json=hasReleases,proto3" json:"has_releases,omitempty"`
+	HasBuilds       []*Build      `protobuf:"bytes,102,rep,name=has_builds,json=hasBuilds,proto3" json:"has_builds,omitempty"`
+	HasProject      *Project      `protobuf:"bytes,103,opt,name=has_project,json=hasProject,proto3" json:"has_project,omitempty"`
+	HasAuthor       *Entity       `protobuf:"bytes,104,opt,name=has_author,json=hasAuthor,proto3" json:"has_author,omitempty"`
+	HasMergerequest *MergeRequest `protobuf:"bytes,105,opt,name=has_mergerequest,json=hasMergerequest,proto3" json:"has_mergerequest,omitempty"`
 }
 
-func (m *Ping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *Commit) Reset()         { *m = Commit{} }
+func (m *Commit) String() string { return proto.CompactTextString(m) }
+func (*Commit) ProtoMessage()    {}
+func (*Commit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{18}
 }
-
-func (m *Ping_Request) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Commit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Commit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Commit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *Ping_Request) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Commit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Commit.Merge(m, src)
 }
-
-func (m *Ping_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *Commit) XXX_Size() int {
+	return m.Size()
+}
+func (m *Commit) XXX_DiscardUnknown() {
+	xxx_messageInfo_Commit.DiscardUnknown(m)
 }
 
-func (m *Ping_Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Commit proto.InternalMessageInfo
+
+func (m *Commit) GetID() string {
+	if m != nil {
+		return m.ID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Ping_Response) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Commit) GetYoloID() string {
+	if m != nil {
+		return m.YoloID
+	}
+	return ""
 }
 
-func (m *Ping_Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *Commit) GetCreatedAt() *time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
 }
 
-func (m *DevDumpObjects) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Commit) GetUpdatedAt() *time.Time {
+	if m != nil {
+		return m.UpdatedAt
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DevDumpObjects) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Commit) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
 }
 
-func (m *DevDumpObjects) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *Commit) GetDriver() Driver {
+	if m != nil {
+		return m.Driver
+	}
+	return Driver_UnknownDriver
 }
 
-func (m *DevDumpObjects_Request) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Commit) GetBranch() string {
+	if m != nil {
+		return m.Branch
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DevDumpObjects_Request) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Commit) GetHasReleases() []*Release {
+	if m != nil {
+		return m.HasReleases
+	}
+	return nil
 }
 
-func (m *DevDumpObjects_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.WithPreloading {
-		i--
-		if m.WithPreloading {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (m *Commit) GetHasBuilds() []*Build {
+	if m != nil {
+		return m.HasBuilds
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *DevDumpObjects_Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Commit) GetHasProject() *Project {
+	if m != nil {
+		return m.HasProject
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DevDumpObjects_Response) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Commit) GetHasAuthor() *Entity {
+	if m != nil {
+		return m.HasAuthor
+	}
+	return nil
 }
 
-func (m *DevDumpObjects_Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Downloads) > 0 {
-		for iNdEx := len(m.Downloads) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Downloads[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYolopb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Batch != nil {
-		{
-			size, err := m.Batch.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYolopb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *Commit) GetHasMergerequest() *MergeRequest {
+	if m != nil {
+		return m.HasMergerequest
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Status) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type MergeRequest struct {
+	ID        string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primary_key"`
+	YoloID    string             `protobuf:"bytes,2,opt,name=yolo_id,json=yoloId,proto3" json:"yolo_id,omitempty"`
+	CreatedAt *time.Time         `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty"`
+	UpdatedAt *time.Time         `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at,omitempty"`
+	MergedAt  *time.Time         `protobuf:"bytes,5,opt,name=merged_at,json=mergedAt,proto3,stdtime" json:"merged_at,omitempty"`
+	Title     string             `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	Message   string             `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	Driver    Driver             `protobuf:"varint,8,opt,name=driver,proto3,enum=yolo.Driver" json:"driver,omitempty"`
+	Branch    string             `protobuf:"bytes,9,opt,name=branch,proto3" json:"branch,omitempty"`
+	State     MergeRequest_State `protobuf:"varint,10,opt,name=state,proto3,enum=yolo.MergeRequest_State" json:"state,omitempty"`
+	CommitURL string             `protobuf:"bytes,11,opt,name=commit_url,json=commitUrl,proto3" json:"commit_url,omitempty"`
+	BranchURL string             `protobuf:"bytes,12,opt,name=branch_url,json=branchUrl,proto3" json:"branch_url,omitempty"`
+	ShortID   string             `protobuf:"bytes,13,opt,name=short_id,json=shortId,proto3" json:"short_id,omitempty"`
+	// is WIP or Draft
+	IsWIP        bool       `protobuf:"varint,14,opt,name=is_wip,json=isWip,proto3" json:"is_wip,omitempty"`
+	HasReleases  []*Release `protobuf:"bytes,101,rep,name=has_releases,json=hasReleases,proto3" json:"has_releases,omitempty"`
+	HasBuilds    []*Build   `protobuf:"bytes,102,rep,name=has_builds,json=hasBuilds,proto3" json:"has_builds,omitempty"`
+	HasAssignees []*Entity  `protobuf:"bytes,103,rep,name=has_assignees,json=hasAssignees,proto3" json:"has_assignees,omitempty" gorm:"many2many:mr_assignees"`
+	HasReviewers []*Entity  `protobuf:"bytes,104,rep,name=has_reviewers,json=hasReviewers,proto3" json:"has_reviewers,omitempty" gorm:"many2many:mr_reviewers"`
+	HasProject   *Project   `protobuf:"bytes,105,opt,name=has_project,json=hasProject,proto3" json:"has_project,omitempty"`
+	HasProjectID string     `protobuf:"bytes,106,opt,name=has_project_id,json=hasProjectId,proto3" json:"has_project_id,omitempty"`
+	HasAuthor    *Entity    `protobuf:"bytes,107,opt,name=has_author,json=hasAuthor,proto3" json:"has_author,omitempty"`
+	HasAuthorID  string     `protobuf:"bytes,108,opt,name=has_author_id,json=hasAuthorId,proto3" json:"has_author_id,omitempty"`
+	HasCommit    *Commit    `protobuf:"bytes,109,opt,name=has_commit,json=hasCommit,proto3" json:"has_commit,omitempty"`
+	HasCommitID  string     `protobuf:"bytes,110,opt,name=has_commit_id,json=hasCommitId,proto3" json:"has_commit_id,omitempty"`
 }
 
-func (m *Status) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MergeRequest) Reset()         { *m = MergeRequest{} }
+func (m *MergeRequest) String() string { return proto.CompactTextString(m) }
+func (*MergeRequest) ProtoMessage()    {}
+func (*MergeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{19}
 }
-
-func (m *Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MergeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Status_Request) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MergeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MergeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MergeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeRequest.Merge(m, src)
+}
+func (m *MergeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MergeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeRequest.DiscardUnknown(m)
 }
 
-func (m *Status_Request) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MergeRequest proto.InternalMessageInfo
+
+func (m *MergeRequest) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
 }
 
-func (m *Status_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MergeRequest) GetYoloID() string {
+	if m != nil {
+		return m.YoloID
+	}
+	return ""
 }
 
-func (m *Status_Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MergeRequest) GetCreatedAt() *time.Time {
+	if m != nil {
+		return m.CreatedAt
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Status_Response) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MergeRequest) GetUpdatedAt() *time.Time {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
 }
 
-func (m *Status_Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NbMergeRequests != 0 {
-		i = encodeVarintYolopb(dAtA, i, uint64(m.NbMergeRequests))
-		i--
-		dAtA[i] = 0x78
+func (m *MergeRequest) GetMergedAt() *time.Time {
+	if m != nil {
+		return m.MergedAt
 	}
-	if m.NbBuilds != 0 {
-		i = encodeVarintYolopb(dAtA, i, uint64(m.NbBuilds))
-		i--
-		dAtA[i] = 0x70
+	return nil
+}
+
+func (m *MergeRequest) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	if m.NbReleases != 0 {
-		i = encodeVarintYolopb(dAtA, i, uint64(m.NbReleases))
-		i--
-		dAtA[i] = 0x68
+	return ""
+}
+
+func (m *MergeRequest) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	if m.NbCommits != 0 {
-		i = encodeVarintYolopb(dAtA, i, uint64(m.NbCommits))
-		i--
-		dAtA[i] = 0x60
+	return ""
+}
+
+func (m *MergeRequest) GetDriver() Driver {
+	if m != nil {
+		return m.Driver
 	}
-	if m.NbProjects != 0 {
-		i = encodeVarintYolopb(dAtA, i, uint64(m.NbProjects))
-		i--
-		dAtA[i] = 0x58
+	return Driver_UnknownDriver
+}
+
+func (m *MergeRequest) GetBranch() string {
+	if m != nil {
+		return m.Branch
 	}
-	if m.NbEntities != 0 {
-		i = encodeVarintYolopb(dAtA, i, uint64(m.NbEntities))
-		i--
-		dAtA[i] = 0x50
+	return ""
+}
+
+func (m *MergeRequest) GetState() MergeRequest_State {
+	if m != nil {
+		return m.State
 	}
-	if len(m.DbErr) > 0 {
-		i -= len(m.DbErr)
-		copy(dAtA[i:], m.DbErr)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.DbErr)))
-		i--
-		dAtA[i] = 0x12
+	return MergeRequest_UnknownState
+}
+
+func (m *MergeRequest) GetCommitURL() string {
+	if m != nil {
+		return m.CommitURL
 	}
-	if m.Uptime != 0 {
-		i = encodeVarintYolopb(dAtA, i, uint64(m.Uptime))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *MergeRequest) GetBranchURL() string {
+	if m != nil {
+		return m.BranchURL
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *BuildList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MergeRequest) GetShortID() string {
+	if m != nil {
+		return m.ShortID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *BuildList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MergeRequest) GetIsWIP() bool {
+	if m != nil {
+		return m.IsWIP
+	}
+	return false
 }
 
-func (m *BuildList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MergeRequest) GetHasReleases() []*Release {
+	if m != nil {
+		return m.HasReleases
+	}
+	return nil
 }
 
-func (m *BuildList_Request) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MergeRequest) GetHasBuilds() []*Build {
+	if m != nil {
+		return m.HasBuilds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *BuildList_Request) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MergeRequest) GetHasAssignees() []*Entity {
+	if m != nil {
+		return m.HasAssignees
+	}
+	return nil
 }
 
-func (m *BuildList_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SortByCommitDate {
-		i--
-		if m.SortByCommitDate {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x78
-	}
-	if m.WithNoMergerequest {
-		i--
-		if m.WithNoMergerequest {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x70
-	}
-	if len(m.Branch) > 0 {
-		for iNdEx := len(m.Branch) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Branch[iNdEx])
-			copy(dAtA[i:], m.Branch[iNdEx])
-			i = encodeVarintYolopb(dAtA, i, uint64(len(m.Branch[iNdEx])))
-			i--
-			dAtA[i] = 0x6a
-		}
-	}
-	if len(m.MergerequestState) > 0 {
-		dAtA3 := make([]byte, len(m.MergerequestState)*10)
-		var j2 int
-		for _, num := range m.MergerequestState {
-			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j2++
-			}
-			dAtA3[j2] = uint8(num)
-			j2++
-		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintYolopb(dAtA, i, uint64(j2))
-		i--
-		dAtA[i] = 0x62
-	}
-	if m.WithMergerequest {
-		i--
-		if m.WithMergerequest {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x58
-	}
-	if len(m.MergeRequestAuthorID) > 0 {
-		for iNdEx := len(m.MergeRequestAuthorID) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.MergeRequestAuthorID[iNdEx])
-			copy(dAtA[i:], m.MergeRequestAuthorID[iNdEx])
-			i = encodeVarintYolopb(dAtA, i, uint64(len(m.MergeRequestAuthorID[iNdEx])))
-			i--
-			dAtA[i] = 0x52
-		}
-	}
-	if len(m.MergeRequestID) > 0 {
-		for iNdEx := len(m.MergeRequestID) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.MergeRequestID[iNdEx])
-			copy(dAtA[i:], m.MergeRequestID[iNdEx])
-			i = encodeVarintYolopb(dAtA, i, uint64(len(m.MergeRequestID[iNdEx])))
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if len(m.BuildState) > 0 {
-		dAtA5 := make([]byte, len(m.BuildState)*10)
-		var j4 int
-		for _, num := range m.BuildState {
-			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j4++
-			}
-			dAtA5[j4] = uint8(num)
-			j4++
-		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintYolopb(dAtA, i, uint64(j4))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.BuildDriver) > 0 {
-		dAtA7 := make([]byte, len(m.BuildDriver)*10)
-		var j6 int
-		for _, num := range m.BuildDriver {
-			for num >= 1<<7 {
-				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j6++
-			}
-			dAtA7[j6] = uint8(num)
-			j6++
-		}
-		i -= j6
-		copy(dAtA[i:], dAtA7[:j6])
-		i = encodeVarintYolopb(dAtA, i, uint64(j6))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.ArtifactID) > 0 {
-		for iNdEx := len(m.ArtifactID) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ArtifactID[iNdEx])
-			copy(dAtA[i:], m.ArtifactID[iNdEx])
-			i = encodeVarintYolopb(dAtA, i, uint64(len(m.ArtifactID[iNdEx])))
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.ProjectID) > 0 {
-		for iNdEx := len(m.ProjectID) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ProjectID[iNdEx])
-			copy(dAtA[i:], m.ProjectID[iNdEx])
-			i = encodeVarintYolopb(dAtA, i, uint64(len(m.ProjectID[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.BuildID) > 0 {
-		for iNdEx := len(m.BuildID) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.BuildID[iNdEx])
-			copy(dAtA[i:], m.BuildID[iNdEx])
-			i = encodeVarintYolopb(dAtA, i, uint64(len(m.BuildID[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.WithArtifacts {
-		i--
-		if m.WithArtifacts {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ArtifactKinds) > 0 {
-		dAtA9 := make([]byte, len(m.ArtifactKinds)*10)
-		var j8 int
-		for _, num := range m.ArtifactKinds {
-			for num >= 1<<7 {
-				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j8++
-			}
-			dAtA9[j8] = uint8(num)
-			j8++
-		}
-		i -= j8
-		copy(dAtA[i:], dAtA9[:j8])
-		i = encodeVarintYolopb(dAtA, i, uint64(j8))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Limit != 0 {
-		i = encodeVarintYolopb(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x8
+func (m *MergeRequest) GetHasReviewers() []*Entity {
+	if m != nil {
+		return m.HasReviewers
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *BuildList_Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MergeRequest) GetHasProject() *Project {
+	if m != nil {
+		return m.HasProject
 	}
-	return dAtA[:n], nil
-}
-
-func (m *BuildList_Response) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return nil
 }
 
-func (m *BuildList_Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Builds) > 0 {
-		for iNdEx := len(m.Builds) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Builds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYolopb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *MergeRequest) GetHasProjectID() string {
+	if m != nil {
+		return m.HasProjectID
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *BuildListFilters) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MergeRequest) GetHasAuthor() *Entity {
+	if m != nil {
+		return m.HasAuthor
 	}
-	return dAtA[:n], nil
-}
-
-func (m *BuildListFilters) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return nil
 }
 
-func (m *BuildListFilters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MergeRequest) GetHasAuthorID() string {
+	if m != nil {
+		return m.HasAuthorID
+	}
+	return ""
 }
 
-func (m *BuildListFilters_Request) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MergeRequest) GetHasCommit() *Commit {
+	if m != nil {
+		return m.HasCommit
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *BuildListFilters_Request) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MergeRequest) GetHasCommitID() string {
+	if m != nil {
+		return m.HasCommitID
+	}
+	return ""
 }
 
-func (m *BuildListFilters_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
+type Project struct {
+	ID               string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primary_key"`
+	YoloID           string          `protobuf:"bytes,2,opt,name=yolo_id,json=yoloId,proto3" json:"yolo_id,omitempty"`
+	CreatedAt        *time.Time      `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty"`
+	UpdatedAt        *time.Time      `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at,omitempty"`
+	Driver           Driver          `protobuf:"varint,5,opt,name=driver,proto3,enum=yolo.Driver" json:"driver,omitempty"`
+	Name             string          `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string          `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	HasArtifacts     []*Artifact     `protobuf:"bytes,101,rep,name=has_artifacts,json=hasArtifacts,proto3" json:"has_artifacts,omitempty"`
+	HasBuilds        []*Build        `protobuf:"bytes,102,rep,name=has_builds,json=hasBuilds,proto3" json:"has_builds,omitempty"`
+	HasCommits       []*Commit       `protobuf:"bytes,103,rep,name=has_commits,json=hasCommits,proto3" json:"has_commits,omitempty"`
+	HasReleases      []*Release      `protobuf:"bytes,104,rep,name=has_releases,json=hasReleases,proto3" json:"has_releases,omitempty"`
+	HasMergerequests []*MergeRequest `protobuf:"bytes,105,rep,name=has_mergerequests,json=hasMergerequests,proto3" json:"has_mergerequests,omitempty"`
+	HasOwner         *Entity         `protobuf:"bytes,106,opt,name=has_owner,json=hasOwner,proto3" json:"has_owner,omitempty"`
+	HasOwnerID       string          `protobuf:"bytes,107,opt,name=has_owner_id,json=hasOwnerId,proto3" json:"has_owner_id,omitempty"`
+}
 
-func (m *BuildListFilters_Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Project) Reset()         { *m = Project{} }
+func (m *Project) String() string { return proto.CompactTextString(m) }
+func (*Project) ProtoMessage()    {}
+func (*Project) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{20}
+}
+func (m *Project) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Project) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Project.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *Project) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Project.Merge(m, src)
+}
+func (m *Project) XXX_Size() int {
+	return m.Size()
+}
+func (m *Project) XXX_DiscardUnknown() {
+	xxx_messageInfo_Project.DiscardUnknown(m)
 }
 
-func (m *BuildListFilters_Response) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_Project proto.InternalMessageInfo
+
+func (m *Project) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
 }
 
-func (m *BuildListFilters_Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Projects) > 0 {
-		for iNdEx := len(m.Projects) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Projects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYolopb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+func (m *Project) GetYoloID() string {
+	if m != nil {
+		return m.YoloID
 	}
-	if len(m.Entities) > 0 {
-		for iNdEx := len(m.Entities) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Entities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYolopb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return ""
+}
+
+func (m *Project) GetCreatedAt() *time.Time {
+	if m != nil {
+		return m.CreatedAt
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MetadataOverride) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Project) GetUpdatedAt() *time.Time {
+	if m != nil {
+		return m.UpdatedAt
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MetadataOverride) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Project) GetDriver() Driver {
+	if m != nil {
+		return m.Driver
+	}
+	return Driver_UnknownDriver
 }
 
-func (m *MetadataOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.HasMergeRequestID) > 0 {
-		i -= len(m.HasMergeRequestID)
-		copy(dAtA[i:], m.HasMergeRequestID)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.HasMergeRequestID)))
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xda
+func (m *Project) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if len(m.HasProjectID) > 0 {
-		i -= len(m.HasProjectID)
-		copy(dAtA[i:], m.HasProjectID)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.HasProjectID)))
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xca
+	return ""
+}
+
+func (m *Project) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	if len(m.HasCommitID) > 0 {
-		i -= len(m.HasCommitID)
-		copy(dAtA[i:], m.HasCommitID)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.HasCommitID)))
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xba
+	return ""
+}
+
+func (m *Project) GetHasArtifacts() []*Artifact {
+	if m != nil {
+		return m.HasArtifacts
 	}
-	if len(m.Branch) > 0 {
-		i -= len(m.Branch)
-		copy(dAtA[i:], m.Branch)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Branch)))
-		i--
-		dAtA[i] = 0x5a
+	return nil
+}
+
+func (m *Project) GetHasBuilds() []*Build {
+	if m != nil {
+		return m.HasBuilds
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Build) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Project) GetHasCommits() []*Commit {
+	if m != nil {
+		return m.HasCommits
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Build) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Project) GetHasReleases() []*Release {
+	if m != nil {
+		return m.HasReleases
+	}
+	return nil
 }
 
-func (m *Build) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.HasMergerequestID) > 0 {
-		i -= len(m.HasMergerequestID)
-		copy(dAtA[i:], m.HasMergerequestID)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.HasMergerequestID)))
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xda
+func (m *Project) GetHasMergerequests() []*MergeRequest {
+	if m != nil {
+		return m.HasMergerequests
 	}
-	if m.HasMergerequest != nil {
-		{
-			size, err := m.HasMergerequest.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYolopb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xd2
+	return nil
+}
+
+func (m *Project) GetHasOwner() *Entity {
+	if m != nil {
+		return m.HasOwner
 	}
-	if len(m.HasProjectID) > 0 {
-		i -= len(m.HasProjectID)
-		copy(dAtA[i:], m.HasProjectID)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.HasProjectID)))
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xca
+	return nil
+}
+
+func (m *Project) GetHasOwnerID() string {
+	if m != nil {
+		return m.HasOwnerID
 	}
-	if m.HasProject != nil {
-		{
-			size, err := m.HasProject.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYolopb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xc2
-	}
-	if len(m.HasCommitID) > 0 {
-		i -= len(m.HasCommitID)
-		copy(dAtA[i:], m.HasCommitID)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.HasCommitID)))
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xba
-	}
-	if m.HasCommit != nil {
-		{
-			size, err := m.HasCommit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYolopb(dAtA, i, uint64(size))
+	return ""
+}
+
+type Entity struct {
+	ID               string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primary_key"`
+	YoloID           string          `protobuf:"bytes,2,opt,name=yolo_id,json=yoloId,proto3" json:"yolo_id,omitempty"`
+	CreatedAt        *time.Time      `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty"`
+	UpdatedAt        *time.Time      `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at,omitempty"`
+	Name             string          `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Driver           Driver          `protobuf:"varint,6,opt,name=driver,proto3,enum=yolo.Driver" json:"driver,omitempty"`
+	AvatarURL        string          `protobuf:"bytes,7,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	Kind             Entity_Kind     `protobuf:"varint,8,opt,name=kind,proto3,enum=yolo.Entity_Kind" json:"kind,omitempty"`
+	Description      string          `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`
+	HasProjects      []*Project      `protobuf:"bytes,101,rep,name=has_projects,json=hasProjects,proto3" json:"has_projects,omitempty"`
+	HasCommits       []*Commit       `protobuf:"bytes,102,rep,name=has_commits,json=hasCommits,proto3" json:"has_commits,omitempty"`
+	HasMergerequests []*MergeRequest `protobuf:"bytes,103,rep,name=has_mergerequests,json=hasMergerequests,proto3" json:"has_mergerequests,omitempty"`
+}
+
+func (m *Entity) Reset()         { *m = Entity{} }
+func (m *Entity) String() string { return proto.CompactTextString(m) }
+func (*Entity) ProtoMessage()    {}
+func (*Entity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{21}
+}
+func (m *Entity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Entity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Entity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xb2
+		return b[:n], nil
 	}
-	if len(m.HasArtifacts) > 0 {
-		for iNdEx := len(m.HasArtifacts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HasArtifacts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYolopb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x6
-			i--
-			dAtA[i] = 0xaa
-		}
+}
+func (m *Entity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Entity.Merge(m, src)
+}
+func (m *Entity) XXX_Size() int {
+	return m.Size()
+}
+func (m *Entity) XXX_DiscardUnknown() {
+	xxx_messageInfo_Entity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Entity proto.InternalMessageInfo
+
+func (m *Entity) GetID() string {
+	if m != nil {
+		return m.ID
 	}
-	if len(m.HasRawMergerequestID) > 0 {
-		i -= len(m.HasRawMergerequestID)
-		copy(dAtA[i:], m.HasRawMergerequestID)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.HasRawMergerequestID)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xda
+	return ""
+}
+
+func (m *Entity) GetYoloID() string {
+	if m != nil {
+		return m.YoloID
 	}
-	if len(m.HasRawProjectID) > 0 {
-		i -= len(m.HasRawProjectID)
-		copy(dAtA[i:], m.HasRawProjectID)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.HasRawProjectID)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xd2
+	return ""
+}
+
+func (m *Entity) GetCreatedAt() *time.Time {
+	if m != nil {
+		return m.CreatedAt
 	}
-	if len(m.HasRawCommitID) > 0 {
-		i -= len(m.HasRawCommitID)
-		copy(dAtA[i:], m.HasRawCommitID)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.HasRawCommitID)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xca
+	return nil
+}
+
+func (m *Entity) GetUpdatedAt() *time.Time {
+	if m != nil {
+		return m.UpdatedAt
 	}
-	if m.HasRawMergerequest != nil {
-		{
-			size, err := m.HasRawMergerequest.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYolopb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xc2
+	return nil
+}
+
+func (m *Entity) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if m.HasRawProject != nil {
-		{
-			size, err := m.HasRawProject.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYolopb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xba
+	return ""
+}
+
+func (m *Entity) GetDriver() Driver {
+	if m != nil {
+		return m.Driver
 	}
-	if m.HasRawCommit != nil {
-		{
-			size, err := m.HasRawCommit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYolopb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb2
+	return Driver_UnknownDriver
+}
+
+func (m *Entity) GetAvatarURL() string {
+	if m != nil {
+		return m.AvatarURL
 	}
-	if len(m.RawBranch) > 0 {
-		i -= len(m.RawBranch)
-		copy(dAtA[i:], m.RawBranch)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.RawBranch)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xaa
+	return ""
+}
+
+func (m *Entity) GetKind() Entity_Kind {
+	if m != nil {
+		return m.Kind
 	}
-	if len(m.VCSTagURL) > 0 {
-		i -= len(m.VCSTagURL)
-		copy(dAtA[i:], m.VCSTagURL)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.VCSTagURL)))
-		i--
-		dAtA[i] = 0x7a
+	return Entity_UnknownKind
+}
+
+func (m *Entity) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	if len(m.VCSTag) > 0 {
-		i -= len(m.VCSTag)
-		copy(dAtA[i:], m.VCSTag)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.VCSTag)))
-		i--
-		dAtA[i] = 0x72
+	return ""
+}
+
+func (m *Entity) GetHasProjects() []*Project {
+	if m != nil {
+		return m.HasProjects
 	}
-	if len(m.ShortID) > 0 {
-		i -= len(m.ShortID)
-		copy(dAtA[i:], m.ShortID)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.ShortID)))
-		i--
-		dAtA[i] = 0x6a
+	return nil
+}
+
+func (m *Entity) GetHasCommits() []*Commit {
+	if m != nil {
+		return m.HasCommits
 	}
-	if m.Driver != 0 {
-		i = encodeVarintYolopb(dAtA, i, uint64(m.Driver))
-		i--
-		dAtA[i] = 0x60
+	return nil
+}
+
+func (m *Entity) GetHasMergerequests() []*MergeRequest {
+	if m != nil {
+		return m.HasMergerequests
 	}
-	if len(m.Branch) > 0 {
-		i -= len(m.Branch)
-		copy(dAtA[i:], m.Branch)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Branch)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.CommitURL) > 0 {
-		i -= len(m.CommitURL)
-		copy(dAtA[i:], m.CommitURL)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.CommitURL)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if m.FinishedAt != nil {
-		n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.FinishedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.FinishedAt):])
-		if err16 != nil {
-			return 0, err16
+	return nil
+}
+
+type Artifact struct {
+	ID     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primary_key"`
+	YoloID string `protobuf:"bytes,2,opt,name=yolo_id,json=yoloId,proto3" json:"yolo_id,omitempty"`
+	// upload time of the artifact, the end of the job uploading it when the provider has no artifact timestamp
+	CreatedAt     *time.Time     `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty"`
+	UpdatedAt     *time.Time     `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at,omitempty"`
+	FileSize      int64          `protobuf:"varint,5,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	LocalPath     string         `protobuf:"bytes,6,opt,name=local_path,json=localPath,proto3" json:"local_path,omitempty"`
+	DownloadURL   string         `protobuf:"bytes,7,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
+	MimeType      string         `protobuf:"bytes,8,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Sha1Sum       string         `protobuf:"bytes,9,opt,name=sha1_sum,json=sha1Sum,proto3" json:"sha1_sum,omitempty"`
+	Sha256Sum     string         `protobuf:"bytes,10,opt,name=sha256_sum,json=sha256Sum,proto3" json:"sha256_sum,omitempty"`
+	State         Artifact_State `protobuf:"varint,11,opt,name=state,proto3,enum=yolo.Artifact_State" json:"state,omitempty"`
+	Kind          Artifact_Kind  `protobuf:"varint,12,opt,name=kind,proto3,enum=yolo.Artifact_Kind" json:"kind,omitempty"`
+	Driver        Driver         `protobuf:"varint,13,opt,name=driver,proto3,enum=yolo.Driver" json:"driver,omitempty"`
+	BundleName    string         `protobuf:"bytes,14,opt,name=bundle_name,json=bundleName,proto3" json:"bundle_name,omitempty"`
+	BundleVersion string         `protobuf:"bytes,15,opt,name=bundle_version,json=bundleVersion,proto3" json:"bundle_version,omitempty"`
+	BundleID      string         `protobuf:"bytes,16,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	BundleIcon    string         `protobuf:"bytes,17,opt,name=bundle_icon,json=bundleIcon,proto3" json:"bundle_icon,omitempty"`
+	// device model family targeted by this artifact (i.e., "iPhone10"), empty for universal builds
+	Variant string `protobuf:"bytes,18,opt,name=variant,proto3" json:"variant,omitempty"`
+	// the artifact exceeds the size budget of its project
+	OverBudget bool `protobuf:"varint,19,opt,name=over_budget,json=overBudget,proto3" json:"over_budget,omitempty"`
+	// build number (CFBundleVersion on iOS, versionCode on Android), bundle_version is the version displayed to the users
+	BundleBuildVersion string `protobuf:"bytes,20,opt,name=bundle_build_version,json=bundleBuildVersion,proto3" json:"bundle_build_version,omitempty"`
+	// computed when the artifact is first mirrored, sha256_sum is also reported by some providers
+	Md5Sum string `protobuf:"bytes,21,opt,name=md5_sum,json=md5Sum,proto3" json:"md5_sum,omitempty"`
+	// CPU architecture of the macOS artifacts (arm64, amd64 or universal), empty if unknown
+	Arch string `protobuf:"bytes,22,opt,name=arch,proto3" json:"arch,omitempty"`
+	// first artifact ingested with the same sha256_sum, its mirrored file is shared instead of storing the same content again
+	DuplicateOfID string `protobuf:"bytes,23,opt,name=duplicate_of_id,json=duplicateOfId,proto3" json:"duplicate_of_id,omitempty"`
+	// EdDSA signature of the .dmg artifacts for the Sparkle appcast, computed once mirrored if a Sparkle key is configured
+	SparkleEdSignature  string      `protobuf:"bytes,24,opt,name=sparkle_ed_signature,json=sparkleEdSignature,proto3" json:"sparkle_ed_signature,omitempty"`
+	HasBuild            *Build      `protobuf:"bytes,101,opt,name=has_build,json=hasBuild,proto3" json:"has_build,omitempty"`
+	HasBuildID          string      `protobuf:"bytes,102,opt,name=has_build_id,json=hasBuildId,proto3" json:"has_build_id,omitempty"`
+	HasRelease          *Release    `protobuf:"bytes,103,opt,name=has_release,json=hasRelease,proto3" json:"has_release,omitempty"`
+	HasReleaseID        string      `protobuf:"bytes,104,opt,name=has_release_id,json=hasReleaseId,proto3" json:"has_release_id,omitempty"`
+	Downloads           []*Download `protobuf:"bytes,105,rep,name=downloads,proto3" json:"downloads,omitempty"`
+	DownloadsCount      int64       `protobuf:"varint,106,opt,name=downloads_count,json=downloadsCount,proto3" json:"downloads_count,omitempty" sql:"-"`
+	DLArtifactSignedURL string      `protobuf:"bytes,201,opt,name=dl_artifact_signed_url,json=dlArtifactSignedUrl,proto3" json:"dl_artifact_signed_url,omitempty"`
+	PListSignedURL      string      `protobuf:"bytes,202,opt,name=plist_signed_url,json=plistSignedUrl,proto3" json:"plist_signed_url,omitempty"`
+}
+
+func (m *Artifact) Reset()         { *m = Artifact{} }
+func (m *Artifact) String() string { return proto.CompactTextString(m) }
+func (*Artifact) ProtoMessage()    {}
+func (*Artifact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{22}
+}
+func (m *Artifact) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Artifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Artifact.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n16
-		i = encodeVarintYolopb(dAtA, i, uint64(n16))
-		i--
-		dAtA[i] = 0x4a
+		return b[:n], nil
 	}
-	if m.StartedAt != nil {
-		n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedAt):])
-		if err17 != nil {
-			return 0, err17
-		}
-		i -= n17
-		i = encodeVarintYolopb(dAtA, i, uint64(n17))
-		i--
-		dAtA[i] = 0x42
+}
+func (m *Artifact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Artifact.Merge(m, src)
+}
+func (m *Artifact) XXX_Size() int {
+	return m.Size()
+}
+func (m *Artifact) XXX_DiscardUnknown() {
+	xxx_messageInfo_Artifact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Artifact proto.InternalMessageInfo
+
+func (m *Artifact) GetID() string {
+	if m != nil {
+		return m.ID
 	}
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Message)))
-		i--
-		dAtA[i] = 0x3a
+	return ""
+}
+
+func (m *Artifact) GetYoloID() string {
+	if m != nil {
+		return m.YoloID
 	}
-	if m.CompletedAt != nil {
-		n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CompletedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CompletedAt):])
-		if err18 != nil {
-			return 0, err18
-		}
-		i -= n18
-		i = encodeVarintYolopb(dAtA, i, uint64(n18))
-		i--
-		dAtA[i] = 0x32
+	return ""
+}
+
+func (m *Artifact) GetCreatedAt() *time.Time {
+	if m != nil {
+		return m.CreatedAt
 	}
-	if m.State != 0 {
-		i = encodeVarintYolopb(dAtA, i, uint64(m.State))
-		i--
-		dAtA[i] = 0x28
+	return nil
+}
+
+func (m *Artifact) GetUpdatedAt() *time.Time {
+	if m != nil {
+		return m.UpdatedAt
 	}
-	if m.UpdatedAt != nil {
-		n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
-		if err19 != nil {
-			return 0, err19
-		}
-		i -= n19
-		i = encodeVarintYolopb(dAtA, i, uint64(n19))
-		i--
-		dAtA[i] = 0x22
+	return nil
+}
+
+func (m *Artifact) GetFileSize() int64 {
+	if m != nil {
+		return m.FileSize
 	}
-	if m.CreatedAt != nil {
-		n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
-		if err20 != nil {
-			return 0, err20
-		}
-		i -= n20
-		i = encodeVarintYolopb(dAtA, i, uint64(n20))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *Artifact) GetLocalPath() string {
+	if m != nil {
+		return m.LocalPath
 	}
-	if len(m.YoloID) > 0 {
-		i -= len(m.YoloID)
-		copy(dAtA[i:], m.YoloID)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.YoloID)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *Artifact) GetDownloadURL() string {
+	if m != nil {
+		return m.DownloadURL
 	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.ID)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *Artifact) GetMimeType() string {
+	if m != nil {
+		return m.MimeType
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Release) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Artifact) GetSha1Sum() string {
+	if m != nil {
+		return m.Sha1Sum
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Release) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Artifact) GetSha256Sum() string {
+	if m != nil {
+		return m.Sha256Sum
+	}
+	return ""
 }
 
-func (m *Release) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.HasMergerequest != nil {
-		{
-			size, err := m.HasMergerequest.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYolopb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xc2
+func (m *Artifact) GetState() Artifact_State {
+	if m != nil {
+		return m.State
 	}
-	if m.HasProject != nil {
-		{
-			size, err := m.HasProject.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYolopb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xba
+	return Artifact_UnknownState
+}
+
+func (m *Artifact) GetKind() Artifact_Kind {
+	if m != nil {
+		return m.Kind
 	}
-	if m.HasCommit != nil {
-		{
-			size, err := m.HasCommit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYolopb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xb2
+	return Artifact_UnknownKind
+}
+
+func (m *Artifact) GetDriver() Driver {
+	if m != nil {
+		return m.Driver
 	}
-	if len(m.HasArtifacts) > 0 {
-		for iNdEx := len(m.HasArtifacts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HasArtifacts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYolopb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x6
-			i--
-			dAtA[i] = 0xaa
-		}
+	return Driver_UnknownDriver
+}
+
+func (m *Artifact) GetBundleName() string {
+	if m != nil {
+		return m.BundleName
 	}
-	if len(m.ShortID) > 0 {
-		i -= len(m.ShortID)
-		copy(dAtA[i:], m.ShortID)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.ShortID)))
-		i--
-		dAtA[i] = 0x42
+	return ""
+}
+
+func (m *Artifact) GetBundleVersion() string {
+	if m != nil {
+		return m.BundleVersion
 	}
-	if len(m.CommitURL) > 0 {
-		i -= len(m.CommitURL)
-		copy(dAtA[i:], m.CommitURL)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.CommitURL)))
-		i--
-		dAtA[i] = 0x3a
+	return ""
+}
+
+func (m *Artifact) GetBundleID() string {
+	if m != nil {
+		return m.BundleID
 	}
-	if m.Driver != 0 {
-		i = encodeVarintYolopb(dAtA, i, uint64(m.Driver))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Message)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.UpdatedAt != nil {
-		n24, err24 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
-		if err24 != nil {
-			return 0, err24
-		}
-		i -= n24
-		i = encodeVarintYolopb(dAtA, i, uint64(n24))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.CreatedAt != nil {
-		n25, err25 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
-		if err25 != nil {
-			return 0, err25
-		}
-		i -= n25
-		i = encodeVarintYolopb(dAtA, i, uint64(n25))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.YoloID) > 0 {
-		i -= len(m.YoloID)
-		copy(dAtA[i:], m.YoloID)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.YoloID)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.ID)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *Artifact) GetBundleIcon() string {
+	if m != nil {
+		return m.BundleIcon
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Commit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Artifact) GetVariant() string {
+	if m != nil {
+		return m.Variant
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Commit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Artifact) GetOverBudget() bool {
+	if m != nil {
+		return m.OverBudget
+	}
+	return false
 }
 
-func (m *Commit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.HasMergerequest != nil {
-		{
-			size, err := m.HasMergerequest.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYolopb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xca
+func (m *Artifact) GetBundleBuildVersion() string {
+	if m != nil {
+		return m.BundleBuildVersion
 	}
-	if m.HasAuthor != nil {
-		{
-			size, err := m.HasAuthor.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYolopb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xc2
+	return ""
+}
+
+func (m *Artifact) GetMd5Sum() string {
+	if m != nil {
+		return m.Md5Sum
 	}
-	if m.HasProject != nil {
-		{
-			size, err := m.HasProject.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYolopb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xba
+	return ""
+}
+
+func (m *Artifact) GetArch() string {
+	if m != nil {
+		return m.Arch
 	}
-	if len(m.HasBuilds) > 0 {
-		for iNdEx := len(m.HasBuilds) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HasBuilds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYolopb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x6
-			i--
-			dAtA[i] = 0xb2
-		}
+	return ""
+}
+
+func (m *Artifact) GetDuplicateOfID() string {
+	if m != nil {
+		return m.DuplicateOfID
 	}
-	if len(m.HasReleases) > 0 {
-		for iNdEx := len(m.HasReleases) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HasReleases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYolopb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x6
-			i--
-			dAtA[i] = 0xaa
-		}
+	return ""
+}
+
+func (m *Artifact) GetSparkleEdSignature() string {
+	if m != nil {
+		return m.SparkleEdSignature
 	}
-	if len(m.Branch) > 0 {
-		i -= len(m.Branch)
-		copy(dAtA[i:], m.Branch)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Branch)))
-		i--
-		dAtA[i] = 0x3a
+	return ""
+}
+
+func (m *Artifact) GetHasBuild() *Build {
+	if m != nil {
+		return m.HasBuild
 	}
-	if m.Driver != 0 {
-		i = encodeVarintYolopb(dAtA, i, uint64(m.Driver))
-		i--
-		dAtA[i] = 0x30
+	return nil
+}
+
+func (m *Artifact) GetHasBuildID() string {
+	if m != nil {
+		return m.HasBuildID
 	}
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Message)))
-		i--
-		dAtA[i] = 0x2a
+	return ""
+}
+
+func (m *Artifact) GetHasRelease() *Release {
+	if m != nil {
+		return m.HasRelease
 	}
-	if m.UpdatedAt != nil {
-		n29, err29 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
-		if err29 != nil {
-			return 0, err29
-		}
-		i -= n29
-		i = encodeVarintYolopb(dAtA, i, uint64(n29))
-		i--
-		dAtA[i] = 0x22
+	return nil
+}
+
+func (m *Artifact) GetHasReleaseID() string {
+	if m != nil {
+		return m.HasReleaseID
 	}
-	if m.CreatedAt != nil {
-		n30, err30 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
-		if err30 != nil {
-			return 0, err30
-		}
-		i -= n30
-		i = encodeVarintYolopb(dAtA, i, uint64(n30))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *Artifact) GetDownloads() []*Download {
+	if m != nil {
+		return m.Downloads
 	}
-	if len(m.YoloID) > 0 {
-		i -= len(m.YoloID)
-		copy(dAtA[i:], m.YoloID)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.YoloID)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *Artifact) GetDownloadsCount() int64 {
+	if m != nil {
+		return m.DownloadsCount
 	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.ID)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *Artifact) GetDLArtifactSignedURL() string {
+	if m != nil {
+		return m.DLArtifactSignedURL
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MergeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Artifact) GetPListSignedURL() string {
+	if m != nil {
+		return m.PListSignedURL
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MergeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Download struct {
+	ID            int64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" gorm:"PRIMARY_KEY;AUTO_INCREMENT"`
+	CreatedAt     *time.Time `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty"`
+	HasArtifact   *Artifact  `protobuf:"bytes,101,opt,name=has_artifact,json=hasArtifact,proto3" json:"has_artifact,omitempty"`
+	HasArtifactID string     `protobuf:"bytes,102,opt,name=has_artifact_id,json=hasArtifactId,proto3" json:"has_artifact_id,omitempty"`
 }
 
-func (m *MergeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.HasCommitID) > 0 {
-		i -= len(m.HasCommitID)
-		copy(dAtA[i:], m.HasCommitID)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.HasCommitID)))
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xf2
-	}
-	if m.HasCommit != nil {
-		{
-			size, err := m.HasCommit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYolopb(dAtA, i, uint64(size))
+func (m *Download) Reset()         { *m = Download{} }
+func (m *Download) String() string { return proto.CompactTextString(m) }
+func (*Download) ProtoMessage()    {}
+func (*Download) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{23}
+}
+func (m *Download) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Download) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Download.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xea
+		return b[:n], nil
 	}
-	if len(m.HasAuthorID) > 0 {
-		i -= len(m.HasAuthorID)
-		copy(dAtA[i:], m.HasAuthorID)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.HasAuthorID)))
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xe2
+}
+func (m *Download) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Download.Merge(m, src)
+}
+func (m *Download) XXX_Size() int {
+	return m.Size()
+}
+func (m *Download) XXX_DiscardUnknown() {
+	xxx_messageInfo_Download.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Download proto.InternalMessageInfo
+
+func (m *Download) GetID() int64 {
+	if m != nil {
+		return m.ID
 	}
-	if m.HasAuthor != nil {
-		{
-			size, err := m.HasAuthor.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYolopb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xda
+	return 0
+}
+
+func (m *Download) GetCreatedAt() *time.Time {
+	if m != nil {
+		return m.CreatedAt
 	}
-	if len(m.HasProjectID) > 0 {
-		i -= len(m.HasProjectID)
-		copy(dAtA[i:], m.HasProjectID)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.HasProjectID)))
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xd2
+	return nil
+}
+
+func (m *Download) GetHasArtifact() *Artifact {
+	if m != nil {
+		return m.HasArtifact
 	}
-	if m.HasProject != nil {
-		{
-			size, err := m.HasProject.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYolopb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xca
+	return nil
+}
+
+func (m *Download) GetHasArtifactID() string {
+	if m != nil {
+		return m.HasArtifactID
 	}
-	if len(m.HasReviewers) > 0 {
-		for iNdEx := len(m.HasReviewers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HasReviewers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYolopb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x6
-			i--
-			dAtA[i] = 0xc2
+	return ""
+}
+
+type Install struct {
+	ID        int64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" gorm:"PRIMARY_KEY;AUTO_INCREMENT"`
+	CreatedAt *time.Time `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty"`
+	// identifies the installed app, a single install of a build is counted per app
+	InstallID  string `protobuf:"bytes,3,opt,name=install_id,json=installId,proto3" json:"install_id,omitempty"`
+	HasBuild   *Build `protobuf:"bytes,101,opt,name=has_build,json=hasBuild,proto3" json:"has_build,omitempty"`
+	HasBuildID string `protobuf:"bytes,102,opt,name=has_build_id,json=hasBuildId,proto3" json:"has_build_id,omitempty"`
+}
+
+func (m *Install) Reset()         { *m = Install{} }
+func (m *Install) String() string { return proto.CompactTextString(m) }
+func (*Install) ProtoMessage()    {}
+func (*Install) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{24}
+}
+func (m *Install) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Install) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Install.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.HasAssignees) > 0 {
-		for iNdEx := len(m.HasAssignees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HasAssignees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYolopb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x6
-			i--
-			dAtA[i] = 0xba
-		}
+}
+func (m *Install) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Install.Merge(m, src)
+}
+func (m *Install) XXX_Size() int {
+	return m.Size()
+}
+func (m *Install) XXX_DiscardUnknown() {
+	xxx_messageInfo_Install.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Install proto.InternalMessageInfo
+
+func (m *Install) GetID() int64 {
+	if m != nil {
+		return m.ID
 	}
-	if len(m.HasBuilds) > 0 {
-		for iNdEx := len(m.HasBuilds) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HasBuilds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYolopb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x6
-			i--
-			dAtA[i] = 0xb2
-		}
+	return 0
+}
+
+func (m *Install) GetCreatedAt() *time.Time {
+	if m != nil {
+		return m.CreatedAt
 	}
-	if len(m.HasReleases) > 0 {
-		for iNdEx := len(m.HasReleases) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HasReleases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYolopb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x6
-			i--
-			dAtA[i] = 0xaa
-		}
+	return nil
+}
+
+func (m *Install) GetInstallID() string {
+	if m != nil {
+		return m.InstallID
 	}
-	if m.IsWIP {
-		i--
-		if m.IsWIP {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x70
+	return ""
+}
+
+func (m *Install) GetHasBuild() *Build {
+	if m != nil {
+		return m.HasBuild
 	}
-	if len(m.ShortID) > 0 {
-		i -= len(m.ShortID)
-		copy(dAtA[i:], m.ShortID)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.ShortID)))
-		i--
-		dAtA[i] = 0x6a
+	return nil
+}
+
+func (m *Install) GetHasBuildID() string {
+	if m != nil {
+		return m.HasBuildID
 	}
-	if len(m.BranchURL) > 0 {
-		i -= len(m.BranchURL)
-		copy(dAtA[i:], m.BranchURL)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.BranchURL)))
-		i--
-		dAtA[i] = 0x62
+	return ""
+}
+
+type Promotion struct {
+	ID         int64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" gorm:"PRIMARY_KEY;AUTO_INCREMENT"`
+	CreatedAt  *time.Time `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty"`
+	Channel    string     `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	HasBuild   *Build     `protobuf:"bytes,101,opt,name=has_build,json=hasBuild,proto3" json:"has_build,omitempty"`
+	HasBuildID string     `protobuf:"bytes,102,opt,name=has_build_id,json=hasBuildId,proto3" json:"has_build_id,omitempty"`
+}
+
+func (m *Promotion) Reset()         { *m = Promotion{} }
+func (m *Promotion) String() string { return proto.CompactTextString(m) }
+func (*Promotion) ProtoMessage()    {}
+func (*Promotion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{25}
+}
+func (m *Promotion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Promotion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Promotion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.CommitURL) > 0 {
-		i -= len(m.CommitURL)
-		copy(dAtA[i:], m.CommitURL)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.CommitURL)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if m.State != 0 {
-		i = encodeVarintYolopb(dAtA, i, uint64(m.State))
-		i--
-		dAtA[i] = 0x50
+}
+func (m *Promotion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Promotion.Merge(m, src)
+}
+func (m *Promotion) XXX_Size() int {
+	return m.Size()
+}
+func (m *Promotion) XXX_DiscardUnknown() {
+	xxx_messageInfo_Promotion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Promotion proto.InternalMessageInfo
+
+func (m *Promotion) GetID() int64 {
+	if m != nil {
+		return m.ID
 	}
-	if len(m.Branch) > 0 {
-		i -= len(m.Branch)
-		copy(dAtA[i:], m.Branch)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Branch)))
-		i--
-		dAtA[i] = 0x4a
+	return 0
+}
+
+func (m *Promotion) GetCreatedAt() *time.Time {
+	if m != nil {
+		return m.CreatedAt
 	}
-	if m.Driver != 0 {
-		i = encodeVarintYolopb(dAtA, i, uint64(m.Driver))
-		i--
-		dAtA[i] = 0x40
+	return nil
+}
+
+func (m *Promotion) GetChannel() string {
+	if m != nil {
+		return m.Channel
 	}
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Message)))
-		i--
-		dAtA[i] = 0x3a
+	return ""
+}
+
+func (m *Promotion) GetHasBuild() *Build {
+	if m != nil {
+		return m.HasBuild
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0x32
+	return nil
+}
+
+func (m *Promotion) GetHasBuildID() string {
+	if m != nil {
+		return m.HasBuildID
 	}
-	if m.MergedAt != nil {
-		n34, err34 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.MergedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.MergedAt):])
-		if err34 != nil {
-			return 0, err34
+	return ""
+}
+
+// persistent server settings, i.e, the generated auth salt
+type Setting struct {
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty" gorm:"primary_key"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *Setting) Reset()         { *m = Setting{} }
+func (m *Setting) String() string { return proto.CompactTextString(m) }
+func (*Setting) ProtoMessage()    {}
+func (*Setting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{26}
+}
+func (m *Setting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Setting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Setting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n34
-		i = encodeVarintYolopb(dAtA, i, uint64(n34))
-		i--
-		dAtA[i] = 0x2a
+		return b[:n], nil
 	}
-	if m.UpdatedAt != nil {
-		n35, err35 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
-		if err35 != nil {
-			return 0, err35
-		}
-		i -= n35
-		i = encodeVarintYolopb(dAtA, i, uint64(n35))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *Setting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Setting.Merge(m, src)
+}
+func (m *Setting) XXX_Size() int {
+	return m.Size()
+}
+func (m *Setting) XXX_DiscardUnknown() {
+	xxx_messageInfo_Setting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Setting proto.InternalMessageInfo
+
+func (m *Setting) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	if m.CreatedAt != nil {
-		n36, err36 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
-		if err36 != nil {
-			return 0, err36
+	return ""
+}
+
+func (m *Setting) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+// number of events removed by the retention, so the totals survive trimming
+type EventCounter struct {
+	// "download" (of an artifact) or "install" (of a build)
+	Kind     string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty" gorm:"primary_key"`
+	ObjectID string `protobuf:"bytes,2,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty" gorm:"primary_key"`
+	Count    int64  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *EventCounter) Reset()         { *m = EventCounter{} }
+func (m *EventCounter) String() string { return proto.CompactTextString(m) }
+func (*EventCounter) ProtoMessage()    {}
+func (*EventCounter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{27}
+}
+func (m *EventCounter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCounter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n36
-		i = encodeVarintYolopb(dAtA, i, uint64(n36))
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if len(m.YoloID) > 0 {
-		i -= len(m.YoloID)
-		copy(dAtA[i:], m.YoloID)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.YoloID)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventCounter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCounter.Merge(m, src)
+}
+func (m *EventCounter) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCounter) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCounter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCounter proto.InternalMessageInfo
+
+func (m *EventCounter) GetKind() string {
+	if m != nil {
+		return m.Kind
 	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.ID)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventCounter) GetObjectID() string {
+	if m != nil {
+		return m.ObjectID
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Project) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventCounter) GetCount() int64 {
+	if m != nil {
+		return m.Count
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Project) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// signature of a single-use URL that was already used
+type SpentSignature struct {
+	// the "sign" query parameter
+	ID        string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primary_key"`
+	CreatedAt *time.Time `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty"`
+	// the signature can be forgotten once the URL expired
+	ExpiresAt *time.Time `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
 }
 
-func (m *Project) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.HasOwnerID) > 0 {
-		i -= len(m.HasOwnerID)
-		copy(dAtA[i:], m.HasOwnerID)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.HasOwnerID)))
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xda
-	}
-	if m.HasOwner != nil {
-		{
-			size, err := m.HasOwner.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYolopb(dAtA, i, uint64(size))
+func (m *SpentSignature) Reset()         { *m = SpentSignature{} }
+func (m *SpentSignature) String() string { return proto.CompactTextString(m) }
+func (*SpentSignature) ProtoMessage()    {}
+func (*SpentSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{28}
+}
+func (m *SpentSignature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpentSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpentSignature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xd2
+		return b[:n], nil
 	}
-	if len(m.HasMergerequests) > 0 {
-		for iNdEx := len(m.HasMergerequests) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HasMergerequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYolopb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x6
-			i--
-			dAtA[i] = 0xca
-		}
+}
+func (m *SpentSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpentSignature.Merge(m, src)
+}
+func (m *SpentSignature) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpentSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpentSignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpentSignature proto.InternalMessageInfo
+
+func (m *SpentSignature) GetID() string {
+	if m != nil {
+		return m.ID
 	}
-	if len(m.HasReleases) > 0 {
-		for iNdEx := len(m.HasReleases) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HasReleases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYolopb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x6
-			i--
-			dAtA[i] = 0xc2
-		}
+	return ""
+}
+
+func (m *SpentSignature) GetCreatedAt() *time.Time {
+	if m != nil {
+		return m.CreatedAt
 	}
-	if len(m.HasCommits) > 0 {
-		for iNdEx := len(m.HasCommits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HasCommits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYolopb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x6
-			i--
-			dAtA[i] = 0xba
-		}
+	return nil
+}
+
+func (m *SpentSignature) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
 	}
-	if len(m.HasBuilds) > 0 {
-		for iNdEx := len(m.HasBuilds) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HasBuilds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYolopb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x6
-			i--
-			dAtA[i] = 0xb2
+	return nil
+}
+
+type Batch struct {
+	Builds        []*Build        `protobuf:"bytes,1,rep,name=builds,proto3" json:"builds,omitempty"`
+	Artifacts     []*Artifact     `protobuf:"bytes,2,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+	Projects      []*Project      `protobuf:"bytes,3,rep,name=projects,proto3" json:"projects,omitempty"`
+	Entities      []*Entity       `protobuf:"bytes,4,rep,name=entities,proto3" json:"entities,omitempty"`
+	Releases      []*Release      `protobuf:"bytes,5,rep,name=releases,proto3" json:"releases,omitempty"`
+	Commits       []*Commit       `protobuf:"bytes,6,rep,name=commits,proto3" json:"commits,omitempty"`
+	MergeRequests []*MergeRequest `protobuf:"bytes,7,rep,name=merge_requests,json=mergeRequests,proto3" json:"merge_requests,omitempty"`
+}
+
+func (m *Batch) Reset()         { *m = Batch{} }
+func (m *Batch) String() string { return proto.CompactTextString(m) }
+func (*Batch) ProtoMessage()    {}
+func (*Batch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62788fcb176084a, []int{29}
+}
+func (m *Batch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Batch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Batch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.HasArtifacts) > 0 {
-		for iNdEx := len(m.HasArtifacts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HasArtifacts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYolopb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x6
-			i--
-			dAtA[i] = 0xaa
-		}
+}
+func (m *Batch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Batch.Merge(m, src)
+}
+func (m *Batch) XXX_Size() int {
+	return m.Size()
+}
+func (m *Batch) XXX_DiscardUnknown() {
+	xxx_messageInfo_Batch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Batch proto.InternalMessageInfo
+
+func (m *Batch) GetBuilds() []*Build {
+	if m != nil {
+		return m.Builds
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x3a
+	return nil
+}
+
+func (m *Batch) GetArtifacts() []*Artifact {
+	if m != nil {
+		return m.Artifacts
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x32
+	return nil
+}
+
+func (m *Batch) GetProjects() []*Project {
+	if m != nil {
+		return m.Projects
 	}
-	if m.Driver != 0 {
-		i = encodeVarintYolopb(dAtA, i, uint64(m.Driver))
-		i--
-		dAtA[i] = 0x28
+	return nil
+}
+
+func (m *Batch) GetEntities() []*Entity {
+	if m != nil {
+		return m.Entities
 	}
-	if m.UpdatedAt != nil {
-		n38, err38 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
-		if err38 != nil {
-			return 0, err38
-		}
-		i -= n38
-		i = encodeVarintYolopb(dAtA, i, uint64(n38))
-		i--
-		dAtA[i] = 0x22
+	return nil
+}
+
+func (m *Batch) GetReleases() []*Release {
+	if m != nil {
+		return m.Releases
 	}
-	if m.CreatedAt != nil {
-		n39, err39 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
-		if err39 != nil {
-			return 0, err39
-		}
-		i -= n39
-		i = encodeVarintYolopb(dAtA, i, uint64(n39))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *Batch) GetCommits() []*Commit {
+	if m != nil {
+		return m.Commits
 	}
-	if len(m.YoloID) > 0 {
-		i -= len(m.YoloID)
-		copy(dAtA[i:], m.YoloID)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.YoloID)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *Batch) GetMergeRequests() []*MergeRequest {
+	if m != nil {
+		return m.MergeRequests
 	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.ID)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("yolo.Driver", Driver_name, Driver_value)
+	proto.RegisterEnum("yolo.Status_CircuitState", Status_CircuitState_name, Status_CircuitState_value)
+	proto.RegisterEnum("yolo.BuildList_SortBy", BuildList_SortBy_name, BuildList_SortBy_value)
+	proto.RegisterEnum("yolo.BuildList_SortOrder", BuildList_SortOrder_name, BuildList_SortOrder_value)
+	proto.RegisterEnum("yolo.Build_State", Build_State_name, Build_State_value)
+	proto.RegisterEnum("yolo.MergeRequest_State", MergeRequest_State_name, MergeRequest_State_value)
+	proto.RegisterEnum("yolo.Entity_Kind", Entity_Kind_name, Entity_Kind_value)
+	proto.RegisterEnum("yolo.Artifact_State", Artifact_State_name, Artifact_State_value)
+	proto.RegisterEnum("yolo.Artifact_Kind", Artifact_Kind_name, Artifact_Kind_value)
+	proto.RegisterType((*Ping)(nil), "yolo.Ping")
+	proto.RegisterType((*Ping_Request)(nil), "yolo.Ping.Request")
+	proto.RegisterType((*Ping_Response)(nil), "yolo.Ping.Response")
+	proto.RegisterType((*DevDumpObjects)(nil), "yolo.DevDumpObjects")
+	proto.RegisterType((*DevDumpObjects_Request)(nil), "yolo.DevDumpObjects.Request")
+	proto.RegisterType((*DevDumpObjects_Response)(nil), "yolo.DevDumpObjects.Response")
+	proto.RegisterType((*Status)(nil), "yolo.Status")
+	proto.RegisterType((*Status_Request)(nil), "yolo.Status.Request")
+	proto.RegisterType((*Status_Response)(nil), "yolo.Status.Response")
+	proto.RegisterType((*Status_DriverStatus)(nil), "yolo.Status.DriverStatus")
+	proto.RegisterType((*Version)(nil), "yolo.Version")
+	proto.RegisterType((*Version_Request)(nil), "yolo.Version.Request")
+	proto.RegisterType((*Version_Response)(nil), "yolo.Version.Response")
+	proto.RegisterType((*BuildList)(nil), "yolo.BuildList")
+	proto.RegisterType((*BuildList_Request)(nil), "yolo.BuildList.Request")
+	proto.RegisterType((*BuildList_Response)(nil), "yolo.BuildList.Response")
+	proto.RegisterType((*PromoteBuild)(nil), "yolo.PromoteBuild")
+	proto.RegisterType((*PromoteBuild_Request)(nil), "yolo.PromoteBuild.Request")
+	proto.RegisterType((*PromoteBuild_Response)(nil), "yolo.PromoteBuild.Response")
+	proto.RegisterType((*GetBuild)(nil), "yolo.GetBuild")
+	proto.RegisterType((*GetBuild_Request)(nil), "yolo.GetBuild.Request")
+	proto.RegisterType((*GetBuild_Response)(nil), "yolo.GetBuild.Response")
+	proto.RegisterType((*SearchBuilds)(nil), "yolo.SearchBuilds")
+	proto.RegisterType((*SearchBuilds_Request)(nil), "yolo.SearchBuilds.Request")
+	proto.RegisterType((*SearchBuilds_Response)(nil), "yolo.SearchBuilds.Response")
+	proto.RegisterType((*Summary)(nil), "yolo.Summary")
+	proto.RegisterType((*Summary_Request)(nil), "yolo.Summary.Request")
+	proto.RegisterType((*Summary_Response)(nil), "yolo.Summary.Response")
+	proto.RegisterType((*Summary_Entry)(nil), "yolo.Summary.Entry")
+	proto.RegisterType((*RefreshBuilds)(nil), "yolo.RefreshBuilds")
+	proto.RegisterType((*RefreshBuilds_Request)(nil), "yolo.RefreshBuilds.Request")
+	proto.RegisterType((*RefreshBuilds_Response)(nil), "yolo.RefreshBuilds.Response")
+	proto.RegisterType((*StreamBuildUpdates)(nil), "yolo.StreamBuildUpdates")
+	proto.RegisterType((*StreamBuildUpdates_Request)(nil), "yolo.StreamBuildUpdates.Request")
+	proto.RegisterType((*StreamBuildUpdates_Response)(nil), "yolo.StreamBuildUpdates.Response")
+	proto.RegisterType((*WhatsNew)(nil), "yolo.WhatsNew")
+	proto.RegisterType((*WhatsNew_Request)(nil), "yolo.WhatsNew.Request")
+	proto.RegisterType((*WhatsNew_Response)(nil), "yolo.WhatsNew.Response")
+	proto.RegisterType((*SignArtifact)(nil), "yolo.SignArtifact")
+	proto.RegisterType((*SignArtifact_Request)(nil), "yolo.SignArtifact.Request")
+	proto.RegisterType((*SignArtifact_Response)(nil), "yolo.SignArtifact.Response")
+	proto.RegisterType((*BranchStats)(nil), "yolo.BranchStats")
+	proto.RegisterType((*BranchStats_Request)(nil), "yolo.BranchStats.Request")
+	proto.RegisterType((*BranchStats_Response)(nil), "yolo.BranchStats.Response")
+	proto.RegisterType((*BranchStats_Entry)(nil), "yolo.BranchStats.Entry")
+	proto.RegisterType((*BuildListFilters)(nil), "yolo.BuildListFilters")
+	proto.RegisterType((*BuildListFilters_Request)(nil), "yolo.BuildListFilters.Request")
+	proto.RegisterType((*BuildListFilters_Response)(nil), "yolo.BuildListFilters.Response")
+	proto.RegisterType((*MetadataOverride)(nil), "yolo.MetadataOverride")
+	proto.RegisterMapType((map[string]string)(nil), "yolo.MetadataOverride.BuildConfigEntry")
+	proto.RegisterType((*Build)(nil), "yolo.Build")
+	proto.RegisterMapType((map[string]string)(nil), "yolo.Build.BuildConfigEntry")
+	proto.RegisterMapType((map[string]string)(nil), "yolo.Build.FlagsEntry")
+	proto.RegisterType((*Release)(nil), "yolo.Release")
+	proto.RegisterType((*Commit)(nil), "yolo.Commit")
+	proto.RegisterType((*MergeRequest)(nil), "yolo.MergeRequest")
+	proto.RegisterType((*Project)(nil), "yolo.Project")
+	proto.RegisterType((*Entity)(nil), "yolo.Entity")
+	proto.RegisterType((*Artifact)(nil), "yolo.Artifact")
+	proto.RegisterType((*Download)(nil), "yolo.Download")
+	proto.RegisterType((*Install)(nil), "yolo.Install")
+	proto.RegisterType((*Promotion)(nil), "yolo.Promotion")
+	proto.RegisterType((*Setting)(nil), "yolo.Setting")
+	proto.RegisterType((*EventCounter)(nil), "yolo.EventCounter")
+	proto.RegisterType((*SpentSignature)(nil), "yolo.SpentSignature")
+	proto.RegisterType((*Batch)(nil), "yolo.Batch")
+}
+
+func init() { proto.RegisterFile("yolopb.proto", fileDescriptor_a62788fcb176084a) }
+
+var fileDescriptor_a62788fcb176084a = []byte{
+	// 5162 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x4b, 0x6c, 0x1c, 0x47,
+	0x76, 0xea, 0x19, 0xce, 0xef, 0xcd, 0x87, 0xcd, 0x22, 0x29, 0xb5, 0x46, 0x9f, 0xa1, 0x46, 0xf1,
+	0x5a, 0x2b, 0x8b, 0xa4, 0x4d, 0xc5, 0x3f, 0x79, 0xbd, 0x5e, 0x92, 0x43, 0x99, 0x63, 0x49, 0x24,
+	0xd1, 0xa4, 0xd6, 0x71, 0x7c, 0x68, 0xf4, 0x4c, 0x17, 0x67, 0xda, 0xec, 0xe9, 0x1e, 0x77, 0xf5,
+	0x90, 0xa6, 0x17, 0xc8, 0x61, 0x03, 0xe4, 0xb0, 0x97, 0x38, 0x08, 0x02, 0x04, 0x58, 0xe4, 0x90,
+	0xcd, 0x39, 0x40, 0x72, 0xca, 0x29, 0xd8, 0x5b, 0xe0, 0xdd, 0xc4, 0xc9, 0x02, 0xc9, 0x21, 0x97,
+	0x4c, 0x02, 0x3a, 0x40, 0xee, 0x3e, 0xec, 0x21, 0xa7, 0xa0, 0x7e, 0xfd, 0x99, 0x19, 0x92, 0xa2,
+	0xbc, 0x46, 0x02, 0x23, 0x17, 0x69, 0xea, 0xd5, 0xab, 0x57, 0xbf, 0xf7, 0xef, 0x57, 0x84, 0xd2,
+	0xb1, 0xe7, 0x78, 0xfd, 0xd6, 0x52, 0xdf, 0xf7, 0x02, 0x0f, 0x4d, 0xd1, 0x56, 0xf5, 0x7a, 0xc7,
+	0xf3, 0x3a, 0x0e, 0x5e, 0x36, 0xfb, 0xf6, 0xb2, 0xe9, 0xba, 0x5e, 0x60, 0x06, 0xb6, 0xe7, 0x12,
+	0x8e, 0x53, 0x5d, 0xec, 0xd8, 0x41, 0x77, 0xd0, 0x5a, 0x6a, 0x7b, 0xbd, 0xe5, 0x8e, 0xd7, 0xf1,
+	0x96, 0x19, 0xb8, 0x35, 0xd8, 0x67, 0x2d, 0xd6, 0x60, 0xbf, 0x04, 0x7a, 0x4d, 0x10, 0x0b, 0xb1,
+	0x02, 0xbb, 0x87, 0x49, 0x60, 0xf6, 0xfa, 0x1c, 0xa1, 0x7e, 0x03, 0xa6, 0x76, 0x6c, 0xb7, 0x53,
+	0x2d, 0x40, 0x4e, 0xc7, 0x1f, 0x0f, 0x30, 0x09, 0xaa, 0x00, 0x79, 0x1d, 0x93, 0xbe, 0xe7, 0x12,
+	0x5c, 0xff, 0x73, 0x05, 0x2a, 0x0d, 0x7c, 0xd8, 0x18, 0xf4, 0xfa, 0xdb, 0xad, 0x8f, 0x70, 0x3b,
+	0x20, 0xd5, 0x95, 0x10, 0x13, 0xbd, 0x08, 0xd3, 0x47, 0x76, 0xd0, 0x35, 0xfa, 0x3e, 0x76, 0x3c,
+	0xd3, 0xb2, 0xdd, 0x8e, 0xa6, 0x2c, 0x28, 0x77, 0xf2, 0x7a, 0x85, 0x82, 0x77, 0x42, 0x68, 0xf5,
+	0xc3, 0x88, 0x24, 0xba, 0x05, 0x99, 0x96, 0x19, 0xb4, 0xbb, 0x0c, 0xb5, 0xb8, 0x52, 0x5c, 0xa2,
+	0xbb, 0x5e, 0x5a, 0xa3, 0x20, 0x9d, 0xf7, 0xa0, 0x7b, 0x50, 0xb0, 0xbc, 0x23, 0x97, 0x8e, 0x26,
+	0x5a, 0x6a, 0x21, 0x7d, 0xa7, 0xb8, 0x52, 0xe1, 0x68, 0x0d, 0x01, 0xd6, 0x23, 0x84, 0xfa, 0x17,
+	0x19, 0xc8, 0xee, 0x06, 0x66, 0x30, 0x20, 0xf1, 0x5d, 0xfc, 0x45, 0x3a, 0x36, 0xe7, 0x65, 0xc8,
+	0x0e, 0xfa, 0x74, 0xeb, 0x6c, 0xd2, 0x8c, 0x2e, 0x5a, 0x68, 0x1e, 0xb2, 0x56, 0xcb, 0xc0, 0xbe,
+	0xaf, 0xa5, 0x16, 0x94, 0x3b, 0x05, 0x3d, 0x63, 0xb5, 0x36, 0x7c, 0x1f, 0xbd, 0x06, 0x57, 0xf0,
+	0x21, 0x76, 0x03, 0xc3, 0xc7, 0x01, 0x76, 0xe9, 0xf1, 0x1b, 0x04, 0xb7, 0x3d, 0xd7, 0x22, 0x5a,
+	0x7a, 0x41, 0xb9, 0x93, 0xd6, 0xe7, 0x59, 0xb7, 0x2e, 0x7b, 0x77, 0x79, 0x27, 0xba, 0x0f, 0x39,
+	0xcb, 0xb7, 0x0f, 0xb1, 0x4f, 0xb4, 0x29, 0xb6, 0xea, 0xab, 0x7c, 0xd5, 0x7c, 0x75, 0x4b, 0x0d,
+	0xd6, 0xc7, 0x1b, 0xba, 0xc4, 0x44, 0x2f, 0x43, 0x8e, 0xfe, 0x6f, 0x7b, 0xae, 0x96, 0x61, 0x27,
+	0x72, 0x99, 0x0f, 0xfa, 0x21, 0x07, 0x2e, 0xc9, 0x4d, 0xe8, 0x12, 0x0d, 0xd5, 0xa0, 0xe8, 0xb6,
+	0x0c, 0x3a, 0x75, 0x60, 0x63, 0xa2, 0x01, 0xdb, 0x12, 0xb8, 0xad, 0x0d, 0x01, 0x11, 0x08, 0x7d,
+	0xdf, 0x63, 0x37, 0xa6, 0x15, 0x25, 0xc2, 0x8e, 0x80, 0xa0, 0x1b, 0x00, 0x6e, 0xcb, 0x68, 0x7b,
+	0xbd, 0x9e, 0x1d, 0x10, 0xad, 0xc4, 0xfa, 0x0b, 0x6e, 0x6b, 0x9d, 0x03, 0xc4, 0x78, 0x1f, 0x3b,
+	0xd8, 0x24, 0x98, 0x68, 0x65, 0x39, 0x5e, 0x17, 0x10, 0x74, 0x0d, 0x0a, 0x6e, 0xcb, 0x68, 0x0d,
+	0x6c, 0xc7, 0x22, 0x5a, 0x85, 0x75, 0xe7, 0xdd, 0xd6, 0x1a, 0x6b, 0xa3, 0xbb, 0x30, 0xe3, 0xb6,
+	0x8c, 0x1e, 0xf6, 0x3b, 0xd8, 0xf0, 0xf9, 0x6d, 0x10, 0x6d, 0x9a, 0x21, 0x4d, 0xbb, 0xad, 0x27,
+	0x14, 0x2e, 0x2e, 0x89, 0x54, 0xff, 0x4d, 0x81, 0x52, 0xfc, 0x58, 0xd0, 0x6f, 0x41, 0x96, 0x1f,
+	0x0c, 0xbb, 0xa9, 0xca, 0x4a, 0x49, 0xdc, 0x3b, 0x83, 0xe9, 0xa2, 0x8f, 0x1e, 0x74, 0xdb, 0xf6,
+	0xdb, 0x03, 0x3b, 0x60, 0x17, 0x57, 0x19, 0x39, 0xe8, 0x75, 0xde, 0x47, 0x5b, 0x58, 0x97, 0x98,
+	0xe8, 0x15, 0x98, 0x6b, 0xd3, 0x83, 0x6c, 0x0f, 0x02, 0xfb, 0x10, 0x1b, 0xfb, 0xa6, 0xed, 0x0c,
+	0x7c, 0xcc, 0xaf, 0x34, 0xa3, 0xcf, 0xc6, 0xfa, 0x1e, 0x8a, 0x2e, 0xf4, 0x0e, 0xe4, 0x7d, 0x1c,
+	0xf8, 0xc7, 0x86, 0x19, 0x68, 0x53, 0xec, 0x72, 0xaa, 0x4b, 0x5c, 0xa2, 0x96, 0xa4, 0x44, 0x2d,
+	0xed, 0x49, 0x89, 0x5a, 0xcb, 0x7f, 0x3e, 0xac, 0x29, 0x9f, 0xfd, 0x7b, 0x4d, 0xd1, 0x73, 0x6c,
+	0xd4, 0x6a, 0x50, 0x5f, 0x81, 0x52, 0x7c, 0x31, 0x08, 0x20, 0xbb, 0xee, 0x78, 0x04, 0x5b, 0xea,
+	0x25, 0x94, 0x87, 0xa9, 0xed, 0x3e, 0x76, 0x55, 0x05, 0x95, 0x20, 0xbf, 0x69, 0x3a, 0xfb, 0xac,
+	0x95, 0xaa, 0x7f, 0xa6, 0x40, 0x4e, 0x5c, 0x7e, 0x9c, 0xa1, 0x3f, 0x8d, 0xf1, 0xb3, 0x16, 0xf1,
+	0x8c, 0xc2, 0x18, 0x57, 0x36, 0x29, 0xa7, 0xf3, 0x6b, 0x15, 0x1c, 0x2d, 0x5a, 0xf4, 0xc6, 0xd9,
+	0x75, 0x19, 0x96, 0x19, 0x60, 0xb6, 0xe5, 0x82, 0x5e, 0x60, 0x90, 0x06, 0x5d, 0xd7, 0x0d, 0x80,
+	0x8e, 0x67, 0x48, 0x9a, 0x53, 0xbc, 0xbb, 0xe3, 0x89, 0x65, 0xd4, 0x7f, 0x0e, 0x50, 0x60, 0xb7,
+	0xfb, 0xd8, 0x26, 0x41, 0xf5, 0xd7, 0xf9, 0x48, 0x05, 0xcc, 0x41, 0xc6, 0xb1, 0xe9, 0x74, 0x5c,
+	0xb0, 0x78, 0x03, 0x3d, 0x80, 0x8a, 0xe9, 0x07, 0xf6, 0xbe, 0xd9, 0x0e, 0x8c, 0x03, 0xdb, 0x15,
+	0x52, 0x5c, 0x59, 0x99, 0xe5, 0xd7, 0xb4, 0x2a, 0xfa, 0x96, 0x1e, 0xd9, 0xae, 0xa5, 0x97, 0x25,
+	0x2a, 0x6d, 0x11, 0xf4, 0x02, 0x30, 0xed, 0x61, 0x48, 0x28, 0xbf, 0xa0, 0xbc, 0x5e, 0xa6, 0x50,
+	0x39, 0x92, 0xa0, 0xef, 0x40, 0x9e, 0x6f, 0xc8, 0xb6, 0x98, 0xb0, 0x15, 0xd6, 0x8a, 0x27, 0xc3,
+	0x5a, 0x8e, 0xad, 0xb2, 0xd9, 0xd0, 0x73, 0xac, 0xb3, 0x69, 0xa1, 0x7b, 0x00, 0x42, 0x10, 0x28,
+	0x66, 0x86, 0x61, 0x96, 0x4f, 0x86, 0xb5, 0x82, 0x10, 0x86, 0x66, 0x43, 0x2f, 0x08, 0x84, 0xa6,
+	0x85, 0x96, 0xa1, 0x18, 0x2e, 0xdc, 0xb6, 0xb4, 0x2c, 0x43, 0xaf, 0x9c, 0x0c, 0x6b, 0x20, 0x67,
+	0x6e, 0x36, 0x74, 0x90, 0x28, 0x6c, 0x40, 0x49, 0x9c, 0x2b, 0xe7, 0xda, 0xdc, 0x42, 0x7a, 0x8c,
+	0x6b, 0x8b, 0xfc, 0x9c, 0x59, 0x03, 0xad, 0x00, 0x6f, 0x1a, 0x84, 0x32, 0x84, 0x96, 0x67, 0xf8,
+	0x33, 0x42, 0x09, 0xd2, 0x8e, 0x25, 0xce, 0xb6, 0xfc, 0xba, 0xd8, 0x6f, 0xf4, 0x16, 0x4c, 0x33,
+	0x71, 0x12, 0xd2, 0x44, 0x57, 0x56, 0x60, 0x2b, 0x43, 0x27, 0xc3, 0x5a, 0x25, 0x2e, 0x51, 0xcd,
+	0x86, 0x5e, 0x89, 0xa3, 0x36, 0x2d, 0xb4, 0x05, 0x97, 0x13, 0x83, 0xcd, 0x41, 0xd0, 0xf5, 0x7c,
+	0x4a, 0x03, 0x18, 0x0d, 0xed, 0x64, 0x58, 0x9b, 0x8b, 0xd3, 0x58, 0x65, 0x08, 0xcd, 0x86, 0x3e,
+	0x17, 0x1f, 0x27, 0xa0, 0x16, 0x7a, 0x09, 0x66, 0xd8, 0xfd, 0xc4, 0x3b, 0x99, 0x8a, 0xc9, 0xeb,
+	0x2a, 0xed, 0x78, 0x12, 0x83, 0xa3, 0x77, 0x01, 0x25, 0x26, 0xe7, 0x9b, 0x2e, 0xb1, 0x4d, 0x6b,
+	0x7c, 0xd3, 0xf1, 0xa9, 0xc5, 0xde, 0x67, 0xe2, 0x63, 0xf8, 0x11, 0x5c, 0x86, 0x6c, 0xcb, 0x37,
+	0xdd, 0x76, 0x57, 0x2b, 0xd3, 0x55, 0xeb, 0xa2, 0x85, 0x5e, 0x86, 0x39, 0xb6, 0x1a, 0xd7, 0x4b,
+	0x2e, 0xa8, 0xc2, 0x16, 0x84, 0x68, 0xdf, 0x96, 0x97, 0x58, 0xd2, 0x22, 0xcc, 0x12, 0xcf, 0x0f,
+	0x8c, 0xd6, 0xb1, 0x50, 0x80, 0x5c, 0x24, 0xa6, 0xf9, 0x0e, 0x68, 0xd7, 0xda, 0x31, 0x57, 0x84,
+	0x4c, 0x32, 0x34, 0xc8, 0xb5, 0xbb, 0xa6, 0xeb, 0x62, 0x47, 0x53, 0xb9, 0xa8, 0x89, 0x26, 0xba,
+	0x25, 0xaf, 0xbe, 0xed, 0xb9, 0xfb, 0x76, 0x47, 0x9b, 0x61, 0x0b, 0xe3, 0xb7, 0xbb, 0xce, 0x40,
+	0x54, 0xac, 0xbc, 0x23, 0x17, 0xfb, 0x46, 0x80, 0xcd, 0x9e, 0x86, 0x18, 0x42, 0x81, 0x41, 0xf6,
+	0xb0, 0xd9, 0xa3, 0x7a, 0xd6, 0x3b, 0xc4, 0xbe, 0xd1, 0x1a, 0x58, 0x1d, 0x1c, 0x68, 0xb3, 0x6c,
+	0x09, 0x40, 0x41, 0x6b, 0x0c, 0x42, 0x77, 0xed, 0xed, 0xef, 0x13, 0x1c, 0x68, 0x73, 0xdc, 0x6e,
+	0xf1, 0x16, 0xba, 0x0d, 0xa1, 0xd0, 0x18, 0xa6, 0xdf, 0xee, 0x6a, 0xf3, 0x8c, 0x74, 0x49, 0x02,
+	0x57, 0xfd, 0x76, 0x97, 0x4e, 0xde, 0x37, 0x3b, 0xd8, 0x08, 0xbc, 0x03, 0xec, 0x6a, 0x97, 0xb9,
+	0x4c, 0x53, 0xc8, 0x1e, 0x05, 0xa0, 0x65, 0xc8, 0x89, 0x73, 0xd0, 0xae, 0x30, 0x1d, 0x7a, 0x39,
+	0xc6, 0x84, 0x54, 0xce, 0x97, 0x76, 0xd9, 0x59, 0xe8, 0x59, 0x7e, 0x26, 0xe8, 0x0d, 0x00, 0x36,
+	0xc0, 0xf3, 0x2d, 0xec, 0x6b, 0x5a, 0x5c, 0xef, 0x26, 0xc7, 0x6c, 0x53, 0x04, 0xbd, 0x40, 0xe4,
+	0x4f, 0x2a, 0xd2, 0xf8, 0x93, 0x00, 0xfb, 0xae, 0xe9, 0x08, 0x0e, 0xb8, 0xca, 0xd6, 0x5b, 0x96,
+	0x50, 0x7e, 0xc7, 0x35, 0x28, 0x06, 0x66, 0xa7, 0x83, 0x2d, 0xc3, 0x73, 0x9d, 0x63, 0xad, 0xca,
+	0x8f, 0x83, 0x83, 0xb6, 0x5d, 0xe7, 0xb8, 0xfa, 0x7e, 0x4c, 0x05, 0xde, 0x86, 0xac, 0xb0, 0x3f,
+	0xca, 0x42, 0x3a, 0xe6, 0x47, 0x50, 0x98, 0x2e, 0xba, 0xd0, 0x77, 0x60, 0xda, 0xc5, 0x9f, 0x04,
+	0x46, 0xec, 0x1c, 0xb8, 0x5a, 0x2c, 0x53, 0xf0, 0x8e, 0x3c, 0x8b, 0xfa, 0x0f, 0x20, 0xcb, 0x37,
+	0x8b, 0xca, 0x50, 0x58, 0xf7, 0xb1, 0x19, 0x60, 0x6b, 0x35, 0x50, 0x2f, 0x51, 0xcd, 0xcc, 0x28,
+	0x6e, 0x0d, 0x7a, 0x5c, 0x4f, 0x37, 0x06, 0x3e, 0xf3, 0xc7, 0xd4, 0x14, 0x2a, 0x86, 0x6a, 0x5a,
+	0x4d, 0xd7, 0x6f, 0x42, 0x21, 0xdc, 0x3a, 0xd5, 0xec, 0x0d, 0x4c, 0xda, 0xea, 0x25, 0x94, 0x83,
+	0xf4, 0x2a, 0x69, 0xab, 0x4a, 0xfd, 0x27, 0x0a, 0x94, 0x76, 0x7c, 0xaf, 0xe7, 0x05, 0x98, 0x11,
+	0xac, 0x3e, 0x8a, 0x74, 0x68, 0x5c, 0x95, 0x31, 0x75, 0x7e, 0x8a, 0x2a, 0x8b, 0xb1, 0x62, 0x2a,
+	0xc1, 0x8a, 0xd5, 0xc5, 0x11, 0xff, 0x8a, 0x0e, 0x18, 0xf1, 0xaf, 0xd8, 0xb9, 0xf0, 0x9e, 0xba,
+	0x03, 0xf9, 0x77, 0x71, 0xc0, 0xd7, 0xf1, 0xca, 0x85, 0xd7, 0x71, 0xd1, 0xd9, 0x0e, 0xa1, 0xb4,
+	0x8b, 0x29, 0x97, 0x32, 0x28, 0xa9, 0xbe, 0x9a, 0xb0, 0x1e, 0x1f, 0x0f, 0xb0, 0x7f, 0x2c, 0xac,
+	0x18, 0x6f, 0x44, 0x36, 0x25, 0x15, 0xb3, 0x29, 0xd5, 0xe5, 0x0b, 0x5e, 0x7e, 0xfd, 0xa7, 0x53,
+	0x90, 0xdb, 0x1d, 0xf4, 0x7a, 0xa6, 0x7f, 0x5c, 0x7d, 0x3d, 0x9a, 0x33, 0x69, 0x10, 0x94, 0xb3,
+	0x0d, 0x42, 0xf5, 0xcd, 0xd8, 0xac, 0x8b, 0x90, 0xc3, 0x6e, 0xe0, 0x53, 0x9f, 0x8b, 0x4f, 0x2b,
+	0xcc, 0x99, 0x98, 0x64, 0x69, 0xc3, 0x0d, 0xfc, 0x63, 0x5d, 0xe2, 0x54, 0x7f, 0x9a, 0x86, 0x0c,
+	0x03, 0x8d, 0x4d, 0xa9, 0x9c, 0x69, 0x83, 0x5e, 0x84, 0x29, 0x6a, 0x33, 0x85, 0x67, 0x33, 0xd1,
+	0x64, 0x32, 0x84, 0x50, 0x01, 0x11, 0xa3, 0xed, 0x0d, 0xdc, 0x40, 0xf8, 0xa6, 0x5c, 0x01, 0x91,
+	0x75, 0x0a, 0x42, 0x8f, 0x61, 0xda, 0x31, 0x03, 0xaa, 0x79, 0xf9, 0xcd, 0x5e, 0xd0, 0x8f, 0x29,
+	0xf3, 0xc1, 0xec, 0x5c, 0x57, 0x03, 0xf4, 0xe6, 0x08, 0x35, 0x66, 0x50, 0xe9, 0x66, 0x66, 0x4e,
+	0x86, 0xb5, 0xf2, 0xe3, 0x08, 0xb7, 0xd9, 0x48, 0x0c, 0x6d, 0x5a, 0x54, 0x05, 0x88, 0xa1, 0xd2,
+	0xc9, 0xc8, 0x72, 0x41, 0xe4, 0x50, 0x21, 0x48, 0xe8, 0xf5, 0x70, 0x06, 0xa9, 0xca, 0xb4, 0xdc,
+	0x82, 0x12, 0xf9, 0xff, 0xf2, 0x18, 0x74, 0x41, 0x4d, 0xb6, 0xa9, 0xe1, 0xb6, 0x5d, 0x12, 0x98,
+	0x8e, 0x63, 0x0c, 0x7c, 0x47, 0xcb, 0x2f, 0x28, 0xd2, 0x70, 0x37, 0x39, 0xf8, 0xa9, 0xfe, 0x58,
+	0x07, 0x81, 0xf2, 0xd4, 0x77, 0xea, 0x7f, 0xa8, 0x40, 0x59, 0xc7, 0xfb, 0x3e, 0x26, 0x92, 0x2f,
+	0x6f, 0x47, 0x3c, 0xa2, 0x41, 0x4e, 0xdc, 0x87, 0xf4, 0xaf, 0x44, 0xb3, 0xfa, 0x41, 0x8c, 0x1f,
+	0x5e, 0x80, 0xca, 0xa0, 0x4f, 0x8d, 0x87, 0x65, 0x84, 0xdc, 0x48, 0x6f, 0xa0, 0x2c, 0xa0, 0x6b,
+	0x52, 0x09, 0x85, 0x51, 0x41, 0x6a, 0x82, 0x77, 0x20, 0x3b, 0xeb, 0x43, 0x05, 0xd0, 0x6e, 0xe0,
+	0x63, 0xb3, 0xc7, 0x06, 0x3e, 0x65, 0x44, 0x48, 0xf5, 0x4f, 0x95, 0xe7, 0xe4, 0xdd, 0xaf, 0xe5,
+	0x85, 0xdd, 0x86, 0x32, 0x71, 0xcd, 0x3e, 0xe9, 0x7a, 0x81, 0x41, 0xec, 0x4f, 0xb1, 0xf0, 0x92,
+	0x4b, 0x12, 0xb8, 0x6b, 0x7f, 0x8a, 0x2f, 0xaa, 0x08, 0xfe, 0x2c, 0x05, 0xf9, 0xf7, 0xbb, 0x66,
+	0x40, 0xb6, 0xf0, 0x51, 0xd5, 0xfc, 0x0d, 0xea, 0xbf, 0x48, 0x63, 0xa4, 0xe3, 0x1a, 0xe3, 0x2f,
+	0x95, 0x8b, 0xda, 0x8b, 0xdb, 0x50, 0x16, 0x51, 0x8f, 0xe1, 0x7a, 0x01, 0x26, 0x62, 0x9e, 0x92,
+	0x00, 0x6e, 0x51, 0x18, 0xbd, 0x4f, 0x19, 0x39, 0xa5, 0x19, 0x29, 0x71, 0x9f, 0xdc, 0x69, 0xd0,
+	0x65, 0x27, 0x65, 0xc9, 0xb6, 0xd7, 0xeb, 0x9b, 0x3e, 0x66, 0x2c, 0x39, 0x15, 0xb1, 0xe4, 0x3a,
+	0x07, 0x33, 0x96, 0x14, 0x28, 0x94, 0x25, 0xff, 0x2a, 0x05, 0xa5, 0x5d, 0xbb, 0xe3, 0xca, 0x8b,
+	0xa9, 0xfe, 0x2c, 0x76, 0xf5, 0x23, 0x9e, 0xa9, 0x12, 0x51, 0x3b, 0xd5, 0x33, 0x2d, 0x06, 0x81,
+	0x13, 0x06, 0xae, 0x74, 0x27, 0x69, 0x3e, 0x60, 0x6f, 0xef, 0xb1, 0x88, 0x58, 0x75, 0x08, 0x02,
+	0x47, 0xfc, 0xa6, 0xfe, 0x02, 0xb1, 0xdd, 0x8e, 0x83, 0x8d, 0x01, 0xc1, 0xc2, 0xe9, 0x2e, 0x70,
+	0xc8, 0x53, 0x1e, 0x43, 0x5b, 0xf8, 0xd0, 0x6e, 0x63, 0x11, 0x1e, 0x88, 0x56, 0xf5, 0x47, 0xb1,
+	0x43, 0xbe, 0x0b, 0xf9, 0x50, 0x6e, 0x95, 0x89, 0x72, 0x1b, 0xf6, 0xa3, 0x75, 0x00, 0xfc, 0x49,
+	0xdf, 0xf6, 0x31, 0xa1, 0x5a, 0x29, 0x75, 0x01, 0xad, 0x54, 0x10, 0xe3, 0x56, 0x83, 0xfa, 0xbf,
+	0xa4, 0xa1, 0xb8, 0xc6, 0x3c, 0x41, 0xea, 0x42, 0x90, 0xea, 0x8f, 0xa2, 0x03, 0x8b, 0x3c, 0x46,
+	0x25, 0xe1, 0x31, 0x26, 0x65, 0x28, 0x75, 0x8e, 0x32, 0x9e, 0x83, 0x0c, 0xb1, 0xdd, 0xb6, 0x0c,
+	0x99, 0x78, 0x83, 0x42, 0x07, 0x6e, 0x60, 0x8b, 0x4b, 0xd5, 0x79, 0xa3, 0xfa, 0x4e, 0xec, 0x24,
+	0xee, 0x43, 0x9e, 0xcf, 0x17, 0x1a, 0x8b, 0x2b, 0x82, 0xe1, 0xa2, 0xd5, 0x0a, 0x83, 0x11, 0x22,
+	0x56, 0xff, 0x20, 0x25, 0x2d, 0x46, 0x7c, 0xf1, 0x4a, 0x6c, 0xf1, 0x73, 0x90, 0x09, 0xbc, 0xc0,
+	0xe4, 0x02, 0x90, 0xd6, 0x79, 0x83, 0x62, 0xf7, 0x4d, 0x42, 0xb0, 0x25, 0x4c, 0x80, 0x68, 0x51,
+	0x38, 0x8d, 0x72, 0xb1, 0xc5, 0xd6, 0x99, 0xd6, 0x45, 0x8b, 0x86, 0xef, 0x14, 0xc3, 0xf0, 0xa9,
+	0x2b, 0x46, 0x35, 0xb8, 0xa2, 0xe7, 0x29, 0x40, 0xa7, 0x5e, 0xd8, 0x1b, 0xa0, 0x99, 0x87, 0xd8,
+	0xa7, 0x1e, 0x93, 0x25, 0x9c, 0x9d, 0x90, 0x89, 0xb2, 0x0c, 0xf7, 0xb2, 0xe8, 0x97, 0xbe, 0x90,
+	0x64, 0xa0, 0x4d, 0x28, 0x3b, 0x66, 0xdc, 0xd4, 0xe4, 0x2e, 0x70, 0xa9, 0x45, 0x3a, 0x54, 0x18,
+	0x9a, 0xfa, 0xef, 0x81, 0x1a, 0xba, 0x94, 0x0f, 0x6d, 0x27, 0xc0, 0x7e, 0x22, 0xb7, 0x63, 0xc4,
+	0x0e, 0xfa, 0x0e, 0xe4, 0xc3, 0x4c, 0x88, 0x12, 0x17, 0x47, 0x96, 0x0d, 0x39, 0xd6, 0xc3, 0x5e,
+	0xf4, 0x5d, 0xc8, 0x87, 0x29, 0x11, 0x9e, 0x54, 0x2a, 0x73, 0x4c, 0x71, 0xf1, 0x7a, 0xd8, 0x5d,
+	0xff, 0x2c, 0x0d, 0xea, 0x13, 0x1c, 0x98, 0x96, 0x19, 0x98, 0xdb, 0x87, 0xd8, 0xf7, 0x6d, 0x2b,
+	0x1e, 0x82, 0x14, 0x13, 0x77, 0x72, 0x1f, 0xca, 0x5d, 0x93, 0xc8, 0x60, 0xc2, 0xb6, 0xb4, 0x0e,
+	0xe3, 0xa9, 0xe9, 0x93, 0x61, 0xad, 0xb8, 0x69, 0x12, 0xae, 0x16, 0x9a, 0x0d, 0xbd, 0xd8, 0x0d,
+	0x1b, 0x16, 0x7a, 0x0d, 0x2a, 0x74, 0x50, 0x8c, 0x13, 0x6d, 0x36, 0x4a, 0x3d, 0x19, 0xd6, 0x4a,
+	0x9b, 0x26, 0x89, 0x98, 0xb1, 0xd4, 0x8d, 0x5a, 0x16, 0xda, 0x80, 0x59, 0x3a, 0x6e, 0x34, 0x1c,
+	0x3c, 0x60, 0x83, 0xe7, 0x4f, 0x86, 0xb5, 0x99, 0x4d, 0x93, 0x8c, 0x44, 0x84, 0x33, 0x5d, 0x01,
+	0x8a, 0x82, 0xc2, 0x31, 0x45, 0xa7, 0x4e, 0x50, 0x74, 0x8f, 0x46, 0x02, 0x9c, 0x2f, 0xf8, 0xf9,
+	0xbe, 0x28, 0xe3, 0xb6, 0xe4, 0xf9, 0x2c, 0xad, 0x45, 0x81, 0x0f, 0x67, 0xec, 0x78, 0x28, 0x54,
+	0xfd, 0xbe, 0xb8, 0xd2, 0x18, 0x02, 0x52, 0x21, 0x7d, 0x80, 0xa5, 0xf3, 0x47, 0x7f, 0x52, 0xfe,
+	0x3e, 0x34, 0x9d, 0x01, 0x96, 0xf9, 0x38, 0xd6, 0x78, 0x90, 0x7a, 0x43, 0xa9, 0xff, 0x7c, 0x1e,
+	0x32, 0x8c, 0x00, 0xba, 0x07, 0xa9, 0x50, 0x01, 0x5e, 0x3f, 0x19, 0xd6, 0x52, 0xcd, 0xc6, 0x57,
+	0xc3, 0x1a, 0xea, 0x78, 0x7e, 0xef, 0x41, 0xbd, 0xef, 0xdb, 0xd4, 0x17, 0x33, 0x0e, 0xf0, 0x71,
+	0x5d, 0x4f, 0xd9, 0x74, 0xa7, 0x39, 0xba, 0xdc, 0x48, 0xd6, 0xe1, 0x64, 0x58, 0xcb, 0x7e, 0xe0,
+	0x39, 0x5e, 0xb3, 0xa1, 0x67, 0x69, 0x57, 0xd3, 0xa2, 0xba, 0xa8, 0xcd, 0xbd, 0x7e, 0xca, 0xb6,
+	0xe9, 0x8b, 0xe8, 0xa2, 0xb6, 0x8c, 0x16, 0x28, 0x11, 0xe9, 0x0e, 0x5c, 0xd0, 0xcd, 0x2a, 0x88,
+	0x71, 0xab, 0x34, 0xa5, 0x9a, 0x21, 0x81, 0x14, 0xcb, 0x89, 0x89, 0x01, 0xde, 0x8f, 0xde, 0x85,
+	0x12, 0x35, 0x1d, 0x0e, 0x16, 0xf3, 0x65, 0x2f, 0x22, 0x6b, 0xe1, 0xc8, 0x55, 0xe6, 0xeb, 0xf4,
+	0x30, 0x21, 0x66, 0x07, 0x33, 0x79, 0x2d, 0xe8, 0xb2, 0x49, 0x37, 0x44, 0x02, 0xd3, 0x17, 0x13,
+	0xe4, 0x2f, 0xb2, 0x21, 0x31, 0x6e, 0x35, 0x40, 0x1b, 0x50, 0xdc, 0xb7, 0x5d, 0x9b, 0x74, 0x39,
+	0x95, 0xc2, 0x05, 0xa8, 0x80, 0x1c, 0xb8, 0xca, 0x3c, 0x1f, 0x21, 0x60, 0xd4, 0x96, 0x42, 0xa4,
+	0xb5, 0xb9, 0x44, 0x51, 0x53, 0x5a, 0xe0, 0x08, 0x4f, 0x7d, 0xe7, 0x54, 0x51, 0x8d, 0xb2, 0x8b,
+	0xa5, 0x33, 0xb2, 0x8b, 0xdf, 0x81, 0x3c, 0xe9, 0xd2, 0x48, 0xd7, 0xb6, 0xb4, 0x72, 0xe4, 0x8f,
+	0xec, 0x52, 0x18, 0xf5, 0x47, 0x58, 0x27, 0x13, 0xa2, 0xdc, 0x61, 0x9b, 0x18, 0x81, 0xd9, 0xd1,
+	0x2a, 0x11, 0x6b, 0xfd, 0x70, 0x7d, 0x77, 0xcf, 0xec, 0xe8, 0xd9, 0xc3, 0x36, 0xd9, 0x33, 0x3b,
+	0x68, 0x11, 0x8a, 0x02, 0x89, 0xad, 0x7c, 0x3a, 0x5a, 0x39, 0x47, 0x64, 0x2b, 0xe7, 0xb8, 0x74,
+	0xe5, 0xcf, 0x24, 0x98, 0xef, 0xc0, 0x4c, 0x5c, 0x30, 0x8d, 0x8f, 0x88, 0xe7, 0x6a, 0x33, 0x8c,
+	0xf2, 0xec, 0xc9, 0xb0, 0x36, 0x1d, 0x13, 0xb4, 0xf7, 0x76, 0xb7, 0xb7, 0xf4, 0xe9, 0x98, 0x20,
+	0xbe, 0x47, 0x3c, 0x17, 0x7d, 0x0f, 0xd4, 0x28, 0x2f, 0x41, 0xf8, 0x78, 0xb4, 0xa0, 0xc8, 0x8c,
+	0xd2, 0xb6, 0xcc, 0x50, 0x10, 0x36, 0xbc, 0xe2, 0x45, 0x6d, 0xc2, 0xf3, 0xcf, 0x67, 0xa7, 0x2d,
+	0xee, 0x01, 0xec, 0x3b, 0x66, 0x47, 0x10, 0x9e, 0x8b, 0xb6, 0xfc, 0x90, 0x42, 0x19, 0xcd, 0x02,
+	0x43, 0x60, 0xe4, 0x6e, 0x43, 0x59, 0x5c, 0x2d, 0x4f, 0x4d, 0x69, 0xd7, 0xf9, 0x96, 0x39, 0x90,
+	0xe7, 0x9d, 0x68, 0xac, 0x23, 0x90, 0x70, 0xcf, 0xb4, 0x1d, 0xed, 0x06, 0xc3, 0x29, 0x72, 0xd8,
+	0x06, 0x05, 0x21, 0x1d, 0xb4, 0x04, 0x1d, 0xc3, 0x3c, 0x34, 0x03, 0xd3, 0x67, 0xc7, 0x7e, 0x93,
+	0xad, 0xe1, 0xea, 0xc9, 0xb0, 0x36, 0xbf, 0x1e, 0x23, 0xbb, 0xca, 0x30, 0xe8, 0x15, 0xcc, 0xb7,
+	0xc7, 0xc1, 0xbe, 0x83, 0xaa, 0x90, 0x97, 0x46, 0x50, 0xab, 0x31, 0x1b, 0x1a, 0xb6, 0x27, 0x64,
+	0x35, 0x16, 0x78, 0x48, 0x33, 0x96, 0xd5, 0x10, 0x21, 0x0f, 0x55, 0x4a, 0xda, 0x2d, 0x86, 0x03,
+	0x02, 0xf4, 0x08, 0x1f, 0x53, 0xbf, 0xcb, 0x37, 0x8f, 0x0c, 0xc1, 0xb0, 0xf3, 0xac, 0xbf, 0xe0,
+	0x9b, 0x47, 0xdc, 0x53, 0x40, 0x2b, 0xdc, 0x52, 0x50, 0x14, 0x91, 0xd9, 0xbd, 0xcc, 0x64, 0x28,
+	0xe9, 0x75, 0x52, 0x2b, 0xa1, 0x9b, 0x47, 0xbc, 0x85, 0x5e, 0x85, 0x69, 0x39, 0x46, 0xc6, 0x31,
+	0x57, 0x16, 0x94, 0x71, 0x8b, 0x57, 0xe6, 0xa3, 0x44, 0x13, 0x35, 0x60, 0x4e, 0x0e, 0x4b, 0x24,
+	0xd3, 0x34, 0x36, 0x16, 0x8d, 0xe7, 0xeb, 0x74, 0xc4, 0x09, 0x24, 0x12, 0x6c, 0x6f, 0xc3, 0x4c,
+	0x72, 0xc1, 0x54, 0x8e, 0xae, 0x46, 0xdc, 0xb5, 0x19, 0x5b, 0x29, 0xcd, 0x57, 0xc6, 0x57, 0xde,
+	0xb4, 0xd0, 0x0f, 0x00, 0x8d, 0xac, 0x9d, 0x8e, 0xaf, 0x46, 0xdc, 0xbd, 0x19, 0x5f, 0x73, 0xb3,
+	0xa1, 0x4f, 0x27, 0x36, 0xd1, 0xb4, 0xd0, 0x36, 0x5c, 0x99, 0xb4, 0x0d, 0x4a, 0xe6, 0xda, 0x82,
+	0x22, 0x53, 0x9e, 0x9b, 0x63, 0x2b, 0xa7, 0x29, 0xcf, 0xf1, 0xfd, 0x34, 0x2d, 0xf4, 0x94, 0x5b,
+	0xf8, 0x28, 0x23, 0x8d, 0x17, 0xd2, 0xe3, 0xbe, 0xed, 0xda, 0xc2, 0x57, 0xc3, 0xda, 0x75, 0x6e,
+	0x86, 0xf6, 0x3d, 0x1f, 0xdb, 0x1d, 0xf7, 0x00, 0x1f, 0x3f, 0xd8, 0x34, 0x89, 0x88, 0x64, 0xea,
+	0xec, 0x96, 0xa2, 0x14, 0xf6, 0x4b, 0x00, 0x91, 0xe3, 0xa0, 0xed, 0x4f, 0xb8, 0xd5, 0x42, 0xe8,
+	0x32, 0x3c, 0x9f, 0x97, 0xb1, 0x04, 0xc5, 0x98, 0x97, 0xa1, 0x75, 0x27, 0xf1, 0x00, 0x44, 0xfe,
+	0xc5, 0x73, 0x7b, 0x25, 0x6f, 0x83, 0x3a, 0xea, 0x95, 0x68, 0x1f, 0x9d, 0xca, 0x34, 0xd3, 0x23,
+	0xfe, 0xc8, 0x05, 0x9c, 0x1a, 0xff, 0x2c, 0xa7, 0xe6, 0x0e, 0xe4, 0x45, 0x40, 0x48, 0xb4, 0x5f,
+	0xf0, 0xe0, 0xb8, 0xf8, 0xd5, 0xb0, 0x96, 0x23, 0x1f, 0x3b, 0x0f, 0xea, 0x8b, 0x75, 0x3d, 0xec,
+	0xa5, 0xf2, 0x11, 0x7e, 0x3f, 0x14, 0xc9, 0x93, 0x5f, 0xb2, 0xd8, 0x3d, 0x39, 0xa0, 0x12, 0x22,
+	0xf1, 0x6c, 0xca, 0x7d, 0xa8, 0x88, 0x0c, 0x82, 0x1c, 0xf5, 0xf7, 0x13, 0x46, 0x95, 0x25, 0x0e,
+	0x1f, 0xb4, 0x05, 0x48, 0x00, 0x0c, 0x62, 0x77, 0x5c, 0x6c, 0x31, 0x85, 0xf4, 0x0f, 0xdc, 0x7f,
+	0xa9, 0x9d, 0x0c, 0x6b, 0xaa, 0xc8, 0x50, 0xec, 0xb2, 0xde, 0xa7, 0xfa, 0xe3, 0x38, 0x31, 0xd5,
+	0x4e, 0x74, 0xfa, 0x0e, 0x7a, 0x32, 0xd9, 0x2b, 0xbb, 0x1e, 0xf7, 0x14, 0x46, 0x3d, 0xad, 0xe4,
+	0x02, 0x13, 0x29, 0xea, 0x45, 0x28, 0xc6, 0x4c, 0x81, 0xf6, 0x8f, 0x13, 0xce, 0x0d, 0x22, 0xfd,
+	0x8f, 0x1e, 0x40, 0x86, 0x69, 0x6e, 0xed, 0x9f, 0xf8, 0xb4, 0xf1, 0xa4, 0xf1, 0x12, 0x53, 0xef,
+	0x13, 0x26, 0xe4, 0x43, 0xbe, 0xae, 0x0b, 0x58, 0x7d, 0x03, 0x20, 0x9a, 0xe1, 0x42, 0xce, 0xe3,
+	0x8f, 0x15, 0xc8, 0x70, 0x6d, 0xac, 0x42, 0xe9, 0xa9, 0x7b, 0xe0, 0x7a, 0x47, 0x2e, 0x6b, 0xab,
+	0x97, 0x68, 0x1a, 0x57, 0x1f, 0xb8, 0xae, 0xed, 0x76, 0x54, 0x85, 0x7e, 0x9f, 0x7b, 0xc8, 0x62,
+	0x24, 0x35, 0x45, 0x7f, 0xef, 0xb0, 0x38, 0x4a, 0x4d, 0xd3, 0xcc, 0xef, 0xba, 0xe9, 0xb6, 0x31,
+	0xed, 0x99, 0xa2, 0x49, 0xe2, 0xdd, 0x76, 0x17, 0x5b, 0x03, 0xda, 0xcc, 0x50, 0x0a, 0xbb, 0x07,
+	0x76, 0xbf, 0x8f, 0x2d, 0x35, 0x4b, 0x47, 0x6d, 0x79, 0x81, 0x3e, 0x70, 0xd5, 0x1c, 0x1d, 0x45,
+	0xfd, 0x1a, 0xcb, 0x1b, 0x04, 0x6a, 0xbe, 0xfe, 0xc5, 0x14, 0x8d, 0x60, 0x98, 0x19, 0xff, 0x76,
+	0xfb, 0xb0, 0x31, 0x8f, 0x32, 0x93, 0xf4, 0x28, 0x23, 0xff, 0x2b, 0x7b, 0x86, 0xff, 0x95, 0xf4,
+	0xf5, 0x72, 0xe7, 0xf8, 0x7a, 0x71, 0x6f, 0x2d, 0x7f, 0x86, 0xb7, 0x76, 0xff, 0x99, 0x94, 0xf8,
+	0xd7, 0x51, 0xd1, 0x23, 0xda, 0xb6, 0x73, 0x9e, 0xb6, 0x9d, 0xa4, 0x35, 0xbb, 0xcf, 0xac, 0x35,
+	0xeb, 0x7f, 0x33, 0x05, 0x59, 0x31, 0xf3, 0xff, 0xb3, 0xd3, 0x19, 0xec, 0x14, 0x05, 0x03, 0xb9,
+	0x44, 0x30, 0xf0, 0x32, 0x94, 0x98, 0x9b, 0x20, 0xcb, 0x1c, 0x70, 0x3c, 0x27, 0x20, 0x04, 0x95,
+	0x99, 0x53, 0xf1, 0x9b, 0x56, 0x36, 0x30, 0x6e, 0x10, 0x79, 0xc4, 0xfd, 0xf1, 0x3c, 0x22, 0x65,
+	0x06, 0x91, 0xf5, 0xbd, 0x28, 0x33, 0x08, 0x4e, 0x13, 0x2e, 0x70, 0x77, 0x41, 0x19, 0xcb, 0x64,
+	0x50, 0xe2, 0xc2, 0x1b, 0x9e, 0xc4, 0x39, 0xf6, 0xb3, 0x73, 0xce, 0x7f, 0x15, 0xa0, 0x14, 0xc7,
+	0xf8, 0x76, 0xf3, 0xcf, 0x2a, 0x14, 0xd8, 0x41, 0x31, 0x1a, 0x99, 0x0b, 0xd0, 0xc8, 0xf3, 0x61,
+	0xab, 0xec, 0x3b, 0x55, 0x60, 0x07, 0x0e, 0x16, 0x1f, 0x2d, 0x78, 0xe3, 0x8c, 0xc8, 0x39, 0x62,
+	0xcc, 0xfc, 0x33, 0x31, 0x66, 0x21, 0xc1, 0x98, 0x4b, 0x32, 0x07, 0x00, 0x0b, 0xca, 0x99, 0xdf,
+	0xc9, 0x39, 0xda, 0x88, 0xbe, 0x2c, 0x9e, 0xa3, 0x2f, 0xef, 0x01, 0xf0, 0x79, 0x18, 0x76, 0x29,
+	0xc2, 0xe6, 0xf1, 0x06, 0xc3, 0xe6, 0x08, 0xa3, 0xda, 0xf5, 0xac, 0x58, 0x78, 0x01, 0xb2, 0x36,
+	0x31, 0x8e, 0xec, 0x3e, 0xff, 0xf2, 0xbe, 0x56, 0x38, 0x19, 0xd6, 0x32, 0x4d, 0xf2, 0x7e, 0x73,
+	0x47, 0xcf, 0xd8, 0xe4, 0x7d, 0xbb, 0xff, 0x0d, 0x8b, 0xdb, 0x9e, 0xd0, 0xee, 0x84, 0xf9, 0x58,
+	0x98, 0x68, 0x9d, 0xf1, 0x5c, 0xe0, 0xda, 0xad, 0xaf, 0x86, 0xb5, 0x1b, 0x9c, 0xa9, 0x7b, 0xa6,
+	0x7b, 0xbc, 0x42, 0xff, 0x79, 0xd0, 0xf3, 0xa3, 0x51, 0xc2, 0x43, 0x97, 0x4d, 0x49, 0xd5, 0xc7,
+	0x87, 0x36, 0x3e, 0xa2, 0x1f, 0x70, 0xba, 0x17, 0xa0, 0x1a, 0x8e, 0xe2, 0x54, 0x75, 0xd9, 0x1c,
+	0x55, 0x0d, 0xf6, 0xc5, 0xbd, 0xf2, 0x8f, 0x9e, 0xc9, 0x2b, 0x4f, 0xaa, 0x94, 0x83, 0xb3, 0x55,
+	0x8a, 0x34, 0x8f, 0x61, 0x75, 0x88, 0x93, 0x88, 0x2f, 0xc2, 0xa2, 0x90, 0x62, 0x38, 0x24, 0x9a,
+	0x41, 0x98, 0xc7, 0xde, 0x05, 0x23, 0x18, 0xf7, 0xfc, 0x08, 0xa6, 0xfe, 0xf6, 0xe9, 0x8e, 0x1b,
+	0x40, 0x96, 0x56, 0x4c, 0x61, 0x4b, 0x55, 0x62, 0x75, 0x55, 0xcc, 0x6f, 0x63, 0xb2, 0x62, 0xa9,
+	0xe9, 0xfa, 0xcf, 0x32, 0x90, 0x93, 0xc7, 0xf8, 0xad, 0x56, 0x72, 0x91, 0xc6, 0xc9, 0x9c, 0xa1,
+	0x71, 0x10, 0x4c, 0xb9, 0x66, 0x4f, 0xaa, 0x31, 0xf6, 0x1b, 0x2d, 0x40, 0xd1, 0xc2, 0xa4, 0xed,
+	0xdb, 0x7d, 0x96, 0xe5, 0xe0, 0x9a, 0x2c, 0x0e, 0x7a, 0x3e, 0xcf, 0xe9, 0x22, 0xc2, 0xbb, 0x08,
+	0xc5, 0x88, 0x33, 0x46, 0x44, 0x57, 0xf0, 0x11, 0x84, 0x4c, 0x41, 0xc6, 0x34, 0x49, 0xf7, 0x5c,
+	0x4d, 0xf2, 0x0e, 0x4f, 0x49, 0xc4, 0xed, 0x25, 0xd1, 0xec, 0x85, 0xf4, 0x29, 0x06, 0x53, 0x1d,
+	0x31, 0x98, 0xf4, 0xdb, 0x01, 0x5d, 0xae, 0xc1, 0x02, 0x21, 0x11, 0xd9, 0x8e, 0x7c, 0x66, 0xe8,
+	0x9a, 0x84, 0xa5, 0xcd, 0xe4, 0xea, 0x18, 0x6a, 0x14, 0xc5, 0xb2, 0x0f, 0x6f, 0x9b, 0x02, 0x87,
+	0x7e, 0xa9, 0x93, 0xf8, 0x4d, 0xab, 0xfe, 0xeb, 0x29, 0xc8, 0x72, 0x32, 0xdf, 0x6e, 0x1e, 0x95,
+	0xdc, 0x97, 0x89, 0x71, 0xdf, 0x33, 0x47, 0x04, 0xb1, 0x64, 0x5e, 0x2c, 0x22, 0x88, 0x12, 0x78,
+	0x05, 0x33, 0x4c, 0xda, 0xbd, 0x20, 0x0a, 0x28, 0xf2, 0xf1, 0x14, 0x3a, 0x3f, 0xe0, 0x78, 0xf9,
+	0xc4, 0x08, 0xe3, 0x17, 0xc6, 0x19, 0x5f, 0x5c, 0x65, 0xf8, 0xd5, 0x08, 0x4f, 0xfa, 0x6a, 0x54,
+	0x8c, 0x74, 0xee, 0x18, 0x27, 0xef, 0x9f, 0xc3, 0xc9, 0x13, 0xf9, 0xb2, 0xf3, 0xec, 0x7c, 0x59,
+	0xff, 0x1e, 0x4c, 0xd1, 0x1d, 0xa1, 0x69, 0x28, 0x0a, 0xed, 0x48, 0x9b, 0xbc, 0xb8, 0xf4, 0x29,
+	0xc1, 0xbe, 0xaa, 0x50, 0xc5, 0xb9, 0xed, 0x77, 0x4c, 0xd7, 0xfe, 0x54, 0x16, 0x2e, 0xe5, 0x20,
+	0xbd, 0xe6, 0x05, 0x6a, 0xba, 0xfe, 0x93, 0x12, 0xe4, 0xc3, 0x0a, 0x8a, 0x6f, 0x35, 0xeb, 0x5d,
+	0x83, 0xc2, 0xbe, 0xed, 0x60, 0x5e, 0xca, 0x90, 0xe1, 0x89, 0x5c, 0x0a, 0xa0, 0x65, 0x0c, 0x34,
+	0x01, 0xeb, 0x78, 0x6d, 0xd3, 0x31, 0xfa, 0x66, 0xd0, 0x15, 0xba, 0xb1, 0xc0, 0x20, 0x3b, 0x66,
+	0x40, 0x13, 0xb0, 0x25, 0x99, 0x07, 0x8a, 0xb1, 0x1f, 0x33, 0x5b, 0xb2, 0x1c, 0x9d, 0x32, 0x60,
+	0x51, 0x22, 0x51, 0x16, 0xbc, 0x06, 0x85, 0x9e, 0xdd, 0xc3, 0x46, 0x70, 0xdc, 0xc7, 0x3c, 0x2a,
+	0xd5, 0xf3, 0x14, 0xb0, 0x77, 0xdc, 0xc7, 0xe8, 0x2a, 0xf5, 0xa9, 0xcc, 0x57, 0x0c, 0x32, 0xe8,
+	0x09, 0xae, 0xcb, 0xd1, 0xf6, 0xee, 0xa0, 0x47, 0x97, 0x42, 0xba, 0xe6, 0xca, 0xab, 0xaf, 0xb1,
+	0x4e, 0xe0, 0x4b, 0xe1, 0x10, 0xda, 0x7d, 0x57, 0x7a, 0x86, 0x45, 0xc6, 0xda, 0x73, 0x23, 0x85,
+	0x1c, 0x09, 0xaf, 0x50, 0x96, 0x11, 0x95, 0xce, 0x2b, 0x23, 0x8a, 0x44, 0xb0, 0x7c, 0x86, 0x08,
+	0xd6, 0x68, 0xdd, 0xaa, 0x6b, 0x39, 0xd8, 0x60, 0x32, 0xcc, 0x3e, 0x78, 0xe8, 0xc0, 0x41, 0x5b,
+	0x54, 0x92, 0x5f, 0x80, 0x8a, 0x40, 0x90, 0x15, 0x3e, 0xd3, 0x3c, 0x1d, 0xce, 0xa1, 0xb2, 0xc2,
+	0xe7, 0xbb, 0x50, 0x10, 0x68, 0xb6, 0xc5, 0x3f, 0x6e, 0xac, 0x95, 0x4e, 0x86, 0xb5, 0xfc, 0x1a,
+	0x03, 0x36, 0x1b, 0x7a, 0x9e, 0x77, 0x37, 0xad, 0xd8, 0x94, 0x76, 0x5b, 0x7e, 0xe0, 0x90, 0x53,
+	0x36, 0xdb, 0x9e, 0xcb, 0xca, 0xa0, 0x4d, 0xdf, 0x36, 0xdd, 0x80, 0x7f, 0xbd, 0xd0, 0x65, 0xf3,
+	0xfc, 0x4f, 0x14, 0x2f, 0xc3, 0x9c, 0xa0, 0xcd, 0x93, 0x69, 0x72, 0xcd, 0xec, 0x63, 0x85, 0x8e,
+	0x78, 0x1f, 0x33, 0x4f, 0x72, 0xe1, 0x57, 0x20, 0xd7, 0xb3, 0x5e, 0x65, 0xf7, 0xc2, 0x73, 0xf4,
+	0xd9, 0x9e, 0xf5, 0x2a, 0xbd, 0x14, 0x04, 0x53, 0xac, 0x06, 0x93, 0x57, 0x58, 0xb2, 0xdf, 0xb4,
+	0x52, 0xca, 0x1a, 0xf4, 0x1d, 0xbb, 0x6d, 0x06, 0xd8, 0xf0, 0xf6, 0xe9, 0x5e, 0xaf, 0x44, 0x95,
+	0x52, 0x0d, 0xd9, 0xb5, 0xbd, 0x4f, 0x2b, 0xa5, 0xac, 0x58, 0xd3, 0xa2, 0x2b, 0x23, 0x7d, 0xd3,
+	0x3f, 0x70, 0xb0, 0x81, 0x2d, 0x96, 0x32, 0x34, 0x83, 0x81, 0x8f, 0x59, 0x12, 0xbe, 0xa0, 0x23,
+	0xd1, 0xb7, 0x61, 0xed, 0xca, 0x1e, 0x74, 0x87, 0x1b, 0x27, 0xb6, 0x11, 0x0d, 0x8f, 0x97, 0xdf,
+	0xe4, 0xa5, 0xa5, 0x95, 0x0a, 0x2d, 0xac, 0xb6, 0xd9, 0x4f, 0xd8, 0x26, 0x59, 0x70, 0x03, 0x12,
+	0x3f, 0xca, 0x20, 0x0b, 0x5b, 0x9b, 0x0c, 0x63, 0xa5, 0xa9, 0x85, 0xc8, 0xd4, 0x4a, 0x5f, 0x55,
+	0xe0, 0xd3, 0x39, 0xba, 0x09, 0x5f, 0x55, 0xe0, 0x09, 0x5f, 0x55, 0xb6, 0xac, 0xe4, 0x93, 0x0f,
+	0xfb, 0x9c, 0x27, 0x1f, 0xe8, 0xb7, 0xc7, 0xf3, 0xb7, 0x1f, 0x9d, 0x9f, 0xbe, 0x7d, 0x02, 0x97,
+	0x2d, 0x27, 0x74, 0x63, 0xe2, 0xd9, 0xd8, 0x5f, 0x70, 0xb5, 0x77, 0xe5, 0x64, 0x58, 0x9b, 0x6d,
+	0x3c, 0x96, 0x42, 0x12, 0x26, 0x64, 0xf5, 0x59, 0xcb, 0x19, 0x01, 0xfa, 0x0e, 0x0d, 0xc2, 0xfb,
+	0x8e, 0x4d, 0x12, 0x84, 0x7e, 0xa9, 0x44, 0xdf, 0x39, 0x76, 0x68, 0xf5, 0x42, 0x44, 0xa3, 0xd2,
+	0x77, 0xa2, 0xb6, 0xef, 0xd4, 0x37, 0x4f, 0xf7, 0x6c, 0x4b, 0x90, 0x7f, 0x28, 0x3e, 0x7d, 0xaa,
+	0x0a, 0x55, 0xd7, 0x5b, 0xf8, 0x48, 0x4d, 0xa1, 0x02, 0x64, 0x36, 0x7c, 0xdf, 0xf3, 0xd5, 0x34,
+	0x4d, 0x39, 0x36, 0x30, 0xfb, 0x82, 0xab, 0x4e, 0xd5, 0x1b, 0xa7, 0x19, 0x81, 0x1c, 0xa4, 0x9b,
+	0x3b, 0xab, 0x9c, 0xc4, 0xea, 0xce, 0x23, 0xae, 0xfa, 0x1b, 0x4f, 0xde, 0x55, 0xd3, 0xf4, 0xc7,
+	0xc6, 0xef, 0x6c, 0xa8, 0x53, 0xf4, 0xc7, 0x93, 0xdd, 0xa6, 0x9a, 0xa9, 0xff, 0xb7, 0x02, 0x79,
+	0x79, 0xd6, 0xe8, 0xad, 0xd0, 0x18, 0xa4, 0xd7, 0x5e, 0x0a, 0x8d, 0xc1, 0x2d, 0x6e, 0x0c, 0x76,
+	0xf4, 0xe6, 0x93, 0x55, 0xfd, 0x03, 0xe3, 0xd1, 0xc6, 0x07, 0x6f, 0xad, 0x3e, 0xdd, 0xdb, 0x36,
+	0x9a, 0x5b, 0xeb, 0xfa, 0xc6, 0x93, 0x8d, 0xad, 0x3d, 0x6e, 0x1b, 0x92, 0x6a, 0x3f, 0xf5, 0x7c,
+	0x6a, 0xff, 0x15, 0xce, 0xaa, 0x61, 0x39, 0x11, 0x9e, 0x58, 0x4e, 0x54, 0x8c, 0xf9, 0x9c, 0x54,
+	0xe8, 0xe2, 0x43, 0x22, 0x06, 0x67, 0x42, 0xb7, 0x19, 0x61, 0x52, 0xa1, 0x8b, 0x0d, 0x6c, 0x5a,
+	0xf5, 0x3f, 0x49, 0x41, 0x4e, 0xa4, 0xe1, 0xff, 0x0f, 0xec, 0xfd, 0x1e, 0xc8, 0x4a, 0x45, 0xba,
+	0x87, 0x74, 0xe4, 0xee, 0x88, 0x25, 0xd2, 0x12, 0x25, 0x81, 0xd0, 0xb4, 0xbe, 0x49, 0xf1, 0xaf,
+	0xff, 0x7e, 0x0a, 0x0a, 0xbc, 0x6c, 0x99, 0xaa, 0xc0, 0xff, 0xfd, 0x93, 0x89, 0x95, 0x00, 0xa6,
+	0x93, 0x25, 0x80, 0xdf, 0xe4, 0x29, 0x34, 0x21, 0xb7, 0x8b, 0x83, 0xc0, 0x76, 0x3b, 0xe8, 0x4e,
+	0xec, 0xab, 0xc3, 0xda, 0xe5, 0x53, 0x1c, 0xa4, 0xd3, 0xbf, 0x46, 0xd4, 0xff, 0x48, 0x81, 0xd2,
+	0x06, 0x7d, 0x3c, 0xc6, 0x54, 0x12, 0xf6, 0xd1, 0x5d, 0x61, 0xa6, 0xcf, 0xa6, 0xc8, 0x70, 0xd0,
+	0x3b, 0x50, 0xf0, 0x5a, 0xc9, 0xca, 0xb5, 0x3a, 0xb5, 0x9d, 0xfc, 0x69, 0xde, 0xa9, 0xfe, 0x5a,
+	0xde, 0x6b, 0x45, 0xd5, 0x6c, 0xf1, 0x52, 0x61, 0xde, 0xa8, 0x7f, 0xae, 0x40, 0x65, 0xb7, 0x8f,
+	0xdd, 0x20, 0x32, 0x29, 0x17, 0x73, 0x06, 0x7f, 0x23, 0x57, 0x9b, 0xac, 0x07, 0x4c, 0x3f, 0x5f,
+	0x3d, 0xe0, 0xdf, 0xa6, 0x20, 0xc3, 0x9e, 0x12, 0x3e, 0x5b, 0xbd, 0xe7, 0x3d, 0x28, 0x44, 0x51,
+	0x6d, 0x6a, 0x62, 0x54, 0x1b, 0x21, 0x24, 0x0a, 0xc8, 0xd2, 0x67, 0x16, 0x90, 0x25, 0xaa, 0xd2,
+	0xa6, 0xce, 0xab, 0x4a, 0x0b, 0x03, 0xd9, 0xcc, 0xa4, 0x40, 0x36, 0xec, 0x8e, 0x17, 0x9e, 0x66,
+	0xcf, 0x2a, 0x3c, 0x7d, 0x13, 0x2a, 0x23, 0xaf, 0xef, 0x72, 0xa7, 0x86, 0x14, 0xe5, 0x5e, 0xac,
+	0x45, 0xee, 0xfe, 0xb5, 0x02, 0x59, 0xf1, 0x50, 0x69, 0x06, 0xca, 0xc2, 0x9a, 0x70, 0x80, 0x7a,
+	0x89, 0x7e, 0xf7, 0x62, 0xe7, 0x77, 0x60, 0x07, 0x98, 0x3f, 0x87, 0xa0, 0x8f, 0xdb, 0x1c, 0xbc,
+	0xde, 0xe4, 0xcf, 0x21, 0xd6, 0x6c, 0x37, 0xf0, 0xcd, 0x63, 0x35, 0x4d, 0x73, 0x30, 0xef, 0xda,
+	0xc1, 0xe6, 0xa0, 0xa5, 0x4e, 0xa1, 0x2c, 0xa4, 0x76, 0xef, 0xab, 0x19, 0x74, 0x0d, 0xae, 0x3c,
+	0xb4, 0x7d, 0xdc, 0x32, 0x09, 0x5e, 0xed, 0xf7, 0x1b, 0x36, 0x09, 0x7c, 0xbb, 0x35, 0x60, 0x31,
+	0x49, 0x16, 0x55, 0x00, 0xf6, 0x30, 0x09, 0x1e, 0x3a, 0x76, 0xa7, 0x1b, 0xa8, 0x39, 0x84, 0xa0,
+	0xb2, 0xfa, 0xe9, 0xc0, 0xc7, 0x3b, 0x76, 0x1f, 0x3b, 0xb6, 0x8b, 0x89, 0x9a, 0xa7, 0x33, 0xbc,
+	0x87, 0xdd, 0x03, 0xdb, 0x25, 0x6a, 0x81, 0x06, 0x38, 0x9b, 0x7b, 0x7b, 0x3b, 0x2a, 0xac, 0xfc,
+	0x1d, 0x40, 0x91, 0x06, 0x1e, 0xbb, 0xd8, 0xa7, 0x05, 0xa9, 0xe8, 0xfb, 0xfc, 0x49, 0x2b, 0x12,
+	0xdb, 0xa5, 0xbf, 0x97, 0x64, 0xe5, 0xe0, 0x6c, 0x02, 0x26, 0x1e, 0xb9, 0x96, 0x7f, 0xfc, 0xcf,
+	0xff, 0xf9, 0xc7, 0xa9, 0x1c, 0xca, 0x2c, 0xf7, 0xe9, 0xb8, 0x87, 0xf2, 0x39, 0x29, 0x9a, 0x4b,
+	0xbc, 0x2a, 0x94, 0x34, 0xe6, 0x47, 0xa0, 0x82, 0xca, 0x34, 0xa3, 0x52, 0x40, 0xb9, 0x65, 0xc2,
+	0x47, 0xbf, 0x17, 0xbe, 0x0f, 0x41, 0xf3, 0xa3, 0x4f, 0x3a, 0x39, 0xa5, 0x53, 0x5e, 0x7a, 0xd6,
+	0x55, 0x46, 0x0a, 0x50, 0x7e, 0x59, 0x3e, 0xeb, 0xdb, 0x8d, 0xbd, 0xbf, 0x43, 0x57, 0x46, 0x1f,
+	0xdd, 0x48, 0x7a, 0xda, 0x78, 0x87, 0xa0, 0x38, 0xcb, 0x28, 0x96, 0x51, 0x71, 0x99, 0x71, 0xfe,
+	0x22, 0x75, 0x45, 0x50, 0x7f, 0xbc, 0xca, 0x12, 0xdd, 0x1c, 0x21, 0x21, 0xe0, 0xe1, 0x14, 0xb5,
+	0x53, 0xfb, 0xc5, 0x4c, 0xd7, 0xd8, 0x4c, 0xf3, 0x68, 0x36, 0x36, 0xd3, 0xe2, 0xbe, 0xa0, 0xde,
+	0x1d, 0x7d, 0x4d, 0x8c, 0xc4, 0x67, 0xeb, 0x24, 0x34, 0x9c, 0xed, 0xc6, 0x29, 0xbd, 0x62, 0xae,
+	0xab, 0x6c, 0xae, 0x59, 0x34, 0xb3, 0x6c, 0xe1, 0xc3, 0x45, 0x6b, 0xd0, 0xeb, 0x2f, 0x7a, 0x82,
+	0x6e, 0x2b, 0xf9, 0xdc, 0x06, 0x55, 0x43, 0x49, 0x0d, 0x61, 0xe1, 0x2c, 0xd7, 0x26, 0xf6, 0x25,
+	0xe7, 0x78, 0xa0, 0xdc, 0xad, 0x57, 0x96, 0xfb, 0x1c, 0x65, 0x91, 0x6d, 0x0d, 0x6d, 0x47, 0xe5,
+	0xec, 0x48, 0x5c, 0xa5, 0x6c, 0x87, 0xb4, 0xaf, 0x8c, 0xc1, 0x05, 0x5d, 0xc4, 0xe8, 0x96, 0x10,
+	0x2c, 0x1f, 0xd1, 0xbe, 0x45, 0x17, 0x1f, 0xa1, 0x0f, 0x13, 0xc5, 0xcc, 0xe8, 0xea, 0x78, 0xc5,
+	0xb0, 0x24, 0x5b, 0x9d, 0xd4, 0x25, 0x28, 0xcf, 0x33, 0xca, 0xd3, 0xa8, 0xbc, 0xcc, 0xd3, 0xf8,
+	0x8b, 0x84, 0x51, 0x6b, 0x25, 0x8b, 0xcb, 0xe5, 0x89, 0xc4, 0x61, 0xa3, 0x27, 0x32, 0xd2, 0x37,
+	0xe9, 0x44, 0xa8, 0xef, 0xbb, 0x18, 0xd6, 0x74, 0x3f, 0x8a, 0x1e, 0x16, 0xc9, 0x13, 0x91, 0xed,
+	0xd1, 0x13, 0x89, 0xc1, 0x05, 0xdd, 0x0a, 0xa3, 0x9b, 0x47, 0x59, 0xce, 0x39, 0xc8, 0x48, 0xbe,
+	0x1b, 0x0a, 0x17, 0x1c, 0x83, 0x8d, 0x2d, 0x38, 0xd9, 0x27, 0x08, 0x5f, 0x66, 0x84, 0x55, 0x54,
+	0x59, 0x26, 0xac, 0x7f, 0x51, 0x68, 0xff, 0xf7, 0xc2, 0xf7, 0x41, 0x52, 0x40, 0x45, 0x73, 0x54,
+	0x40, 0x23, 0xf0, 0x98, 0x80, 0x12, 0x41, 0x00, 0x8f, 0xbc, 0x26, 0x41, 0xd7, 0xa4, 0x16, 0x8f,
+	0x01, 0x43, 0xba, 0xd7, 0x27, 0x77, 0x4e, 0x3a, 0x60, 0xd3, 0xea, 0xd9, 0xee, 0xb2, 0xcf, 0x31,
+	0xd1, 0x87, 0x93, 0x9e, 0x88, 0xa0, 0x05, 0xa9, 0x91, 0x46, 0x7b, 0xc2, 0x09, 0x6f, 0x9d, 0x81,
+	0xc1, 0x67, 0x7d, 0x59, 0x59, 0x7b, 0xfd, 0xf3, 0x93, 0x9b, 0xca, 0xaf, 0x4e, 0x6e, 0x2a, 0xff,
+	0x71, 0x72, 0x53, 0xf9, 0xec, 0xcb, 0x9b, 0x97, 0x7e, 0xf5, 0xe5, 0xcd, 0x4b, 0xff, 0xfa, 0xe5,
+	0xcd, 0x4b, 0xbf, 0x7b, 0xa3, 0x85, 0xfd, 0xe0, 0x78, 0x29, 0xc0, 0xed, 0xee, 0x32, 0x25, 0xb4,
+	0x4c, 0xff, 0xf0, 0xc0, 0x41, 0x67, 0x99, 0xff, 0xf9, 0x82, 0x56, 0x96, 0x99, 0xe7, 0xfb, 0xff,
+	0x33, 0x00, 0xfe, 0xbc, 0x29, 0x7f, 0xcf, 0x40, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// YoloServiceClient is the client API for YoloService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type YoloServiceClient interface {
+	Ping(ctx context.Context, in *Ping_Request, opts ...grpc.CallOption) (*Ping_Response, error)
+	Status(ctx context.Context, in *Status_Request, opts ...grpc.CallOption) (*Status_Response, error)
+	Version(ctx context.Context, in *Version_Request, opts ...grpc.CallOption) (*Version_Response, error)
+	BuildList(ctx context.Context, in *BuildList_Request, opts ...grpc.CallOption) (*BuildList_Response, error)
+	BuildListFilters(ctx context.Context, in *BuildListFilters_Request, opts ...grpc.CallOption) (*BuildListFilters_Response, error)
+	DevDumpObjects(ctx context.Context, in *DevDumpObjects_Request, opts ...grpc.CallOption) (*DevDumpObjects_Response, error)
+	PromoteBuild(ctx context.Context, in *PromoteBuild_Request, opts ...grpc.CallOption) (*PromoteBuild_Response, error)
+	WhatsNew(ctx context.Context, in *WhatsNew_Request, opts ...grpc.CallOption) (*WhatsNew_Response, error)
+	BranchStats(ctx context.Context, in *BranchStats_Request, opts ...grpc.CallOption) (*BranchStats_Response, error)
+	SignArtifact(ctx context.Context, in *SignArtifact_Request, opts ...grpc.CallOption) (*SignArtifact_Response, error)
+	GetBuild(ctx context.Context, in *GetBuild_Request, opts ...grpc.CallOption) (*GetBuild_Response, error)
+	SearchBuilds(ctx context.Context, in *SearchBuilds_Request, opts ...grpc.CallOption) (*SearchBuilds_Response, error)
+	Summary(ctx context.Context, in *Summary_Request, opts ...grpc.CallOption) (*Summary_Response, error)
+	RefreshBuilds(ctx context.Context, in *RefreshBuilds_Request, opts ...grpc.CallOption) (*RefreshBuilds_Response, error)
+	// StreamBuildUpdates sends the recent builds, then the new and updated builds as they are ingested.
+	// it is only exposed over gRPC, /api/builds/stream is the HTTP equivalent.
+	StreamBuildUpdates(ctx context.Context, in *StreamBuildUpdates_Request, opts ...grpc.CallOption) (YoloService_StreamBuildUpdatesClient, error)
+}
+
+type yoloServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewYoloServiceClient(cc *grpc.ClientConn) YoloServiceClient {
+	return &yoloServiceClient{cc}
+}
+
+func (c *yoloServiceClient) Ping(ctx context.Context, in *Ping_Request, opts ...grpc.CallOption) (*Ping_Response, error) {
+	out := new(Ping_Response)
+	err := c.cc.Invoke(ctx, "/yolo.YoloService/Ping", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *Entity) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *yoloServiceClient) Status(ctx context.Context, in *Status_Request, opts ...grpc.CallOption) (*Status_Response, error) {
+	out := new(Status_Response)
+	err := c.cc.Invoke(ctx, "/yolo.YoloService/Status", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *Entity) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *yoloServiceClient) Version(ctx context.Context, in *Version_Request, opts ...grpc.CallOption) (*Version_Response, error) {
+	out := new(Version_Response)
+	err := c.cc.Invoke(ctx, "/yolo.YoloService/Version", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *Entity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.HasMergerequests) > 0 {
-		for iNdEx := len(m.HasMergerequests) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HasMergerequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYolopb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x6
-			i--
-			dAtA[i] = 0xba
-		}
-	}
-	if len(m.HasCommits) > 0 {
-		for iNdEx := len(m.HasCommits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HasCommits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYolopb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x6
-			i--
-			dAtA[i] = 0xb2
-		}
+func (c *yoloServiceClient) BuildList(ctx context.Context, in *BuildList_Request, opts ...grpc.CallOption) (*BuildList_Response, error) {
+	out := new(BuildList_Response)
+	err := c.cc.Invoke(ctx, "/yolo.YoloService/BuildList", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.HasProjects) > 0 {
-		for iNdEx := len(m.HasProjects) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HasProjects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYolopb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x6
-			i--
-			dAtA[i] = 0xaa
-		}
+	return out, nil
+}
+
+func (c *yoloServiceClient) BuildListFilters(ctx context.Context, in *BuildListFilters_Request, opts ...grpc.CallOption) (*BuildListFilters_Response, error) {
+	out := new(BuildListFilters_Response)
+	err := c.cc.Invoke(ctx, "/yolo.YoloService/BuildListFilters", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x4a
+	return out, nil
+}
+
+func (c *yoloServiceClient) DevDumpObjects(ctx context.Context, in *DevDumpObjects_Request, opts ...grpc.CallOption) (*DevDumpObjects_Response, error) {
+	out := new(DevDumpObjects_Response)
+	err := c.cc.Invoke(ctx, "/yolo.YoloService/DevDumpObjects", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Kind != 0 {
-		i = encodeVarintYolopb(dAtA, i, uint64(m.Kind))
-		i--
-		dAtA[i] = 0x40
+	return out, nil
+}
+
+func (c *yoloServiceClient) PromoteBuild(ctx context.Context, in *PromoteBuild_Request, opts ...grpc.CallOption) (*PromoteBuild_Response, error) {
+	out := new(PromoteBuild_Response)
+	err := c.cc.Invoke(ctx, "/yolo.YoloService/PromoteBuild", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.AvatarURL) > 0 {
-		i -= len(m.AvatarURL)
-		copy(dAtA[i:], m.AvatarURL)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.AvatarURL)))
-		i--
-		dAtA[i] = 0x3a
+	return out, nil
+}
+
+func (c *yoloServiceClient) WhatsNew(ctx context.Context, in *WhatsNew_Request, opts ...grpc.CallOption) (*WhatsNew_Response, error) {
+	out := new(WhatsNew_Response)
+	err := c.cc.Invoke(ctx, "/yolo.YoloService/WhatsNew", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Driver != 0 {
-		i = encodeVarintYolopb(dAtA, i, uint64(m.Driver))
-		i--
-		dAtA[i] = 0x30
+	return out, nil
+}
+
+func (c *yoloServiceClient) BranchStats(ctx context.Context, in *BranchStats_Request, opts ...grpc.CallOption) (*BranchStats_Response, error) {
+	out := new(BranchStats_Response)
+	err := c.cc.Invoke(ctx, "/yolo.YoloService/BranchStats", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x2a
+	return out, nil
+}
+
+func (c *yoloServiceClient) SignArtifact(ctx context.Context, in *SignArtifact_Request, opts ...grpc.CallOption) (*SignArtifact_Response, error) {
+	out := new(SignArtifact_Response)
+	err := c.cc.Invoke(ctx, "/yolo.YoloService/SignArtifact", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.UpdatedAt != nil {
-		n40, err40 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
-		if err40 != nil {
-			return 0, err40
-		}
-		i -= n40
-		i = encodeVarintYolopb(dAtA, i, uint64(n40))
-		i--
-		dAtA[i] = 0x22
+	return out, nil
+}
+
+func (c *yoloServiceClient) GetBuild(ctx context.Context, in *GetBuild_Request, opts ...grpc.CallOption) (*GetBuild_Response, error) {
+	out := new(GetBuild_Response)
+	err := c.cc.Invoke(ctx, "/yolo.YoloService/GetBuild", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.CreatedAt != nil {
-		n41, err41 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
-		if err41 != nil {
-			return 0, err41
-		}
-		i -= n41
-		i = encodeVarintYolopb(dAtA, i, uint64(n41))
-		i--
-		dAtA[i] = 0x1a
+	return out, nil
+}
+
+func (c *yoloServiceClient) SearchBuilds(ctx context.Context, in *SearchBuilds_Request, opts ...grpc.CallOption) (*SearchBuilds_Response, error) {
+	out := new(SearchBuilds_Response)
+	err := c.cc.Invoke(ctx, "/yolo.YoloService/SearchBuilds", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.YoloID) > 0 {
-		i -= len(m.YoloID)
-		copy(dAtA[i:], m.YoloID)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.YoloID)))
-		i--
-		dAtA[i] = 0x12
+	return out, nil
+}
+
+func (c *yoloServiceClient) Summary(ctx context.Context, in *Summary_Request, opts ...grpc.CallOption) (*Summary_Response, error) {
+	out := new(Summary_Response)
+	err := c.cc.Invoke(ctx, "/yolo.YoloService/Summary", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.ID)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *yoloServiceClient) RefreshBuilds(ctx context.Context, in *RefreshBuilds_Request, opts ...grpc.CallOption) (*RefreshBuilds_Response, error) {
+	out := new(RefreshBuilds_Response)
+	err := c.cc.Invoke(ctx, "/yolo.YoloService/RefreshBuilds", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *Artifact) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *yoloServiceClient) StreamBuildUpdates(ctx context.Context, in *StreamBuildUpdates_Request, opts ...grpc.CallOption) (YoloService_StreamBuildUpdatesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_YoloService_serviceDesc.Streams[0], "/yolo.YoloService/StreamBuildUpdates", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &yoloServiceStreamBuildUpdatesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *Artifact) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type YoloService_StreamBuildUpdatesClient interface {
+	Recv() (*StreamBuildUpdates_Response, error)
+	grpc.ClientStream
 }
 
-func (m *Artifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PListSignedURL) > 0 {
-		i -= len(m.PListSignedURL)
-		copy(dAtA[i:], m.PListSignedURL)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.PListSignedURL)))
-		i--
-		dAtA[i] = 0xc
-		i--
-		dAtA[i] = 0xd2
+type yoloServiceStreamBuildUpdatesClient struct {
+	grpc.ClientStream
+}
+
+func (x *yoloServiceStreamBuildUpdatesClient) Recv() (*StreamBuildUpdates_Response, error) {
+	m := new(StreamBuildUpdates_Response)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	if len(m.DLArtifactSignedURL) > 0 {
-		i -= len(m.DLArtifactSignedURL)
-		copy(dAtA[i:], m.DLArtifactSignedURL)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.DLArtifactSignedURL)))
-		i--
-		dAtA[i] = 0xc
-		i--
-		dAtA[i] = 0xca
+	return m, nil
+}
+
+// YoloServiceServer is the server API for YoloService service.
+type YoloServiceServer interface {
+	Ping(context.Context, *Ping_Request) (*Ping_Response, error)
+	Status(context.Context, *Status_Request) (*Status_Response, error)
+	Version(context.Context, *Version_Request) (*Version_Response, error)
+	BuildList(context.Context, *BuildList_Request) (*BuildList_Response, error)
+	BuildListFilters(context.Context, *BuildListFilters_Request) (*BuildListFilters_Response, error)
+	DevDumpObjects(context.Context, *DevDumpObjects_Request) (*DevDumpObjects_Response, error)
+	PromoteBuild(context.Context, *PromoteBuild_Request) (*PromoteBuild_Response, error)
+	WhatsNew(context.Context, *WhatsNew_Request) (*WhatsNew_Response, error)
+	BranchStats(context.Context, *BranchStats_Request) (*BranchStats_Response, error)
+	SignArtifact(context.Context, *SignArtifact_Request) (*SignArtifact_Response, error)
+	GetBuild(context.Context, *GetBuild_Request) (*GetBuild_Response, error)
+	SearchBuilds(context.Context, *SearchBuilds_Request) (*SearchBuilds_Response, error)
+	Summary(context.Context, *Summary_Request) (*Summary_Response, error)
+	RefreshBuilds(context.Context, *RefreshBuilds_Request) (*RefreshBuilds_Response, error)
+	// StreamBuildUpdates sends the recent builds, then the new and updated builds as they are ingested.
+	// it is only exposed over gRPC, /api/builds/stream is the HTTP equivalent.
+	StreamBuildUpdates(*StreamBuildUpdates_Request, YoloService_StreamBuildUpdatesServer) error
+}
+
+// UnimplementedYoloServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedYoloServiceServer struct {
+}
+
+func (*UnimplementedYoloServiceServer) Ping(ctx context.Context, req *Ping_Request) (*Ping_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (*UnimplementedYoloServiceServer) Status(ctx context.Context, req *Status_Request) (*Status_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+}
+func (*UnimplementedYoloServiceServer) Version(ctx context.Context, req *Version_Request) (*Version_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
+}
+func (*UnimplementedYoloServiceServer) BuildList(ctx context.Context, req *BuildList_Request) (*BuildList_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuildList not implemented")
+}
+func (*UnimplementedYoloServiceServer) BuildListFilters(ctx context.Context, req *BuildListFilters_Request) (*BuildListFilters_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuildListFilters not implemented")
+}
+func (*UnimplementedYoloServiceServer) DevDumpObjects(ctx context.Context, req *DevDumpObjects_Request) (*DevDumpObjects_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DevDumpObjects not implemented")
+}
+func (*UnimplementedYoloServiceServer) PromoteBuild(ctx context.Context, req *PromoteBuild_Request) (*PromoteBuild_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteBuild not implemented")
+}
+func (*UnimplementedYoloServiceServer) WhatsNew(ctx context.Context, req *WhatsNew_Request) (*WhatsNew_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhatsNew not implemented")
+}
+func (*UnimplementedYoloServiceServer) BranchStats(ctx context.Context, req *BranchStats_Request) (*BranchStats_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BranchStats not implemented")
+}
+func (*UnimplementedYoloServiceServer) SignArtifact(ctx context.Context, req *SignArtifact_Request) (*SignArtifact_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignArtifact not implemented")
+}
+func (*UnimplementedYoloServiceServer) GetBuild(ctx context.Context, req *GetBuild_Request) (*GetBuild_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBuild not implemented")
+}
+func (*UnimplementedYoloServiceServer) SearchBuilds(ctx context.Context, req *SearchBuilds_Request) (*SearchBuilds_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchBuilds not implemented")
+}
+func (*UnimplementedYoloServiceServer) Summary(ctx context.Context, req *Summary_Request) (*Summary_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Summary not implemented")
+}
+func (*UnimplementedYoloServiceServer) RefreshBuilds(ctx context.Context, req *RefreshBuilds_Request) (*RefreshBuilds_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshBuilds not implemented")
+}
+func (*UnimplementedYoloServiceServer) StreamBuildUpdates(req *StreamBuildUpdates_Request, srv YoloService_StreamBuildUpdatesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBuildUpdates not implemented")
+}
+
+func RegisterYoloServiceServer(s *grpc.Server, srv YoloServiceServer) {
+	s.RegisterService(&_YoloService_serviceDesc, srv)
+}
+
+func _YoloService_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Ping_Request)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.DownloadsCount != 0 {
-		i = encodeVarintYolopb(dAtA, i, uint64(m.DownloadsCount))
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xd0
+	if interceptor == nil {
+		return srv.(YoloServiceServer).Ping(ctx, in)
 	}
-	if len(m.Downloads) > 0 {
-		for iNdEx := len(m.Downloads) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Downloads[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYolopb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x6
-			i--
-			dAtA[i] = 0xca
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yolo.YoloService/Ping",
 	}
-	if len(m.HasReleaseID) > 0 {
-		i -= len(m.HasReleaseID)
-		copy(dAtA[i:], m.HasReleaseID)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.HasReleaseID)))
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xc2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoloServiceServer).Ping(ctx, req.(*Ping_Request))
 	}
-	if m.HasRelease != nil {
-		{
-			size, err := m.HasRelease.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYolopb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xba
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoloService_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Status_Request)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.HasBuildID) > 0 {
-		i -= len(m.HasBuildID)
-		copy(dAtA[i:], m.HasBuildID)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.HasBuildID)))
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xb2
+	if interceptor == nil {
+		return srv.(YoloServiceServer).Status(ctx, in)
 	}
-	if m.HasBuild != nil {
-		{
-			size, err := m.HasBuild.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYolopb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xaa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yolo.YoloService/Status",
 	}
-	if len(m.BundleIcon) > 0 {
-		i -= len(m.BundleIcon)
-		copy(dAtA[i:], m.BundleIcon)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.BundleIcon)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoloServiceServer).Status(ctx, req.(*Status_Request))
 	}
-	if len(m.BundleID) > 0 {
-		i -= len(m.BundleID)
-		copy(dAtA[i:], m.BundleID)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.BundleID)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoloService_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Version_Request)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.BundleVersion) > 0 {
-		i -= len(m.BundleVersion)
-		copy(dAtA[i:], m.BundleVersion)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.BundleVersion)))
-		i--
-		dAtA[i] = 0x7a
+	if interceptor == nil {
+		return srv.(YoloServiceServer).Version(ctx, in)
 	}
-	if len(m.BundleName) > 0 {
-		i -= len(m.BundleName)
-		copy(dAtA[i:], m.BundleName)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.BundleName)))
-		i--
-		dAtA[i] = 0x72
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yolo.YoloService/Version",
 	}
-	if m.Driver != 0 {
-		i = encodeVarintYolopb(dAtA, i, uint64(m.Driver))
-		i--
-		dAtA[i] = 0x68
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoloServiceServer).Version(ctx, req.(*Version_Request))
 	}
-	if m.Kind != 0 {
-		i = encodeVarintYolopb(dAtA, i, uint64(m.Kind))
-		i--
-		dAtA[i] = 0x60
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoloService_BuildList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildList_Request)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.State != 0 {
-		i = encodeVarintYolopb(dAtA, i, uint64(m.State))
-		i--
-		dAtA[i] = 0x58
+	if interceptor == nil {
+		return srv.(YoloServiceServer).BuildList(ctx, in)
 	}
-	if len(m.Sha256Sum) > 0 {
-		i -= len(m.Sha256Sum)
-		copy(dAtA[i:], m.Sha256Sum)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Sha256Sum)))
-		i--
-		dAtA[i] = 0x52
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yolo.YoloService/BuildList",
 	}
-	if len(m.Sha1Sum) > 0 {
-		i -= len(m.Sha1Sum)
-		copy(dAtA[i:], m.Sha1Sum)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Sha1Sum)))
-		i--
-		dAtA[i] = 0x4a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoloServiceServer).BuildList(ctx, req.(*BuildList_Request))
 	}
-	if len(m.MimeType) > 0 {
-		i -= len(m.MimeType)
-		copy(dAtA[i:], m.MimeType)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.MimeType)))
-		i--
-		dAtA[i] = 0x42
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoloService_BuildListFilters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildListFilters_Request)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.DownloadURL) > 0 {
-		i -= len(m.DownloadURL)
-		copy(dAtA[i:], m.DownloadURL)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.DownloadURL)))
-		i--
-		dAtA[i] = 0x3a
+	if interceptor == nil {
+		return srv.(YoloServiceServer).BuildListFilters(ctx, in)
 	}
-	if len(m.LocalPath) > 0 {
-		i -= len(m.LocalPath)
-		copy(dAtA[i:], m.LocalPath)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.LocalPath)))
-		i--
-		dAtA[i] = 0x32
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yolo.YoloService/BuildListFilters",
 	}
-	if m.FileSize != 0 {
-		i = encodeVarintYolopb(dAtA, i, uint64(m.FileSize))
-		i--
-		dAtA[i] = 0x28
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoloServiceServer).BuildListFilters(ctx, req.(*BuildListFilters_Request))
 	}
-	if m.UpdatedAt != nil {
-		n44, err44 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
-		if err44 != nil {
-			return 0, err44
-		}
-		i -= n44
-		i = encodeVarintYolopb(dAtA, i, uint64(n44))
-		i--
-		dAtA[i] = 0x22
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoloService_DevDumpObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DevDumpObjects_Request)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.CreatedAt != nil {
-		n45, err45 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
-		if err45 != nil {
-			return 0, err45
-		}
-		i -= n45
-		i = encodeVarintYolopb(dAtA, i, uint64(n45))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(YoloServiceServer).DevDumpObjects(ctx, in)
 	}
-	if len(m.YoloID) > 0 {
-		i -= len(m.YoloID)
-		copy(dAtA[i:], m.YoloID)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.YoloID)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yolo.YoloService/DevDumpObjects",
 	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.ID)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoloServiceServer).DevDumpObjects(ctx, req.(*DevDumpObjects_Request))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Download) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _YoloService_PromoteBuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteBuild_Request)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(YoloServiceServer).PromoteBuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yolo.YoloService/PromoteBuild",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoloServiceServer).PromoteBuild(ctx, req.(*PromoteBuild_Request))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Download) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _YoloService_WhatsNew_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WhatsNew_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoloServiceServer).WhatsNew(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yolo.YoloService/WhatsNew",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoloServiceServer).WhatsNew(ctx, req.(*WhatsNew_Request))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Download) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.HasArtifactID) > 0 {
-		i -= len(m.HasArtifactID)
-		copy(dAtA[i:], m.HasArtifactID)
-		i = encodeVarintYolopb(dAtA, i, uint64(len(m.HasArtifactID)))
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xb2
+func _YoloService_BranchStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BranchStats_Request)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.HasArtifact != nil {
-		{
-			size, err := m.HasArtifact.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYolopb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xaa
+	if interceptor == nil {
+		return srv.(YoloServiceServer).BranchStats(ctx, in)
 	}
-	if m.CreatedAt != nil {
-		n47, err47 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
-		if err47 != nil {
-			return 0, err47
-		}
-		i -= n47
-		i = encodeVarintYolopb(dAtA, i, uint64(n47))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yolo.YoloService/BranchStats",
 	}
-	if m.ID != 0 {
-		i = encodeVarintYolopb(dAtA, i, uint64(m.ID))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoloServiceServer).BranchStats(ctx, req.(*BranchStats_Request))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Batch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _YoloService_SignArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignArtifact_Request)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Batch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Batch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MergeRequests) > 0 {
-		for iNdEx := len(m.MergeRequests) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MergeRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYolopb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
+	if interceptor == nil {
+		return srv.(YoloServiceServer).SignArtifact(ctx, in)
 	}
-	if len(m.Commits) > 0 {
-		for iNdEx := len(m.Commits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Commits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYolopb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yolo.YoloService/SignArtifact",
 	}
-	if len(m.Releases) > 0 {
-		for iNdEx := len(m.Releases) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Releases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYolopb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoloServiceServer).SignArtifact(ctx, req.(*SignArtifact_Request))
 	}
-	if len(m.Entities) > 0 {
-		for iNdEx := len(m.Entities) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Entities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYolopb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoloService_GetBuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBuild_Request)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Projects) > 0 {
-		for iNdEx := len(m.Projects) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Projects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYolopb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(YoloServiceServer).GetBuild(ctx, in)
 	}
-	if len(m.Artifacts) > 0 {
-		for iNdEx := len(m.Artifacts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Artifacts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYolopb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yolo.YoloService/GetBuild",
 	}
-	if len(m.Builds) > 0 {
-		for iNdEx := len(m.Builds) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Builds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYolopb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoloServiceServer).GetBuild(ctx, req.(*GetBuild_Request))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func encodeVarintYolopb(dAtA []byte, offset int, v uint64) int {
-	offset -= sovYolopb(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _YoloService_SearchBuilds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchBuilds_Request)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Ping) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(YoloServiceServer).SearchBuilds(ctx, in)
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *Ping_Request) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yolo.YoloService/SearchBuilds",
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *Ping_Response) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoloServiceServer).SearchBuilds(ctx, req.(*SearchBuilds_Request))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DevDumpObjects) Size() (n int) {
-	if m == nil {
-		return 0
+func _YoloService_Summary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Summary_Request)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *DevDumpObjects_Request) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(YoloServiceServer).Summary(ctx, in)
 	}
-	var l int
-	_ = l
-	if m.WithPreloading {
-		n += 2
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yolo.YoloService/Summary",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoloServiceServer).Summary(ctx, req.(*Summary_Request))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DevDumpObjects_Response) Size() (n int) {
-	if m == nil {
-		return 0
+func _YoloService_RefreshBuilds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshBuilds_Request)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Batch != nil {
-		l = m.Batch.Size()
-		n += 1 + l + sovYolopb(uint64(l))
+	if interceptor == nil {
+		return srv.(YoloServiceServer).RefreshBuilds(ctx, in)
 	}
-	if len(m.Downloads) > 0 {
-		for _, e := range m.Downloads {
-			l = e.Size()
-			n += 1 + l + sovYolopb(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yolo.YoloService/RefreshBuilds",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoloServiceServer).RefreshBuilds(ctx, req.(*RefreshBuilds_Request))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Status) Size() (n int) {
-	if m == nil {
-		return 0
+func _YoloService_StreamBuildUpdates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamBuildUpdates_Request)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	var l int
-	_ = l
-	return n
+	return srv.(YoloServiceServer).StreamBuildUpdates(m, &yoloServiceStreamBuildUpdatesServer{stream})
 }
 
-func (m *Status_Request) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+type YoloService_StreamBuildUpdatesServer interface {
+	Send(*StreamBuildUpdates_Response) error
+	grpc.ServerStream
 }
 
-func (m *Status_Response) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Uptime != 0 {
-		n += 1 + sovYolopb(uint64(m.Uptime))
-	}
-	l = len(m.DbErr)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	if m.NbEntities != 0 {
-		n += 1 + sovYolopb(uint64(m.NbEntities))
-	}
-	if m.NbProjects != 0 {
-		n += 1 + sovYolopb(uint64(m.NbProjects))
-	}
-	if m.NbCommits != 0 {
-		n += 1 + sovYolopb(uint64(m.NbCommits))
-	}
-	if m.NbReleases != 0 {
-		n += 1 + sovYolopb(uint64(m.NbReleases))
-	}
-	if m.NbBuilds != 0 {
-		n += 1 + sovYolopb(uint64(m.NbBuilds))
-	}
-	if m.NbMergeRequests != 0 {
-		n += 1 + sovYolopb(uint64(m.NbMergeRequests))
-	}
-	return n
+type yoloServiceStreamBuildUpdatesServer struct {
+	grpc.ServerStream
 }
 
-func (m *BuildList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (x *yoloServiceStreamBuildUpdatesServer) Send(m *StreamBuildUpdates_Response) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *BuildList_Request) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Limit != 0 {
-		n += 1 + sovYolopb(uint64(m.Limit))
-	}
-	if len(m.ArtifactKinds) > 0 {
-		l = 0
-		for _, e := range m.ArtifactKinds {
-			l += sovYolopb(uint64(e))
-		}
-		n += 1 + sovYolopb(uint64(l)) + l
-	}
-	if m.WithArtifacts {
-		n += 2
-	}
-	if len(m.BuildID) > 0 {
-		for _, s := range m.BuildID {
-			l = len(s)
-			n += 1 + l + sovYolopb(uint64(l))
-		}
-	}
-	if len(m.ProjectID) > 0 {
-		for _, s := range m.ProjectID {
-			l = len(s)
-			n += 1 + l + sovYolopb(uint64(l))
-		}
-	}
-	if len(m.ArtifactID) > 0 {
-		for _, s := range m.ArtifactID {
-			l = len(s)
-			n += 1 + l + sovYolopb(uint64(l))
-		}
-	}
-	if len(m.BuildDriver) > 0 {
-		l = 0
-		for _, e := range m.BuildDriver {
-			l += sovYolopb(uint64(e))
-		}
-		n += 1 + sovYolopb(uint64(l)) + l
-	}
-	if len(m.BuildState) > 0 {
-		l = 0
-		for _, e := range m.BuildState {
-			l += sovYolopb(uint64(e))
-		}
-		n += 1 + sovYolopb(uint64(l)) + l
-	}
-	if len(m.MergeRequestID) > 0 {
-		for _, s := range m.MergeRequestID {
-			l = len(s)
-			n += 1 + l + sovYolopb(uint64(l))
-		}
-	}
-	if len(m.MergeRequestAuthorID) > 0 {
-		for _, s := range m.MergeRequestAuthorID {
-			l = len(s)
-			n += 1 + l + sovYolopb(uint64(l))
-		}
-	}
-	if m.WithMergerequest {
-		n += 2
-	}
-	if len(m.MergerequestState) > 0 {
-		l = 0
-		for _, e := range m.MergerequestState {
-			l += sovYolopb(uint64(e))
-		}
-		n += 1 + sovYolopb(uint64(l)) + l
-	}
-	if len(m.Branch) > 0 {
-		for _, s := range m.Branch {
-			l = len(s)
-			n += 1 + l + sovYolopb(uint64(l))
-		}
-	}
-	if m.WithNoMergerequest {
-		n += 2
-	}
-	if m.SortByCommitDate {
-		n += 2
-	}
-	return n
+var _YoloService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "yolo.YoloService",
+	HandlerType: (*YoloServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Ping",
+			Handler:    _YoloService_Ping_Handler,
+		},
+		{
+			MethodName: "Status",
+			Handler:    _YoloService_Status_Handler,
+		},
+		{
+			MethodName: "Version",
+			Handler:    _YoloService_Version_Handler,
+		},
+		{
+			MethodName: "BuildList",
+			Handler:    _YoloService_BuildList_Handler,
+		},
+		{
+			MethodName: "BuildListFilters",
+			Handler:    _YoloService_BuildListFilters_Handler,
+		},
+		{
+			MethodName: "DevDumpObjects",
+			Handler:    _YoloService_DevDumpObjects_Handler,
+		},
+		{
+			MethodName: "PromoteBuild",
+			Handler:    _YoloService_PromoteBuild_Handler,
+		},
+		{
+			MethodName: "WhatsNew",
+			Handler:    _YoloService_WhatsNew_Handler,
+		},
+		{
+			MethodName: "BranchStats",
+			Handler:    _YoloService_BranchStats_Handler,
+		},
+		{
+			MethodName: "SignArtifact",
+			Handler:    _YoloService_SignArtifact_Handler,
+		},
+		{
+			MethodName: "GetBuild",
+			Handler:    _YoloService_GetBuild_Handler,
+		},
+		{
+			MethodName: "SearchBuilds",
+			Handler:    _YoloService_SearchBuilds_Handler,
+		},
+		{
+			MethodName: "Summary",
+			Handler:    _YoloService_Summary_Handler,
+		},
+		{
+			MethodName: "RefreshBuilds",
+			Handler:    _YoloService_RefreshBuilds_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamBuildUpdates",
+			Handler:       _YoloService_StreamBuildUpdates_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "yolopb.proto",
 }
 
-func (m *BuildList_Response) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Ping) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Ping) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Ping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Builds) > 0 {
-		for _, e := range m.Builds {
-			l = e.Size()
-			n += 1 + l + sovYolopb(uint64(l))
-		}
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BuildListFilters) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Ping_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Ping_Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Ping_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BuildListFilters_Request) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Ping_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Ping_Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Ping_Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BuildListFilters_Response) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DevDumpObjects) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Entities) > 0 {
-		for _, e := range m.Entities {
-			l = e.Size()
-			n += 1 + l + sovYolopb(uint64(l))
-		}
+	return dAtA[:n], nil
+}
+
+func (m *DevDumpObjects) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DevDumpObjects) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DevDumpObjects_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Projects) > 0 {
-		for _, e := range m.Projects {
-			l = e.Size()
-			n += 1 + l + sovYolopb(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *DevDumpObjects_Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DevDumpObjects_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WithPreloading {
+		i--
+		if m.WithPreloading {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MetadataOverride) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DevDumpObjects_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DevDumpObjects_Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DevDumpObjects_Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Branch)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	l = len(m.HasCommitID)
-	if l > 0 {
-		n += 2 + l + sovYolopb(uint64(l))
+	if len(m.Downloads) > 0 {
+		for iNdEx := len(m.Downloads) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Downloads[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYolopb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.HasProjectID)
-	if l > 0 {
-		n += 2 + l + sovYolopb(uint64(l))
+	if m.Batch != nil {
+		{
+			size, err := m.Batch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYolopb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.HasMergeRequestID)
-	if l > 0 {
-		n += 2 + l + sovYolopb(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *Status) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Build) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Status) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *Status_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Status_Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Status_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *Status_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.YoloID)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *Status_Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Status_Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NbMergeRequests != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.NbMergeRequests))
+		i--
+		dAtA[i] = 0x78
 	}
-	if m.CreatedAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
-		n += 1 + l + sovYolopb(uint64(l))
+	if m.NbBuilds != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.NbBuilds))
+		i--
+		dAtA[i] = 0x70
 	}
-	if m.UpdatedAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt)
-		n += 1 + l + sovYolopb(uint64(l))
+	if m.NbReleases != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.NbReleases))
+		i--
+		dAtA[i] = 0x68
 	}
-	if m.State != 0 {
-		n += 1 + sovYolopb(uint64(m.State))
+	if m.NbCommits != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.NbCommits))
+		i--
+		dAtA[i] = 0x60
 	}
-	if m.CompletedAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CompletedAt)
-		n += 1 + l + sovYolopb(uint64(l))
+	if m.NbProjects != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.NbProjects))
+		i--
+		dAtA[i] = 0x58
 	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
+	if m.NbEntities != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.NbEntities))
+		i--
+		dAtA[i] = 0x50
 	}
-	if m.StartedAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedAt)
-		n += 1 + l + sovYolopb(uint64(l))
+	if m.Version != nil {
+		{
+			size, err := m.Version.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYolopb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.FinishedAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.FinishedAt)
-		n += 1 + l + sovYolopb(uint64(l))
+	if len(m.Drivers) > 0 {
+		for iNdEx := len(m.Drivers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Drivers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYolopb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.CommitURL)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
+	if m.EventRetentionSeconds != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.EventRetentionSeconds))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Branch)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
+	if len(m.DbErr) > 0 {
+		i -= len(m.DbErr)
+		copy(dAtA[i:], m.DbErr)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.DbErr)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Driver != 0 {
-		n += 1 + sovYolopb(uint64(m.Driver))
-	}
-	l = len(m.ShortID)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	l = len(m.VCSTag)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	l = len(m.VCSTagURL)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	l = len(m.RawBranch)
-	if l > 0 {
-		n += 2 + l + sovYolopb(uint64(l))
-	}
-	if m.HasRawCommit != nil {
-		l = m.HasRawCommit.Size()
-		n += 2 + l + sovYolopb(uint64(l))
+	if m.Uptime != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.Uptime))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.HasRawProject != nil {
-		l = m.HasRawProject.Size()
-		n += 2 + l + sovYolopb(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *Status_DriverStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.HasRawMergerequest != nil {
-		l = m.HasRawMergerequest.Size()
-		n += 2 + l + sovYolopb(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *Status_DriverStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Status_DriverStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetryAt != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RetryAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RetryAt):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintYolopb(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.HasRawCommitID)
-	if l > 0 {
-		n += 2 + l + sovYolopb(uint64(l))
+	if m.ConsecutiveFailures != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.ConsecutiveFailures))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.HasRawProjectID)
-	if l > 0 {
-		n += 2 + l + sovYolopb(uint64(l))
+	if m.Circuit != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.Circuit))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.HasRawMergerequestID)
-	if l > 0 {
-		n += 2 + l + sovYolopb(uint64(l))
+	if m.Driver != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.Driver))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.HasArtifacts) > 0 {
-		for _, e := range m.HasArtifacts {
-			l = e.Size()
-			n += 2 + l + sovYolopb(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *Version) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.HasCommit != nil {
-		l = m.HasCommit.Size()
-		n += 2 + l + sovYolopb(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *Version) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Version) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *Version_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.HasCommitID)
-	if l > 0 {
-		n += 2 + l + sovYolopb(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *Version_Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Version_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *Version_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.HasProject != nil {
-		l = m.HasProject.Size()
-		n += 2 + l + sovYolopb(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *Version_Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Version_Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GoVersion) > 0 {
+		i -= len(m.GoVersion)
+		copy(dAtA[i:], m.GoVersion)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.GoVersion)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.HasProjectID)
-	if l > 0 {
-		n += 2 + l + sovYolopb(uint64(l))
+	if len(m.BuildDate) > 0 {
+		i -= len(m.BuildDate)
+		copy(dAtA[i:], m.BuildDate)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.BuildDate)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.HasMergerequest != nil {
-		l = m.HasMergerequest.Size()
-		n += 2 + l + sovYolopb(uint64(l))
+	if len(m.Commit) > 0 {
+		i -= len(m.Commit)
+		copy(dAtA[i:], m.Commit)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Commit)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.HasMergerequestID)
-	if l > 0 {
-		n += 2 + l + sovYolopb(uint64(l))
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Release) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BuildList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BuildList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuildList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *BuildList_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.YoloID)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *BuildList_Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuildList_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TaggedOnly {
+		i--
+		if m.TaggedOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
 	}
-	if m.CreatedAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
-		n += 1 + l + sovYolopb(uint64(l))
+	if len(m.ExternalState) > 0 {
+		for iNdEx := len(m.ExternalState) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExternalState[iNdEx])
+			copy(dAtA[i:], m.ExternalState[iNdEx])
+			i = encodeVarintYolopb(dAtA, i, uint64(len(m.ExternalState[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
 	}
-	if m.UpdatedAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt)
-		n += 1 + l + sovYolopb(uint64(l))
+	if m.SortOrder != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.SortOrder))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
 	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
+	if m.SortBy != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.SortBy))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
 	}
-	if m.Driver != 0 {
-		n += 1 + sovYolopb(uint64(m.Driver))
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.PageToken)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
 	}
-	l = len(m.CommitURL)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
+	if len(m.ArtifactArch) > 0 {
+		for iNdEx := len(m.ArtifactArch) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ArtifactArch[iNdEx])
+			copy(dAtA[i:], m.ArtifactArch[iNdEx])
+			i = encodeVarintYolopb(dAtA, i, uint64(len(m.ArtifactArch[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
 	}
-	l = len(m.ShortID)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
+	if m.Offset != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
 	}
-	if len(m.HasArtifacts) > 0 {
-		for _, e := range m.HasArtifacts {
-			l = e.Size()
-			n += 2 + l + sovYolopb(uint64(l))
+	if m.OverBudget {
+		i--
+		if m.OverBudget {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
 	}
-	if m.HasCommit != nil {
-		l = m.HasCommit.Size()
-		n += 2 + l + sovYolopb(uint64(l))
+	if len(m.OwnerTeam) > 0 {
+		for iNdEx := len(m.OwnerTeam) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OwnerTeam[iNdEx])
+			copy(dAtA[i:], m.OwnerTeam[iNdEx])
+			i = encodeVarintYolopb(dAtA, i, uint64(len(m.OwnerTeam[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
 	}
-	if m.HasProject != nil {
-		l = m.HasProject.Size()
-		n += 2 + l + sovYolopb(uint64(l))
+	if len(m.BuildConfig) > 0 {
+		for iNdEx := len(m.BuildConfig) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BuildConfig[iNdEx])
+			copy(dAtA[i:], m.BuildConfig[iNdEx])
+			i = encodeVarintYolopb(dAtA, i, uint64(len(m.BuildConfig[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
 	}
-	if m.HasMergerequest != nil {
-		l = m.HasMergerequest.Size()
-		n += 2 + l + sovYolopb(uint64(l))
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	return n
-}
-
-func (m *Commit) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.SortByCommitDate {
+		i--
+		if m.SortByCommitDate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
 	}
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
+	if m.WithNoMergerequest {
+		i--
+		if m.WithNoMergerequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
 	}
-	l = len(m.YoloID)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
+	if len(m.Branch) > 0 {
+		for iNdEx := len(m.Branch) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Branch[iNdEx])
+			copy(dAtA[i:], m.Branch[iNdEx])
+			i = encodeVarintYolopb(dAtA, i, uint64(len(m.Branch[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
 	}
-	if m.CreatedAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
-		n += 1 + l + sovYolopb(uint64(l))
+	if len(m.MergerequestState) > 0 {
+		dAtA5 := make([]byte, len(m.MergerequestState)*10)
+		var j4 int
+		for _, num := range m.MergerequestState {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintYolopb(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x62
 	}
-	if m.UpdatedAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt)
-		n += 1 + l + sovYolopb(uint64(l))
+	if m.WithMergerequest {
+		i--
+		if m.WithMergerequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
 	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
+	if len(m.MergeRequestAuthorID) > 0 {
+		for iNdEx := len(m.MergeRequestAuthorID) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MergeRequestAuthorID[iNdEx])
+			copy(dAtA[i:], m.MergeRequestAuthorID[iNdEx])
+			i = encodeVarintYolopb(dAtA, i, uint64(len(m.MergeRequestAuthorID[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	if m.Driver != 0 {
-		n += 1 + sovYolopb(uint64(m.Driver))
+	if len(m.MergeRequestID) > 0 {
+		for iNdEx := len(m.MergeRequestID) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MergeRequestID[iNdEx])
+			copy(dAtA[i:], m.MergeRequestID[iNdEx])
+			i = encodeVarintYolopb(dAtA, i, uint64(len(m.MergeRequestID[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
 	}
-	l = len(m.Branch)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
+	if len(m.BuildState) > 0 {
+		dAtA7 := make([]byte, len(m.BuildState)*10)
+		var j6 int
+		for _, num := range m.BuildState {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintYolopb(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x42
 	}
-	if len(m.HasReleases) > 0 {
-		for _, e := range m.HasReleases {
-			l = e.Size()
-			n += 2 + l + sovYolopb(uint64(l))
+	if len(m.BuildDriver) > 0 {
+		dAtA9 := make([]byte, len(m.BuildDriver)*10)
+		var j8 int
+		for _, num := range m.BuildDriver {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
 		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintYolopb(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if len(m.HasBuilds) > 0 {
-		for _, e := range m.HasBuilds {
-			l = e.Size()
-			n += 2 + l + sovYolopb(uint64(l))
+	if len(m.ArtifactID) > 0 {
+		for iNdEx := len(m.ArtifactID) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ArtifactID[iNdEx])
+			copy(dAtA[i:], m.ArtifactID[iNdEx])
+			i = encodeVarintYolopb(dAtA, i, uint64(len(m.ArtifactID[iNdEx])))
+			i--
+			dAtA[i] = 0x32
 		}
 	}
-	if m.HasProject != nil {
-		l = m.HasProject.Size()
-		n += 2 + l + sovYolopb(uint64(l))
+	if len(m.ProjectID) > 0 {
+		for iNdEx := len(m.ProjectID) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProjectID[iNdEx])
+			copy(dAtA[i:], m.ProjectID[iNdEx])
+			i = encodeVarintYolopb(dAtA, i, uint64(len(m.ProjectID[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if m.HasAuthor != nil {
-		l = m.HasAuthor.Size()
-		n += 2 + l + sovYolopb(uint64(l))
+	if len(m.BuildID) > 0 {
+		for iNdEx := len(m.BuildID) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BuildID[iNdEx])
+			copy(dAtA[i:], m.BuildID[iNdEx])
+			i = encodeVarintYolopb(dAtA, i, uint64(len(m.BuildID[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.HasMergerequest != nil {
-		l = m.HasMergerequest.Size()
-		n += 2 + l + sovYolopb(uint64(l))
+	if m.WithArtifacts {
+		i--
+		if m.WithArtifacts {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if len(m.ArtifactKinds) > 0 {
+		dAtA11 := make([]byte, len(m.ArtifactKinds)*10)
+		var j10 int
+		for _, num := range m.ArtifactKinds {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintYolopb(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Limit != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MergeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BuildList_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BuildList_Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuildList_Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	l = len(m.YoloID)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	if m.CreatedAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
-		n += 1 + l + sovYolopb(uint64(l))
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.UpdatedAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt)
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	if m.MergedAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.MergedAt)
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	if m.Driver != 0 {
-		n += 1 + sovYolopb(uint64(m.Driver))
-	}
-	l = len(m.Branch)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	if m.State != 0 {
-		n += 1 + sovYolopb(uint64(m.State))
-	}
-	l = len(m.CommitURL)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	l = len(m.BranchURL)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	l = len(m.ShortID)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	if m.IsWIP {
-		n += 2
-	}
-	if len(m.HasReleases) > 0 {
-		for _, e := range m.HasReleases {
-			l = e.Size()
-			n += 2 + l + sovYolopb(uint64(l))
-		}
-	}
-	if len(m.HasBuilds) > 0 {
-		for _, e := range m.HasBuilds {
-			l = e.Size()
-			n += 2 + l + sovYolopb(uint64(l))
-		}
-	}
-	if len(m.HasAssignees) > 0 {
-		for _, e := range m.HasAssignees {
-			l = e.Size()
-			n += 2 + l + sovYolopb(uint64(l))
-		}
-	}
-	if len(m.HasReviewers) > 0 {
-		for _, e := range m.HasReviewers {
-			l = e.Size()
-			n += 2 + l + sovYolopb(uint64(l))
+	if len(m.Builds) > 0 {
+		for iNdEx := len(m.Builds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Builds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYolopb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.HasProject != nil {
-		l = m.HasProject.Size()
-		n += 2 + l + sovYolopb(uint64(l))
-	}
-	l = len(m.HasProjectID)
-	if l > 0 {
-		n += 2 + l + sovYolopb(uint64(l))
-	}
-	if m.HasAuthor != nil {
-		l = m.HasAuthor.Size()
-		n += 2 + l + sovYolopb(uint64(l))
-	}
-	l = len(m.HasAuthorID)
-	if l > 0 {
-		n += 2 + l + sovYolopb(uint64(l))
-	}
-	if m.HasCommit != nil {
-		l = m.HasCommit.Size()
-		n += 2 + l + sovYolopb(uint64(l))
-	}
-	l = len(m.HasCommitID)
-	if l > 0 {
-		n += 2 + l + sovYolopb(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Project) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PromoteBuild) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PromoteBuild) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PromoteBuild) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	l = len(m.YoloID)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	if m.CreatedAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	if m.UpdatedAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt)
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	if m.Driver != 0 {
-		n += 1 + sovYolopb(uint64(m.Driver))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	if len(m.HasArtifacts) > 0 {
-		for _, e := range m.HasArtifacts {
-			l = e.Size()
-			n += 2 + l + sovYolopb(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *PromoteBuild_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.HasBuilds) > 0 {
-		for _, e := range m.HasBuilds {
-			l = e.Size()
-			n += 2 + l + sovYolopb(uint64(l))
-		}
+	return dAtA[:n], nil
+}
+
+func (m *PromoteBuild_Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PromoteBuild_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.HasCommits) > 0 {
-		for _, e := range m.HasCommits {
-			l = e.Size()
-			n += 2 + l + sovYolopb(uint64(l))
-		}
+	if len(m.BuildID) > 0 {
+		i -= len(m.BuildID)
+		copy(dAtA[i:], m.BuildID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.BuildID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.HasReleases) > 0 {
-		for _, e := range m.HasReleases {
-			l = e.Size()
-			n += 2 + l + sovYolopb(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *PromoteBuild_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.HasMergerequests) > 0 {
-		for _, e := range m.HasMergerequests {
-			l = e.Size()
-			n += 2 + l + sovYolopb(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *PromoteBuild_Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PromoteBuild_Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Build != nil {
+		{
+			size, err := m.Build.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYolopb(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.HasOwner != nil {
-		l = m.HasOwner.Size()
-		n += 2 + l + sovYolopb(uint64(l))
-	}
-	l = len(m.HasOwnerID)
-	if l > 0 {
-		n += 2 + l + sovYolopb(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Entity) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetBuild) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBuild) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBuild) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBuild_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.YoloID)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	if m.CreatedAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	if m.UpdatedAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt)
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	if m.Driver != 0 {
-		n += 1 + sovYolopb(uint64(m.Driver))
-	}
-	l = len(m.AvatarURL)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	if m.Kind != 0 {
-		n += 1 + sovYolopb(uint64(m.Kind))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	if len(m.HasProjects) > 0 {
-		for _, e := range m.HasProjects {
-			l = e.Size()
-			n += 2 + l + sovYolopb(uint64(l))
-		}
-	}
-	if len(m.HasCommits) > 0 {
-		for _, e := range m.HasCommits {
-			l = e.Size()
-			n += 2 + l + sovYolopb(uint64(l))
-		}
-	}
-	if len(m.HasMergerequests) > 0 {
-		for _, e := range m.HasMergerequests {
-			l = e.Size()
-			n += 2 + l + sovYolopb(uint64(l))
-		}
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Artifact) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetBuild_Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBuild_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	l = len(m.YoloID)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	if m.CreatedAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	if m.UpdatedAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt)
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	if m.FileSize != 0 {
-		n += 1 + sovYolopb(uint64(m.FileSize))
-	}
-	l = len(m.LocalPath)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	l = len(m.DownloadURL)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	l = len(m.MimeType)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	l = len(m.Sha1Sum)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	l = len(m.Sha256Sum)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	if m.State != 0 {
-		n += 1 + sovYolopb(uint64(m.State))
-	}
-	if m.Kind != 0 {
-		n += 1 + sovYolopb(uint64(m.Kind))
-	}
-	if m.Driver != 0 {
-		n += 1 + sovYolopb(uint64(m.Driver))
-	}
-	l = len(m.BundleName)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	l = len(m.BundleVersion)
-	if l > 0 {
-		n += 1 + l + sovYolopb(uint64(l))
-	}
-	l = len(m.BundleID)
-	if l > 0 {
-		n += 2 + l + sovYolopb(uint64(l))
-	}
-	l = len(m.BundleIcon)
-	if l > 0 {
-		n += 2 + l + sovYolopb(uint64(l))
-	}
-	if m.HasBuild != nil {
-		l = m.HasBuild.Size()
-		n += 2 + l + sovYolopb(uint64(l))
-	}
-	l = len(m.HasBuildID)
-	if l > 0 {
-		n += 2 + l + sovYolopb(uint64(l))
-	}
-	if m.HasRelease != nil {
-		l = m.HasRelease.Size()
-		n += 2 + l + sovYolopb(uint64(l))
+	if len(m.BuildID) > 0 {
+		i -= len(m.BuildID)
+		copy(dAtA[i:], m.BuildID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.BuildID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.HasReleaseID)
-	if l > 0 {
-		n += 2 + l + sovYolopb(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBuild_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Downloads) > 0 {
-		for _, e := range m.Downloads {
-			l = e.Size()
-			n += 2 + l + sovYolopb(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *GetBuild_Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBuild_Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Build != nil {
+		{
+			size, err := m.Build.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYolopb(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.DownloadsCount != 0 {
-		n += 2 + sovYolopb(uint64(m.DownloadsCount))
-	}
-	l = len(m.DLArtifactSignedURL)
-	if l > 0 {
-		n += 2 + l + sovYolopb(uint64(l))
-	}
-	l = len(m.PListSignedURL)
-	if l > 0 {
-		n += 2 + l + sovYolopb(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Download) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SearchBuilds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchBuilds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SearchBuilds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		n += 1 + sovYolopb(uint64(m.ID))
-	}
-	if m.CreatedAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
-		n += 1 + l + sovYolopb(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *SearchBuilds_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.HasArtifact != nil {
-		l = m.HasArtifact.Size()
-		n += 2 + l + sovYolopb(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *SearchBuilds_Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SearchBuilds_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.HasArtifactID)
-	if l > 0 {
-		n += 2 + l + sovYolopb(uint64(l))
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Batch) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SearchBuilds_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchBuilds_Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SearchBuilds_Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Builds) > 0 {
-		for _, e := range m.Builds {
-			l = e.Size()
-			n += 1 + l + sovYolopb(uint64(l))
+		for iNdEx := len(m.Builds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Builds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYolopb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.Artifacts) > 0 {
-		for _, e := range m.Artifacts {
-			l = e.Size()
-			n += 1 + l + sovYolopb(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *Summary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Summary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Summary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *Summary_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Summary_Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Summary_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProjectID) > 0 {
+		for iNdEx := len(m.ProjectID) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProjectID[iNdEx])
+			copy(dAtA[i:], m.ProjectID[iNdEx])
+			i = encodeVarintYolopb(dAtA, i, uint64(len(m.ProjectID[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.Projects) > 0 {
-		for _, e := range m.Projects {
-			l = e.Size()
-			n += 1 + l + sovYolopb(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *Summary_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Summary_Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Summary_Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYolopb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.Entities) > 0 {
-		for _, e := range m.Entities {
-			l = e.Size()
-			n += 1 + l + sovYolopb(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *Summary_Entry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Summary_Entry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Summary_Entry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InstallURL) > 0 {
+		i -= len(m.InstallURL)
+		copy(dAtA[i:], m.InstallURL)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.InstallURL)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.LatestArtifact != nil {
+		{
+			size, err := m.LatestArtifact.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYolopb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.LatestVersion) > 0 {
+		i -= len(m.LatestVersion)
+		copy(dAtA[i:], m.LatestVersion)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.LatestVersion)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.LatestBuildID) > 0 {
+		i -= len(m.LatestBuildID)
+		copy(dAtA[i:], m.LatestBuildID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.LatestBuildID)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.LatestBuildAt != nil {
+		n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LatestBuildAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LatestBuildAt):])
+		if err15 != nil {
+			return 0, err15
+		}
+		i -= n15
+		i = encodeVarintYolopb(dAtA, i, uint64(n15))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BuildsCount != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.BuildsCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Kind != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProjectID) > 0 {
+		i -= len(m.ProjectID)
+		copy(dAtA[i:], m.ProjectID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.ProjectID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefreshBuilds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefreshBuilds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshBuilds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RefreshBuilds_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefreshBuilds_Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshBuilds_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Project) > 0 {
+		i -= len(m.Project)
+		copy(dAtA[i:], m.Project)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Project)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefreshBuilds_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefreshBuilds_Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshBuilds_Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Drivers) > 0 {
+		dAtA17 := make([]byte, len(m.Drivers)*10)
+		var j16 int
+		for _, num := range m.Drivers {
+			for num >= 1<<7 {
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j16++
+			}
+			dAtA17[j16] = uint8(num)
+			j16++
+		}
+		i -= j16
+		copy(dAtA[i:], dAtA17[:j16])
+		i = encodeVarintYolopb(dAtA, i, uint64(j16))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.UpdatedBuilds != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.UpdatedBuilds))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamBuildUpdates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamBuildUpdates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamBuildUpdates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamBuildUpdates_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamBuildUpdates_Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamBuildUpdates_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SnapshotSize != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.SnapshotSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ArtifactKinds) > 0 {
+		dAtA19 := make([]byte, len(m.ArtifactKinds)*10)
+		var j18 int
+		for _, num := range m.ArtifactKinds {
+			for num >= 1<<7 {
+				dAtA19[j18] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j18++
+			}
+			dAtA19[j18] = uint8(num)
+			j18++
+		}
+		i -= j18
+		copy(dAtA[i:], dAtA19[:j18])
+		i = encodeVarintYolopb(dAtA, i, uint64(j18))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProjectID) > 0 {
+		for iNdEx := len(m.ProjectID) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProjectID[iNdEx])
+			copy(dAtA[i:], m.ProjectID[iNdEx])
+			i = encodeVarintYolopb(dAtA, i, uint64(len(m.ProjectID[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamBuildUpdates_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamBuildUpdates_Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamBuildUpdates_Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Build != nil {
+		{
+			size, err := m.Build.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYolopb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WhatsNew) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WhatsNew) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WhatsNew) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *WhatsNew_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WhatsNew_Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WhatsNew_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BuildID) > 0 {
+		i -= len(m.BuildID)
+		copy(dAtA[i:], m.BuildID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.BuildID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WhatsNew_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WhatsNew_Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WhatsNew_Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CompareURL) > 0 {
+		i -= len(m.CompareURL)
+		copy(dAtA[i:], m.CompareURL)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.CompareURL)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Commits) > 0 {
+		for iNdEx := len(m.Commits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYolopb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ReleaseNotes) > 0 {
+		i -= len(m.ReleaseNotes)
+		copy(dAtA[i:], m.ReleaseNotes)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.ReleaseNotes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Builds) > 0 {
+		for iNdEx := len(m.Builds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Builds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYolopb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignArtifact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignArtifact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *SignArtifact_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignArtifact_Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignArtifact_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Device) > 0 {
+		i -= len(m.Device)
+		copy(dAtA[i:], m.Device)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Device)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SingleUse {
+		i--
+		if m.SingleUse {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TTLSeconds != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.TTLSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ArtifactID) > 0 {
+		i -= len(m.ArtifactID)
+		copy(dAtA[i:], m.ArtifactID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.ArtifactID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignArtifact_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignArtifact_Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignArtifact_Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != nil {
+		n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err21 != nil {
+			return 0, err21
+		}
+		i -= n21
+		i = encodeVarintYolopb(dAtA, i, uint64(n21))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Artifact != nil {
+		{
+			size, err := m.Artifact.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYolopb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BranchStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BranchStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BranchStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *BranchStats_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BranchStats_Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BranchStats_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Until) > 0 {
+		i -= len(m.Until)
+		copy(dAtA[i:], m.Until)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Until)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Since) > 0 {
+		i -= len(m.Since)
+		copy(dAtA[i:], m.Since)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Since)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ProjectID) > 0 {
+		i -= len(m.ProjectID)
+		copy(dAtA[i:], m.ProjectID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.ProjectID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Branch) > 0 {
+		for iNdEx := len(m.Branch) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Branch[iNdEx])
+			copy(dAtA[i:], m.Branch[iNdEx])
+			i = encodeVarintYolopb(dAtA, i, uint64(len(m.Branch[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BranchStats_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BranchStats_Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BranchStats_Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Branches) > 0 {
+		for iNdEx := len(m.Branches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Branches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYolopb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BranchStats_Entry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BranchStats_Entry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BranchStats_Entry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastBuildAt != nil {
+		n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastBuildAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastBuildAt):])
+		if err23 != nil {
+			return 0, err23
+		}
+		i -= n23
+		i = encodeVarintYolopb(dAtA, i, uint64(n23))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.AverageDurationSeconds != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.AverageDurationSeconds))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if m.PassRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.PassRate))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.Failed != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.Failed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Passed != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.Passed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Total != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Branch) > 0 {
+		i -= len(m.Branch)
+		copy(dAtA[i:], m.Branch)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Branch)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BuildListFilters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BuildListFilters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuildListFilters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *BuildListFilters_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BuildListFilters_Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuildListFilters_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *BuildListFilters_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BuildListFilters_Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuildListFilters_Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Projects) > 0 {
+		for iNdEx := len(m.Projects) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Projects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYolopb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Entities) > 0 {
+		for iNdEx := len(m.Entities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYolopb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MetadataOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetadataOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetadataOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BuildConfig) > 0 {
+		for k := range m.BuildConfig {
+			v := m.BuildConfig[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintYolopb(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintYolopb(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintYolopb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xc
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.HasMergeRequestID) > 0 {
+		i -= len(m.HasMergeRequestID)
+		copy(dAtA[i:], m.HasMergeRequestID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.HasMergeRequestID)))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xda
+	}
+	if len(m.HasProjectID) > 0 {
+		i -= len(m.HasProjectID)
+		copy(dAtA[i:], m.HasProjectID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.HasProjectID)))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.HasCommitID) > 0 {
+		i -= len(m.HasCommitID)
+		copy(dAtA[i:], m.HasCommitID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.HasCommitID)))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.ReleaseNotes) > 0 {
+		i -= len(m.ReleaseNotes)
+		copy(dAtA[i:], m.ReleaseNotes)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.ReleaseNotes)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.Branch) > 0 {
+		i -= len(m.Branch)
+		copy(dAtA[i:], m.Branch)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Branch)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Build) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Build) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Build) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Flags) > 0 {
+		for k := range m.Flags {
+			v := m.Flags[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintYolopb(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintYolopb(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintYolopb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xc
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.OwnerTeams) > 0 {
+		for iNdEx := len(m.OwnerTeams) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OwnerTeams[iNdEx])
+			copy(dAtA[i:], m.OwnerTeams[iNdEx])
+			i = encodeVarintYolopb(dAtA, i, uint64(len(m.OwnerTeams[iNdEx])))
+			i--
+			dAtA[i] = 0xc
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.BuildConfig) > 0 {
+		for k := range m.BuildConfig {
+			v := m.BuildConfig[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintYolopb(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintYolopb(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintYolopb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xc
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.InstallSignedURL) > 0 {
+		i -= len(m.InstallSignedURL)
+		copy(dAtA[i:], m.InstallSignedURL)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.InstallSignedURL)))
+		i--
+		dAtA[i] = 0xc
+		i--
+		dAtA[i] = 0xe2
+	}
+	if m.InstallsCount != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.InstallsCount))
+		i--
+		dAtA[i] = 0xc
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.DownloadsCount != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.DownloadsCount))
+		i--
+		dAtA[i] = 0xc
+		i--
+		dAtA[i] = 0xd0
+	}
+	if len(m.Channels) > 0 {
+		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Channels[iNdEx])
+			copy(dAtA[i:], m.Channels[iNdEx])
+			i = encodeVarintYolopb(dAtA, i, uint64(len(m.Channels[iNdEx])))
+			i--
+			dAtA[i] = 0xc
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.HasMergerequestID) > 0 {
+		i -= len(m.HasMergerequestID)
+		copy(dAtA[i:], m.HasMergerequestID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.HasMergerequestID)))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xda
+	}
+	if m.HasMergerequest != nil {
+		{
+			size, err := m.HasMergerequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYolopb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.HasProjectID) > 0 {
+		i -= len(m.HasProjectID)
+		copy(dAtA[i:], m.HasProjectID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.HasProjectID)))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.HasProject != nil {
+		{
+			size, err := m.HasProject.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYolopb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xc2
+	}
+	if len(m.HasCommitID) > 0 {
+		i -= len(m.HasCommitID)
+		copy(dAtA[i:], m.HasCommitID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.HasCommitID)))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.HasCommit != nil {
+		{
+			size, err := m.HasCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYolopb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.HasArtifacts) > 0 {
+		for iNdEx := len(m.HasArtifacts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HasArtifacts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYolopb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.VersionKey) > 0 {
+		i -= len(m.VersionKey)
+		copy(dAtA[i:], m.VersionKey)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.VersionKey)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.ExternalState) > 0 {
+		i -= len(m.ExternalState)
+		copy(dAtA[i:], m.ExternalState)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.ExternalState)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.Duration != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if len(m.CommitAuthorAvatarURL) > 0 {
+		i -= len(m.CommitAuthorAvatarURL)
+		copy(dAtA[i:], m.CommitAuthorAvatarURL)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.CommitAuthorAvatarURL)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
+	if len(m.CommitEmail) > 0 {
+		i -= len(m.CommitEmail)
+		copy(dAtA[i:], m.CommitEmail)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.CommitEmail)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
+	if len(m.CommitAuthor) > 0 {
+		i -= len(m.CommitAuthor)
+		copy(dAtA[i:], m.CommitAuthor)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.CommitAuthor)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	if len(m.HasRawMergerequestID) > 0 {
+		i -= len(m.HasRawMergerequestID)
+		copy(dAtA[i:], m.HasRawMergerequestID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.HasRawMergerequestID)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if len(m.HasRawProjectID) > 0 {
+		i -= len(m.HasRawProjectID)
+		copy(dAtA[i:], m.HasRawProjectID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.HasRawProjectID)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.HasRawCommitID) > 0 {
+		i -= len(m.HasRawCommitID)
+		copy(dAtA[i:], m.HasRawCommitID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.HasRawCommitID)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.HasRawMergerequest != nil {
+		{
+			size, err := m.HasRawMergerequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYolopb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.HasRawProject != nil {
+		{
+			size, err := m.HasRawProject.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYolopb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.HasRawCommit != nil {
+		{
+			size, err := m.HasRawCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYolopb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.RawBranch) > 0 {
+		i -= len(m.RawBranch)
+		copy(dAtA[i:], m.RawBranch)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.RawBranch)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.FlagsJSON) > 0 {
+		i -= len(m.FlagsJSON)
+		copy(dAtA[i:], m.FlagsJSON)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.FlagsJSON)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.OverBudget {
+		i--
+		if m.OverBudget {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.OwnerTeamsJSON) > 0 {
+		i -= len(m.OwnerTeamsJSON)
+		copy(dAtA[i:], m.OwnerTeamsJSON)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.OwnerTeamsJSON)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.BuildConfigJSON) > 0 {
+		i -= len(m.BuildConfigJSON)
+		copy(dAtA[i:], m.BuildConfigJSON)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.BuildConfigJSON)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.ReleaseNotes) > 0 {
+		i -= len(m.ReleaseNotes)
+		copy(dAtA[i:], m.ReleaseNotes)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.ReleaseNotes)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.VCSTagURL) > 0 {
+		i -= len(m.VCSTagURL)
+		copy(dAtA[i:], m.VCSTagURL)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.VCSTagURL)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.VCSTag) > 0 {
+		i -= len(m.VCSTag)
+		copy(dAtA[i:], m.VCSTag)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.VCSTag)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.ShortID) > 0 {
+		i -= len(m.ShortID)
+		copy(dAtA[i:], m.ShortID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.ShortID)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Driver != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.Driver))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Branch) > 0 {
+		i -= len(m.Branch)
+		copy(dAtA[i:], m.Branch)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Branch)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.CommitURL) > 0 {
+		i -= len(m.CommitURL)
+		copy(dAtA[i:], m.CommitURL)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.CommitURL)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.FinishedAt != nil {
+		n30, err30 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.FinishedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.FinishedAt):])
+		if err30 != nil {
+			return 0, err30
+		}
+		i -= n30
+		i = encodeVarintYolopb(dAtA, i, uint64(n30))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.StartedAt != nil {
+		n31, err31 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedAt):])
+		if err31 != nil {
+			return 0, err31
+		}
+		i -= n31
+		i = encodeVarintYolopb(dAtA, i, uint64(n31))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.CompletedAt != nil {
+		n32, err32 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CompletedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CompletedAt):])
+		if err32 != nil {
+			return 0, err32
+		}
+		i -= n32
+		i = encodeVarintYolopb(dAtA, i, uint64(n32))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.State != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UpdatedAt != nil {
+		n33, err33 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
+		if err33 != nil {
+			return 0, err33
+		}
+		i -= n33
+		i = encodeVarintYolopb(dAtA, i, uint64(n33))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CreatedAt != nil {
+		n34, err34 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err34 != nil {
+			return 0, err34
+		}
+		i -= n34
+		i = encodeVarintYolopb(dAtA, i, uint64(n34))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.YoloID) > 0 {
+		i -= len(m.YoloID)
+		copy(dAtA[i:], m.YoloID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.YoloID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Release) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Release) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Release) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HasMergerequest != nil {
+		{
+			size, err := m.HasMergerequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYolopb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.HasProject != nil {
+		{
+			size, err := m.HasProject.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYolopb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.HasCommit != nil {
+		{
+			size, err := m.HasCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYolopb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.HasArtifacts) > 0 {
+		for iNdEx := len(m.HasArtifacts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HasArtifacts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYolopb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.ShortID) > 0 {
+		i -= len(m.ShortID)
+		copy(dAtA[i:], m.ShortID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.ShortID)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.CommitURL) > 0 {
+		i -= len(m.CommitURL)
+		copy(dAtA[i:], m.CommitURL)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.CommitURL)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Driver != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.Driver))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.UpdatedAt != nil {
+		n38, err38 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
+		if err38 != nil {
+			return 0, err38
+		}
+		i -= n38
+		i = encodeVarintYolopb(dAtA, i, uint64(n38))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CreatedAt != nil {
+		n39, err39 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err39 != nil {
+			return 0, err39
+		}
+		i -= n39
+		i = encodeVarintYolopb(dAtA, i, uint64(n39))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.YoloID) > 0 {
+		i -= len(m.YoloID)
+		copy(dAtA[i:], m.YoloID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.YoloID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Commit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Commit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Commit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HasMergerequest != nil {
+		{
+			size, err := m.HasMergerequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYolopb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.HasAuthor != nil {
+		{
+			size, err := m.HasAuthor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYolopb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.HasProject != nil {
+		{
+			size, err := m.HasProject.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYolopb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.HasBuilds) > 0 {
+		for iNdEx := len(m.HasBuilds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HasBuilds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYolopb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.HasReleases) > 0 {
+		for iNdEx := len(m.HasReleases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HasReleases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYolopb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.Branch) > 0 {
+		i -= len(m.Branch)
+		copy(dAtA[i:], m.Branch)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Branch)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Driver != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.Driver))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.UpdatedAt != nil {
+		n43, err43 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
+		if err43 != nil {
+			return 0, err43
+		}
+		i -= n43
+		i = encodeVarintYolopb(dAtA, i, uint64(n43))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CreatedAt != nil {
+		n44, err44 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err44 != nil {
+			return 0, err44
+		}
+		i -= n44
+		i = encodeVarintYolopb(dAtA, i, uint64(n44))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.YoloID) > 0 {
+		i -= len(m.YoloID)
+		copy(dAtA[i:], m.YoloID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.YoloID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MergeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MergeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MergeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HasCommitID) > 0 {
+		i -= len(m.HasCommitID)
+		copy(dAtA[i:], m.HasCommitID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.HasCommitID)))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xf2
+	}
+	if m.HasCommit != nil {
+		{
+			size, err := m.HasCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYolopb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xea
+	}
+	if len(m.HasAuthorID) > 0 {
+		i -= len(m.HasAuthorID)
+		copy(dAtA[i:], m.HasAuthorID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.HasAuthorID)))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xe2
+	}
+	if m.HasAuthor != nil {
+		{
+			size, err := m.HasAuthor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYolopb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xda
+	}
+	if len(m.HasProjectID) > 0 {
+		i -= len(m.HasProjectID)
+		copy(dAtA[i:], m.HasProjectID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.HasProjectID)))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.HasProject != nil {
+		{
+			size, err := m.HasProject.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYolopb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.HasReviewers) > 0 {
+		for iNdEx := len(m.HasReviewers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HasReviewers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYolopb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.HasAssignees) > 0 {
+		for iNdEx := len(m.HasAssignees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HasAssignees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYolopb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.HasBuilds) > 0 {
+		for iNdEx := len(m.HasBuilds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HasBuilds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYolopb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.HasReleases) > 0 {
+		for iNdEx := len(m.HasReleases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HasReleases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYolopb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if m.IsWIP {
+		i--
+		if m.IsWIP {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.ShortID) > 0 {
+		i -= len(m.ShortID)
+		copy(dAtA[i:], m.ShortID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.ShortID)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.BranchURL) > 0 {
+		i -= len(m.BranchURL)
+		copy(dAtA[i:], m.BranchURL)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.BranchURL)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.CommitURL) > 0 {
+		i -= len(m.CommitURL)
+		copy(dAtA[i:], m.CommitURL)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.CommitURL)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.State != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Branch) > 0 {
+		i -= len(m.Branch)
+		copy(dAtA[i:], m.Branch)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Branch)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Driver != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.Driver))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MergedAt != nil {
+		n48, err48 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.MergedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.MergedAt):])
+		if err48 != nil {
+			return 0, err48
+		}
+		i -= n48
+		i = encodeVarintYolopb(dAtA, i, uint64(n48))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.UpdatedAt != nil {
+		n49, err49 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
+		if err49 != nil {
+			return 0, err49
+		}
+		i -= n49
+		i = encodeVarintYolopb(dAtA, i, uint64(n49))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CreatedAt != nil {
+		n50, err50 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err50 != nil {
+			return 0, err50
+		}
+		i -= n50
+		i = encodeVarintYolopb(dAtA, i, uint64(n50))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.YoloID) > 0 {
+		i -= len(m.YoloID)
+		copy(dAtA[i:], m.YoloID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.YoloID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Project) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Project) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Project) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HasOwnerID) > 0 {
+		i -= len(m.HasOwnerID)
+		copy(dAtA[i:], m.HasOwnerID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.HasOwnerID)))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xda
+	}
+	if m.HasOwner != nil {
+		{
+			size, err := m.HasOwner.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYolopb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.HasMergerequests) > 0 {
+		for iNdEx := len(m.HasMergerequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HasMergerequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYolopb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.HasReleases) > 0 {
+		for iNdEx := len(m.HasReleases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HasReleases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYolopb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.HasCommits) > 0 {
+		for iNdEx := len(m.HasCommits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HasCommits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYolopb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.HasBuilds) > 0 {
+		for iNdEx := len(m.HasBuilds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HasBuilds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYolopb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.HasArtifacts) > 0 {
+		for iNdEx := len(m.HasArtifacts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HasArtifacts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYolopb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Driver != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.Driver))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UpdatedAt != nil {
+		n52, err52 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
+		if err52 != nil {
+			return 0, err52
+		}
+		i -= n52
+		i = encodeVarintYolopb(dAtA, i, uint64(n52))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CreatedAt != nil {
+		n53, err53 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err53 != nil {
+			return 0, err53
+		}
+		i -= n53
+		i = encodeVarintYolopb(dAtA, i, uint64(n53))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.YoloID) > 0 {
+		i -= len(m.YoloID)
+		copy(dAtA[i:], m.YoloID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.YoloID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Entity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Entity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Entity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HasMergerequests) > 0 {
+		for iNdEx := len(m.HasMergerequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HasMergerequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYolopb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.HasCommits) > 0 {
+		for iNdEx := len(m.HasCommits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HasCommits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYolopb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.HasProjects) > 0 {
+		for iNdEx := len(m.HasProjects) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HasProjects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYolopb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Kind != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.AvatarURL) > 0 {
+		i -= len(m.AvatarURL)
+		copy(dAtA[i:], m.AvatarURL)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.AvatarURL)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Driver != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.Driver))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.UpdatedAt != nil {
+		n54, err54 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
+		if err54 != nil {
+			return 0, err54
+		}
+		i -= n54
+		i = encodeVarintYolopb(dAtA, i, uint64(n54))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CreatedAt != nil {
+		n55, err55 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err55 != nil {
+			return 0, err55
+		}
+		i -= n55
+		i = encodeVarintYolopb(dAtA, i, uint64(n55))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.YoloID) > 0 {
+		i -= len(m.YoloID)
+		copy(dAtA[i:], m.YoloID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.YoloID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Artifact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Artifact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Artifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PListSignedURL) > 0 {
+		i -= len(m.PListSignedURL)
+		copy(dAtA[i:], m.PListSignedURL)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.PListSignedURL)))
+		i--
+		dAtA[i] = 0xc
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.DLArtifactSignedURL) > 0 {
+		i -= len(m.DLArtifactSignedURL)
+		copy(dAtA[i:], m.DLArtifactSignedURL)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.DLArtifactSignedURL)))
+		i--
+		dAtA[i] = 0xc
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.DownloadsCount != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.DownloadsCount))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xd0
+	}
+	if len(m.Downloads) > 0 {
+		for iNdEx := len(m.Downloads) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Downloads[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYolopb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.HasReleaseID) > 0 {
+		i -= len(m.HasReleaseID)
+		copy(dAtA[i:], m.HasReleaseID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.HasReleaseID)))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.HasRelease != nil {
+		{
+			size, err := m.HasRelease.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYolopb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.HasBuildID) > 0 {
+		i -= len(m.HasBuildID)
+		copy(dAtA[i:], m.HasBuildID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.HasBuildID)))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.HasBuild != nil {
+		{
+			size, err := m.HasBuild.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYolopb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.SparkleEdSignature) > 0 {
+		i -= len(m.SparkleEdSignature)
+		copy(dAtA[i:], m.SparkleEdSignature)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.SparkleEdSignature)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if len(m.DuplicateOfID) > 0 {
+		i -= len(m.DuplicateOfID)
+		copy(dAtA[i:], m.DuplicateOfID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.DuplicateOfID)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.Arch) > 0 {
+		i -= len(m.Arch)
+		copy(dAtA[i:], m.Arch)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Arch)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.Md5Sum) > 0 {
+		i -= len(m.Md5Sum)
+		copy(dAtA[i:], m.Md5Sum)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Md5Sum)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.BundleBuildVersion) > 0 {
+		i -= len(m.BundleBuildVersion)
+		copy(dAtA[i:], m.BundleBuildVersion)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.BundleBuildVersion)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.OverBudget {
+		i--
+		if m.OverBudget {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.Variant) > 0 {
+		i -= len(m.Variant)
+		copy(dAtA[i:], m.Variant)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Variant)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.BundleIcon) > 0 {
+		i -= len(m.BundleIcon)
+		copy(dAtA[i:], m.BundleIcon)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.BundleIcon)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.BundleID) > 0 {
+		i -= len(m.BundleID)
+		copy(dAtA[i:], m.BundleID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.BundleID)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.BundleVersion) > 0 {
+		i -= len(m.BundleVersion)
+		copy(dAtA[i:], m.BundleVersion)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.BundleVersion)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.BundleName) > 0 {
+		i -= len(m.BundleName)
+		copy(dAtA[i:], m.BundleName)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.BundleName)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Driver != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.Driver))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Kind != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.State != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Sha256Sum) > 0 {
+		i -= len(m.Sha256Sum)
+		copy(dAtA[i:], m.Sha256Sum)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Sha256Sum)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Sha1Sum) > 0 {
+		i -= len(m.Sha1Sum)
+		copy(dAtA[i:], m.Sha1Sum)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Sha1Sum)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.MimeType) > 0 {
+		i -= len(m.MimeType)
+		copy(dAtA[i:], m.MimeType)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.MimeType)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.DownloadURL) > 0 {
+		i -= len(m.DownloadURL)
+		copy(dAtA[i:], m.DownloadURL)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.DownloadURL)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.LocalPath) > 0 {
+		i -= len(m.LocalPath)
+		copy(dAtA[i:], m.LocalPath)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.LocalPath)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.FileSize != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.FileSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UpdatedAt != nil {
+		n58, err58 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
+		if err58 != nil {
+			return 0, err58
+		}
+		i -= n58
+		i = encodeVarintYolopb(dAtA, i, uint64(n58))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CreatedAt != nil {
+		n59, err59 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err59 != nil {
+			return 0, err59
+		}
+		i -= n59
+		i = encodeVarintYolopb(dAtA, i, uint64(n59))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.YoloID) > 0 {
+		i -= len(m.YoloID)
+		copy(dAtA[i:], m.YoloID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.YoloID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Download) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Download) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Download) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HasArtifactID) > 0 {
+		i -= len(m.HasArtifactID)
+		copy(dAtA[i:], m.HasArtifactID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.HasArtifactID)))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.HasArtifact != nil {
+		{
+			size, err := m.HasArtifact.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYolopb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.CreatedAt != nil {
+		n61, err61 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err61 != nil {
+			return 0, err61
+		}
+		i -= n61
+		i = encodeVarintYolopb(dAtA, i, uint64(n61))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Install) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Install) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Install) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HasBuildID) > 0 {
+		i -= len(m.HasBuildID)
+		copy(dAtA[i:], m.HasBuildID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.HasBuildID)))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.HasBuild != nil {
+		{
+			size, err := m.HasBuild.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYolopb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.InstallID) > 0 {
+		i -= len(m.InstallID)
+		copy(dAtA[i:], m.InstallID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.InstallID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CreatedAt != nil {
+		n63, err63 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err63 != nil {
+			return 0, err63
+		}
+		i -= n63
+		i = encodeVarintYolopb(dAtA, i, uint64(n63))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Promotion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Promotion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Promotion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HasBuildID) > 0 {
+		i -= len(m.HasBuildID)
+		copy(dAtA[i:], m.HasBuildID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.HasBuildID)))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.HasBuild != nil {
+		{
+			size, err := m.HasBuild.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYolopb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CreatedAt != nil {
+		n65, err65 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err65 != nil {
+			return 0, err65
+		}
+		i -= n65
+		i = encodeVarintYolopb(dAtA, i, uint64(n65))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Setting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Setting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Setting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCounter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCounter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintYolopb(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ObjectID) > 0 {
+		i -= len(m.ObjectID)
+		copy(dAtA[i:], m.ObjectID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.ObjectID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpentSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpentSignature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpentSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != nil {
+		n66, err66 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err66 != nil {
+			return 0, err66
+		}
+		i -= n66
+		i = encodeVarintYolopb(dAtA, i, uint64(n66))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CreatedAt != nil {
+		n67, err67 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err67 != nil {
+			return 0, err67
+		}
+		i -= n67
+		i = encodeVarintYolopb(dAtA, i, uint64(n67))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintYolopb(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Batch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Batch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Batch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MergeRequests) > 0 {
+		for iNdEx := len(m.MergeRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MergeRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYolopb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Commits) > 0 {
+		for iNdEx := len(m.Commits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYolopb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Releases) > 0 {
+		for iNdEx := len(m.Releases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Releases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYolopb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Entities) > 0 {
+		for iNdEx := len(m.Entities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYolopb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Projects) > 0 {
+		for iNdEx := len(m.Projects) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Projects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYolopb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Artifacts) > 0 {
+		for iNdEx := len(m.Artifacts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Artifacts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYolopb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Builds) > 0 {
+		for iNdEx := len(m.Builds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Builds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYolopb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintYolopb(dAtA []byte, offset int, v uint64) int {
+	offset -= sovYolopb(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Ping) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *Ping_Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *Ping_Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DevDumpObjects) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DevDumpObjects_Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WithPreloading {
+		n += 2
+	}
+	return n
+}
+
+func (m *DevDumpObjects_Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Batch != nil {
+		l = m.Batch.Size()
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if len(m.Downloads) > 0 {
+		for _, e := range m.Downloads {
+			l = e.Size()
+			n += 1 + l + sovYolopb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Status) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *Status_Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *Status_Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Uptime != 0 {
+		n += 1 + sovYolopb(uint64(m.Uptime))
+	}
+	l = len(m.DbErr)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.EventRetentionSeconds != 0 {
+		n += 1 + sovYolopb(uint64(m.EventRetentionSeconds))
+	}
+	if len(m.Drivers) > 0 {
+		for _, e := range m.Drivers {
+			l = e.Size()
+			n += 1 + l + sovYolopb(uint64(l))
+		}
+	}
+	if m.Version != nil {
+		l = m.Version.Size()
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.NbEntities != 0 {
+		n += 1 + sovYolopb(uint64(m.NbEntities))
+	}
+	if m.NbProjects != 0 {
+		n += 1 + sovYolopb(uint64(m.NbProjects))
+	}
+	if m.NbCommits != 0 {
+		n += 1 + sovYolopb(uint64(m.NbCommits))
+	}
+	if m.NbReleases != 0 {
+		n += 1 + sovYolopb(uint64(m.NbReleases))
+	}
+	if m.NbBuilds != 0 {
+		n += 1 + sovYolopb(uint64(m.NbBuilds))
+	}
+	if m.NbMergeRequests != 0 {
+		n += 1 + sovYolopb(uint64(m.NbMergeRequests))
+	}
+	return n
+}
+
+func (m *Status_DriverStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Driver != 0 {
+		n += 1 + sovYolopb(uint64(m.Driver))
+	}
+	if m.Circuit != 0 {
+		n += 1 + sovYolopb(uint64(m.Circuit))
+	}
+	if m.ConsecutiveFailures != 0 {
+		n += 1 + sovYolopb(uint64(m.ConsecutiveFailures))
+	}
+	if m.RetryAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.RetryAt)
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	return n
+}
+
+func (m *Version) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *Version_Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *Version_Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.Commit)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.BuildDate)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.GoVersion)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	return n
+}
+
+func (m *BuildList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BuildList_Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovYolopb(uint64(m.Limit))
+	}
+	if len(m.ArtifactKinds) > 0 {
+		l = 0
+		for _, e := range m.ArtifactKinds {
+			l += sovYolopb(uint64(e))
+		}
+		n += 1 + sovYolopb(uint64(l)) + l
+	}
+	if m.WithArtifacts {
+		n += 2
+	}
+	if len(m.BuildID) > 0 {
+		for _, s := range m.BuildID {
+			l = len(s)
+			n += 1 + l + sovYolopb(uint64(l))
+		}
+	}
+	if len(m.ProjectID) > 0 {
+		for _, s := range m.ProjectID {
+			l = len(s)
+			n += 1 + l + sovYolopb(uint64(l))
+		}
+	}
+	if len(m.ArtifactID) > 0 {
+		for _, s := range m.ArtifactID {
+			l = len(s)
+			n += 1 + l + sovYolopb(uint64(l))
+		}
+	}
+	if len(m.BuildDriver) > 0 {
+		l = 0
+		for _, e := range m.BuildDriver {
+			l += sovYolopb(uint64(e))
+		}
+		n += 1 + sovYolopb(uint64(l)) + l
+	}
+	if len(m.BuildState) > 0 {
+		l = 0
+		for _, e := range m.BuildState {
+			l += sovYolopb(uint64(e))
+		}
+		n += 1 + sovYolopb(uint64(l)) + l
+	}
+	if len(m.MergeRequestID) > 0 {
+		for _, s := range m.MergeRequestID {
+			l = len(s)
+			n += 1 + l + sovYolopb(uint64(l))
+		}
+	}
+	if len(m.MergeRequestAuthorID) > 0 {
+		for _, s := range m.MergeRequestAuthorID {
+			l = len(s)
+			n += 1 + l + sovYolopb(uint64(l))
+		}
+	}
+	if m.WithMergerequest {
+		n += 2
+	}
+	if len(m.MergerequestState) > 0 {
+		l = 0
+		for _, e := range m.MergerequestState {
+			l += sovYolopb(uint64(e))
+		}
+		n += 1 + sovYolopb(uint64(l)) + l
+	}
+	if len(m.Branch) > 0 {
+		for _, s := range m.Branch {
+			l = len(s)
+			n += 1 + l + sovYolopb(uint64(l))
+		}
+	}
+	if m.WithNoMergerequest {
+		n += 2
+	}
+	if m.SortByCommitDate {
+		n += 2
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	if len(m.BuildConfig) > 0 {
+		for _, s := range m.BuildConfig {
+			l = len(s)
+			n += 2 + l + sovYolopb(uint64(l))
+		}
+	}
+	if len(m.OwnerTeam) > 0 {
+		for _, s := range m.OwnerTeam {
+			l = len(s)
+			n += 2 + l + sovYolopb(uint64(l))
+		}
+	}
+	if m.OverBudget {
+		n += 3
+	}
+	if m.Offset != 0 {
+		n += 2 + sovYolopb(uint64(m.Offset))
+	}
+	if len(m.ArtifactArch) > 0 {
+		for _, s := range m.ArtifactArch {
+			l = len(s)
+			n += 2 + l + sovYolopb(uint64(l))
+		}
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	if m.SortBy != 0 {
+		n += 2 + sovYolopb(uint64(m.SortBy))
+	}
+	if m.SortOrder != 0 {
+		n += 2 + sovYolopb(uint64(m.SortOrder))
+	}
+	if len(m.ExternalState) > 0 {
+		for _, s := range m.ExternalState {
+			l = len(s)
+			n += 2 + l + sovYolopb(uint64(l))
+		}
+	}
+	if m.TaggedOnly {
+		n += 3
+	}
+	return n
+}
+
+func (m *BuildList_Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Builds) > 0 {
+		for _, e := range m.Builds {
+			l = e.Size()
+			n += 1 + l + sovYolopb(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	return n
+}
+
+func (m *PromoteBuild) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PromoteBuild_Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BuildID)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	return n
+}
+
+func (m *PromoteBuild_Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Build != nil {
+		l = m.Build.Size()
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	return n
+}
+
+func (m *GetBuild) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetBuild_Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BuildID)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	return n
+}
+
+func (m *GetBuild_Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Build != nil {
+		l = m.Build.Size()
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	return n
+}
+
+func (m *SearchBuilds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SearchBuilds_Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovYolopb(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *SearchBuilds_Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Builds) > 0 {
+		for _, e := range m.Builds {
+			l = e.Size()
+			n += 1 + l + sovYolopb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Summary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *Summary_Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProjectID) > 0 {
+		for _, s := range m.ProjectID {
+			l = len(s)
+			n += 1 + l + sovYolopb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Summary_Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovYolopb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Summary_Entry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProjectID)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovYolopb(uint64(m.Kind))
+	}
+	if m.BuildsCount != 0 {
+		n += 1 + sovYolopb(uint64(m.BuildsCount))
+	}
+	if m.LatestBuildAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LatestBuildAt)
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.LatestBuildID)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.LatestVersion)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.LatestArtifact != nil {
+		l = m.LatestArtifact.Size()
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.InstallURL)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	return n
+}
+
+func (m *RefreshBuilds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RefreshBuilds_Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Project)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	return n
+}
+
+func (m *RefreshBuilds_Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdatedBuilds != 0 {
+		n += 1 + sovYolopb(uint64(m.UpdatedBuilds))
+	}
+	if len(m.Drivers) > 0 {
+		l = 0
+		for _, e := range m.Drivers {
+			l += sovYolopb(uint64(e))
+		}
+		n += 1 + sovYolopb(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *StreamBuildUpdates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *StreamBuildUpdates_Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProjectID) > 0 {
+		for _, s := range m.ProjectID {
+			l = len(s)
+			n += 1 + l + sovYolopb(uint64(l))
+		}
+	}
+	if len(m.ArtifactKinds) > 0 {
+		l = 0
+		for _, e := range m.ArtifactKinds {
+			l += sovYolopb(uint64(e))
+		}
+		n += 1 + sovYolopb(uint64(l)) + l
+	}
+	if m.SnapshotSize != 0 {
+		n += 1 + sovYolopb(uint64(m.SnapshotSize))
+	}
+	return n
+}
+
+func (m *StreamBuildUpdates_Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Build != nil {
+		l = m.Build.Size()
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	return n
+}
+
+func (m *WhatsNew) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *WhatsNew_Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BuildID)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovYolopb(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *WhatsNew_Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Builds) > 0 {
+		for _, e := range m.Builds {
+			l = e.Size()
+			n += 1 + l + sovYolopb(uint64(l))
+		}
+	}
+	l = len(m.ReleaseNotes)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if len(m.Commits) > 0 {
+		for _, e := range m.Commits {
+			l = e.Size()
+			n += 1 + l + sovYolopb(uint64(l))
+		}
+	}
+	l = len(m.CompareURL)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	return n
+}
+
+func (m *SignArtifact) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SignArtifact_Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ArtifactID)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.TTLSeconds != 0 {
+		n += 1 + sovYolopb(uint64(m.TTLSeconds))
+	}
+	if m.SingleUse {
+		n += 2
+	}
+	l = len(m.Device)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	return n
+}
+
+func (m *SignArtifact_Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Artifact != nil {
+		l = m.Artifact.Size()
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	return n
+}
+
+func (m *BranchStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BranchStats_Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Branch) > 0 {
+		for _, s := range m.Branch {
+			l = len(s)
+			n += 1 + l + sovYolopb(uint64(l))
+		}
+	}
+	l = len(m.ProjectID)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.Since)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.Until)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	return n
+}
+
+func (m *BranchStats_Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Branches) > 0 {
+		for _, e := range m.Branches {
+			l = e.Size()
+			n += 1 + l + sovYolopb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BranchStats_Entry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.Total != 0 {
+		n += 1 + sovYolopb(uint64(m.Total))
+	}
+	if m.Passed != 0 {
+		n += 1 + sovYolopb(uint64(m.Passed))
+	}
+	if m.Failed != 0 {
+		n += 1 + sovYolopb(uint64(m.Failed))
+	}
+	if m.PassRate != 0 {
+		n += 9
+	}
+	if m.AverageDurationSeconds != 0 {
+		n += 9
+	}
+	if m.LastBuildAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastBuildAt)
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	return n
+}
+
+func (m *BuildListFilters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BuildListFilters_Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BuildListFilters_Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entities) > 0 {
+		for _, e := range m.Entities {
+			l = e.Size()
+			n += 1 + l + sovYolopb(uint64(l))
+		}
+	}
+	if len(m.Projects) > 0 {
+		for _, e := range m.Projects {
+			l = e.Size()
+			n += 1 + l + sovYolopb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MetadataOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.ReleaseNotes)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.HasCommitID)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.HasProjectID)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.HasMergeRequestID)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	if len(m.BuildConfig) > 0 {
+		for k, v := range m.BuildConfig {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovYolopb(uint64(len(k))) + 1 + len(v) + sovYolopb(uint64(len(v)))
+			n += mapEntrySize + 2 + sovYolopb(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *Build) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.YoloID)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.UpdatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt)
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovYolopb(uint64(m.State))
+	}
+	if m.CompletedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CompletedAt)
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.StartedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedAt)
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.FinishedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.FinishedAt)
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.CommitURL)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.Driver != 0 {
+		n += 1 + sovYolopb(uint64(m.Driver))
+	}
+	l = len(m.ShortID)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.VCSTag)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.VCSTagURL)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.ReleaseNotes)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.BuildConfigJSON)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.OwnerTeamsJSON)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	if m.OverBudget {
+		n += 3
+	}
+	l = len(m.FlagsJSON)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.RawBranch)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	if m.HasRawCommit != nil {
+		l = m.HasRawCommit.Size()
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	if m.HasRawProject != nil {
+		l = m.HasRawProject.Size()
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	if m.HasRawMergerequest != nil {
+		l = m.HasRawMergerequest.Size()
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.HasRawCommitID)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.HasRawProjectID)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.HasRawMergerequestID)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.CommitAuthor)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.CommitEmail)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.CommitAuthorAvatarURL)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	if m.Duration != 0 {
+		n += 2 + sovYolopb(uint64(m.Duration))
+	}
+	l = len(m.ExternalState)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.VersionKey)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	if len(m.HasArtifacts) > 0 {
+		for _, e := range m.HasArtifacts {
+			l = e.Size()
+			n += 2 + l + sovYolopb(uint64(l))
+		}
+	}
+	if m.HasCommit != nil {
+		l = m.HasCommit.Size()
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.HasCommitID)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	if m.HasProject != nil {
+		l = m.HasProject.Size()
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.HasProjectID)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	if m.HasMergerequest != nil {
+		l = m.HasMergerequest.Size()
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.HasMergerequestID)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	if len(m.Channels) > 0 {
+		for _, s := range m.Channels {
+			l = len(s)
+			n += 2 + l + sovYolopb(uint64(l))
+		}
+	}
+	if m.DownloadsCount != 0 {
+		n += 2 + sovYolopb(uint64(m.DownloadsCount))
+	}
+	if m.InstallsCount != 0 {
+		n += 2 + sovYolopb(uint64(m.InstallsCount))
+	}
+	l = len(m.InstallSignedURL)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	if len(m.BuildConfig) > 0 {
+		for k, v := range m.BuildConfig {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovYolopb(uint64(len(k))) + 1 + len(v) + sovYolopb(uint64(len(v)))
+			n += mapEntrySize + 2 + sovYolopb(uint64(mapEntrySize))
+		}
+	}
+	if len(m.OwnerTeams) > 0 {
+		for _, s := range m.OwnerTeams {
+			l = len(s)
+			n += 2 + l + sovYolopb(uint64(l))
+		}
+	}
+	if len(m.Flags) > 0 {
+		for k, v := range m.Flags {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovYolopb(uint64(len(k))) + 1 + len(v) + sovYolopb(uint64(len(v)))
+			n += mapEntrySize + 2 + sovYolopb(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *Release) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.YoloID)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.UpdatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt)
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.Driver != 0 {
+		n += 1 + sovYolopb(uint64(m.Driver))
+	}
+	l = len(m.CommitURL)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.ShortID)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if len(m.HasArtifacts) > 0 {
+		for _, e := range m.HasArtifacts {
+			l = e.Size()
+			n += 2 + l + sovYolopb(uint64(l))
+		}
+	}
+	if m.HasCommit != nil {
+		l = m.HasCommit.Size()
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	if m.HasProject != nil {
+		l = m.HasProject.Size()
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	if m.HasMergerequest != nil {
+		l = m.HasMergerequest.Size()
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	return n
+}
+
+func (m *Commit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.YoloID)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.UpdatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt)
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.Driver != 0 {
+		n += 1 + sovYolopb(uint64(m.Driver))
+	}
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if len(m.HasReleases) > 0 {
+		for _, e := range m.HasReleases {
+			l = e.Size()
+			n += 2 + l + sovYolopb(uint64(l))
+		}
+	}
+	if len(m.HasBuilds) > 0 {
+		for _, e := range m.HasBuilds {
+			l = e.Size()
+			n += 2 + l + sovYolopb(uint64(l))
+		}
+	}
+	if m.HasProject != nil {
+		l = m.HasProject.Size()
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	if m.HasAuthor != nil {
+		l = m.HasAuthor.Size()
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	if m.HasMergerequest != nil {
+		l = m.HasMergerequest.Size()
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	return n
+}
+
+func (m *MergeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.YoloID)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.UpdatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt)
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.MergedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.MergedAt)
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.Driver != 0 {
+		n += 1 + sovYolopb(uint64(m.Driver))
+	}
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovYolopb(uint64(m.State))
+	}
+	l = len(m.CommitURL)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.BranchURL)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.ShortID)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.IsWIP {
+		n += 2
+	}
+	if len(m.HasReleases) > 0 {
+		for _, e := range m.HasReleases {
+			l = e.Size()
+			n += 2 + l + sovYolopb(uint64(l))
+		}
+	}
+	if len(m.HasBuilds) > 0 {
+		for _, e := range m.HasBuilds {
+			l = e.Size()
+			n += 2 + l + sovYolopb(uint64(l))
+		}
+	}
+	if len(m.HasAssignees) > 0 {
+		for _, e := range m.HasAssignees {
+			l = e.Size()
+			n += 2 + l + sovYolopb(uint64(l))
+		}
+	}
+	if len(m.HasReviewers) > 0 {
+		for _, e := range m.HasReviewers {
+			l = e.Size()
+			n += 2 + l + sovYolopb(uint64(l))
+		}
+	}
+	if m.HasProject != nil {
+		l = m.HasProject.Size()
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.HasProjectID)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	if m.HasAuthor != nil {
+		l = m.HasAuthor.Size()
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.HasAuthorID)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	if m.HasCommit != nil {
+		l = m.HasCommit.Size()
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.HasCommitID)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	return n
+}
+
+func (m *Project) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.YoloID)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.UpdatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt)
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.Driver != 0 {
+		n += 1 + sovYolopb(uint64(m.Driver))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if len(m.HasArtifacts) > 0 {
+		for _, e := range m.HasArtifacts {
+			l = e.Size()
+			n += 2 + l + sovYolopb(uint64(l))
+		}
+	}
+	if len(m.HasBuilds) > 0 {
+		for _, e := range m.HasBuilds {
+			l = e.Size()
+			n += 2 + l + sovYolopb(uint64(l))
+		}
+	}
+	if len(m.HasCommits) > 0 {
+		for _, e := range m.HasCommits {
+			l = e.Size()
+			n += 2 + l + sovYolopb(uint64(l))
+		}
+	}
+	if len(m.HasReleases) > 0 {
+		for _, e := range m.HasReleases {
+			l = e.Size()
+			n += 2 + l + sovYolopb(uint64(l))
+		}
+	}
+	if len(m.HasMergerequests) > 0 {
+		for _, e := range m.HasMergerequests {
+			l = e.Size()
+			n += 2 + l + sovYolopb(uint64(l))
+		}
+	}
+	if m.HasOwner != nil {
+		l = m.HasOwner.Size()
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.HasOwnerID)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	return n
+}
+
+func (m *Entity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.YoloID)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.UpdatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt)
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.Driver != 0 {
+		n += 1 + sovYolopb(uint64(m.Driver))
+	}
+	l = len(m.AvatarURL)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovYolopb(uint64(m.Kind))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if len(m.HasProjects) > 0 {
+		for _, e := range m.HasProjects {
+			l = e.Size()
+			n += 2 + l + sovYolopb(uint64(l))
+		}
+	}
+	if len(m.HasCommits) > 0 {
+		for _, e := range m.HasCommits {
+			l = e.Size()
+			n += 2 + l + sovYolopb(uint64(l))
+		}
+	}
+	if len(m.HasMergerequests) > 0 {
+		for _, e := range m.HasMergerequests {
+			l = e.Size()
+			n += 2 + l + sovYolopb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Artifact) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.YoloID)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.UpdatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt)
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.FileSize != 0 {
+		n += 1 + sovYolopb(uint64(m.FileSize))
+	}
+	l = len(m.LocalPath)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.DownloadURL)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.MimeType)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.Sha1Sum)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.Sha256Sum)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovYolopb(uint64(m.State))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovYolopb(uint64(m.Kind))
+	}
+	if m.Driver != 0 {
+		n += 1 + sovYolopb(uint64(m.Driver))
+	}
+	l = len(m.BundleName)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.BundleVersion)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.BundleID)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.BundleIcon)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.Variant)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	if m.OverBudget {
+		n += 3
+	}
+	l = len(m.BundleBuildVersion)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.Md5Sum)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.Arch)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.DuplicateOfID)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.SparkleEdSignature)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	if m.HasBuild != nil {
+		l = m.HasBuild.Size()
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.HasBuildID)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	if m.HasRelease != nil {
+		l = m.HasRelease.Size()
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.HasReleaseID)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	if len(m.Downloads) > 0 {
+		for _, e := range m.Downloads {
+			l = e.Size()
+			n += 2 + l + sovYolopb(uint64(l))
+		}
+	}
+	if m.DownloadsCount != 0 {
+		n += 2 + sovYolopb(uint64(m.DownloadsCount))
+	}
+	l = len(m.DLArtifactSignedURL)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.PListSignedURL)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	return n
+}
+
+func (m *Download) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovYolopb(uint64(m.ID))
+	}
+	if m.CreatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.HasArtifact != nil {
+		l = m.HasArtifact.Size()
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.HasArtifactID)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	return n
+}
+
+func (m *Install) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovYolopb(uint64(m.ID))
+	}
+	if m.CreatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.InstallID)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.HasBuild != nil {
+		l = m.HasBuild.Size()
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.HasBuildID)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	return n
+}
+
+func (m *Promotion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovYolopb(uint64(m.ID))
+	}
+	if m.CreatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.HasBuild != nil {
+		l = m.HasBuild.Size()
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.HasBuildID)
+	if l > 0 {
+		n += 2 + l + sovYolopb(uint64(l))
+	}
+	return n
+}
+
+func (m *Setting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	l = len(m.ObjectID)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovYolopb(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *SpentSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovYolopb(uint64(l))
+	}
+	return n
+}
+
+func (m *Batch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Builds) > 0 {
+		for _, e := range m.Builds {
+			l = e.Size()
+			n += 1 + l + sovYolopb(uint64(l))
+		}
+	}
+	if len(m.Artifacts) > 0 {
+		for _, e := range m.Artifacts {
+			l = e.Size()
+			n += 1 + l + sovYolopb(uint64(l))
+		}
+	}
+	if len(m.Projects) > 0 {
+		for _, e := range m.Projects {
+			l = e.Size()
+			n += 1 + l + sovYolopb(uint64(l))
+		}
+	}
+	if len(m.Entities) > 0 {
+		for _, e := range m.Entities {
+			l = e.Size()
+			n += 1 + l + sovYolopb(uint64(l))
+		}
+	}
+	if len(m.Releases) > 0 {
+		for _, e := range m.Releases {
+			l = e.Size()
+			n += 1 + l + sovYolopb(uint64(l))
+		}
+	}
+	if len(m.Commits) > 0 {
+		for _, e := range m.Commits {
+			l = e.Size()
+			n += 1 + l + sovYolopb(uint64(l))
+		}
+	}
+	if len(m.MergeRequests) > 0 {
+		for _, e := range m.MergeRequests {
+			l = e.Size()
+			n += 1 + l + sovYolopb(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovYolopb(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozYolopb(x uint64) (n int) {
+	return sovYolopb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Ping) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Ping: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Ping: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Ping_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Ping_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DevDumpObjects) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DevDumpObjects: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DevDumpObjects: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DevDumpObjects_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithPreloading", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithPreloading = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DevDumpObjects_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Batch == nil {
+				m.Batch = &Batch{}
+			}
+			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Downloads", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Downloads = append(m.Downloads, &Download{})
+			if err := m.Downloads[len(m.Downloads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Status) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Status: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Status: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Status_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Status_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
+			}
+			m.Uptime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Uptime |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbErr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DbErr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventRetentionSeconds", wireType)
+			}
+			m.EventRetentionSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventRetentionSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Drivers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Drivers = append(m.Drivers, &Status_DriverStatus{})
+			if err := m.Drivers[len(m.Drivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Version == nil {
+				m.Version = &Version_Response{}
+			}
+			if err := m.Version.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NbEntities", wireType)
+			}
+			m.NbEntities = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NbEntities |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NbProjects", wireType)
+			}
+			m.NbProjects = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NbProjects |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NbCommits", wireType)
+			}
+			m.NbCommits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NbCommits |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NbReleases", wireType)
+			}
+			m.NbReleases = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NbReleases |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NbBuilds", wireType)
+			}
+			m.NbBuilds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NbBuilds |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NbMergeRequests", wireType)
+			}
+			m.NbMergeRequests = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NbMergeRequests |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Status_DriverStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DriverStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DriverStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Driver", wireType)
+			}
+			m.Driver = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Driver |= Driver(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Circuit", wireType)
+			}
+			m.Circuit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Circuit |= Status_CircuitState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailures", wireType)
+			}
+			m.ConsecutiveFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveFailures |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryAt == nil {
+				m.RetryAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.RetryAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Version) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Version: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Version: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Version_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Version_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GoVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BuildList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BuildList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BuildList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BuildList_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v Artifact_Kind
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowYolopb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Artifact_Kind(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ArtifactKinds = append(m.ArtifactKinds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowYolopb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthYolopb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthYolopb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.ArtifactKinds) == 0 {
+					m.ArtifactKinds = make([]Artifact_Kind, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Artifact_Kind
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowYolopb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Artifact_Kind(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ArtifactKinds = append(m.ArtifactKinds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactKinds", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithArtifacts", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithArtifacts = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildID = append(m.BuildID, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectID = append(m.ProjectID, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArtifactID = append(m.ArtifactID, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType == 0 {
+				var v Driver
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowYolopb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Driver(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BuildDriver = append(m.BuildDriver, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowYolopb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthYolopb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthYolopb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.BuildDriver) == 0 {
+					m.BuildDriver = make([]Driver, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Driver
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowYolopb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Driver(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BuildDriver = append(m.BuildDriver, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildDriver", wireType)
+			}
+		case 8:
+			if wireType == 0 {
+				var v Build_State
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowYolopb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Build_State(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BuildState = append(m.BuildState, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowYolopb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthYolopb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthYolopb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.BuildState) == 0 {
+					m.BuildState = make([]Build_State, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Build_State
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowYolopb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Build_State(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BuildState = append(m.BuildState, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildState", wireType)
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeRequestID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MergeRequestID = append(m.MergeRequestID, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeRequestAuthorID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MergeRequestAuthorID = append(m.MergeRequestAuthorID, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithMergerequest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithMergerequest = bool(v != 0)
+		case 12:
+			if wireType == 0 {
+				var v MergeRequest_State
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowYolopb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= MergeRequest_State(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MergerequestState = append(m.MergerequestState, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowYolopb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthYolopb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthYolopb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.MergerequestState) == 0 {
+					m.MergerequestState = make([]MergeRequest_State, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v MergeRequest_State
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowYolopb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= MergeRequest_State(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MergerequestState = append(m.MergerequestState, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergerequestState", wireType)
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = append(m.Branch, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithNoMergerequest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithNoMergerequest = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SortByCommitDate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SortByCommitDate = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildConfig", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildConfig = append(m.BuildConfig, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerTeam", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerTeam = append(m.OwnerTeam, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverBudget", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OverBudget = bool(v != 0)
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactArch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArtifactArch = append(m.ArtifactArch, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SortBy", wireType)
+			}
+			m.SortBy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SortBy |= BuildList_SortBy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SortOrder", wireType)
+			}
+			m.SortOrder = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SortOrder |= BuildList_SortOrder(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalState = append(m.ExternalState, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaggedOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TaggedOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BuildList_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Builds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Builds = append(m.Builds, &Build{})
+			if err := m.Builds[len(m.Builds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PromoteBuild) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PromoteBuild: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PromoteBuild: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PromoteBuild_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PromoteBuild_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Build", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Build == nil {
+				m.Build = &Build{}
+			}
+			if err := m.Build.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBuild) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBuild: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBuild: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBuild_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBuild_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Build", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Build == nil {
+				m.Build = &Build{}
+			}
+			if err := m.Build.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchBuilds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchBuilds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchBuilds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchBuilds_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchBuilds_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Builds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Builds = append(m.Builds, &Build{})
+			if err := m.Builds[len(m.Builds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Summary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Summary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Summary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Summary_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectID = append(m.ProjectID, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Summary_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &Summary_Entry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Summary_Entry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Entry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Entry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= Artifact_Kind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildsCount", wireType)
+			}
+			m.BuildsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BuildsCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestBuildAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LatestBuildAt == nil {
+				m.LatestBuildAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LatestBuildAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestBuildID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LatestBuildID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LatestVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestArtifact", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LatestArtifact == nil {
+				m.LatestArtifact = &Artifact{}
+			}
+			if err := m.LatestArtifact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstallURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InstallURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefreshBuilds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefreshBuilds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefreshBuilds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefreshBuilds_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Project = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefreshBuilds_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBuilds", wireType)
+			}
+			m.UpdatedBuilds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedBuilds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v Driver
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowYolopb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Driver(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Drivers = append(m.Drivers, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowYolopb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthYolopb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthYolopb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Drivers) == 0 {
+					m.Drivers = make([]Driver, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Driver
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowYolopb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Driver(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Drivers = append(m.Drivers, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Drivers", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamBuildUpdates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamBuildUpdates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamBuildUpdates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamBuildUpdates_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectID = append(m.ProjectID, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v Artifact_Kind
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowYolopb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Artifact_Kind(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ArtifactKinds = append(m.ArtifactKinds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowYolopb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthYolopb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthYolopb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.ArtifactKinds) == 0 {
+					m.ArtifactKinds = make([]Artifact_Kind, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Artifact_Kind
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowYolopb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Artifact_Kind(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ArtifactKinds = append(m.ArtifactKinds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactKinds", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotSize", wireType)
+			}
+			m.SnapshotSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamBuildUpdates_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Build", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Build == nil {
+				m.Build = &Build{}
+			}
+			if err := m.Build.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WhatsNew) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WhatsNew: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WhatsNew: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WhatsNew_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WhatsNew_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Builds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Builds = append(m.Builds, &Build{})
+			if err := m.Builds[len(m.Builds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseNotes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReleaseNotes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commits = append(m.Commits, &Commit{})
+			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompareURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompareURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignArtifact_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArtifactID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TTLSeconds", wireType)
+			}
+			m.TTLSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TTLSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SingleUse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SingleUse = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Device = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignArtifact_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifact", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Artifact == nil {
+				m.Artifact = &Artifact{}
+			}
+			if err := m.Artifact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BranchStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BranchStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BranchStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BranchStats_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = append(m.Branch, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Since = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Until = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BranchStats_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branches = append(m.Branches, &BranchStats_Entry{})
+			if err := m.Branches[len(m.Branches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BranchStats_Entry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	if len(m.Releases) > 0 {
-		for _, e := range m.Releases {
-			l = e.Size()
-			n += 1 + l + sovYolopb(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Entry: wiretype end group for non-group")
 		}
-	}
-	if len(m.Commits) > 0 {
-		for _, e := range m.Commits {
-			l = e.Size()
-			n += 1 + l + sovYolopb(uint64(l))
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Entry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
-	}
-	if len(m.MergeRequests) > 0 {
-		for _, e := range m.MergeRequests {
-			l = e.Size()
-			n += 1 + l + sovYolopb(uint64(l))
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passed", wireType)
+			}
+			m.Passed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Passed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PassRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.PassRate = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageDurationSeconds", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.AverageDurationSeconds = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBuildAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastBuildAt == nil {
+				m.LastBuildAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastBuildAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovYolopb(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozYolopb(x uint64) (n int) {
-	return sovYolopb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Ping) Unmarshal(dAtA []byte) error {
+func (m *BuildListFilters) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6266,10 +17510,10 @@ func (m *Ping) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Ping: wiretype end group for non-group")
+			return fmt.Errorf("proto: BuildListFilters: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Ping: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BuildListFilters: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6296,7 +17540,7 @@ func (m *Ping) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Ping_Request) Unmarshal(dAtA []byte) error {
+func (m *BuildListFilters_Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6349,7 +17593,7 @@ func (m *Ping_Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Ping_Response) Unmarshal(dAtA []byte) error {
+func (m *BuildListFilters_Response) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6368,16 +17612,84 @@ func (m *Ping_Response) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Response: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entities = append(m.Entities, &Entity{})
+			if err := m.Entities[len(m.Entities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Projects", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Projects = append(m.Projects, &Project{})
+			if err := m.Projects[len(m.Projects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipYolopb(dAtA[iNdEx:])
@@ -6402,7 +17714,7 @@ func (m *Ping_Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DevDumpObjects) Unmarshal(dAtA []byte) error {
+func (m *MetadataOverride) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6421,16 +17733,303 @@ func (m *DevDumpObjects) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DevDumpObjects: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DevDumpObjects: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetadataOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetadataOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseNotes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReleaseNotes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 103:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasCommitID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HasCommitID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 105:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasProjectID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HasProjectID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 107:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMergeRequestID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HasMergeRequestID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 205:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BuildConfig == nil {
+				m.BuildConfig = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowYolopb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowYolopb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthYolopb
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthYolopb
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowYolopb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthYolopb
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthYolopb
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipYolopb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthYolopb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.BuildConfig[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipYolopb(dAtA[iNdEx:])
@@ -6455,7 +18054,7 @@ func (m *DevDumpObjects) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DevDumpObjects_Request) Unmarshal(dAtA []byte) error {
+func (m *Build) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6478,17 +18077,17 @@ func (m *DevDumpObjects_Request) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+			return fmt.Errorf("proto: Build: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Build: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithPreloading", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -6498,68 +18097,218 @@ func (m *DevDumpObjects_Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.WithPreloading = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipYolopb(dAtA[iNdEx:])
-			if err != nil {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YoloID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.YoloID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DevDumpObjects_Response) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowYolopb
+			if m.UpdatedAt == nil {
+				m.UpdatedAt = new(time.Time)
 			}
-			if iNdEx >= l {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= Build_State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.CompletedAt == nil {
+				m.CompletedAt = new(time.Time)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Response: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CompletedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6586,16 +18335,16 @@ func (m *DevDumpObjects_Response) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Batch == nil {
-				m.Batch = &Batch{}
+			if m.StartedAt == nil {
+				m.StartedAt = new(time.Time)
 			}
-			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Downloads", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6622,175 +18371,133 @@ func (m *DevDumpObjects_Response) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Downloads = append(m.Downloads, &Download{})
-			if err := m.Downloads[len(m.Downloads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.FinishedAt == nil {
+				m.FinishedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.FinishedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipYolopb(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitURL", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthYolopb
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Status) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowYolopb
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.CommitURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Status: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Status: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipYolopb(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Status_Request) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowYolopb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Driver", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Driver = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Driver |= Driver(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Request: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipYolopb(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortID", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthYolopb
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Status_Response) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowYolopb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Response: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
 			}
-			m.Uptime = 0
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShortID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VCSTag", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -6800,14 +18507,27 @@ func (m *Status_Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Uptime |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VCSTag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DbErr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VCSTagURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6835,13 +18555,13 @@ func (m *Status_Response) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DbErr = string(dAtA[iNdEx:postIndex])
+			m.VCSTagURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NbEntities", wireType)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseNotes", wireType)
 			}
-			m.NbEntities = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -6851,16 +18571,29 @@ func (m *Status_Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NbEntities |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NbProjects", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
 			}
-			m.NbProjects = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReleaseNotes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildConfigJSON", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -6870,16 +18603,29 @@ func (m *Status_Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NbProjects |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NbCommits", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
 			}
-			m.NbCommits = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildConfigJSON = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerTeamsJSON", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -6889,16 +18635,29 @@ func (m *Status_Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NbCommits |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerTeamsJSON = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NbReleases", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OverBudget", wireType)
 			}
-			m.NbReleases = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -6908,16 +18667,17 @@ func (m *Status_Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NbReleases |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NbBuilds", wireType)
+			m.OverBudget = bool(v != 0)
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlagsJSON", wireType)
 			}
-			m.NbBuilds = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -6927,16 +18687,29 @@ func (m *Status_Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NbBuilds |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NbMergeRequests", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
 			}
-			m.NbMergeRequests = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FlagsJSON = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawBranch", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -6946,122 +18719,65 @@ func (m *Status_Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NbMergeRequests |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipYolopb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BuildList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowYolopb
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.RawBranch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasRawCommit", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BuildList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BuildList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipYolopb(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BuildList_Request) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowYolopb
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Request: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
-			}
-			m.Limit = 0
+			if m.HasRawCommit == nil {
+				m.HasRawCommit = &Commit{}
+			}
+			if err := m.HasRawCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasRawProject", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -7071,85 +18787,33 @@ func (m *BuildList_Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType == 0 {
-				var v Artifact_Kind
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowYolopb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= Artifact_Kind(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ArtifactKinds = append(m.ArtifactKinds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowYolopb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthYolopb
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthYolopb
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				if elementCount != 0 && len(m.ArtifactKinds) == 0 {
-					m.ArtifactKinds = make([]Artifact_Kind, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v Artifact_Kind
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowYolopb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= Artifact_Kind(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ArtifactKinds = append(m.ArtifactKinds, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactKinds", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthYolopb
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithArtifacts", wireType)
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
 			}
-			var v int
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HasRawProject == nil {
+				m.HasRawProject = &Project{}
+			}
+			if err := m.HasRawProject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasRawMergerequest", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -7159,15 +18823,31 @@ func (m *BuildList_Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.WithArtifacts = bool(v != 0)
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HasRawMergerequest == nil {
+				m.HasRawMergerequest = &MergeRequest{}
+			}
+			if err := m.HasRawMergerequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuildID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasRawCommitID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7195,11 +18875,11 @@ func (m *BuildList_Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BuildID = append(m.BuildID, string(dAtA[iNdEx:postIndex]))
+			m.HasRawCommitID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProjectID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasRawProjectID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7227,11 +18907,11 @@ func (m *BuildList_Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProjectID = append(m.ProjectID, string(dAtA[iNdEx:postIndex]))
+			m.HasRawProjectID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasRawMergerequestID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7257,151 +18937,45 @@ func (m *BuildList_Request) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ArtifactID = append(m.ArtifactID, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 7:
-			if wireType == 0 {
-				var v Driver
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowYolopb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= Driver(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.BuildDriver = append(m.BuildDriver, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowYolopb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthYolopb
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthYolopb
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				if elementCount != 0 && len(m.BuildDriver) == 0 {
-					m.BuildDriver = make([]Driver, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v Driver
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowYolopb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= Driver(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.BuildDriver = append(m.BuildDriver, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuildDriver", wireType)
-			}
-		case 8:
-			if wireType == 0 {
-				var v Build_State
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowYolopb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= Build_State(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.BuildState = append(m.BuildState, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowYolopb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthYolopb
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthYolopb
+				return io.ErrUnexpectedEOF
+			}
+			m.HasRawMergerequestID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitAuthor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				if elementCount != 0 && len(m.BuildState) == 0 {
-					m.BuildState = make([]Build_State, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v Build_State
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowYolopb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= Build_State(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.BuildState = append(m.BuildState, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuildState", wireType)
 			}
-		case 9:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitAuthor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MergeRequestID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitEmail", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7429,11 +19003,11 @@ func (m *BuildList_Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MergeRequestID = append(m.MergeRequestID, string(dAtA[iNdEx:postIndex]))
+			m.CommitEmail = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MergeRequestAuthorID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitAuthorAvatarURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7461,13 +19035,13 @@ func (m *BuildList_Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MergeRequestAuthorID = append(m.MergeRequestAuthorID, string(dAtA[iNdEx:postIndex]))
+			m.CommitAuthorAvatarURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 31:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithMergerequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var v int
+			m.Duration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -7477,84 +19051,148 @@ func (m *BuildList_Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Duration |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.WithMergerequest = bool(v != 0)
-		case 12:
-			if wireType == 0 {
-				var v MergeRequest_State
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowYolopb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= MergeRequest_State(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
 				}
-				m.MergerequestState = append(m.MergerequestState, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowYolopb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthYolopb
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthYolopb
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				if elementCount != 0 && len(m.MergerequestState) == 0 {
-					m.MergerequestState = make([]MergeRequest_State, 0, elementCount)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				for iNdEx < postIndex {
-					var v MergeRequest_State
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowYolopb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= MergeRequest_State(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MergerequestState = append(m.MergerequestState, v)
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 101:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasArtifacts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MergerequestState", wireType)
 			}
-		case 13:
+			if msglen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HasArtifacts = append(m.HasArtifacts, &Artifact{})
+			if err := m.HasArtifacts[len(m.HasArtifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 102:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HasCommit == nil {
+				m.HasCommit = &Commit{}
+			}
+			if err := m.HasCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 103:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasCommitID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7582,13 +19220,13 @@ func (m *BuildList_Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = append(m.Branch, string(dAtA[iNdEx:postIndex]))
+			m.HasCommitID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithNoMergerequest", wireType)
+		case 104:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasProject", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -7598,17 +19236,33 @@ func (m *BuildList_Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.WithNoMergerequest = bool(v != 0)
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SortByCommitDate", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthYolopb
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HasProject == nil {
+				m.HasProject = &Project{}
+			}
+			if err := m.HasProject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 105:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasProjectID", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -7618,68 +19272,27 @@ func (m *BuildList_Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SortByCommitDate = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipYolopb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BuildList_Response) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowYolopb
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Response: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.HasProjectID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 106:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Builds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMergerequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7706,175 +19319,18 @@ func (m *BuildList_Response) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Builds = append(m.Builds, &Build{})
-			if err := m.Builds[len(m.Builds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipYolopb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BuildListFilters) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowYolopb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BuildListFilters: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BuildListFilters: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipYolopb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BuildListFilters_Request) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowYolopb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Request: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipYolopb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BuildListFilters_Response) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowYolopb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Response: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if m.HasMergerequest == nil {
+				m.HasMergerequest = &MergeRequest{}
+			}
+			if err := m.HasMergerequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 107:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entities", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMergerequestID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -7884,31 +19340,29 @@ func (m *BuildListFilters_Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entities = append(m.Entities, &Entity{})
-			if err := m.Entities[len(m.Entities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.HasMergerequestID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 201:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Projects", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -7918,82 +19372,65 @@ func (m *BuildListFilters_Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Projects = append(m.Projects, &Project{})
-			if err := m.Projects[len(m.Projects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Channels = append(m.Channels, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipYolopb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 202:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadsCount", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MetadataOverride) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowYolopb
+			m.DownloadsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownloadsCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 203:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstallsCount", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.InstallsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InstallsCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MetadataOverride: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MetadataOverride: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 11:
+		case 204:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InstallSignedURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8021,13 +19458,13 @@ func (m *MetadataOverride) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			m.InstallSignedURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 103:
+		case 205:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasCommitID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -8037,27 +19474,122 @@ func (m *MetadataOverride) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HasCommitID = string(dAtA[iNdEx:postIndex])
+			if m.BuildConfig == nil {
+				m.BuildConfig = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowYolopb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowYolopb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthYolopb
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthYolopb
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowYolopb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthYolopb
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthYolopb
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipYolopb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthYolopb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.BuildConfig[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 105:
+		case 206:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasProjectID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerTeams", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8085,13 +19617,13 @@ func (m *MetadataOverride) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HasProjectID = string(dAtA[iNdEx:postIndex])
+			m.OwnerTeams = append(m.OwnerTeams, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 107:
+		case 207:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasMergeRequestID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -8101,23 +19633,118 @@ func (m *MetadataOverride) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HasMergeRequestID = string(dAtA[iNdEx:postIndex])
+			if msglen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flags == nil {
+				m.Flags = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowYolopb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowYolopb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthYolopb
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthYolopb
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowYolopb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthYolopb
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthYolopb
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipYolopb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthYolopb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Flags[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8143,7 +19770,7 @@ func (m *MetadataOverride) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Build) Unmarshal(dAtA []byte) error {
+func (m *Release) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8166,10 +19793,10 @@ func (m *Build) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Build: wiretype end group for non-group")
+			return fmt.Errorf("proto: Release: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Build: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Release: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8309,61 +19936,6 @@ func (m *Build) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
-			}
-			m.State = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowYolopb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.State |= Build_State(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletedAt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowYolopb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CompletedAt == nil {
-				m.CompletedAt = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CompletedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
@@ -8395,143 +19967,7 @@ func (m *Build) Unmarshal(dAtA []byte) error {
 			}
 			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowYolopb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.StartedAt == nil {
-				m.StartedAt = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowYolopb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.FinishedAt == nil {
-				m.FinishedAt = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.FinishedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitURL", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowYolopb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CommitURL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowYolopb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
+		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Driver", wireType)
 			}
@@ -8550,41 +19986,9 @@ func (m *Build) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShortID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowYolopb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ShortID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 14:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VCSTag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8612,11 +20016,11 @@ func (m *Build) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VCSTag = string(dAtA[iNdEx:postIndex])
+			m.CommitURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VCSTagURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8644,13 +20048,13 @@ func (m *Build) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VCSTagURL = string(dAtA[iNdEx:postIndex])
+			m.ShortID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 21:
+		case 101:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasArtifacts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -8660,27 +20064,29 @@ func (m *Build) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RawBranch = string(dAtA[iNdEx:postIndex])
+			m.HasArtifacts = append(m.HasArtifacts, &Artifact{})
+			if err := m.HasArtifacts[len(m.HasArtifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 22:
+		case 102:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasRawCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8707,16 +20113,16 @@ func (m *Build) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HasRawCommit == nil {
-				m.HasRawCommit = &Commit{}
+			if m.HasCommit == nil {
+				m.HasCommit = &Commit{}
 			}
-			if err := m.HasRawCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HasCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 23:
+		case 103:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasRawProject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasProject", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8743,16 +20149,16 @@ func (m *Build) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HasRawProject == nil {
-				m.HasRawProject = &Project{}
+			if m.HasProject == nil {
+				m.HasProject = &Project{}
 			}
-			if err := m.HasRawProject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HasProject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 24:
+		case 104:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasRawMergerequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMergerequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8779,16 +20185,69 @@ func (m *Build) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HasRawMergerequest == nil {
-				m.HasRawMergerequest = &MergeRequest{}
+			if m.HasMergerequest == nil {
+				m.HasMergerequest = &MergeRequest{}
 			}
-			if err := m.HasRawMergerequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HasMergerequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 25:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Commit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Commit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Commit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasRawCommitID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8816,11 +20275,11 @@ func (m *Build) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HasRawCommitID = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 26:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasRawProjectID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field YoloID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8848,13 +20307,13 @@ func (m *Build) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HasRawProjectID = string(dAtA[iNdEx:postIndex])
+			m.YoloID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 27:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasRawMergerequestID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -8864,27 +20323,31 @@ func (m *Build) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HasRawMergerequestID = string(dAtA[iNdEx:postIndex])
+			if m.CreatedAt == nil {
+				m.CreatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 101:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasArtifacts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8911,16 +20374,18 @@ func (m *Build) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HasArtifacts = append(m.HasArtifacts, &Artifact{})
-			if err := m.HasArtifacts[len(m.HasArtifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.UpdatedAt == nil {
+				m.UpdatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 102:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -8930,31 +20395,46 @@ func (m *Build) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HasCommit == nil {
-				m.HasCommit = &Commit{}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Driver", wireType)
 			}
-			if err := m.HasCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Driver = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Driver |= Driver(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 103:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasCommitID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8982,11 +20462,11 @@ func (m *Build) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HasCommitID = string(dAtA[iNdEx:postIndex])
+			m.Branch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 104:
+		case 101:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasProject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasReleases", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9013,18 +20493,50 @@ func (m *Build) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HasProject == nil {
-				m.HasProject = &Project{}
+			m.HasReleases = append(m.HasReleases, &Release{})
+			if err := m.HasReleases[len(m.HasReleases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.HasProject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 102:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasBuilds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HasBuilds = append(m.HasBuilds, &Build{})
+			if err := m.HasBuilds[len(m.HasBuilds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 105:
+		case 103:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasProjectID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasProject", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -9034,27 +20546,31 @@ func (m *Build) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HasProjectID = string(dAtA[iNdEx:postIndex])
+			if m.HasProject == nil {
+				m.HasProject = &Project{}
+			}
+			if err := m.HasProject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 106:
+		case 104:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasMergerequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasAuthor", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9081,18 +20597,18 @@ func (m *Build) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HasMergerequest == nil {
-				m.HasMergerequest = &MergeRequest{}
+			if m.HasAuthor == nil {
+				m.HasAuthor = &Entity{}
 			}
-			if err := m.HasMergerequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HasAuthor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 107:
+		case 105:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasMergerequestID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMergerequest", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -9102,23 +20618,27 @@ func (m *Build) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HasMergerequestID = string(dAtA[iNdEx:postIndex])
+			if m.HasMergerequest == nil {
+				m.HasMergerequest = &MergeRequest{}
+			}
+			if err := m.HasMergerequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9144,7 +20664,7 @@ func (m *Build) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Release) Unmarshal(dAtA []byte) error {
+func (m *MergeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9167,10 +20687,10 @@ func (m *Release) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Release: wiretype end group for non-group")
+			return fmt.Errorf("proto: MergeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Release: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MergeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9311,9 +20831,9 @@ func (m *Release) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MergedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -9323,46 +20843,31 @@ func (m *Release) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Driver", wireType)
+			if m.MergedAt == nil {
+				m.MergedAt = new(time.Time)
 			}
-			m.Driver = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowYolopb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Driver |= Driver(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.MergedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 7:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9390,11 +20895,11 @@ func (m *Release) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CommitURL = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShortID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9422,13 +20927,13 @@ func (m *Release) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ShortID = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 101:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasArtifacts", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Driver", wireType)
 			}
-			var msglen int
+			m.Driver = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -9438,31 +20943,16 @@ func (m *Release) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Driver |= Driver(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HasArtifacts = append(m.HasArtifacts, &Artifact{})
-			if err := m.HasArtifacts[len(m.HasArtifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 102:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -9472,33 +20962,48 @@ func (m *Release) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HasCommit == nil {
-				m.HasCommit = &Commit{}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			if err := m.HasCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= MergeRequest_State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 103:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasProject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -9508,33 +21013,29 @@ func (m *Release) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HasProject == nil {
-				m.HasProject = &Project{}
-			}
-			if err := m.HasProject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CommitURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 104:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasMergerequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -9544,84 +21045,27 @@ func (m *Release) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HasMergerequest == nil {
-				m.HasMergerequest = &MergeRequest{}
-			}
-			if err := m.HasMergerequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BranchURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipYolopb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Commit) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowYolopb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Commit: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Commit: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9649,13 +21093,33 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.ShortID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsWIP", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsWIP = bool(v != 0)
+		case 101:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field YoloID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasReleases", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -9665,27 +21129,29 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.YoloID = string(dAtA[iNdEx:postIndex])
+			m.HasReleases = append(m.HasReleases, &Release{})
+			if err := m.HasReleases[len(m.HasReleases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 102:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasBuilds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9712,16 +21178,14 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			m.HasBuilds = append(m.HasBuilds, &Build{})
+			if err := m.HasBuilds[len(m.HasBuilds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 103:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasAssignees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9748,18 +21212,16 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UpdatedAt == nil {
-				m.UpdatedAt = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			m.HasAssignees = append(m.HasAssignees, &Entity{})
+			if err := m.HasAssignees[len(m.HasAssignees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 104:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasReviewers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -9769,48 +21231,31 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Driver", wireType)
-			}
-			m.Driver = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowYolopb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Driver |= Driver(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.HasReviewers = append(m.HasReviewers, &Entity{})
+			if err := m.HasReviewers[len(m.HasReviewers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 7:
+			iNdEx = postIndex
+		case 105:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasProject", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -9820,29 +21265,33 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			if m.HasProject == nil {
+				m.HasProject = &Project{}
+			}
+			if err := m.HasProject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 101:
+		case 106:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasReleases", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasProjectID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -9852,29 +21301,27 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HasReleases = append(m.HasReleases, &Release{})
-			if err := m.HasReleases[len(m.HasReleases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.HasProjectID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 102:
+		case 107:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasBuilds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasAuthor", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9901,16 +21348,18 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HasBuilds = append(m.HasBuilds, &Build{})
-			if err := m.HasBuilds[len(m.HasBuilds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.HasAuthor == nil {
+				m.HasAuthor = &Entity{}
+			}
+			if err := m.HasAuthor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 103:
+		case 108:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasProject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasAuthorID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -9920,31 +21369,27 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HasProject == nil {
-				m.HasProject = &Project{}
-			}
-			if err := m.HasProject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.HasAuthorID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 104:
+		case 109:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasAuthor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9971,18 +21416,18 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HasAuthor == nil {
-				m.HasAuthor = &Entity{}
+			if m.HasCommit == nil {
+				m.HasCommit = &Commit{}
 			}
-			if err := m.HasAuthor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HasCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 105:
+		case 110:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasMergerequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasCommitID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -9992,27 +21437,23 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HasMergerequest == nil {
-				m.HasMergerequest = &MergeRequest{}
-			}
-			if err := m.HasMergerequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.HasCommitID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10038,7 +21479,7 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MergeRequest) Unmarshal(dAtA []byte) error {
+func (m *Project) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10061,10 +21502,10 @@ func (m *MergeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MergeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Project: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MergeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Project: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10204,10 +21645,29 @@ func (m *MergeRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Driver", wireType)
+			}
+			m.Driver = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Driver |= Driver(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MergedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -10217,31 +21677,27 @@ func (m *MergeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MergedAt == nil {
-				m.MergedAt = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.MergedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10269,13 +21725,47 @@ func (m *MergeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 101:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasArtifacts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HasArtifacts = append(m.HasArtifacts, &Artifact{})
+			if err := m.HasArtifacts[len(m.HasArtifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 102:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasBuilds", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -10285,29 +21775,31 @@ func (m *MergeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.HasBuilds = append(m.HasBuilds, &Build{})
+			if err := m.HasBuilds[len(m.HasBuilds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Driver", wireType)
+		case 103:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasCommits", wireType)
 			}
-			m.Driver = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -10317,16 +21809,31 @@ func (m *MergeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Driver |= Driver(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			if msglen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HasCommits = append(m.HasCommits, &Commit{})
+			if err := m.HasCommits[len(m.HasCommits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 104:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasReleases", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -10336,29 +21843,31 @@ func (m *MergeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			m.HasReleases = append(m.HasReleases, &Release{})
+			if err := m.HasReleases[len(m.HasReleases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+		case 105:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMergerequests", wireType)
 			}
-			m.State = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -10368,16 +21877,31 @@ func (m *MergeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= MergeRequest_State(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+			if msglen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HasMergerequests = append(m.HasMergerequests, &MergeRequest{})
+			if err := m.HasMergerequests[len(m.HasMergerequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 106:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasOwner", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -10387,27 +21911,31 @@ func (m *MergeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CommitURL = string(dAtA[iNdEx:postIndex])
+			if m.HasOwner == nil {
+				m.HasOwner = &Entity{}
+			}
+			if err := m.HasOwner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
+		case 107:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BranchURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasOwnerID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10435,11 +21963,64 @@ func (m *MergeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BranchURL = string(dAtA[iNdEx:postIndex])
+			m.HasOwnerID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Entity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Entity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Entity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShortID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10467,33 +22048,13 @@ func (m *MergeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ShortID = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsWIP", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowYolopb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsWIP = bool(v != 0)
-		case 101:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasReleases", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field YoloID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -10503,29 +22064,27 @@ func (m *MergeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HasReleases = append(m.HasReleases, &Release{})
-			if err := m.HasReleases[len(m.HasReleases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.YoloID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 102:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasBuilds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10552,14 +22111,16 @@ func (m *MergeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HasBuilds = append(m.HasBuilds, &Build{})
-			if err := m.HasBuilds[len(m.HasBuilds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CreatedAt == nil {
+				m.CreatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 103:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasAssignees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10586,16 +22147,18 @@ func (m *MergeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HasAssignees = append(m.HasAssignees, &Entity{})
-			if err := m.HasAssignees[len(m.HasAssignees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.UpdatedAt == nil {
+				m.UpdatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 104:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasReviewers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -10605,31 +22168,29 @@ func (m *MergeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HasReviewers = append(m.HasReviewers, &Entity{})
-			if err := m.HasReviewers[len(m.HasReviewers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 105:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasProject", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Driver", wireType)
 			}
-			var msglen int
+			m.Driver = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -10639,31 +22200,14 @@ func (m *MergeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Driver |= Driver(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.HasProject == nil {
-				m.HasProject = &Project{}
-			}
-			if err := m.HasProject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 106:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasProjectID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AvatarURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10691,13 +22235,32 @@ func (m *MergeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HasProjectID = string(dAtA[iNdEx:postIndex])
+			m.AvatarURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 107:
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= Entity_Kind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasAuthor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -10707,33 +22270,29 @@ func (m *MergeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HasAuthor == nil {
-				m.HasAuthor = &Entity{}
-			}
-			if err := m.HasAuthor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 108:
+		case 101:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasAuthorID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasProjects", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -10743,27 +22302,29 @@ func (m *MergeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HasAuthorID = string(dAtA[iNdEx:postIndex])
+			m.HasProjects = append(m.HasProjects, &Project{})
+			if err := m.HasProjects[len(m.HasProjects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 109:
+		case 102:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasCommits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10790,18 +22351,16 @@ func (m *MergeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HasCommit == nil {
-				m.HasCommit = &Commit{}
-			}
-			if err := m.HasCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HasCommits = append(m.HasCommits, &Commit{})
+			if err := m.HasCommits[len(m.HasCommits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 110:
+		case 103:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasCommitID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMergerequests", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -10811,23 +22370,25 @@ func (m *MergeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HasCommitID = string(dAtA[iNdEx:postIndex])
+			m.HasMergerequests = append(m.HasMergerequests, &MergeRequest{})
+			if err := m.HasMergerequests[len(m.HasMergerequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10853,7 +22414,7 @@ func (m *MergeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Project) Unmarshal(dAtA []byte) error {
+func (m *Artifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10876,10 +22437,10 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Project: wiretype end group for non-group")
+			return fmt.Errorf("proto: Artifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Project: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Artifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11020,126 +22581,9 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Driver", wireType)
-			}
-			m.Driver = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowYolopb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Driver |= Driver(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowYolopb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowYolopb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 101:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasArtifacts", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowYolopb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HasArtifacts = append(m.HasArtifacts, &Artifact{})
-			if err := m.HasArtifacts[len(m.HasArtifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 102:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasBuilds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FileSize", wireType)
 			}
-			var msglen int
+			m.FileSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -11149,31 +22593,16 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HasBuilds = append(m.HasBuilds, &Build{})
-			if err := m.HasBuilds[len(m.HasBuilds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				m.FileSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 103:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasCommits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalPath", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -11183,31 +22612,29 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HasCommits = append(m.HasCommits, &Commit{})
-			if err := m.HasCommits[len(m.HasCommits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LocalPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 104:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasReleases", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -11217,31 +22644,29 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HasReleases = append(m.HasReleases, &Release{})
-			if err := m.HasReleases[len(m.HasReleases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DownloadURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 105:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasMergerequests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MimeType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -11251,31 +22676,29 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HasMergerequests = append(m.HasMergerequests, &MergeRequest{})
-			if err := m.HasMergerequests[len(m.HasMergerequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MimeType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 106:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sha1Sum", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -11285,31 +22708,27 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HasOwner == nil {
-				m.HasOwner = &Entity{}
-			}
-			if err := m.HasOwner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sha1Sum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 107:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasOwnerID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sha256Sum", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11337,64 +22756,68 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HasOwnerID = string(dAtA[iNdEx:postIndex])
+			m.Sha256Sum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipYolopb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthYolopb
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthYolopb
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= Artifact_State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Entity) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowYolopb
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= Artifact_Kind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Driver", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Driver = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Driver |= Driver(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Entity: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Entity: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11422,11 +22845,11 @@ func (m *Entity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.BundleName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field YoloID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11454,13 +22877,13 @@ func (m *Entity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.YoloID = string(dAtA[iNdEx:postIndex])
+			m.BundleVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -11470,33 +22893,29 @@ func (m *Entity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BundleID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleIcon", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -11506,31 +22925,27 @@ func (m *Entity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UpdatedAt == nil {
-				m.UpdatedAt = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BundleIcon = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Variant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11558,13 +22973,13 @@ func (m *Entity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Variant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 19:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Driver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OverBudget", wireType)
 			}
-			m.Driver = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -11574,14 +22989,15 @@ func (m *Entity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Driver |= Driver(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			m.OverBudget = bool(v != 0)
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvatarURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleBuildVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11609,13 +23025,13 @@ func (m *Entity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AvatarURL = string(dAtA[iNdEx:postIndex])
+			m.BundleBuildVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Md5Sum", wireType)
 			}
-			m.Kind = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -11625,14 +23041,27 @@ func (m *Entity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Kind |= Entity_Kind(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Md5Sum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Arch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11660,13 +23089,13 @@ func (m *Entity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Arch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 101:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasProjects", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DuplicateOfID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -11676,31 +23105,29 @@ func (m *Entity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HasProjects = append(m.HasProjects, &Project{})
-			if err := m.HasProjects[len(m.HasProjects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DuplicateOfID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 102:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasCommits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SparkleEdSignature", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -11710,29 +23137,27 @@ func (m *Entity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HasCommits = append(m.HasCommits, &Commit{})
-			if err := m.HasCommits[len(m.HasCommits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SparkleEdSignature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 103:
+		case 101:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasMergerequests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasBuild", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11759,67 +23184,16 @@ func (m *Entity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HasMergerequests = append(m.HasMergerequests, &MergeRequest{})
-			if err := m.HasMergerequests[len(m.HasMergerequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.HasBuild == nil {
+				m.HasBuild = &Build{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipYolopb(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.HasBuild.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Artifact) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowYolopb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Artifact: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Artifact: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 102:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasBuildID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11847,13 +23221,13 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.HasBuildID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 103:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field YoloID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasRelease", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -11863,29 +23237,33 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.YoloID = string(dAtA[iNdEx:postIndex])
+			if m.HasRelease == nil {
+				m.HasRelease = &Release{}
+			}
+			if err := m.HasRelease.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 104:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasReleaseID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -11895,31 +23273,27 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.HasReleaseID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 105:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Downloads", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11946,18 +23320,16 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UpdatedAt == nil {
-				m.UpdatedAt = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			m.Downloads = append(m.Downloads, &Download{})
+			if err := m.Downloads[len(m.Downloads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 106:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadsCount", wireType)
 			}
-			m.FileSize = 0
+			m.DownloadsCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -11967,14 +23339,14 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FileSize |= int64(b&0x7F) << shift
+				m.DownloadsCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 201:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocalPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DLArtifactSignedURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12002,11 +23374,11 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LocalPath = string(dAtA[iNdEx:postIndex])
+			m.DLArtifactSignedURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 202:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PListSignedURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12034,13 +23406,85 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DownloadURL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+			m.PListSignedURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Download) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Download: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Download: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MimeType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -12050,29 +23494,33 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MimeType = string(dAtA[iNdEx:postIndex])
+			if m.CreatedAt == nil {
+				m.CreatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 101:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sha1Sum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasArtifact", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -12082,27 +23530,31 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sha1Sum = string(dAtA[iNdEx:postIndex])
+			if m.HasArtifact == nil {
+				m.HasArtifact = &Artifact{}
+			}
+			if err := m.HasArtifact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		case 102:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sha256Sum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasArtifactID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12130,13 +23582,66 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sha256Sum = string(dAtA[iNdEx:postIndex])
+			m.HasArtifactID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Install) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Install: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Install: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.State = 0
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -12146,16 +23651,16 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= Artifact_State(b&0x7F) << shift
+				m.ID |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			m.Kind = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -12165,16 +23670,33 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Kind |= Artifact_Kind(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Driver", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthYolopb
 			}
-			m.Driver = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstallID", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -12184,16 +23706,29 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Driver |= Driver(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InstallID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 101:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BundleName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasBuild", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -12203,27 +23738,31 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BundleName = string(dAtA[iNdEx:postIndex])
+			if m.HasBuild == nil {
+				m.HasBuild = &Build{}
+			}
+			if err := m.HasBuild.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 15:
+		case 102:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BundleVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasBuildID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12251,13 +23790,85 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BundleVersion = string(dAtA[iNdEx:postIndex])
+			m.HasBuildID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Promotion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Promotion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Promotion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BundleID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -12267,27 +23878,31 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BundleID = string(dAtA[iNdEx:postIndex])
+			if m.CreatedAt == nil {
+				m.CreatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 17:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BundleIcon", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12315,7 +23930,7 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BundleIcon = string(dAtA[iNdEx:postIndex])
+			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 101:
 			if wireType != 2 {
@@ -12385,45 +24000,62 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 			}
 			m.HasBuildID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 103:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasRelease", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowYolopb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HasRelease == nil {
-				m.HasRelease = &Release{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Setting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
 			}
-			if err := m.HasRelease.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 104:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Setting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Setting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasReleaseID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12451,13 +24083,13 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HasReleaseID = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 105:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Downloads", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -12467,48 +24099,80 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYolopb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthYolopb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Downloads = append(m.Downloads, &Download{})
-			if err := m.Downloads[len(m.Downloads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYolopb(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 106:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadsCount", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthYolopb
 			}
-			m.DownloadsCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowYolopb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DownloadsCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYolopb
 			}
-		case 201:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCounter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYolopb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCounter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DLArtifactSignedURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12536,11 +24200,11 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DLArtifactSignedURL = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 202:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PListSignedURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12568,8 +24232,27 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PListSignedURL = string(dAtA[iNdEx:postIndex])
+			m.ObjectID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYolopb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipYolopb(dAtA[iNdEx:])
@@ -12594,7 +24277,7 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Download) Unmarshal(dAtA []byte) error {
+func (m *SpentSignature) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12617,17 +24300,17 @@ func (m *Download) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Download: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpentSignature: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Download: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpentSignature: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.ID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYolopb
@@ -12637,11 +24320,24 @@ func (m *Download) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYolopb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
@@ -12678,9 +24374,9 @@ func (m *Download) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 101:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasArtifact", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12707,45 +24403,13 @@ func (m *Download) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HasArtifact == nil {
-				m.HasArtifact = &Artifact{}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
 			}
-			if err := m.HasArtifact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 102:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasArtifactID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowYolopb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthYolopb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HasArtifactID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipYolopb(dAtA[iNdEx:])
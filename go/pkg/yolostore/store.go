@@ -1,9 +1,12 @@
 package yolostore
 
 import (
+	"database/sql"
+	"errors"
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"berty.tech/yolo/v2/go/pkg/yolopb"
 	"go.uber.org/zap"
@@ -15,12 +18,28 @@ type Store interface {
 	// artifacts store
 	GetArtifactByID(id string) (*yolopb.Artifact, error)
 	GetAllArtifactsWithoutBundleID() ([]*yolopb.Artifact, error)
+	GetArtifactsByBuildID(buildID string, kind yolopb.Artifact_Kind) ([]*yolopb.Artifact, error)
 	SaveArtifact(artifact *yolopb.Artifact) error
+	SetArtifactMimeType(id, mimetype string) error
+	GetArtifactMimeTypes(ids []string) (map[string]string, error)
+	SetArtifactChecksums(id, md5Sum, sha256Sum string) error
+	GetArtifactChecksums(ids []string) (map[string]*yolopb.Artifact, error)
+	GetArtifactsBySHA256(sums []string) (map[string]*yolopb.Artifact, error)
+	SetArtifactDuplicateOf(id, duplicateOfID string) error
+	GetArtifactStates(ids []string) (map[string]yolopb.Artifact_State, error)
+	GetOrphanArtifacts() ([]*yolopb.Artifact, error)
+	DeleteArtifacts(ids []string) (map[string]string, error)
 
 	// build store
 	GetBuildListFilters() (*BuildListFilters, error)
 	GetLastBuild(driver yolopb.Driver) (*yolopb.Build, error)
+	GetProjectDrivers(projectID string, buildIDPrefixes []string) ([]yolopb.Driver, error)
 	GetBuildList(bl GetBuildListOpts) ([]*yolopb.Build, error)
+	GetBuildByID(id string) (*yolopb.Build, error)
+	PromoteBuild(buildID, channel string) (*yolopb.Build, error)
+	GetBranchStats(opts GetBranchStatsOpts) ([]*yolopb.BranchStats_Entry, error)
+	SearchBuilds(query string, limit int32, hideUnpromoted []UnpromotedBranch) ([]*yolopb.Build, error)
+	GetSummary(projectIDs []string) ([]*yolopb.Summary_Entry, error)
 
 	// batch store
 	GetBatchWithPreloading() (*yolopb.Batch, error)
@@ -30,11 +49,31 @@ type Store interface {
 	// download store
 	GetDumpWithPreloading() ([]*yolopb.Download, error)
 	CreateDownload(download *yolopb.Download) error
+	GetArtifactDownloadStats(artifactID string) (*ArtifactDownloadStats, error)
+
+	// install store
+	CreateInstall(install *yolopb.Install) (bool, error)
+
+	// single-use signature store
+	IsSignatureSpent(signature string) (bool, error)
+	SpendSignature(spent *yolopb.SpentSignature) error
+	DeleteExpiredSpentSignatures(before time.Time) (int64, error)
+
+	// retention
+	TrimEvents(before time.Time) (int64, error)
+	GetExpiredBuildIDs(before time.Time, keepPerProject int) ([]string, error)
+	DeleteBuilds(ids []string) error
+
+	// settings
+	GetOrCreateSetting(key, defaultValue string) (string, error)
 
 	// internal
 	DB() *gorm.DB
 }
 
+// ErrNotFound is returned when the requested object does not exist
+var ErrNotFound = errors.New("store: not found")
+
 type store struct {
 	db *gorm.DB
 }
@@ -60,6 +99,9 @@ func (s *store) GetArtifactByID(id string) (*yolopb.Artifact, error) {
 		Preload("HasBuild.HasProject.HasOwner").
 		First(&artifact, "ID = ?", id).
 		Error
+	if gorm.IsRecordNotFoundError(err) {
+		return nil, ErrNotFound
+	}
 	if err != nil {
 		return nil, fmt.Errorf("store: GetArtifactByID :%w", err)
 	}
@@ -197,10 +239,97 @@ func (s *store) GetDumpWithPreloading() ([]*yolopb.Download, error) {
 	return downloads, nil
 }
 
+// CreateDownload saves a download event, dated now if unset, so that the event retention can trim it
 func (s *store) CreateDownload(download *yolopb.Download) error {
+	if download.CreatedAt == nil {
+		now := time.Now()
+		download.CreatedAt = &now
+	}
 	return s.db.Create(download).Error
 }
 
+// ArtifactDownloadStats sums up the downloads of an artifact
+type ArtifactDownloadStats struct {
+	Downloads int64
+	// LastDownloadAt is unknown once all the download events of the artifact were trimmed
+	LastDownloadAt *time.Time
+}
+
+// GetArtifactDownloadStats returns the total of the downloads of an artifact, including the trimmed ones
+func (s *store) GetArtifactDownloadStats(artifactID string) (*ArtifactDownloadStats, error) {
+	stats := ArtifactDownloadStats{}
+	err := s.db.Model(&yolopb.Download{}).Where("has_artifact_id = ?", artifactID).Count(&stats.Downloads).Error
+	if err != nil {
+		return nil, fmt.Errorf("store: GetArtifactDownloadStats: count downloads: %w", err)
+	}
+	trimmed, err := s.eventCounts(downloadEvents, []string{artifactID})
+	if err != nil {
+		return nil, fmt.Errorf("store: GetArtifactDownloadStats: %w", err)
+	}
+	stats.Downloads += trimmed[artifactID]
+
+	var last yolopb.Download
+	err = s.db.Where("has_artifact_id = ?", artifactID).Order("created_at desc").First(&last).Error
+	switch {
+	case gorm.IsRecordNotFoundError(err):
+	case err != nil:
+		return nil, fmt.Errorf("store: GetArtifactDownloadStats: find last download: %w", err)
+	default:
+		stats.LastDownloadAt = last.CreatedAt
+	}
+	return &stats, nil
+}
+
+// CreateInstall saves an install event, dated now if unset, so that the event retention can trim it.
+// it returns false without saving it if the app already reported an install of the build.
+func (s *store) CreateInstall(install *yolopb.Install) (bool, error) {
+	if install.CreatedAt == nil {
+		now := time.Now()
+		install.CreatedAt = &now
+	}
+	created := false
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if install.InstallID != "" {
+			var count int
+			err := tx.Model(&yolopb.Install{}).Where("has_build_id = ? AND install_id = ?", install.HasBuildID, install.InstallID).Count(&count).Error
+			if err != nil || count > 0 {
+				return err
+			}
+		}
+		created = true
+		return tx.Create(install).Error
+	})
+	if err != nil {
+		return false, fmt.Errorf("store: CreateInstall: %w", err)
+	}
+	return created, nil
+}
+
+func (s *store) IsSignatureSpent(signature string) (bool, error) {
+	var count int
+	err := s.db.Model(&yolopb.SpentSignature{}).Where("id = ?", signature).Count(&count).Error
+	if err != nil {
+		return false, fmt.Errorf("store: IsSignatureSpent: %w", err)
+	}
+	return count > 0, nil
+}
+
+func (s *store) SpendSignature(spent *yolopb.SpentSignature) error {
+	if err := s.db.Create(spent).Error; err != nil {
+		return fmt.Errorf("store: SpendSignature: %w", err)
+	}
+	return nil
+}
+
+// DeleteExpiredSpentSignatures removes the spent signatures of the URLs expired before a date, they are rejected anyway
+func (s *store) DeleteExpiredSpentSignatures(before time.Time) (int64, error) {
+	query := s.db.Where("expires_at IS NOT NULL AND expires_at < ?", before).Delete(&yolopb.SpentSignature{})
+	if query.Error != nil {
+		return 0, fmt.Errorf("store: DeleteExpiredSpentSignatures: %w", query.Error)
+	}
+	return query.RowsAffected, nil
+}
+
 // GetLastBuild returns last finished build with driver filter
 func (s *store) GetLastBuild(driver yolopb.Driver) (*yolopb.Build, error) {
 	build := yolopb.Build{Driver: driver}
@@ -212,6 +341,29 @@ func (s *store) GetLastBuild(driver yolopb.Driver) (*yolopb.Build, error) {
 	return &build, err
 }
 
+// GetProjectDrivers returns the drivers of the builds of a project,
+// the builds without project are matched by the prefixes of their ID (i.e, their URL)
+func (s *store) GetProjectDrivers(projectID string, buildIDPrefixes []string) ([]yolopb.Driver, error) {
+	clauses := []string{"lower(has_project_id) = lower(?)"}
+	args := []interface{}{projectID}
+	for _, prefix := range buildIDPrefixes {
+		clauses = append(clauses, `lower(id) LIKE lower(?) ESCAPE '\'`)
+		args = append(args, escapeLike(prefix)+"%")
+	}
+
+	var drivers []yolopb.Driver
+	err := s.db.
+		Model(&yolopb.Build{}).
+		Where(strings.Join(clauses, " OR "), args...).
+		Order("driver").
+		Pluck("DISTINCT driver", &drivers).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	return drivers, nil
+}
+
 func (s *store) GetAllArtifactsWithoutBundleID() ([]*yolopb.Artifact, error) {
 	var artifacts []*yolopb.Artifact
 	err := s.db.
@@ -224,16 +376,272 @@ func (s *store) GetAllArtifactsWithoutBundleID() ([]*yolopb.Artifact, error) {
 	return artifacts, nil
 }
 
+// GetArtifactsByBuildID returns the artifacts of a build with the given kind
+func (s *store) GetArtifactsByBuildID(buildID string, kind yolopb.Artifact_Kind) ([]*yolopb.Artifact, error) {
+	var artifacts []*yolopb.Artifact
+	err := s.db.
+		Preload("HasBuild").
+		Preload("HasBuild.HasProject").
+		Preload("HasBuild.HasProject.HasOwner").
+		Where("has_build_id = ? AND kind = ?", buildID, kind).
+		Find(&artifacts).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("store: GetArtifactsByBuildID: %w", err)
+	}
+	return artifacts, nil
+}
+
 func (s *store) SaveArtifact(artifact *yolopb.Artifact) error {
 	return s.db.Save(artifact).Error
 }
 
+// SetArtifactMimeType only updates the mimetype column, leaving the associations untouched
+func (s *store) SetArtifactMimeType(id, mimetype string) error {
+	err := s.db.
+		Model(&yolopb.Artifact{}).
+		Where("id = ?", id).
+		UpdateColumn("mime_type", mimetype).
+		Error
+	if err != nil {
+		return fmt.Errorf("store: SetArtifactMimeType: %w", err)
+	}
+	return nil
+}
+
+// GetArtifactMimeTypes returns the stored mimetypes of the existing artifacts, indexed by ID
+func (s *store) GetArtifactMimeTypes(ids []string) (map[string]string, error) {
+	mimetypes := map[string]string{}
+	if len(ids) == 0 {
+		return mimetypes, nil
+	}
+	var artifacts []*yolopb.Artifact
+	err := s.db.
+		Select("id, mime_type").
+		Where("id IN (?)", ids).
+		Find(&artifacts).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("store: GetArtifactMimeTypes: %w", err)
+	}
+	for _, artifact := range artifacts {
+		mimetypes[artifact.ID] = artifact.MimeType
+	}
+	return mimetypes, nil
+}
+
+// SetArtifactChecksums only updates the checksum columns, leaving the associations untouched
+func (s *store) SetArtifactChecksums(id, md5Sum, sha256Sum string) error {
+	err := s.db.
+		Model(&yolopb.Artifact{}).
+		Where("id = ?", id).
+		UpdateColumns(map[string]interface{}{"md5_sum": md5Sum, "sha256_sum": sha256Sum}).
+		Error
+	if err != nil {
+		return fmt.Errorf("store: SetArtifactChecksums: %w", err)
+	}
+	return nil
+}
+
+// GetArtifactChecksums returns the existing artifacts with only their checksums loaded, indexed by ID
+func (s *store) GetArtifactChecksums(ids []string) (map[string]*yolopb.Artifact, error) {
+	checksums := map[string]*yolopb.Artifact{}
+	if len(ids) == 0 {
+		return checksums, nil
+	}
+	var artifacts []*yolopb.Artifact
+	err := s.db.
+		Select("id, md5_sum, sha256_sum").
+		Where("id IN (?)", ids).
+		Find(&artifacts).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("store: GetArtifactChecksums: %w", err)
+	}
+	for _, artifact := range artifacts {
+		checksums[artifact.ID] = artifact
+	}
+	return checksums, nil
+}
+
+// GetArtifactsBySHA256 returns the first artifact ingested with each checksum, ignoring the duplicates, indexed by SHA-256
+func (s *store) GetArtifactsBySHA256(sums []string) (map[string]*yolopb.Artifact, error) {
+	artifacts := map[string]*yolopb.Artifact{}
+	if len(sums) == 0 {
+		return artifacts, nil
+	}
+	var found []*yolopb.Artifact
+	err := s.db.
+		Select("id, sha256_sum, has_build_id").
+		Where("sha256_sum IN (?)", sums).
+		Where("duplicate_of_id IS NULL OR duplicate_of_id = ''").
+		Order("created_at, id").
+		Find(&found).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("store: GetArtifactsBySHA256: %w", err)
+	}
+	for _, artifact := range found {
+		if _, exists := artifacts[artifact.Sha256Sum]; !exists {
+			artifacts[artifact.Sha256Sum] = artifact
+		}
+	}
+	return artifacts, nil
+}
+
+// SetArtifactDuplicateOf only updates the reference to the artifact with the same content, leaving the associations untouched
+func (s *store) SetArtifactDuplicateOf(id, duplicateOfID string) error {
+	err := s.db.
+		Model(&yolopb.Artifact{}).
+		Where("id = ?", id).
+		UpdateColumn("duplicate_of_id", duplicateOfID).
+		Error
+	if err != nil {
+		return fmt.Errorf("store: SetArtifactDuplicateOf: %w", err)
+	}
+	return nil
+}
+
+// GetArtifactStates returns the stored states of the existing artifacts, indexed by ID
+func (s *store) GetArtifactStates(ids []string) (map[string]yolopb.Artifact_State, error) {
+	states := map[string]yolopb.Artifact_State{}
+	if len(ids) == 0 {
+		return states, nil
+	}
+	var artifacts []*yolopb.Artifact
+	err := s.db.
+		Select("id, state").
+		Where("id IN (?)", ids).
+		Find(&artifacts).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("store: GetArtifactStates: %w", err)
+	}
+	for _, artifact := range artifacts {
+		states[artifact.ID] = artifact.State
+	}
+	return states, nil
+}
+
+// GetOrphanArtifacts returns the artifacts that are not linked to any existing build
+func (s *store) GetOrphanArtifacts() ([]*yolopb.Artifact, error) {
+	var artifacts []*yolopb.Artifact
+	err := s.db.
+		Joins("LEFT JOIN build ON build.id = artifact.has_build_id").
+		Where("build.id IS NULL").
+		Find(&artifacts).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("store: GetOrphanArtifacts: %w", err)
+	}
+	return artifacts, nil
+}
+
+// DeleteArtifacts removes the artifacts, their download log entries and counters.
+// The surviving duplicates of a removed artifact are linked to the first one of them, returned by removed ID as it now owns their mirrored file.
+func (s *store) DeleteArtifacts(ids []string) (map[string]string, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	successors := map[string]string{}
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var duplicates []*yolopb.Artifact
+		err := tx.
+			Select("id, duplicate_of_id").
+			Where("duplicate_of_id IN (?) AND id NOT IN (?)", ids, ids).
+			Order("created_at, id").
+			Find(&duplicates).
+			Error
+		if err != nil {
+			return fmt.Errorf("store: DeleteArtifacts: duplicates: %w", err)
+		}
+		for _, duplicate := range duplicates {
+			successor, found := successors[duplicate.DuplicateOfID]
+			if !found {
+				successors[duplicate.DuplicateOfID] = duplicate.ID // the new canonical artifact
+			}
+			if err := tx.Model(&yolopb.Artifact{}).Where("id = ?", duplicate.ID).UpdateColumn("duplicate_of_id", successor).Error; err != nil {
+				return fmt.Errorf("store: DeleteArtifacts: duplicates: %w", err)
+			}
+		}
+
+		if err := tx.Where("has_artifact_id IN (?)", ids).Delete(&yolopb.Download{}).Error; err != nil {
+			return fmt.Errorf("store: DeleteArtifacts: downloads: %w", err)
+		}
+		if err := tx.Where("kind = ? AND object_id IN (?)", downloadEvents, ids).Delete(&yolopb.EventCounter{}).Error; err != nil {
+			return fmt.Errorf("store: DeleteArtifacts: event counters: %w", err)
+		}
+		if err := tx.Where("id IN (?)", ids).Delete(&yolopb.Artifact{}).Error; err != nil {
+			return fmt.Errorf("store: DeleteArtifacts: artifacts: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return successors, nil
+}
+
+// GetExpiredBuildIDs returns the builds created before a date (if not zero) or beyond the keepPerProject most recent ones of their project (if not 0), the promoted builds are always kept
+func (s *store) GetExpiredBuildIDs(before time.Time, keepPerProject int) ([]string, error) {
+	if before.IsZero() && keepPerProject == 0 {
+		return nil, nil
+	}
+
+	var builds []*yolopb.Build
+	err := s.db.
+		Select("id, has_project_id, created_at").
+		Where("id NOT IN (SELECT has_build_id FROM promotion)").
+		Order("has_project_id, created_at desc").
+		Find(&builds).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("store: GetExpiredBuildIDs: %w", err)
+	}
+
+	ids := []string{}
+	perProject := map[string]int{}
+	for _, build := range builds {
+		perProject[build.HasProjectID]++
+		tooOld := !before.IsZero() && build.CreatedAt != nil && build.CreatedAt.Before(before)
+		tooMany := keepPerProject > 0 && perProject[build.HasProjectID] > keepPerProject
+		if tooOld || tooMany {
+			ids = append(ids, build.ID)
+		}
+	}
+	return ids, nil
+}
+
+// DeleteBuilds removes the builds, their install log entries and counters, the artifacts are left orphan
+func (s *store) DeleteBuilds(ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("has_build_id IN (?)", ids).Delete(&yolopb.Install{}).Error; err != nil {
+			return fmt.Errorf("store: DeleteBuilds: installs: %w", err)
+		}
+		if err := tx.Where("kind = ? AND object_id IN (?)", installEvents, ids).Delete(&yolopb.EventCounter{}).Error; err != nil {
+			return fmt.Errorf("store: DeleteBuilds: event counters: %w", err)
+		}
+		if err := tx.Where("has_build_id IN (?)", ids).Delete(&yolopb.Promotion{}).Error; err != nil {
+			return fmt.Errorf("store: DeleteBuilds: promotions: %w", err)
+		}
+		if err := tx.Where("id IN (?)", ids).Delete(&yolopb.Build{}).Error; err != nil {
+			return fmt.Errorf("store: DeleteBuilds: builds: %w", err)
+		}
+		return nil
+	})
+}
+
 type GetBuildListOpts struct {
 	ArtifactID           []string
 	ArtifactKinds        []yolopb.Artifact_Kind
+	ArtifactArch         []string
 	WithArtifact         bool
 	BuildID              []string
 	BuildState           []yolopb.Build_State
+	ExternalState        []string
 	BuildDriver          []yolopb.Driver
 	ProjectID            []string
 	MergeRequestID       []string
@@ -241,7 +649,61 @@ type GetBuildListOpts struct {
 	MergeRequestState    []yolopb.MergeRequest_State
 	Branch               []string
 	Limit                int32
+	Offset               int32
 	SortByCommitDate     bool
+	PromotedTo           string
+	CreatedAfter         *time.Time
+	BuildConfig          map[string]string
+	OwnerTeam            []string
+	OverBudget           bool
+	TaggedOnly           bool
+	// HideUnpromoted excludes the builds of these branches until they are promoted to their channel
+	HideUnpromoted []UnpromotedBranch
+	// Cursor only returns the builds listed after this one, to paginate over the build history sorted by creation date
+	Cursor *BuildCursor
+	// SortBy defaults to the creation date, the builds are sorted in descending order unless SortAsc is set
+	SortBy  yolopb.BuildList_SortBy
+	SortAsc bool
+}
+
+// UnpromotedBranch is a branch whose builds are hidden until they are promoted to a channel
+type UnpromotedBranch struct {
+	Branch  string
+	Channel string
+}
+
+// whereNotUnpromoted excludes the builds of the branches not promoted to their channel yet
+func whereNotUnpromoted(query *gorm.DB, branches []UnpromotedBranch) *gorm.DB {
+	for _, branch := range branches {
+		query = query.Where("build.branch IS NULL OR build.branch != ? OR EXISTS (SELECT 1 FROM promotion WHERE promotion.has_build_id = build.id AND promotion.channel = ?)", branch.Branch, branch.Channel)
+	}
+	return query
+}
+
+// BuildCursor is the position of a build in the build list, sorted by creation date then ID
+type BuildCursor struct {
+	CreatedAt *time.Time
+	ID        string
+}
+
+// buildListOrder returns the ORDER BY clause of a build list, the builds without a value are listed last
+func buildListOrder(sortBy yolopb.BuildList_SortBy, asc bool) string {
+	var key string
+	switch sortBy {
+	case yolopb.BuildList_BuildNum:
+		key = "CAST(build.short_id AS INTEGER)"
+	case yolopb.BuildList_Version:
+		key = "NULLIF(build.version_key, '')"
+	case yolopb.BuildList_Duration:
+		// the builds stored before their duration fall back to their timestamps
+		key = "COALESCE(NULLIF(build.duration, 0), (julianday(build.finished_at) - julianday(build.started_at)) * 86400)"
+	default:
+		key = "build.created_at"
+	}
+	if asc {
+		return fmt.Sprintf("(%s) IS NULL, %s asc, build.id asc", key, key)
+	}
+	return fmt.Sprintf("%s desc, build.id desc", key)
 }
 
 //  i.e, has_project=berty/berty -> has_project=https://github.com/berty/berty
@@ -255,6 +717,41 @@ func formatProjectIDs(projectIDs []string) []string {
 	return projectIDs
 }
 
+// escapeLike escapes the LIKE wildcards, to be used with ESCAPE '\'
+func escapeLike(input string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(input)
+}
+
+// sortArtifactsByCreation sorts the artifacts of a build in upload order, the ones without creation date are listed last
+func sortArtifactsByCreation(artifacts []*yolopb.Artifact) {
+	sort.SliceStable(artifacts, func(i, j int) bool {
+		a, b := artifacts[i].CreatedAt, artifacts[j].CreatedAt
+		switch {
+		case a == nil || b == nil:
+			return a != nil && b == nil
+		case a.Equal(*b):
+			return artifacts[i].ID < artifacts[j].ID
+		default:
+			return a.Before(*b)
+		}
+	})
+}
+
+// artifactFilter returns the condition on the artifact kinds and architectures, unqualified to be used in subqueries and preloads
+func artifactFilter(kinds []yolopb.Artifact_Kind, archs []string) (string, []interface{}) {
+	conditions := []string{}
+	args := []interface{}{}
+	if len(kinds) > 0 {
+		conditions = append(conditions, "kind IN (?)")
+		args = append(args, kinds)
+	}
+	if len(archs) > 0 {
+		conditions = append(conditions, "arch IN (?)")
+		args = append(args, archs)
+	}
+	return strings.Join(conditions, " AND "), args
+}
+
 func (s *store) GetBuildList(bl GetBuildListOpts) ([]*yolopb.Build, error) {
 	var builds []*yolopb.Build
 
@@ -269,13 +766,15 @@ func (s *store) GetBuildList(bl GetBuildListOpts) ([]*yolopb.Build, error) {
 			Joins("JOIN artifact ON artifact.has_build_id = build.id AND (artifact.id IN (?) OR artifact.yolo_id IN (?))", bl.ArtifactID, bl.ArtifactID).
 			Preload("HasArtifacts")
 		noMoreFilters = true
-	case len(bl.ArtifactKinds) > 0:
+	// EXISTS instead of JOIN, builds with several matching artifacts would be listed several times
+	case len(bl.ArtifactKinds) > 0 || len(bl.ArtifactArch) > 0:
+		filter, args := artifactFilter(bl.ArtifactKinds, bl.ArtifactArch)
 		query = query.
-			Joins("JOIN artifact ON artifact.has_build_id = build.id AND artifact.kind IN (?)", bl.ArtifactKinds).
-			Preload("HasArtifacts", "kind IN (?)", bl.ArtifactKinds)
+			Where("EXISTS (SELECT 1 FROM artifact WHERE artifact.has_build_id = build.id AND "+filter+")", args...).
+			Preload("HasArtifacts", append([]interface{}{filter}, args...)...)
 	case bl.WithArtifact:
 		query = query.
-			Joins("JOIN artifact ON artifact.has_build_id = build.id", bl.ArtifactKinds).
+			Where("EXISTS (SELECT 1 FROM artifact WHERE artifact.has_build_id = build.id)").
 			Preload("HasArtifacts")
 	default:
 		query = query.
@@ -289,6 +788,9 @@ func (s *store) GetBuildList(bl GetBuildListOpts) ([]*yolopb.Build, error) {
 		if len(bl.BuildState) > 0 {
 			query = query.Where("build.state IN (?)", bl.BuildState)
 		}
+		if len(bl.ExternalState) > 0 {
+			query = query.Where("build.external_state IN (?)", bl.ExternalState)
+		}
 		if len(bl.BuildDriver) > 0 {
 			query = query.Where("build.driver IN (?)", bl.BuildDriver)
 		}
@@ -312,7 +814,8 @@ func (s *store) GetBuildList(bl GetBuildListOpts) ([]*yolopb.Build, error) {
 		if len(bl.MergeRequestState) > 0 {
 			query = query.Where("merge_request.state IN (?)", bl.MergeRequestState)
 		}
-		if !withMergeRequest {
+		// the releases are usually built from a tag push, without merge request
+		if !withMergeRequest && !bl.TaggedOnly {
 			query = query.Where("build.has_mergerequest_id IS NOT NULL AND build.has_mergerequest_id != ''")
 		}
 		if len(bl.Branch) > 0 {
@@ -322,6 +825,45 @@ func (s *store) GetBuildList(bl GetBuildListOpts) ([]*yolopb.Build, error) {
 				query = query.Where("build.branch IN (?)", bl.Branch)
 			}
 		}
+		if bl.CreatedAfter != nil {
+			query = query.Where("build.created_at > ?", *bl.CreatedAfter)
+		}
+		for key, value := range bl.BuildConfig {
+			query = query.Where(`build.build_config_json LIKE ? ESCAPE '\'`, "%"+escapeLike(yolopb.BuildConfigFilter(key, value))+"%")
+		}
+		if len(bl.OwnerTeam) > 0 {
+			clauses := make([]string, len(bl.OwnerTeam))
+			args := make([]interface{}, len(bl.OwnerTeam))
+			for i, team := range bl.OwnerTeam {
+				clauses[i] = `build.owner_teams_json LIKE ? ESCAPE '\'`
+				args[i] = "%" + escapeLike(yolopb.OwnerTeamFilter(team)) + "%"
+			}
+			query = query.Where(strings.Join(clauses, " OR "), args...)
+		}
+		if bl.OverBudget {
+			query = query.Where("build.over_budget = ?", true)
+		}
+		if bl.TaggedOnly {
+			query = query.Where("build.vcs_tag IS NOT NULL AND build.vcs_tag != ''")
+		}
+		if bl.PromotedTo != "" {
+			query = query.Joins("JOIN promotion ON promotion.has_build_id = build.id AND promotion.channel = ?", bl.PromotedTo)
+		}
+	}
+
+	query = whereNotUnpromoted(query, bl.HideUnpromoted)
+
+	// the builds without creation date are listed last
+	if bl.Cursor != nil {
+		cmp := "<"
+		if bl.SortAsc {
+			cmp = ">"
+		}
+		if bl.Cursor.CreatedAt != nil {
+			query = query.Where("build.created_at "+cmp+" ? OR (build.created_at = ? AND build.id "+cmp+" ?) OR build.created_at IS NULL", *bl.Cursor.CreatedAt, *bl.Cursor.CreatedAt, bl.Cursor.ID)
+		} else {
+			query = query.Where("build.created_at IS NULL AND build.id "+cmp+" ?", bl.Cursor.ID)
+		}
 	}
 
 	query = query.
@@ -336,12 +878,16 @@ func (s *store) GetBuildList(bl GetBuildListOpts) ([]*yolopb.Build, error) {
 		Preload("HasMergerequest.HasAuthor").
 		Preload("HasMergerequest.HasCommit").
 		Limit(bl.Limit).
-		Order("created_at desc")
+		Offset(bl.Offset).
+		Order(buildListOrder(bl.SortBy, bl.SortAsc))
 
 	err := query.Find(&builds).Error
 	if err != nil {
 		return nil, fmt.Errorf("store: GetBuildList: find builds: %w", err)
 	}
+	for _, build := range builds {
+		sortArtifactsByCreation(build.HasArtifacts)
+	}
 
 	// compute download stats
 	artifactMap := map[string]int64{}
@@ -376,16 +922,32 @@ func (s *store) GetBuildList(bl GetBuildListOpts) ([]*yolopb.Build, error) {
 			}
 			artifactMap[artifactID] = count
 		}
+		trimmed, err := s.eventCounts(downloadEvents, artifactIDs)
+		if err != nil {
+			return nil, fmt.Errorf("store: GetBuildList: %w", err)
+		}
+		for artifactID, count := range trimmed {
+			artifactMap[artifactID] += count
+		}
 	}
 
 	for _, build := range builds {
 		for _, artifact := range build.HasArtifacts {
 			if count, found := artifactMap[artifact.ID]; found {
 				artifact.DownloadsCount = count
+				build.DownloadsCount += count
 			}
 		}
 	}
 
+	if err := s.fillBuildInstallsCount(builds); err != nil {
+		return nil, fmt.Errorf("store: GetBuildList: %w", err)
+	}
+
+	if err := s.fillBuildChannels(builds); err != nil {
+		return nil, fmt.Errorf("store: GetBuildList: %w", err)
+	}
+
 	// sort by commit date
 	if bl.SortByCommitDate {
 		sort.Slice(builds, func(i, j int) bool {
@@ -402,6 +964,388 @@ func (s *store) GetBuildList(bl GetBuildListOpts) ([]*yolopb.Build, error) {
 	return builds, nil
 }
 
+// GetBuildByID returns a build with its artifacts by its ID or yolo_id
+func (s *store) GetBuildByID(id string) (*yolopb.Build, error) {
+	var build yolopb.Build
+	err := s.db.
+		Preload("HasArtifacts").
+		Preload("HasCommit").
+		Preload("HasProject").
+		Preload("HasProject.HasOwner").
+		Preload("HasMergerequest").
+		Preload("HasMergerequest.HasAuthor").
+		Where("id = ? OR yolo_id = ?", id, id).
+		First(&build).
+		Error
+	if gorm.IsRecordNotFoundError(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("store: GetBuildByID: %w", err)
+	}
+	if err := s.fillBuildChannels([]*yolopb.Build{&build}); err != nil {
+		return nil, fmt.Errorf("store: GetBuildByID: %w", err)
+	}
+	return &build, nil
+}
+
+// SearchBuilds returns the builds whose commit SHA starts with the query, or whose commit message or branch contains it, ignoring case.
+// The commit SHA matches are ranked first, then the exact branch matches, then the other matches, most recent first.
+// The builds of hideUnpromoted are excluded until they are promoted.
+func (s *store) SearchBuilds(query string, limit int32, hideUnpromoted []UnpromotedBranch) ([]*yolopb.Build, error) {
+	query = strings.ToLower(query)
+	prefix := escapeLike(query) + "%"
+	substring := "%" + escapeLike(query) + "%"
+
+	var builds []*yolopb.Build
+	err := whereNotUnpromoted(s.db.Model(&yolopb.Build{}), hideUnpromoted).
+		Select(`build.*, CASE
+			WHEN lower(build.has_commit_id) LIKE ? ESCAPE '\' THEN 0
+			WHEN lower(build.branch) = ? THEN 1
+			WHEN lower(build.branch) LIKE ? ESCAPE '\' THEN 2
+			ELSE 3 END AS search_rank`, prefix, query, substring).
+		Where(`lower(build.has_commit_id) LIKE ? ESCAPE '\' OR lower(build.message) LIKE ? ESCAPE '\' OR lower(build.branch) LIKE ? ESCAPE '\'`, prefix, substring, substring).
+		Preload("HasArtifacts").
+		Preload("HasCommit").
+		Preload("HasProject").
+		Preload("HasProject.HasOwner").
+		Preload("HasMergerequest").
+		Preload("HasMergerequest.HasAuthor").
+		Order("search_rank asc, build.created_at desc, build.id desc").
+		Limit(limit).
+		Find(&builds).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("store: SearchBuilds: %w", err)
+	}
+	for _, build := range builds {
+		sortArtifactsByCreation(build.HasArtifacts)
+	}
+	if err := s.fillBuildChannels(builds); err != nil {
+		return nil, fmt.Errorf("store: SearchBuilds: %w", err)
+	}
+	return builds, nil
+}
+
+type GetBranchStatsOpts struct {
+	Branches  []string
+	ProjectID string
+	Since     time.Time
+	Until     time.Time
+}
+
+// GetBranchStats returns the build stats of each branch, computed by a single grouped query
+func (s *store) GetBranchStats(opts GetBranchStatsOpts) ([]*yolopb.BranchStats_Entry, error) {
+	failedStates := []yolopb.Build_State{yolopb.Build_Failed, yolopb.Build_Timedout}
+	query := s.db.
+		Model(&yolopb.Build{}).
+		Select(`branch,
+			count(id),
+			sum(case when state = ? then 1 else 0 end),
+			sum(case when state IN (?) then 1 else 0 end),
+			avg(case when started_at IS NOT NULL AND finished_at IS NOT NULL then (julianday(finished_at) - julianday(started_at)) * 86400 end),
+			max(julianday(created_at)) AS last_build`, yolopb.Build_Passed, failedStates).
+		Where("branch IS NOT NULL AND branch != ''").
+		Where("julianday(created_at) >= julianday(?) AND julianday(created_at) < julianday(?)", opts.Since, opts.Until) // the dates can have different time zones
+	if len(opts.Branches) > 0 {
+		query = query.Where("branch IN (?)", opts.Branches)
+	}
+	if opts.ProjectID != "" {
+		query = query.Where("has_project_id = ?", formatProjectIDs([]string{opts.ProjectID})[0])
+	}
+	rows, err := query.
+		Group("branch").
+		Order("last_build desc").
+		Rows()
+	if err != nil {
+		return nil, fmt.Errorf("store: GetBranchStats: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*yolopb.BranchStats_Entry
+	for rows.Next() {
+		var (
+			entry       yolopb.BranchStats_Entry
+			avgDuration sql.NullFloat64
+			lastBuild   sql.NullFloat64
+		)
+		if err := rows.Scan(&entry.Branch, &entry.Total, &entry.Passed, &entry.Failed, &avgDuration, &lastBuild); err != nil {
+			return nil, fmt.Errorf("store: GetBranchStats: scan: %w", err)
+		}
+		if finished := entry.Passed + entry.Failed; finished > 0 {
+			entry.PassRate = float64(entry.Passed) / float64(finished)
+		}
+		entry.AverageDurationSeconds = avgDuration.Float64
+		if lastBuild.Valid {
+			lastBuildAt := julianDayToTime(lastBuild.Float64)
+			entry.LastBuildAt = &lastBuildAt
+		}
+		entries = append(entries, &entry)
+	}
+	return entries, rows.Err()
+}
+
+// GetSummary returns the builds count and the latest artifact of each project and artifact kind, counted by a single grouped query
+func (s *store) GetSummary(projectIDs []string) ([]*yolopb.Summary_Entry, error) {
+	query := s.db.
+		Table("artifact").
+		Select("COALESCE(build.has_project_id, ''), artifact.kind, count(DISTINCT build.id), max(julianday(build.created_at))").
+		Joins("JOIN build ON build.id = artifact.has_build_id").
+		Where("artifact.kind != ? AND artifact.state = ?", yolopb.Artifact_UnknownKind, yolopb.Artifact_Finished)
+	if len(projectIDs) > 0 {
+		query = query.Where("build.has_project_id IN (?)", formatProjectIDs(projectIDs))
+	}
+	rows, err := query.
+		Group("COALESCE(build.has_project_id, ''), artifact.kind").
+		Order("COALESCE(build.has_project_id, ''), artifact.kind").
+		Rows()
+	if err != nil {
+		return nil, fmt.Errorf("store: GetSummary: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*yolopb.Summary_Entry
+	for rows.Next() {
+		var (
+			entry       yolopb.Summary_Entry
+			latestBuild sql.NullFloat64
+		)
+		if err := rows.Scan(&entry.ProjectID, &entry.Kind, &entry.BuildsCount, &latestBuild); err != nil {
+			return nil, fmt.Errorf("store: GetSummary: scan: %w", err)
+		}
+		if latestBuild.Valid {
+			latestBuildAt := julianDayToTime(latestBuild.Float64)
+			entry.LatestBuildAt = &latestBuildAt
+		}
+		entries = append(entries, &entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("store: GetSummary: %w", err)
+	}
+
+	// a query per entry, there are only a few projects and kinds
+	for _, entry := range entries {
+		var artifact yolopb.Artifact
+		err := s.db.
+			Select("artifact.*").
+			Joins("JOIN build ON build.id = artifact.has_build_id").
+			Where("COALESCE(build.has_project_id, '') = ? AND artifact.kind = ? AND artifact.state = ?", entry.ProjectID, entry.Kind, yolopb.Artifact_Finished).
+			Order("build.created_at desc, artifact.created_at desc, artifact.id desc").
+			Preload("HasBuild").
+			First(&artifact).
+			Error
+		if err != nil {
+			return nil, fmt.Errorf("store: GetSummary: latest artifact: %w", err)
+		}
+		entry.LatestArtifact = &artifact
+		entry.LatestBuildID = artifact.HasBuildID
+		entry.LatestVersion = artifact.BundleVersion
+		if entry.LatestVersion == "" && artifact.HasBuild != nil {
+			entry.LatestVersion = artifact.HasBuild.VCSTag
+		}
+	}
+	return entries, nil
+}
+
+func julianDayToTime(jd float64) time.Time {
+	const unixEpochJulianDay = 2440587.5
+	return time.Unix(0, int64((jd-unixEpochJulianDay)*86400*float64(time.Second))).UTC()
+}
+
+// PromoteBuild adds a build to a release channel, promoting an already promoted build is a no-op
+func (s *store) PromoteBuild(buildID, channel string) (*yolopb.Build, error) {
+	var build yolopb.Build
+	err := s.db.
+		Preload("HasArtifacts").
+		Where("id = ? OR yolo_id = ?", buildID, buildID).
+		First(&build).
+		Error
+	if gorm.IsRecordNotFoundError(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("store: PromoteBuild: find build: %w", err)
+	}
+
+	now := time.Now()
+	promotion := yolopb.Promotion{HasBuildID: build.ID, Channel: channel}
+	err = s.db.
+		Where(&promotion).
+		Attrs(yolopb.Promotion{CreatedAt: &now}).
+		FirstOrCreate(&promotion).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("store: PromoteBuild: create promotion: %w", err)
+	}
+
+	if err := s.fillBuildChannels([]*yolopb.Build{&build}); err != nil {
+		return nil, fmt.Errorf("store: PromoteBuild: %w", err)
+	}
+	return &build, nil
+}
+
+// fillBuildChannels sets the non-stored Channels field of the builds based on their promotions
+func (s *store) fillBuildChannels(builds []*yolopb.Build) error {
+	if len(builds) == 0 {
+		return nil
+	}
+	buildMap := map[string]*yolopb.Build{}
+	buildIDs := make([]string, len(builds))
+	for i, build := range builds {
+		buildMap[build.ID] = build
+		buildIDs[i] = build.ID
+	}
+	var promotions []*yolopb.Promotion
+	err := s.db.
+		Where("has_build_id IN (?)", buildIDs).
+		Order("created_at asc").
+		Find(&promotions).
+		Error
+	if err != nil {
+		return fmt.Errorf("find promotions: %w", err)
+	}
+	for _, promotion := range promotions {
+		if build, found := buildMap[promotion.HasBuildID]; found {
+			build.Channels = append(build.Channels, promotion.Channel)
+		}
+	}
+	return nil
+}
+
+// fillBuildInstallsCount sets the non-stored InstallsCount field of the builds
+func (s *store) fillBuildInstallsCount(builds []*yolopb.Build) error {
+	if len(builds) == 0 {
+		return nil
+	}
+	buildMap := map[string]*yolopb.Build{}
+	buildIDs := make([]string, len(builds))
+	for i, build := range builds {
+		buildMap[build.ID] = build
+		buildIDs[i] = build.ID
+	}
+	rows, err := s.db.
+		Model(&yolopb.Install{}).
+		Group("has_build_id").
+		Select("has_build_id, count(id)").
+		Where("has_build_id IN (?)", buildIDs).
+		Rows()
+	if err != nil {
+		return fmt.Errorf("find installs: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			buildID string
+			count   int64
+		)
+		if err := rows.Scan(&buildID, &count); err != nil {
+			return err
+		}
+		if build, found := buildMap[buildID]; found {
+			build.InstallsCount = count
+		}
+	}
+	trimmed, err := s.eventCounts(installEvents, buildIDs)
+	if err != nil {
+		return err
+	}
+	for buildID, count := range trimmed {
+		buildMap[buildID].InstallsCount += count
+	}
+	return nil
+}
+
+const (
+	downloadEvents = "download"
+	installEvents  = "install"
+)
+
+// TrimEvents removes the download and install events created before a date, their totals are kept in the event counters
+func (s *store) TrimEvents(before time.Time) (int64, error) {
+	var trimmed int64
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		downloads, err := trimEvents(tx, downloadEvents, &yolopb.Download{}, "has_artifact_id", before)
+		if err != nil {
+			return fmt.Errorf("store: TrimEvents: downloads: %w", err)
+		}
+		installs, err := trimEvents(tx, installEvents, &yolopb.Install{}, "has_build_id", before)
+		if err != nil {
+			return fmt.Errorf("store: TrimEvents: installs: %w", err)
+		}
+		trimmed = downloads + installs
+		return nil
+	})
+	return trimmed, err
+}
+
+func trimEvents(tx *gorm.DB, kind string, model interface{}, column string, before time.Time) (int64, error) {
+	rows, err := tx.
+		Model(model).
+		Group(column).
+		Select(column+", count(id)").
+		Where("created_at < ? OR created_at IS NULL", before).
+		Rows()
+	if err != nil {
+		return 0, err
+	}
+	counts := map[string]int64{}
+	for rows.Next() {
+		var (
+			objectID string
+			count    int64
+		)
+		if err := rows.Scan(&objectID, &count); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		counts[objectID] = count
+	}
+	rows.Close()
+
+	for objectID, count := range counts {
+		counter := yolopb.EventCounter{Kind: kind, ObjectID: objectID}
+		if err := tx.FirstOrInit(&counter, counter).Error; err != nil {
+			return 0, err
+		}
+		counter.Count += count
+		if err := tx.Save(&counter).Error; err != nil {
+			return 0, err
+		}
+	}
+
+	// the events saved before they were dated are considered out of the retention window
+	query := tx.Where("created_at < ? OR created_at IS NULL", before).Delete(model)
+	return query.RowsAffected, query.Error
+}
+
+// eventCounts returns the number of trimmed events of the objects
+func (s *store) eventCounts(kind string, objectIDs []string) (map[string]int64, error) {
+	var counters []*yolopb.EventCounter
+	err := s.db.Where("kind = ? AND object_id IN (?)", kind, objectIDs).Find(&counters).Error
+	if err != nil {
+		return nil, fmt.Errorf("find event counters: %w", err)
+	}
+	counts := make(map[string]int64, len(counters))
+	for _, counter := range counters {
+		counts[counter.ObjectID] = counter.Count
+	}
+	return counts, nil
+}
+
+// GetOrCreateSetting returns the value of a setting, it is initialized with defaultValue if missing
+func (s *store) GetOrCreateSetting(key, defaultValue string) (string, error) {
+	setting := yolopb.Setting{}
+	err := s.db.
+		Where(yolopb.Setting{Key: key}).
+		Attrs(yolopb.Setting{Value: defaultValue}).
+		FirstOrCreate(&setting).
+		Error
+	if err != nil {
+		return "", fmt.Errorf("store: GetOrCreateSetting: %w", err)
+	}
+	return setting.Value, nil
+}
+
 func (s *store) SaveBatch(batch *yolopb.Batch) error {
 	return s.db.Transaction(func(tx *gorm.DB) error {
 		// FIXME: use this for Entities (users, orgs): db.Model(&entity).Update(&entity)?
@@ -0,0 +1,14 @@
+package yolostore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJulianDayToTime(t *testing.T) {
+	assert.Equal(t, time.Unix(0, 0).UTC(), julianDayToTime(2440587.5))
+	assert.Equal(t, time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC), julianDayToTime(2451545))
+	assert.WithinDuration(t, time.Date(2022, 1, 1, 18, 0, 0, 0, time.UTC), julianDayToTime(2459581.25), time.Millisecond)
+}
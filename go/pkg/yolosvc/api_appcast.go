@@ -0,0 +1,159 @@
+package yolosvc
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"berty.tech/yolo/v2/go/pkg/appcast"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"berty.tech/yolo/v2/go/pkg/yolostore"
+	"github.com/go-chi/chi"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	appcastDefaultLimit = 10
+	appcastMaxLimit     = 50
+)
+
+// SparkleAppcast serves the Sparkle appcast of a project (ID or yolo_id), with a release per build having a .dmg, most recent first.
+// the "channel" parameter restricts it to a release channel, "arch" to an architecture, and "limit" sets the number of releases.
+func (svc *service) SparkleAppcast(w http.ResponseWriter, r *http.Request) {
+	projectID := chi.URLParam(r, "projectID")
+	query := r.URL.Query()
+
+	limit := appcastDefaultLimit
+	if raw := query.Get("limit"); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n <= 0 {
+			httpError(w, fmt.Errorf("invalid limit %q", raw), codes.InvalidArgument)
+			return
+		}
+		if n > appcastMaxLimit {
+			n = appcastMaxLimit
+		}
+		limit = n
+	}
+	opts := yolostore.GetBuildListOpts{
+		ProjectID:     []string{projectID},
+		ArtifactKinds: []yolopb.Artifact_Kind{yolopb.Artifact_DMG},
+		Limit:         int32(limit),
+	}
+	if arch := query.Get("arch"); arch != "" {
+		switch arch {
+		case archARM64, archAMD64, archUniversal:
+		default:
+			httpError(w, fmt.Errorf("invalid arch %q, expected arm64, amd64 or universal", arch), codes.InvalidArgument)
+			return
+		}
+		opts.ArtifactArch = []string{arch}
+	}
+	svc.applyChannelFilter(&opts, query.Get("channel"), svc.isStaffRequest(r))
+
+	builds, err := svc.store.GetBuildList(opts)
+	if err != nil {
+		httpError(w, err, codes.Internal)
+		return
+	}
+
+	baseURL := requestBaseURL(r)
+	title := projectID
+	items := []*appcast.Item{}
+	for _, build := range builds {
+		if build.HasProject != nil && build.HasProject.Name != "" {
+			title = build.HasProject.Name
+		}
+		artifact := appcastArtifact(build.HasArtifacts)
+		if artifact == nil {
+			continue
+		}
+		item, err := svc.appcastItem(baseURL, build, artifact)
+		if err != nil {
+			httpError(w, err, codes.Internal)
+			return
+		}
+		items = append(items, item)
+	}
+
+	feed := appcast.Feed(title, baseURL+r.URL.Path, items)
+	b, err := feed.Marshal()
+	if err != nil {
+		httpError(w, err, codes.Internal)
+		return
+	}
+	w.Header().Add("Content-Type", "application/xml; charset=utf-8")
+	_, _ = w.Write(b)
+}
+
+// appcastArtifact returns the .dmg of a build offered to the Sparkle updates, the universal one if there are several architectures
+func appcastArtifact(artifacts []*yolopb.Artifact) *yolopb.Artifact {
+	var selected *yolopb.Artifact
+	for _, artifact := range artifacts {
+		if artifact.Kind != yolopb.Artifact_DMG {
+			continue
+		}
+		if artifact.Arch == archUniversal {
+			return artifact
+		}
+		if selected == nil {
+			selected = artifact
+		}
+	}
+	return selected
+}
+
+func (svc *service) appcastItem(baseURL string, build *yolopb.Build, artifact *yolopb.Artifact) (*appcast.Item, error) {
+	var err error
+	if expiresAt := svc.signedURLExpiry(); expiresAt.IsZero() {
+		err = artifact.AddSignedURLs(svc.authSalt)
+	} else {
+		err = artifact.AddExpiringSignedURLs(svc.authSalt, expiresAt)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Sparkle compares the build numbers, the CI build number is used if the bundle one is unknown
+	version := artifact.BundleBuildVersion
+	if version == "" {
+		version = build.ShortID
+	}
+	shortVersion := artifact.BundleVersion
+	if shortVersion == "" {
+		shortVersion = build.VCSTag
+	}
+	title := artifact.BundleName
+	if title == "" && build.HasProject != nil {
+		title = build.HasProject.Name
+	}
+	if shortVersion != "" {
+		title += " " + shortVersion
+	} else {
+		title += " " + version
+	}
+	mimetype := artifact.MimeType
+	if mimetype == "" {
+		mimetype = "application/octet-stream"
+	}
+
+	item := &appcast.Item{
+		Title:              title,
+		Version:            version,
+		ShortVersionString: shortVersion,
+		Description:        build.ReleaseNotes,
+		Enclosure: appcast.Enclosure{
+			URL:         baseURL + artifact.DLArtifactSignedURL,
+			Length:      artifact.FileSize,
+			Type:        mimetype,
+			EdSignature: artifact.SparkleEdSignature,
+		},
+	}
+	switch {
+	case artifact.CreatedAt != nil:
+		item.SetPubDate(*artifact.CreatedAt)
+	case build.CreatedAt != nil:
+		item.SetPubDate(*build.CreatedAt)
+	}
+	return item, nil
+}
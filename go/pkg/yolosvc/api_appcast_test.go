@@ -0,0 +1,125 @@
+package yolosvc
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSparkleAppcast(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	svc := api.(*service)
+
+	created := time.Date(2022, 9, 1, 12, 0, 0, 0, time.UTC)
+	older := created.Add(-24 * time.Hour)
+	err := svc.store.SaveBatch(&yolopb.Batch{
+		Builds: []*yolopb.Build{
+			{ID: "dmg-new", ShortID: "42", CreatedAt: &created, ReleaseNotes: "fix: crash", State: yolopb.Build_Passed, Driver: yolopb.Driver_GitHub, HasProjectID: "https://github.com/berty/berty", HasMergerequestID: testMergeRequestID},
+			{ID: "dmg-old", ShortID: "41", CreatedAt: &older, State: yolopb.Build_Passed, Driver: yolopb.Driver_GitHub, HasProjectID: "https://github.com/berty/berty", HasMergerequestID: testMergeRequestID},
+		},
+		Artifacts: []*yolopb.Artifact{
+			{ID: "dmg-arm64", Kind: yolopb.Artifact_DMG, Arch: archARM64, HasBuildID: "dmg-new"},
+			{ID: "dmg-universal", Kind: yolopb.Artifact_DMG, Arch: archUniversal, FileSize: 42, MimeType: "application/x-apple-diskimage", BundleVersion: "2.3.0", BundleBuildVersion: "230", SparkleEdSignature: "c2lnbmF0dXJl", CreatedAt: &created, HasBuildID: "dmg-new"},
+			{ID: "apk", Kind: yolopb.Artifact_APK, HasBuildID: "dmg-new"},
+			{ID: "dmg", Kind: yolopb.Artifact_DMG, HasBuildID: "dmg-old"},
+		},
+	})
+	require.NoError(t, err)
+
+	// the feed URL uses the yolo_id, the project IDs are URLs
+	builds, err := svc.BuildList(context.Background(), &yolopb.BuildList_Request{BuildID: []string{"dmg-new"}})
+	require.NoError(t, err)
+	require.Len(t, builds.Builds, 1)
+	require.NotNil(t, builds.Builds[0].HasProject)
+	projectID := builds.Builds[0].HasProject.YoloID
+	require.NotEmpty(t, projectID)
+
+	router := chi.NewRouter()
+	router.Get("/api/appcast/{projectID}.xml", svc.SparkleAppcast)
+	type feed struct {
+		Title string `xml:"channel>title"`
+		Link  string `xml:"channel>link"`
+		Items []struct {
+			Title              string `xml:"title"`
+			PubDate            string `xml:"pubDate"`
+			Version            string `xml:"http://www.andymatuschak.org/xml-namespaces/sparkle version"`
+			ShortVersionString string `xml:"http://www.andymatuschak.org/xml-namespaces/sparkle shortVersionString"`
+			Description        string `xml:"description"`
+			Enclosure          struct {
+				URL         string `xml:"url,attr"`
+				Length      int64  `xml:"length,attr"`
+				Type        string `xml:"type,attr"`
+				EdSignature string `xml:"http://www.andymatuschak.org/xml-namespaces/sparkle edSignature,attr"`
+			} `xml:"enclosure"`
+		} `xml:"channel>item"`
+	}
+	get := func(path string) (*httptest.ResponseRecorder, feed) {
+		r := httptest.NewRequest("GET", path, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+		var parsed feed
+		if w.Code == http.StatusOK {
+			require.NoError(t, xml.Unmarshal(w.Body.Bytes(), &parsed))
+		}
+		return w, parsed
+	}
+
+	w, parsed := get("/api/appcast/" + projectID + ".xml")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/xml; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Equal(t, "berty", parsed.Title)
+	assert.Equal(t, "http://example.com/api/appcast/"+projectID+".xml", parsed.Link)
+	require.Len(t, parsed.Items, 2)
+	item := parsed.Items[0]
+	assert.Equal(t, "berty 2.3.0", item.Title)
+	assert.Equal(t, "Thu, 01 Sep 2022 12:00:00 +0000", item.PubDate)
+	assert.Equal(t, "230", item.Version)
+	assert.Equal(t, "2.3.0", item.ShortVersionString)
+	assert.Equal(t, "fix: crash", item.Description)
+	assert.True(t, strings.HasPrefix(item.Enclosure.URL, "http://example.com/api/artifact-dl/dmg-universal?"), item.Enclosure.URL)
+	assert.Contains(t, item.Enclosure.URL, "sign=")
+	assert.Equal(t, int64(42), item.Enclosure.Length)
+	assert.Equal(t, "application/x-apple-diskimage", item.Enclosure.Type)
+	assert.Equal(t, "c2lnbmF0dXJl", item.Enclosure.EdSignature)
+	// without bundle info
+	assert.Equal(t, "41", parsed.Items[1].Version)
+	assert.Equal(t, "berty 41", parsed.Items[1].Title)
+	assert.Empty(t, parsed.Items[1].ShortVersionString)
+	assert.Empty(t, parsed.Items[1].Enclosure.EdSignature)
+	assert.True(t, strings.HasPrefix(parsed.Items[1].Enclosure.URL, "http://example.com/api/artifact-dl/dmg?"))
+
+	w, parsed = get("/api/appcast/" + projectID + ".xml?arch=arm64")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Len(t, parsed.Items, 1)
+	assert.True(t, strings.HasPrefix(parsed.Items[0].Enclosure.URL, "http://example.com/api/artifact-dl/dmg-arm64?"))
+
+	w, parsed = get("/api/appcast/" + projectID + ".xml?limit=1")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Len(t, parsed.Items, 1)
+	assert.Equal(t, "230", parsed.Items[0].Version)
+
+	// a channel without promoted builds
+	w, parsed = get("/api/appcast/" + projectID + ".xml?channel=stable")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, parsed.Items)
+
+	w, parsed = get("/api/appcast/p:unknown.xml")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, parsed.Items)
+
+	w, _ = get("/api/appcast/" + projectID + ".xml?limit=-1")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	w, _ = get("/api/appcast/" + projectID + ".xml?arch=ppc")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
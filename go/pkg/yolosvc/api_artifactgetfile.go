@@ -1,10 +1,12 @@
 package yolosvc
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"path/filepath"
 
+	"berty.tech/yolo/v2/go/pkg/yolostore"
 	"github.com/go-chi/chi"
 	"google.golang.org/grpc/codes"
 	"moul.io/pkgman/pkg/ipa"
@@ -17,14 +19,18 @@ func (svc *service) ArtifactGetFile(w http.ResponseWriter, r *http.Request) {
 	fmt.Println("id", id, "path", filePath)
 
 	artifact, err := svc.store.GetArtifactByID(id)
-	if err != nil {
-		httpError(w, err, codes.InvalidArgument)
+	switch {
+	case errors.Is(err, yolostore.ErrNotFound):
+		httpError(w, err, codes.NotFound)
+		return
+	case err != nil:
+		httpError(w, err, codes.Internal)
 		return
 	}
 
-	artifactPath := filepath.Join(svc.artifactsCachePath, artifact.ID)
+	artifactPath := filepath.Join(svc.artifactsCachePath, artifactCacheKey(artifact))
 	if !u.FileExists(artifactPath) {
-		httpError(w, err, codes.NotFound)
+		httpError(w, fmt.Errorf("artifact not cached"), codes.NotFound)
 		return
 	}
 
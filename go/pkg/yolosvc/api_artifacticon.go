@@ -20,7 +20,7 @@ func (svc *service) ArtifactIcon(w http.ResponseWriter, r *http.Request) {
 
 	p := filepath.Join(svc.artifactsCachePath, "icons", name)
 	if !u.FileExists(p) {
-		httpError(w, fmt.Errorf("no such icon"), codes.InvalidArgument)
+		httpError(w, fmt.Errorf("no such icon"), codes.NotFound)
 		return
 	}
 
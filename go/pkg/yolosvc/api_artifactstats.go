@@ -0,0 +1,42 @@
+package yolosvc
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/yolostore"
+	"github.com/go-chi/chi"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+type artifactStats struct {
+	Downloads      int64      `json:"downloads"`
+	LastDownloadAt *time.Time `json:"last_download_at,omitempty"`
+}
+
+// ArtifactStats returns the number of complete downloads of an artifact and the date of the last one as JSON
+func (svc *service) ArtifactStats(w http.ResponseWriter, r *http.Request) {
+	artifact, err := svc.store.GetArtifactByID(chi.URLParam(r, "artifactID"))
+	switch {
+	case errors.Is(err, yolostore.ErrNotFound):
+		httpError(w, err, codes.NotFound)
+		return
+	case err != nil:
+		httpError(w, err, codes.Internal)
+		return
+	}
+
+	stats, err := svc.store.GetArtifactDownloadStats(artifact.ID)
+	if err != nil {
+		httpError(w, err, codes.Internal)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(artifactStats{Downloads: stats.Downloads, LastDownloadAt: stats.LastDownloadAt})
+	if err != nil {
+		svc.logger.Warn("failed to send artifact stats", zap.Error(err))
+	}
+}
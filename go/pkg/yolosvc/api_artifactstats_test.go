@@ -0,0 +1,55 @@
+package yolosvc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArtifactStats(t *testing.T) {
+	cachePath := t.TempDir()
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t), ArtifactsCachePath: cachePath})
+	defer cleanup()
+	svc := api.(*service)
+	require.NoError(t, os.WriteFile(filepath.Join(cachePath, "artif1"), []byte("hello"), 0o600))
+	require.NoError(t, svc.store.SaveBatch(&yolopb.Batch{Artifacts: []*yolopb.Artifact{
+		{ID: "artif-uncached", LocalPath: "app.apk", Driver: yolopb.Driver_Buildkite, HasBuildID: "https://buildkite.com/berty/berty/builds/2738"},
+	}}))
+
+	request := func(handler http.HandlerFunc, id string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("artifactID", id)
+		r := httptest.NewRequest("GET", "/", nil)
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+		w := httptest.NewRecorder()
+		handler(w, r)
+		return w
+	}
+	stats := func(id string) artifactStats {
+		w := request(svc.ArtifactStats, id)
+		require.Equal(t, http.StatusOK, w.Code)
+		var stats artifactStats
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&stats))
+		return stats
+	}
+
+	assert.Equal(t, int64(1), stats("artif1").Downloads)
+	require.Equal(t, http.StatusOK, request(svc.ArtifactDownloader, "artif1").Code)
+	after := stats("artif1")
+	assert.Equal(t, int64(2), after.Downloads)
+	assert.NotNil(t, after.LastDownloadAt)
+
+	// the failed downloads are not counted
+	assert.NotEqual(t, http.StatusOK, request(svc.ArtifactDownloader, "artif-uncached").Code)
+	assert.Equal(t, artifactStats{}, stats("artif-uncached"))
+}
@@ -0,0 +1,48 @@
+package yolosvc
+
+import (
+	"context"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"berty.tech/yolo/v2/go/pkg/yolostore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const branchStatsDefaultWindow = 30 * 24 * time.Hour
+
+func (svc *service) BranchStats(ctx context.Context, req *yolopb.BranchStats_Request) (*yolopb.BranchStats_Response, error) {
+	if req == nil {
+		req = &yolopb.BranchStats_Request{}
+	}
+	opts := yolostore.GetBranchStatsOpts{
+		Branches:  req.Branch,
+		ProjectID: req.ProjectID,
+		Until:     time.Now(),
+	}
+	if req.Until != "" {
+		until, err := time.Parse(time.RFC3339, req.Until)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid until: %v", err)
+		}
+		opts.Until = until
+	}
+	opts.Since = opts.Until.Add(-branchStatsDefaultWindow)
+	if req.Since != "" {
+		since, err := time.Parse(time.RFC3339, req.Since)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid since: %v", err)
+		}
+		opts.Since = since
+	}
+	if !opts.Since.Before(opts.Until) {
+		return nil, status.Error(codes.InvalidArgument, "since should be before until")
+	}
+
+	entries, err := svc.store.GetBranchStats(opts)
+	if err != nil {
+		return nil, err
+	}
+	return &yolopb.BranchStats_Response{Branches: entries}, nil
+}
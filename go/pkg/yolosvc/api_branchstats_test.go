@@ -0,0 +1,92 @@
+package yolosvc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServiceBranchStats(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	svc := api.(*service)
+
+	start := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+	paris := time.FixedZone("CET", 3600)
+	at := func(offset time.Duration) *time.Time {
+		date := start.Add(offset)
+		return &date
+	}
+	const berty, yolo = "https://github.com/berty/berty", "https://github.com/berty/yolo"
+	err := svc.store.SaveBatch(&yolopb.Batch{Builds: []*yolopb.Build{
+		{ID: "main-1", Branch: "main", HasProjectID: berty, State: yolopb.Build_Passed, CreatedAt: at(0), StartedAt: at(0), FinishedAt: at(10 * time.Minute)},
+		{ID: "main-2", Branch: "main", HasProjectID: berty, State: yolopb.Build_Failed, CreatedAt: at(time.Hour), StartedAt: at(time.Hour), FinishedAt: at(time.Hour + 20*time.Minute)},
+		{ID: "main-3", Branch: "main", HasProjectID: berty, State: yolopb.Build_Timedout, CreatedAt: at(2 * time.Hour)},
+		{ID: "main-4", Branch: "main", HasProjectID: berty, State: yolopb.Build_Passed, CreatedAt: at(3 * time.Hour)},
+		{ID: "main-running", Branch: "main", HasProjectID: berty, State: yolopb.Build_Running, CreatedAt: at(4 * time.Hour)},
+		{ID: "feat-1", Branch: "feat", HasProjectID: berty, State: yolopb.Build_Running, CreatedAt: at(5 * time.Hour)},
+		{ID: "yolo-1", Branch: "main", HasProjectID: yolo, State: yolopb.Build_Passed, CreatedAt: at(time.Hour)},
+		// stored with another time zone, 11:00 UTC
+		{ID: "main-cet", Branch: "main", HasProjectID: berty, State: yolopb.Build_Passed, CreatedAt: func() *time.Time { date := start.Add(-time.Hour).In(paris); return &date }()},
+	}})
+	require.NoError(t, err)
+
+	stats := func(req *yolopb.BranchStats_Request) map[string]*yolopb.BranchStats_Entry {
+		if req.Since == "" {
+			req.Since = start.Format(time.RFC3339)
+		}
+		if req.Until == "" {
+			req.Until = start.Add(24 * time.Hour).Format(time.RFC3339)
+		}
+		resp, err := svc.BranchStats(context.Background(), req)
+		require.NoError(t, err)
+		entries := map[string]*yolopb.BranchStats_Entry{}
+		for _, entry := range resp.Branches {
+			entries[entry.Branch] = entry
+		}
+		return entries
+	}
+
+	entries := stats(&yolopb.BranchStats_Request{ProjectID: "berty/berty"})
+	require.Len(t, entries, 2)
+	main := entries["main"]
+	assert.EqualValues(t, 5, main.Total)
+	assert.EqualValues(t, 2, main.Passed)
+	assert.EqualValues(t, 2, main.Failed)
+	assert.Equal(t, 0.5, main.PassRate)
+	assert.InDelta(t, 15*60, main.AverageDurationSeconds, 0.01) // only the builds with both dates
+	require.NotNil(t, main.LastBuildAt)
+	assert.WithinDuration(t, start.Add(4*time.Hour), *main.LastBuildAt, time.Second)
+	feat := entries["feat"]
+	assert.EqualValues(t, 1, feat.Total)
+	assert.Zero(t, feat.PassRate) // no finished build
+	assert.Zero(t, feat.AverageDurationSeconds)
+
+	// the filters
+	entries = stats(&yolopb.BranchStats_Request{Branch: []string{"feat"}})
+	assert.Len(t, entries, 1)
+	assert.Contains(t, entries, "feat")
+	entries = stats(&yolopb.BranchStats_Request{ProjectID: "berty/yolo"})
+	require.Len(t, entries, 1)
+	assert.EqualValues(t, 1, entries["main"].Total)
+	entries = stats(&yolopb.BranchStats_Request{})
+	assert.EqualValues(t, 6, entries["main"].Total)
+
+	// the range is compared as dates, whatever their time zone
+	entries = stats(&yolopb.BranchStats_Request{ProjectID: berty, Since: start.Add(-90 * time.Minute).Format(time.RFC3339), Until: start.Add(time.Minute).Format(time.RFC3339)})
+	assert.EqualValues(t, 2, entries["main"].Total)
+	entries = stats(&yolopb.BranchStats_Request{ProjectID: berty, Since: start.Add(-30 * time.Minute).In(paris).Format(time.RFC3339), Until: start.Add(time.Minute).Format(time.RFC3339)})
+	assert.EqualValues(t, 1, entries["main"].Total)
+
+	_, err = svc.BranchStats(context.Background(), &yolopb.BranchStats_Request{Since: start.Format(time.RFC3339), Until: start.Format(time.RFC3339)})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = svc.BranchStats(context.Background(), &yolopb.BranchStats_Request{Since: "yesterday"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,105 @@
+package yolosvc
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/gogo/gateway"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/utilities"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+// BuildEvents pushes the builds created or changing state as Server-Sent Events, to be prepended by the web pages.
+//
+// It supports the same query parameters as the BuildList API, i.e, ?artifact_kinds=1 for the iOS builds.
+// The events are pushed as soon as the builds are saved, a comment is sent as a heartbeat to keep the
+// proxies from closing idle streams.
+func (svc *service) BuildEvents(w http.ResponseWriter, r *http.Request) {
+	req := yolopb.BuildList_Request{}
+	if err := runtime.PopulateQueryParameters(&req, r.URL.Query(), utilities.NewDoubleArray(nil)); err != nil {
+		httpError(w, err, codes.InvalidArgument)
+		return
+	}
+	opts, err := svc.buildListOpts(&req, svc.isStaffRequest(r))
+	if err != nil {
+		httpError(w, err, codes.InvalidArgument)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		httpError(w, fmt.Errorf("streaming not supported"), codes.Unimplemented)
+		return
+	}
+
+	// subscribe before listing the known builds, so the builds saved in between are not missed
+	updates, unsubscribe := svc.buildFeed.subscribe()
+	defer unsubscribe()
+
+	seen := map[string]yolopb.Build_State{} // last known state of the builds
+	builds, err := svc.store.GetBuildList(opts)
+	if err != nil {
+		httpError(w, err, codes.Internal)
+		return
+	}
+	for _, build := range builds {
+		seen[build.ID] = build.State
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no") // disable nginx buffering
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	var (
+		ctx       = r.Context()
+		marshaler = gateway.JSONPb{OrigName: true}
+		heartbeat = time.NewTicker(buildStreamHeartbeat)
+	)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-heartbeat.C:
+			if _, err := w.Write([]byte(": heartbeat\n\n")); err != nil {
+				return
+			}
+			flusher.Flush()
+		case id := <-updates:
+			opts.BuildID = pendingBuildIDs(id, updates)
+			opts.Limit = int32(len(opts.BuildID))
+			opts.Offset, opts.Cursor = 0, nil
+			builds, err := svc.store.GetBuildList(opts)
+			if err != nil {
+				svc.logger.Warn("build events: get build list", zap.Error(err))
+				continue
+			}
+			for i := len(builds) - 1; i >= 0; i-- { // oldest first
+				build := builds[i]
+				if state, found := seen[build.ID]; found && state == build.State {
+					continue
+				}
+				seen[build.ID] = build.State
+				if err := svc.prepareBuildOutput(build, r.Header.Get("Authorization")); err != nil {
+					svc.logger.Warn("build events: prepare output", zap.Error(err))
+					continue
+				}
+				out, err := marshaler.Marshal(build)
+				if err != nil {
+					svc.logger.Warn("build events: marshal", zap.Error(err))
+					continue
+				}
+				if _, err := fmt.Fprintf(w, "event: build\nid: %s\ndata: %s\n\n", build.ID, out); err != nil {
+					return // client disconnected
+				}
+			}
+			flusher.Flush()
+		}
+	}
+}
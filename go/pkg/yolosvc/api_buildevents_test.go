@@ -0,0 +1,73 @@
+package yolosvc
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceBuildEvents(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	svc := api.(*service)
+
+	server := httptest.NewServer(http.HandlerFunc(svc.BuildEvents))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"?artifact_kinds=1", nil)
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	lines := make(chan string, 100)
+	go func() {
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+		close(lines)
+	}()
+	receive := func() string {
+		select {
+		case line := <-lines:
+			return line
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "no build event")
+			return ""
+		}
+	}
+
+	save := func(build *yolopb.Build, artifacts ...*yolopb.Artifact) {
+		require.NoError(t, svc.saveBatch(ctx, &yolopb.Batch{Builds: []*yolopb.Build{build}, Artifacts: artifacts}))
+	}
+	save(&yolopb.Build{ID: "apk-only", State: yolopb.Build_Running, HasMergerequestID: testMergeRequestID}, &yolopb.Artifact{ID: "artif-apk", Kind: yolopb.Artifact_APK, HasBuildID: "apk-only"})
+	save(&yolopb.Build{ID: "ipa-build", State: yolopb.Build_Running, HasMergerequestID: testMergeRequestID}, &yolopb.Artifact{ID: "artif-ipa", Kind: yolopb.Artifact_IPA, HasBuildID: "ipa-build"})
+
+	assert.Equal(t, "event: build", receive())
+	assert.Equal(t, "id: ipa-build", receive())
+	data := receive()
+	assert.True(t, strings.HasPrefix(data, "data: {"), data)
+	assert.Contains(t, data, `"state":"Running"`)
+	assert.Equal(t, "", receive())
+}
+
+func TestPendingBuildIDs(t *testing.T) {
+	updates := make(chan string, 3)
+	updates <- "b"
+	updates <- "c"
+	assert.Equal(t, []string{"a", "b", "c"}, pendingBuildIDs("a", updates))
+	assert.Empty(t, updates)
+}
@@ -2,29 +2,91 @@ package yolosvc
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
 	"berty.tech/yolo/v2/go/pkg/yolopb"
 	"berty.tech/yolo/v2/go/pkg/yolostore"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-func (svc *service) BuildList(ctx context.Context, req *yolopb.BuildList_Request) (*yolopb.BuildList_Response, error) {
+const (
+	defaultBuildListLimit = 50
+	maxBuildListLimit     = 1000
+)
+
+func (svc *service) BuildList(ctx context.Context, req *yolopb.BuildList_Request) (_ *yolopb.BuildList_Response, err error) {
+	defer svc.metrics.observeBuildList(time.Now())
+	ctx, span := svc.tracer.Start(ctx, "BuildList")
+	defer func() { endSpan(span, err) }()
+
+	opts, err := svc.buildListOpts(req, svc.isStaff(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := yolopb.BuildList_Response{}
+	before := time.Now()
+	_, query := svc.tracer.Start(ctx, "store.GetBuildList")
+	resp.Builds, err = svc.store.GetBuildList(opts)
+	endSpan(query, err)
+	svc.metrics.observeBuildListQuery(before)
+	if err != nil {
+		return nil, err
+	}
+	span.SetAttributes(attribute.Int("yolo.builds", len(resp.Builds)), attribute.Int("yolo.artifacts", buildsArtifactsCount(resp.Builds)))
+
+	// prepare response
+	for _, build := range resp.Builds {
+		if err := svc.prepareBuildOutput(build, incomingAuthorization(ctx)); err != nil {
+			return nil, fmt.Errorf("failed preparing output")
+		}
+	}
+
+	// a full page may be followed by more builds, the other sorts are paginated with the offset
+	if req.GetSortBy() == yolopb.BuildList_CreatedAt && len(resp.Builds) > 0 && len(resp.Builds) == int(opts.Limit) {
+		resp.NextPageToken = encodePageToken(lastBuildCursor(resp.Builds, opts.SortAsc))
+	}
+
+	return &resp, nil
+}
+
+// buildListOpts converts a BuildList request to store options, applying the defaults
+func (svc *service) buildListOpts(req *yolopb.BuildList_Request, staff bool) (yolostore.GetBuildListOpts, error) {
 	if req == nil {
 		req = &yolopb.BuildList_Request{}
 	}
-	if req.Limit == 0 {
-		req.Limit = 50
+	switch {
+	case req.Limit < 0 || req.Offset < 0:
+		return yolostore.GetBuildListOpts{}, status.Error(codes.InvalidArgument, "limit and offset should be positive")
+	case req.Limit == 0:
+		req.Limit = defaultBuildListLimit
+	case req.Limit > maxBuildListLimit:
+		req.Limit = maxBuildListLimit
+	}
+	for _, arch := range req.ArtifactArch {
+		switch arch {
+		case archARM64, archAMD64, archUniversal:
+		default:
+			return yolostore.GetBuildListOpts{}, status.Errorf(codes.InvalidArgument, "invalid artifact_arch %q, expected arm64, amd64 or universal", arch)
+		}
 	}
 	if !req.WithArtifacts {
-		req.WithArtifacts = len(req.ArtifactKinds) > 0
+		req.WithArtifacts = len(req.ArtifactKinds) > 0 || len(req.ArtifactArch) > 0
 	}
-	resp := yolopb.BuildList_Response{}
 	opts := yolostore.GetBuildListOpts{
 		ArtifactID:           req.ArtifactID,
 		ArtifactKinds:        req.ArtifactKinds,
+		ArtifactArch:         req.ArtifactArch,
 		WithArtifact:         req.WithArtifacts,
 		BuildID:              req.BuildID,
 		BuildState:           req.BuildState,
+		ExternalState:        req.ExternalState,
 		BuildDriver:          req.BuildDriver,
 		ProjectID:            req.ProjectID,
 		MergeRequestID:       req.MergeRequestID,
@@ -32,21 +94,110 @@ func (svc *service) BuildList(ctx context.Context, req *yolopb.BuildList_Request
 		MergeRequestState:    req.MergerequestState,
 		Branch:               req.Branch,
 		Limit:                req.Limit,
+		Offset:               req.Offset,
 		SortByCommitDate:     req.SortByCommitDate,
+		SortBy:               req.SortBy,
+		SortAsc:              req.SortOrder == yolopb.BuildList_Asc,
+		OwnerTeam:            req.OwnerTeam,
+		OverBudget:           req.OverBudget,
+		TaggedOnly:           req.TaggedOnly,
 	}
 
-	var err error
-	resp.Builds, err = svc.store.GetBuildList(opts)
-	if err != nil {
-		return nil, err
+	if req.PageToken != "" {
+		if req.SortBy != yolopb.BuildList_CreatedAt {
+			return opts, status.Error(codes.InvalidArgument, "page_token is only supported when sorting by creation date, use offset instead")
+		}
+		cursor, err := decodePageToken(req.PageToken)
+		if err != nil {
+			return opts, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+		opts.Cursor = &cursor
 	}
 
-	// prepare response
-	for _, build := range resp.Builds {
-		if err := build.PrepareOutput(svc.authSalt); err != nil {
-			return nil, fmt.Errorf("failed preparing output")
+	svc.applyChannelFilter(&opts, req.Channel, staff)
+
+	if len(req.BuildConfig) > 0 {
+		opts.BuildConfig = map[string]string{}
+		for _, entry := range req.BuildConfig {
+			parts := strings.SplitN(entry, "=", 2)
+			if len(parts) != 2 || parts[0] == "" {
+				return opts, status.Errorf(codes.InvalidArgument, "invalid build_config filter %q, expected KEY=value", entry)
+			}
+			opts.BuildConfig[parts[0]] = parts[1]
 		}
 	}
 
-	return &resp, nil
+	return opts, nil
+}
+
+// applyChannelFilter restricts the build list options to the builds of a release channel,
+// without channel the non-staff callers don't see the builds waiting for a promotion
+func (svc *service) applyChannelFilter(opts *yolostore.GetBuildListOpts, name string, staff bool) {
+	if name == "" {
+		if !staff {
+			opts.HideUnpromoted = svc.unpromotedBranches()
+		}
+		return
+	}
+	channel, found := svc.getChannel(name)
+	if !found { // unconfigured channels only contain the builds explicitly promoted to them
+		opts.PromotedTo = name
+		return
+	}
+	if channel.Branch != "" {
+		opts.Branch = []string{channel.Branch}
+	}
+	if channel.RequirePromotion {
+		opts.PromotedTo = channel.Name
+	}
+}
+
+// lastBuildCursor returns the position of the last build of a page in the build list sorted by creation date,
+// it may not be the last one of the response when sorted by commit date
+func lastBuildCursor(builds []*yolopb.Build, asc bool) yolostore.BuildCursor {
+	last := builds[0]
+	for _, build := range builds[1:] {
+		if buildListedAfter(build, last, asc) {
+			last = build
+		}
+	}
+	return yolostore.BuildCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+}
+
+// buildListedAfter reports whether a is listed after b, the builds without creation date are listed last
+func buildListedAfter(a, b *yolopb.Build, asc bool) bool {
+	switch {
+	case (a.CreatedAt == nil) != (b.CreatedAt == nil):
+		return a.CreatedAt == nil
+	case a.CreatedAt == nil || a.CreatedAt.Equal(*b.CreatedAt):
+		return (a.ID > b.ID) == asc
+	default:
+		return a.CreatedAt.After(*b.CreatedAt) == asc
+	}
+}
+
+// pageToken is the JSON content of the opaque BuildList cursors
+type pageToken struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	ID        string     `json:"id"`
+}
+
+func encodePageToken(cursor yolostore.BuildCursor) string {
+	raw, _ := json.Marshal(pageToken{CreatedAt: cursor.CreatedAt, ID: cursor.ID})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodePageToken(token string) (yolostore.BuildCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return yolostore.BuildCursor{}, err
+	}
+	var decoded pageToken
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return yolostore.BuildCursor{}, err
+	}
+	if decoded.ID == "" {
+		return yolostore.BuildCursor{}, fmt.Errorf("missing build ID")
+	}
+	return yolostore.BuildCursor{CreatedAt: decoded.CreatedAt, ID: decoded.ID}, nil
 }
@@ -2,14 +2,21 @@ package yolosvc
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"berty.tech/yolo/v2/go/pkg/testutil"
 	"berty.tech/yolo/v2/go/pkg/yolopb"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// testMergeRequestID is the merge request of the fixture build, BuildList only lists the builds of a merge request
+const testMergeRequestID = "https://github.com/berty/berty/pull/2438"
+
 func TestServiceBuildList(t *testing.T) {
 	svc, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
 	defer cleanup()
@@ -77,8 +84,468 @@ func TestServiceBuildList(t *testing.T) {
 		HasProjectID:      "https://github.com/berty/berty",
 		HasMergerequestID: "https://github.com/berty/berty/pull/2438",
 		HasProject:        project,
+		DownloadsCount:    1,
 	}
+	// the install URL expires, see TestInstallCallback
+	require.NoError(t, build.AddInstallSignedURL(svc.(*service).authSalt, svc.(*service).installURLExpiry()))
 
 	assert.Equal(t, 1, len(resp.Builds))
 	assert.Equal(t, resp.Builds[0], build)
 }
+
+func TestServiceBuildListPagination(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	svc := api.(*service)
+
+	opts, err := svc.buildListOpts(&yolopb.BuildList_Request{}, false)
+	require.NoError(t, err)
+	assert.Equal(t, int32(defaultBuildListLimit), opts.Limit)
+	opts, err = svc.buildListOpts(&yolopb.BuildList_Request{Limit: 5000, Offset: 10}, false)
+	require.NoError(t, err)
+	assert.Equal(t, int32(maxBuildListLimit), opts.Limit)
+	assert.Equal(t, int32(10), opts.Offset)
+	_, err = svc.buildListOpts(&yolopb.BuildList_Request{Offset: -1}, false)
+	assert.Error(t, err)
+
+	resp, err := svc.BuildList(context.Background(), &yolopb.BuildList_Request{Offset: 1})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Builds)
+}
+
+func TestServiceBuildListPageToken(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	svc := api.(*service)
+
+	day := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+	yesterday := day.Add(-24 * time.Hour)
+	builds := []*yolopb.Build{}
+	for i, createdAt := range []*time.Time{&day, &day, &day, &yesterday, &yesterday, nil} {
+		builds = append(builds, &yolopb.Build{ID: fmt.Sprintf("paged-%d", i), CreatedAt: createdAt, Driver: yolopb.Driver_GitHub, HasMergerequestID: testMergeRequestID})
+	}
+	require.NoError(t, svc.store.SaveBatch(&yolopb.Batch{Builds: builds}))
+
+	all, err := svc.BuildList(context.Background(), &yolopb.BuildList_Request{})
+	require.NoError(t, err)
+	assert.Empty(t, all.NextPageToken)
+	expected := []string{}
+	for _, build := range all.Builds {
+		expected = append(expected, build.ID)
+	}
+	require.Len(t, expected, 7)
+
+	// each build is listed exactly once, even if new builds are added while paging
+	listed := []string{}
+	req := &yolopb.BuildList_Request{Limit: 2}
+	for page := 0; ; page++ {
+		require.Less(t, page, 10)
+		resp, err := svc.BuildList(context.Background(), req)
+		require.NoError(t, err)
+		for _, build := range resp.Builds {
+			listed = append(listed, build.ID)
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		if page == 0 {
+			now := time.Now()
+			require.NoError(t, svc.store.SaveBatch(&yolopb.Batch{Builds: []*yolopb.Build{{ID: "paged-new", CreatedAt: &now, Driver: yolopb.Driver_GitHub, HasMergerequestID: testMergeRequestID}}}))
+		}
+		req.PageToken = resp.NextPageToken
+	}
+	assert.Equal(t, expected, listed)
+
+	_, err = svc.BuildList(context.Background(), &yolopb.BuildList_Request{PageToken: "invalid"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServiceBuildListSort(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	svc := api.(*service)
+
+	day := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+	build := func(id string, days int, shortID string, duration time.Duration) *yolopb.Build {
+		createdAt := day.AddDate(0, 0, days)
+		build := &yolopb.Build{ID: id, CreatedAt: &createdAt, ShortID: shortID, Driver: yolopb.Driver_GitHub, HasMergerequestID: testMergeRequestID}
+		if duration > 0 {
+			finishedAt := createdAt.Add(duration)
+			build.StartedAt, build.FinishedAt = &createdAt, &finishedAt
+		}
+		return build
+	}
+	require.NoError(t, svc.store.SaveBatch(&yolopb.Batch{Builds: []*yolopb.Build{
+		build("sorted-a", 0, "10", 30*time.Minute),
+		build("sorted-b", 1, "9", 10*time.Minute),
+		build("sorted-c", 2, "100", 0), // running
+	}}))
+
+	list := func(req *yolopb.BuildList_Request) []string {
+		req.BuildID = []string{"sorted-a", "sorted-b", "sorted-c"}
+		resp, err := svc.BuildList(context.Background(), req)
+		require.NoError(t, err)
+		ids := []string{}
+		for _, build := range resp.Builds {
+			ids = append(ids, build.ID)
+		}
+		return ids
+	}
+	assert.Equal(t, []string{"sorted-c", "sorted-b", "sorted-a"}, list(&yolopb.BuildList_Request{}))
+
+	resp, err := svc.BuildList(context.Background(), &yolopb.BuildList_Request{BuildID: []string{"sorted-a", "sorted-c"}})
+	require.NoError(t, err)
+	require.Len(t, resp.Builds, 2)
+	assert.Equal(t, int64(0), resp.Builds[0].Duration)
+	assert.Equal(t, int64(1800), resp.Builds[1].Duration)
+	assert.Equal(t, []string{"sorted-a", "sorted-b", "sorted-c"}, list(&yolopb.BuildList_Request{SortOrder: yolopb.BuildList_Asc}))
+	assert.Equal(t, []string{"sorted-c", "sorted-a", "sorted-b"}, list(&yolopb.BuildList_Request{SortBy: yolopb.BuildList_BuildNum}))
+	assert.Equal(t, []string{"sorted-b", "sorted-a", "sorted-c"}, list(&yolopb.BuildList_Request{SortBy: yolopb.BuildList_BuildNum, SortOrder: yolopb.BuildList_Asc}))
+	assert.Equal(t, []string{"sorted-a", "sorted-b", "sorted-c"}, list(&yolopb.BuildList_Request{SortBy: yolopb.BuildList_Duration}))
+	assert.Equal(t, []string{"sorted-b", "sorted-a", "sorted-c"}, list(&yolopb.BuildList_Request{SortBy: yolopb.BuildList_Duration, SortOrder: yolopb.BuildList_Asc}))
+
+	// the page tokens follow the sort order
+	resp, err = svc.BuildList(context.Background(), &yolopb.BuildList_Request{BuildID: []string{"sorted-a", "sorted-b", "sorted-c"}, SortOrder: yolopb.BuildList_Asc, Limit: 2})
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.NextPageToken)
+	assert.Equal(t, []string{"sorted-c"}, list(&yolopb.BuildList_Request{SortOrder: yolopb.BuildList_Asc, PageToken: resp.NextPageToken}))
+
+	_, err = svc.BuildList(context.Background(), &yolopb.BuildList_Request{SortBy: yolopb.BuildList_BuildNum, PageToken: resp.NextPageToken})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// the full pages of the other sorts have no token, they would be rejected
+	resp, err = svc.BuildList(context.Background(), &yolopb.BuildList_Request{BuildID: []string{"sorted-a", "sorted-b", "sorted-c"}, SortBy: yolopb.BuildList_Duration, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, resp.Builds, 2)
+	assert.Empty(t, resp.NextPageToken)
+}
+
+func TestServiceBuildListArtifactKinds(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	svc := api.(*service)
+
+	err := svc.store.SaveBatch(&yolopb.Batch{
+		Builds: []*yolopb.Build{{ID: "dmg-only", State: yolopb.Build_Passed, Driver: yolopb.Driver_GitHub, HasMergerequestID: testMergeRequestID}},
+		Artifacts: []*yolopb.Artifact{
+			{ID: "artif-ipa", Kind: yolopb.Artifact_IPA, HasBuildID: "https://buildkite.com/berty/berty/builds/2738"},
+			{ID: "artif-dmg", Kind: yolopb.Artifact_DMG, HasBuildID: "dmg-only"},
+		},
+	})
+	require.NoError(t, err)
+
+	resp, err := svc.BuildList(context.Background(), &yolopb.BuildList_Request{ArtifactKinds: []yolopb.Artifact_Kind{yolopb.Artifact_APK}})
+	require.NoError(t, err)
+	require.Len(t, resp.Builds, 1)
+	assert.Equal(t, "https://buildkite.com/berty/berty/builds/2738", resp.Builds[0].ID)
+	require.Len(t, resp.Builds[0].HasArtifacts, 1)
+	assert.Equal(t, "artif1", resp.Builds[0].HasArtifacts[0].ID)
+
+	resp, err = svc.BuildList(context.Background(), &yolopb.BuildList_Request{ArtifactKinds: []yolopb.Artifact_Kind{yolopb.Artifact_IPA, yolopb.Artifact_DMG}})
+	require.NoError(t, err)
+	require.Len(t, resp.Builds, 2)
+	for _, build := range resp.Builds {
+		require.Len(t, build.HasArtifacts, 1)
+		assert.NotEqual(t, yolopb.Artifact_APK, build.HasArtifacts[0].Kind)
+	}
+}
+
+func TestServiceBuildListArtifactsNoDuplicates(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	svc := api.(*service)
+
+	// a build with several artifacts matching the filters
+	err := svc.store.SaveBatch(&yolopb.Batch{
+		Builds: []*yolopb.Build{{ID: "several", Driver: yolopb.Driver_GitHub, HasMergerequestID: testMergeRequestID}},
+		Artifacts: []*yolopb.Artifact{
+			{ID: "several-apk-1", Kind: yolopb.Artifact_APK, HasBuildID: "several"},
+			{ID: "several-apk-2", Kind: yolopb.Artifact_APK, HasBuildID: "several"},
+			{ID: "several-ipa", Kind: yolopb.Artifact_IPA, HasBuildID: "several"},
+		},
+	})
+	require.NoError(t, err)
+
+	for _, req := range []*yolopb.BuildList_Request{
+		{ArtifactKinds: []yolopb.Artifact_Kind{yolopb.Artifact_APK}},
+		{ArtifactKinds: []yolopb.Artifact_Kind{yolopb.Artifact_APK, yolopb.Artifact_IPA}},
+		{WithArtifacts: true},
+	} {
+		resp, err := svc.BuildList(context.Background(), req)
+		require.NoError(t, err)
+		ids := []string{}
+		for _, build := range resp.Builds {
+			ids = append(ids, build.ID)
+		}
+		assert.ElementsMatch(t, []string{"several", "https://buildkite.com/berty/berty/builds/2738"}, ids, req.String())
+	}
+
+	// the limit applies to builds, not to artifacts
+	resp, err := svc.BuildList(context.Background(), &yolopb.BuildList_Request{WithArtifacts: true, Limit: 2})
+	require.NoError(t, err)
+	assert.Len(t, resp.Builds, 2)
+}
+
+func TestServiceBuildListArtifactOrder(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	svc := api.(*service)
+
+	uploaded := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+	later := uploaded.Add(3 * time.Minute)
+	err := svc.store.SaveBatch(&yolopb.Batch{
+		Builds: []*yolopb.Build{{ID: "uploads", State: yolopb.Build_Passed, Driver: yolopb.Driver_Buildkite, HasMergerequestID: testMergeRequestID}},
+		Artifacts: []*yolopb.Artifact{
+			{ID: "artif-c", Kind: yolopb.Artifact_IPA, HasBuildID: "uploads", CreatedAt: &later},
+			{ID: "artif-a", Kind: yolopb.Artifact_APK, HasBuildID: "uploads", CreatedAt: &later},
+			{ID: "artif-b", Kind: yolopb.Artifact_APK, HasBuildID: "uploads", CreatedAt: &uploaded},
+		},
+	})
+	require.NoError(t, err)
+
+	resp, err := svc.BuildList(context.Background(), &yolopb.BuildList_Request{BuildID: []string{"uploads"}})
+	require.NoError(t, err)
+	require.Len(t, resp.Builds, 1)
+	ids := []string{}
+	for _, artifact := range resp.Builds[0].HasArtifacts {
+		ids = append(ids, artifact.ID)
+	}
+	assert.Equal(t, []string{"artif-b", "artif-a", "artif-c"}, ids)
+	assert.True(t, resp.Builds[0].HasArtifacts[0].CreatedAt.Equal(uploaded))
+}
+
+func TestServiceBuildListArtifactArch(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	svc := api.(*service)
+
+	err := svc.store.SaveBatch(&yolopb.Batch{
+		Builds: []*yolopb.Build{
+			{ID: "mac-split", State: yolopb.Build_Passed, Driver: yolopb.Driver_GitHub, HasMergerequestID: testMergeRequestID},
+			{ID: "mac-universal", State: yolopb.Build_Passed, Driver: yolopb.Driver_GitHub, HasMergerequestID: testMergeRequestID},
+		},
+		Artifacts: []*yolopb.Artifact{
+			{ID: "artif-arm64", Kind: yolopb.Artifact_DMG, Arch: artifactArchByPath("Berty-arm64.dmg"), HasBuildID: "mac-split"},
+			{ID: "artif-amd64", Kind: yolopb.Artifact_DMG, Arch: artifactArchByPath("Berty_x86_64.dmg"), HasBuildID: "mac-split"},
+			{ID: "artif-universal", Kind: yolopb.Artifact_DMG, Arch: artifactArchByPath("Berty-Universal.dmg"), HasBuildID: "mac-universal"},
+		},
+	})
+	require.NoError(t, err)
+
+	resp, err := svc.BuildList(context.Background(), &yolopb.BuildList_Request{ArtifactArch: []string{"arm64"}})
+	require.NoError(t, err)
+	require.Len(t, resp.Builds, 1)
+	require.Len(t, resp.Builds[0].HasArtifacts, 1)
+	assert.Equal(t, "artif-arm64", resp.Builds[0].HasArtifacts[0].ID)
+
+	resp, err = svc.BuildList(context.Background(), &yolopb.BuildList_Request{ArtifactKinds: []yolopb.Artifact_Kind{yolopb.Artifact_DMG}, ArtifactArch: []string{"amd64", "universal"}})
+	require.NoError(t, err)
+	require.Len(t, resp.Builds, 2)
+	for _, build := range resp.Builds {
+		require.Len(t, build.HasArtifacts, 1)
+		assert.NotEqual(t, "arm64", build.HasArtifacts[0].Arch)
+	}
+
+	_, err = svc.BuildList(context.Background(), &yolopb.BuildList_Request{ArtifactArch: []string{"x86"}})
+	assert.Error(t, err)
+
+	for path, expected := range map[string]string{
+		"Berty-arm64.dmg":               "arm64",
+		"dist/Berty-apple-silicon.dmg":  "arm64",
+		"Berty-intel.unsigned-dmg":      "amd64",
+		"Berty-universal.dmg":           "universal",
+		"Berty.dmg":                     "",
+		"Berty-arm64-v8a.apk":           "",
+		"js/packages/Berty-aarch64.dmg": "arm64",
+		"Berty-Setup-1.2.0-x64.exe":     "amd64",
+		"Berty-arm64.msi":               "arm64",
+	} {
+		assert.Equal(t, expected, artifactArchByPath(path), path)
+	}
+}
+
+func TestServiceBuildListCommitAuthor(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	svc := api.(*service)
+
+	err := svc.store.SaveBatch(&yolopb.Batch{
+		Builds: []*yolopb.Build{{
+			ID:                "authored",
+			State:             yolopb.Build_Passed,
+			Driver:            yolopb.Driver_GitHub,
+			Message:           "fix login bug",
+			CommitAuthor:      "Alice",
+			CommitEmail:       "alice@example.com",
+			HasMergerequestID: testMergeRequestID,
+		}},
+		Artifacts: []*yolopb.Artifact{{ID: "artif-authored", Kind: yolopb.Artifact_APK, HasBuildID: "authored"}},
+	})
+	require.NoError(t, err)
+
+	resp, err := svc.BuildList(context.Background(), &yolopb.BuildList_Request{BuildID: []string{"authored"}})
+	require.NoError(t, err)
+	require.Len(t, resp.Builds, 1)
+	assert.Equal(t, "fix login bug", resp.Builds[0].Message)
+	assert.Equal(t, "Alice", resp.Builds[0].CommitAuthor)
+	assert.Equal(t, "alice@example.com", resp.Builds[0].CommitEmail)
+}
+
+func TestServiceBuildListProject(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	svc := api.(*service)
+
+	err := svc.store.SaveBatch(&yolopb.Batch{
+		Builds:    []*yolopb.Build{{ID: "yolo-build", State: yolopb.Build_Passed, Driver: yolopb.Driver_GitHub, HasProjectID: "https://github.com/berty/yolo", HasMergerequestID: testMergeRequestID}},
+		Artifacts: []*yolopb.Artifact{{ID: "artif-yolo", Kind: yolopb.Artifact_APK, HasBuildID: "yolo-build"}},
+		Projects:  []*yolopb.Project{{ID: "https://github.com/berty/yolo", Driver: yolopb.Driver_GitHub, Name: "yolo"}},
+	})
+	require.NoError(t, err)
+
+	resp, err := svc.BuildList(context.Background(), &yolopb.BuildList_Request{})
+	require.NoError(t, err)
+	assert.Len(t, resp.Builds, 2)
+
+	// projects can be selected by their "owner/repo" slug
+	resp, err = svc.BuildList(context.Background(), &yolopb.BuildList_Request{ProjectID: []string{"berty/yolo"}})
+	require.NoError(t, err)
+	require.Len(t, resp.Builds, 1)
+	assert.Equal(t, "yolo-build", resp.Builds[0].ID)
+}
+
+func TestServiceBuildListBuildState(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	svc := api.(*service)
+
+	// the fixture build is running
+	err := svc.store.SaveBatch(&yolopb.Batch{Builds: []*yolopb.Build{
+		{ID: "build-passed", State: yolopb.Build_Passed, Driver: yolopb.Driver_GitHub, HasMergerequestID: testMergeRequestID},
+		{ID: "build-failed", State: yolopb.Build_Failed, Driver: yolopb.Driver_GitHub, HasMergerequestID: testMergeRequestID},
+		{ID: "build-canceled", State: yolopb.Build_Canceled, Driver: yolopb.Driver_CircleCI, HasMergerequestID: testMergeRequestID},
+	}})
+	require.NoError(t, err)
+
+	buildIDs := func(builds []*yolopb.Build) []string {
+		ids := make([]string, len(builds))
+		for i, build := range builds {
+			ids[i] = build.ID
+		}
+		return ids
+	}
+
+	// all the states by default
+	resp, err := svc.BuildList(context.Background(), &yolopb.BuildList_Request{})
+	require.NoError(t, err)
+	assert.Len(t, resp.Builds, 4)
+
+	resp, err = svc.BuildList(context.Background(), &yolopb.BuildList_Request{BuildState: []yolopb.Build_State{yolopb.Build_Passed}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"build-passed"}, buildIDs(resp.Builds))
+
+	resp, err = svc.BuildList(context.Background(), &yolopb.BuildList_Request{BuildState: []yolopb.Build_State{yolopb.Build_Failed, yolopb.Build_Running}})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"build-failed", "https://buildkite.com/berty/berty/builds/2738"}, buildIDs(resp.Builds))
+
+	resp, err = svc.BuildList(context.Background(), &yolopb.BuildList_Request{BuildState: []yolopb.Build_State{yolopb.Build_Timedout}})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Builds)
+}
+
+func TestServiceBuildListWithArtifacts(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	svc := api.(*service)
+
+	// i.e, a failed build, or a build whose artifacts were garbage collected
+	err := svc.store.SaveBatch(&yolopb.Batch{Builds: []*yolopb.Build{
+		{ID: "no-artifacts", State: yolopb.Build_Failed, Driver: yolopb.Driver_GitHub, HasMergerequestID: testMergeRequestID},
+	}})
+	require.NoError(t, err)
+
+	// all the builds by default
+	resp, err := svc.BuildList(context.Background(), &yolopb.BuildList_Request{})
+	require.NoError(t, err)
+	assert.Len(t, resp.Builds, 2)
+
+	resp, err = svc.BuildList(context.Background(), &yolopb.BuildList_Request{WithArtifacts: true})
+	require.NoError(t, err)
+	require.Len(t, resp.Builds, 1)
+	assert.Equal(t, "https://buildkite.com/berty/berty/builds/2738", resp.Builds[0].ID)
+	assert.NotEmpty(t, resp.Builds[0].HasArtifacts)
+}
+
+func TestServiceBuildListTagged(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	svc := api.(*service)
+
+	// the tag pushes have no merge request
+	err := svc.store.SaveBatch(&yolopb.Batch{Builds: []*yolopb.Build{
+		{ID: "tag-1.10.0", VCSTag: "v1.10.0", Driver: yolopb.Driver_GitHub},
+		{ID: "tag-1.9.0", VCSTag: "1.9.0", Driver: yolopb.Driver_GitHub},
+		{ID: "tag-1.10.0-rc.1", VCSTag: "v1.10.0-rc.1", Driver: yolopb.Driver_GitHub},
+		{ID: "tag-nightly", VCSTag: "nightly", Driver: yolopb.Driver_GitHub},
+	}})
+	require.NoError(t, err)
+
+	list := func(req *yolopb.BuildList_Request) []string {
+		resp, err := svc.BuildList(context.Background(), req)
+		require.NoError(t, err)
+		ids := []string{}
+		for _, build := range resp.Builds {
+			ids = append(ids, build.ID)
+			assert.Empty(t, build.VersionKey)
+		}
+		return ids
+	}
+	assert.Equal(t, []string{"tag-1.10.0", "tag-1.10.0-rc.1", "tag-1.9.0", "tag-nightly"}, list(&yolopb.BuildList_Request{TaggedOnly: true, SortBy: yolopb.BuildList_Version}))
+	assert.Equal(t, []string{"tag-1.9.0", "tag-1.10.0-rc.1", "tag-1.10.0", "tag-nightly"}, list(&yolopb.BuildList_Request{TaggedOnly: true, SortBy: yolopb.BuildList_Version, SortOrder: yolopb.BuildList_Asc}))
+
+	// the untagged builds are filtered out
+	assert.NotContains(t, list(&yolopb.BuildList_Request{TaggedOnly: true}), "https://buildkite.com/berty/berty/builds/2738")
+	assert.Contains(t, list(&yolopb.BuildList_Request{}), "https://buildkite.com/berty/berty/builds/2738")
+}
+
+func TestServiceBuildListBuildConfig(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	svc := api.(*service)
+
+	err := svc.store.SaveBatch(&yolopb.Batch{Builds: []*yolopb.Build{
+		{ID: "production", BuildConfig: map[string]string{"API_ENV": "production", "FLAVOR": "full"}, Driver: yolopb.Driver_GitHub, HasMergerequestID: testMergeRequestID},
+		{ID: "staging", BuildConfig: map[string]string{"API_ENV": "staging", "FLAVOR": "full"}, Driver: yolopb.Driver_GitHub, HasMergerequestID: testMergeRequestID},
+		{ID: "wildcard", BuildConfig: map[string]string{"API_ENV": "prod%"}, Driver: yolopb.Driver_GitHub, HasMergerequestID: testMergeRequestID},
+	}})
+	require.NoError(t, err)
+
+	list := func(filters ...string) []string {
+		resp, err := svc.BuildList(context.Background(), &yolopb.BuildList_Request{BuildConfig: filters})
+		require.NoError(t, err)
+		ids := []string{}
+		for _, build := range resp.Builds {
+			ids = append(ids, build.ID)
+		}
+		return ids
+	}
+
+	// the config is loaded back from its JSON representation, which is not exposed
+	resp, err := svc.BuildList(context.Background(), &yolopb.BuildList_Request{BuildID: []string{"production"}})
+	require.NoError(t, err)
+	require.Len(t, resp.Builds, 1)
+	assert.Equal(t, map[string]string{"API_ENV": "production", "FLAVOR": "full"}, resp.Builds[0].BuildConfig)
+	assert.Empty(t, resp.Builds[0].BuildConfigJSON)
+
+	assert.Equal(t, []string{"production"}, list("API_ENV=production"))
+	assert.ElementsMatch(t, []string{"production", "staging"}, list("FLAVOR=full"))
+	assert.Equal(t, []string{"staging"}, list("FLAVOR=full", "API_ENV=staging"))
+	assert.Empty(t, list("API_ENV=prod"))
+	assert.Equal(t, []string{"wildcard"}, list("API_ENV=prod%"))
+	assert.Len(t, list(), 4)
+
+	for _, filter := range []string{"API_ENV", "=production"} {
+		_, err := svc.BuildList(context.Background(), &yolopb.BuildList_Request{BuildConfig: []string{filter}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), filter)
+	}
+}
@@ -0,0 +1,106 @@
+package yolosvc
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/gogo/gateway"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/utilities"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	buildStreamPollInterval = 5 * time.Second
+	buildStreamHeartbeat    = 15 * time.Second
+)
+
+// BuildStreamer streams the new and updated builds as newline-delimited JSON.
+//
+// It supports the same query parameters as the BuildList API, the builds existing
+// when the stream is opened are not sent. An empty line is sent as a heartbeat to
+// keep the proxies from closing idle streams.
+func (svc *service) BuildStreamer(w http.ResponseWriter, r *http.Request) {
+	req := yolopb.BuildList_Request{}
+	if err := runtime.PopulateQueryParameters(&req, r.URL.Query(), utilities.NewDoubleArray(nil)); err != nil {
+		httpError(w, err, codes.InvalidArgument)
+		return
+	}
+	opts, err := svc.buildListOpts(&req, svc.isStaffRequest(r))
+	if err != nil {
+		httpError(w, err, codes.InvalidArgument)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		httpError(w, fmt.Errorf("streaming not supported"), codes.Unimplemented)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no") // disable nginx buffering
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	var (
+		ctx       = r.Context()
+		marshaler = gateway.JSONPb{OrigName: true}
+		poll      = time.NewTicker(buildStreamPollInterval)
+		heartbeat = time.NewTicker(buildStreamHeartbeat)
+		seen      map[string]yolopb.Build_State // last known state of the builds
+	)
+	defer poll.Stop()
+	defer heartbeat.Stop()
+
+	for {
+		builds, err := svc.store.GetBuildList(opts)
+		if err != nil {
+			svc.logger.Warn("build stream: get build list", zap.Error(err))
+		} else {
+			next := make(map[string]yolopb.Build_State, len(builds))
+			for i := len(builds) - 1; i >= 0; i-- { // oldest first
+				build := builds[i]
+				next[build.ID] = build.State
+				if seen == nil {
+					continue
+				}
+				if state, found := seen[build.ID]; found && state == build.State {
+					continue
+				}
+				if err := svc.prepareBuildOutput(build, r.Header.Get("Authorization")); err != nil {
+					svc.logger.Warn("build stream: prepare output", zap.Error(err))
+					continue
+				}
+				out, err := marshaler.Marshal(build)
+				if err != nil {
+					svc.logger.Warn("build stream: marshal", zap.Error(err))
+					continue
+				}
+				if _, err := w.Write(append(out, '\n')); err != nil {
+					return // client disconnected
+				}
+			}
+			seen = next
+			flusher.Flush()
+		}
+
+	wait:
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-heartbeat.C:
+				if _, err := w.Write([]byte("\n")); err != nil {
+					return
+				}
+				flusher.Flush()
+			case <-poll.C:
+				break wait
+			}
+		}
+	}
+}
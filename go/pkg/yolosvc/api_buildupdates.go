@@ -0,0 +1,78 @@
+package yolosvc
+
+import (
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultBuildUpdatesSnapshot = 20
+
+// StreamBuildUpdates sends a snapshot of the recent builds, then the builds created or changing state
+func (svc *service) StreamBuildUpdates(req *yolopb.StreamBuildUpdates_Request, stream yolopb.YoloService_StreamBuildUpdatesServer) error {
+	if req.SnapshotSize < 0 {
+		return status.Error(codes.InvalidArgument, "snapshot_size should be positive")
+	}
+	if req.SnapshotSize == 0 {
+		req.SnapshotSize = defaultBuildUpdatesSnapshot
+	}
+	opts, err := svc.buildListOpts(&yolopb.BuildList_Request{
+		ProjectID:     req.ProjectID,
+		ArtifactKinds: req.ArtifactKinds,
+		Limit:         req.SnapshotSize,
+	}, svc.isStaff(stream.Context()))
+	if err != nil {
+		return err
+	}
+
+	// subscribe before the snapshot, so the builds saved in between are not missed
+	updates, unsubscribe := svc.buildFeed.subscribe()
+	defer unsubscribe()
+
+	seen := map[string]yolopb.Build_State{} // last sent state of the builds
+	send := func(builds []*yolopb.Build) error {
+		for i := len(builds) - 1; i >= 0; i-- { // oldest first
+			build := builds[i]
+			if state, found := seen[build.ID]; found && state == build.State {
+				continue
+			}
+			seen[build.ID] = build.State
+			if err := svc.prepareBuildOutput(build, incomingAuthorization(stream.Context())); err != nil {
+				return status.Error(codes.Internal, "failed preparing output")
+			}
+			if err := stream.Send(&yolopb.StreamBuildUpdates_Response{Build: build}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	builds, err := svc.store.GetBuildList(opts)
+	if err != nil {
+		return err
+	}
+	if err := send(builds); err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case id := <-updates:
+			opts.BuildID = pendingBuildIDs(id, updates)
+			opts.Limit = int32(len(opts.BuildID))
+
+			builds, err := svc.store.GetBuildList(opts)
+			if err != nil {
+				svc.logger.Warn("build updates: get build list", zap.Error(err))
+				continue
+			}
+			if err := send(builds); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package yolosvc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type testingBuildUpdatesStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	builds chan *yolopb.Build
+}
+
+func (s *testingBuildUpdatesStream) Context() context.Context { return s.ctx }
+
+func (s *testingBuildUpdatesStream) Send(resp *yolopb.StreamBuildUpdates_Response) error {
+	s.builds <- resp.Build
+	return nil
+}
+
+func TestServiceStreamBuildUpdates(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	svc := api.(*service)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &testingBuildUpdatesStream{ctx: ctx, builds: make(chan *yolopb.Build, 10)}
+	done := make(chan error)
+	go func() {
+		done <- svc.StreamBuildUpdates(&yolopb.StreamBuildUpdates_Request{ArtifactKinds: []yolopb.Artifact_Kind{yolopb.Artifact_APK}}, stream)
+	}()
+	receive := func() *yolopb.Build {
+		select {
+		case build := <-stream.builds:
+			return build
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "no build update")
+			return nil
+		}
+	}
+
+	// snapshot
+	assert.Equal(t, "https://buildkite.com/berty/berty/builds/2738", receive().ID)
+
+	save := func(build *yolopb.Build, artifacts ...*yolopb.Artifact) {
+		require.NoError(t, svc.saveBatch(ctx, &yolopb.Batch{Builds: []*yolopb.Build{build}, Artifacts: artifacts}))
+	}
+	save(&yolopb.Build{ID: "ipa-only", State: yolopb.Build_Running, HasMergerequestID: testMergeRequestID}, &yolopb.Artifact{ID: "artif-ipa", Kind: yolopb.Artifact_IPA, HasBuildID: "ipa-only"})
+	save(&yolopb.Build{ID: "new-build", State: yolopb.Build_Running, HasMergerequestID: testMergeRequestID}, &yolopb.Artifact{ID: "artif-apk", Kind: yolopb.Artifact_APK, HasBuildID: "new-build"})
+	build := receive()
+	assert.Equal(t, "new-build", build.ID)
+	assert.Equal(t, yolopb.Build_Running, build.State)
+
+	// unchanged builds are not sent again
+	save(&yolopb.Build{ID: "new-build", State: yolopb.Build_Running, HasMergerequestID: testMergeRequestID})
+	save(&yolopb.Build{ID: "new-build", State: yolopb.Build_Passed, HasMergerequestID: testMergeRequestID})
+	build = receive()
+	assert.Equal(t, "new-build", build.ID)
+	assert.Equal(t, yolopb.Build_Passed, build.State)
+	assert.Empty(t, stream.builds)
+
+	// the subscription is released on disconnect
+	cancel()
+	require.NoError(t, <-done)
+	svc.buildFeed.mutex.Lock()
+	assert.Empty(t, svc.buildFeed.subscribers)
+	svc.buildFeed.mutex.Unlock()
+}
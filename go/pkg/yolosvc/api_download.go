@@ -1,9 +1,9 @@
 package yolosvc
 
 import (
-	"archive/zip"
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -16,9 +16,11 @@ import (
 	"strings"
 	"sync"
 
-	"berty.tech/yolo/v2/go/pkg/bintray"
 	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"berty.tech/yolo/v2/go/pkg/yolostore"
 	"github.com/go-chi/chi"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"moul.io/u"
@@ -26,24 +28,54 @@ import (
 
 func (svc *service) ArtifactDownloader(w http.ResponseWriter, r *http.Request) {
 	id := chi.URLParam(r, "artifactID")
+	ctx, span := svc.tracer.Start(r.Context(), "ArtifactDownloader", trace.WithAttributes(attribute.String("yolo.artifact_id", id)))
+	r = r.WithContext(ctx)
+	var (
+		err  error
+		sent int64
+	)
+	defer func() {
+		span.SetAttributes(attribute.Int64("yolo.bytes", sent))
+		endSpan(span, err)
+	}()
 
 	artifact, err := svc.store.GetArtifactByID(id)
-	if err != nil {
-		httpError(w, err, codes.InvalidArgument)
+	switch {
+	case errors.Is(err, yolostore.ErrNotFound):
+		httpError(w, err, codes.NotFound)
+		return
+	case err != nil:
+		httpError(w, err, codes.Internal)
 		return
 	}
 	svc.logger.Debug("artifact downloader", zap.Any("artifact", artifact))
+	span.SetAttributes(attribute.String("yolo.driver", artifact.Driver.String()))
 
-	// save download
-	{
-		download := yolopb.Download{
-			HasArtifactID: artifact.ID,
-			// FIXME: user agent for analytics?
+	// the clients re-requesting an artifact they already have (i.e, after a network blip) don't download it again,
+	// nor spend its single-use URL or count as a download
+	if etag := artifactETag(artifact); etag != "" {
+		w.Header().Set("ETag", etag)
+		if etagMatch(r.Header.Get("If-None-Match"), etag) {
+			w.WriteHeader(http.StatusNotModified)
+			return
 		}
-		err := svc.store.CreateDownload(&download)
-		if err != nil {
-			svc.logger.Warn("failed to add download log entry", zap.Error(err))
+	}
+
+	if rate := svc.requestDownloadRate(r); rate > 0 {
+		w = newThrottledResponseWriter(r.Context(), w, rate)
+	}
+	w = &countingResponseWriter{ResponseWriter: w, count: func(n int64) {
+		sent += n
+		svc.metrics.sent(artifact.Driver, n)
+	}}
+
+	// single-use URLs are spent once fully downloaded
+	signature := singleUseSignature(r)
+	if signature != "" {
+		if !svc.beginSingleUse(w, signature) {
+			return
 		}
+		defer svc.endSingleUse(signature)
 	}
 
 	switch ext := filepath.Ext(artifact.LocalPath); ext {
@@ -62,48 +94,95 @@ func (svc *service) ArtifactDownloader(w http.ResponseWriter, r *http.Request) {
 		}
 
 		var (
-			cacheKey = artifact.ID + ".signed"
+			cacheKey = artifactCacheKey(artifact) + ".signed"
 			filename = strings.TrimSuffix(path.Base(artifact.LocalPath), ext) + ".ipa"
 			mimetype = artifact.MimeType
 			filesize = int64(0) // will be automatically computed if using cache
 		)
-		err := svc.sendFileMayCache(filename, cacheKey, mimetype, filesize, w, func(w io.Writer) error {
-			return svc.signAndStreamIPA(*artifact, w)
+		err = svc.sendFileMayCache(filename, cacheKey, mimetype, filesize, w, func(w io.Writer) error {
+			return svc.signAndStreamIPA(r.Context(), *artifact, w)
 		})
-		if err != nil {
-			httpError(w, err, codes.Internal)
-		}
 	case ".unsigned-dmg", ".dummy-signed-dmg":
 		// TODO: implement à-la-zsign (re)signature
 		// TODO: patch the .dmg to append some additional context
 		var (
-			cacheKey = artifact.ID
+			cacheKey = artifactCacheKey(artifact)
 			filename = strings.TrimSuffix(path.Base(artifact.LocalPath), ext) + ".dmg"
 			mimetype = artifact.MimeType
 			filesize = artifact.FileSize
 		)
-		err := svc.sendFileMayCache(filename, cacheKey, mimetype, filesize, w, func(w io.Writer) error {
-			return svc.artifactDownloadFromProvider(artifact, w)
+		err = svc.sendFileMayCache(filename, cacheKey, mimetype, filesize, w, func(w io.Writer) error {
+			return svc.artifactDownloadFromProvider(r.Context(), artifact, w)
 		})
-		if err != nil {
-			httpError(w, err, codes.Internal)
-		}
 	default:
 		var (
-			cacheKey = artifact.ID
+			cacheKey = artifactCacheKey(artifact)
 			filename = path.Base(artifact.LocalPath)
 			mimetype = artifact.MimeType
 			filesize = artifact.FileSize
 		)
-		err := svc.sendFileMayCache(filename, cacheKey, mimetype, filesize, w, func(w io.Writer) error {
-			return svc.artifactDownloadFromProvider(artifact, w)
+		// the single-use downloads are always proxied, the upstream URL could be reused until it expires.
+		// the redirections aren't counted, the client may not follow them
+		if svc.preferRedirect[artifact.Driver] && signature == "" {
+			location, err := svc.downloadLocation(r.Context(), artifact, filename)
+			if err == nil {
+				span.SetAttributes(attribute.Bool("yolo.redirected", true))
+				http.Redirect(w, r, location, http.StatusFound)
+				return
+			}
+			svc.logger.Warn("failed to redirect download, proxying it", zap.String("artifact", artifact.ID), zap.Error(err))
+		}
+		// the checksums are computed from the first complete download if unknown, and compared to the stored ones if verified
+		var (
+			out  http.ResponseWriter = w
+			sums *checksumResponseWriter
+		)
+		digest := digestHeader(artifact.Sha256Sum)
+		if digest != "" {
+			w.Header().Set("Digest", digest)
+		}
+		if digest == "" || svc.verifyDownloads {
+			sums = &checksumResponseWriter{ResponseWriter: w, sums: newChecksumWriter()}
+			out = sums
+		}
+		err = svc.sendFileMayCache(filename, cacheKey, mimetype, filesize, out, func(w io.Writer) error {
+			return svc.artifactDownloadFromProvider(r.Context(), artifact, w)
 		})
-		if err != nil {
-			httpError(w, err, codes.Internal)
+		// an upstream response longer than the stored size fails to be sent
+		corrupt := false
+		if svc.verifyDownloads && (err == nil || errors.Is(err, http.ErrContentLength)) {
+			corrupt = !svc.verifyDownload(artifact, sums)
 		}
+		if err == nil && needsMimeSniffing(filename, mimetype) {
+			svc.saveSniffedMimetype(artifact, w.Header().Get("Content-Type"))
+		}
+		if err == nil && digest == "" && !corrupt {
+			svc.saveChecksums(artifact, sums.sums)
+		}
+	}
+	if err != nil {
+		httpError(w, err, codes.Internal)
+		return
+	}
+
+	// only the complete downloads are counted, the failed and aborted ones end with an error
+	svc.saveDownload(artifact)
+	if signature != "" {
+		svc.spendSingleUse(r, signature)
 	}
 }
 
+func (svc *service) saveDownload(artifact *yolopb.Artifact) {
+	download := yolopb.Download{
+		HasArtifactID: artifact.ID,
+		// FIXME: user agent for analytics?
+	}
+	if err := svc.store.CreateDownload(&download); err != nil {
+		svc.logger.Warn("failed to add download log entry", zap.Error(err))
+	}
+	svc.metrics.download(artifact.Driver)
+}
+
 func (svc *service) streamMayCache(cacheKey string, w io.Writer, fn func(io.Writer) error) error {
 	svc.logger.Debug("stream may cache", zap.String("cachekey", cacheKey))
 	// if cache is disabled, just stream the file
@@ -171,11 +250,22 @@ func (svc *service) sendFileMayCache(filename, cacheKey, mimetype string, filesi
 	if filesize > 0 {
 		w.Header().Add("Content-Length", fmt.Sprintf("%d", filesize))
 	}
-	if mimetype != "" {
-		w.Header().Add("Content-Type", mimetype)
-	}
 	// FIXME: cache-control and expires
 
+	// guess untrustworthy mimetypes from the first bytes instead of reading the whole file
+	if needsMimeSniffing(filename, mimetype) {
+		sniffer := newSniffingResponseWriter(w, filename, svc.mimeSniffLimit)
+		if err := svc.sendFileContentMayCache(cacheKey, filesize, sniffer, fn); err != nil {
+			return err
+		}
+		return sniffer.flushHead()
+	}
+	w.Header().Add("Content-Type", mimetype)
+
+	return svc.sendFileContentMayCache(cacheKey, filesize, w, fn)
+}
+
+func (svc *service) sendFileContentMayCache(cacheKey string, filesize int64, w http.ResponseWriter, fn func(io.Writer) error) error {
 	// if cache is disabled, just stream fn to the writer
 	if svc.artifactsCachePath == "" {
 		return fn(w)
@@ -193,7 +283,7 @@ func (svc *service) sendFileMayCache(filename, cacheKey, mimetype string, filesi
 	return svc.streamMayCache(cacheKey, w, fn)
 }
 
-func (svc *service) signAndStreamIPA(artifact yolopb.Artifact, w io.Writer) error {
+func (svc *service) signAndStreamIPA(ctx context.Context, artifact yolopb.Artifact, w io.Writer) error {
 	svc.logger.Debug("sign and stream IPA", zap.Any("artifact", artifact))
 	// sign ipa
 	var signed string
@@ -212,8 +302,8 @@ func (svc *service) signAndStreamIPA(artifact yolopb.Artifact, w io.Writer) erro
 			return err
 		}
 
-		err = svc.streamMayCache(artifact.ID, f, func(w io.Writer) error {
-			return svc.artifactDownloadFromProvider(&artifact, w)
+		err = svc.streamMayCache(artifactCacheKey(&artifact), f, func(w io.Writer) error {
+			return svc.artifactDownloadFromProvider(ctx, &artifact, w)
 		})
 		if err != nil {
 			return err
@@ -262,19 +352,39 @@ func (svc *service) signAndStreamIPA(artifact yolopb.Artifact, w io.Writer) erro
 	return nil
 }
 
-func (svc *service) artifactDownloadFromProvider(artifact *yolopb.Artifact, w io.Writer) error {
+// artifactDownloadFromProvider streams an artifact from its driver, in a span of the trace of ctx
+func (svc *service) artifactDownloadFromProvider(ctx context.Context, artifact *yolopb.Artifact, w io.Writer) (err error) {
 	svc.logger.Debug("download from provider", zap.Any("artifact", artifact))
-	ctx := context.Background()
+	ctx, span := svc.tracer.Start(ctx, "download "+artifact.Driver.String(), trace.WithAttributes(
+		attribute.String("yolo.driver", artifact.Driver.String()),
+		attribute.String("yolo.artifact_id", artifact.ID),
+	))
+	defer func() { endSpan(span, err) }()
+	w = svc.bufferPool.writer(w)
 	switch artifact.Driver {
 	case yolopb.Driver_Buildkite:
 		if svc.bkc == nil {
 			return fmt.Errorf("buildkite token required")
 		}
-		_, err := svc.bkc.Artifacts.DownloadArtifactByURL(artifact.DownloadURL, w)
+		_, err := svc.bkc.Artifacts.DownloadArtifactByURL(svc.rewriteDownloadURL(artifact), w)
 		return err
-	case yolopb.Driver_Bintray:
-		return bintray.DownloadContent(artifact.DownloadURL, w)
-		// case Driver_CircleCI:
+	case yolopb.Driver_Bintray, yolopb.Driver_HTTP: // Bintray is sunset, its stored artifacts are plain URLs until they are migrated
+		return svc.httpDownload(ctx, artifact, w)
+	case yolopb.Driver_CircleCI:
+		if svc.ccc == nil {
+			return fmt.Errorf("circleci token required")
+		}
+		return circleciDownloadArtifact(ctx, svc.ccc, svc.rewriteDownloadURL(artifact), w)
+	case yolopb.Driver_S3:
+		if svc.s3c == nil {
+			return fmt.Errorf("s3 configuration required")
+		}
+		return svc.s3c.Download(ctx, svc.rewriteDownloadURL(artifact), w)
+	case yolopb.Driver_FirebaseAppDistribution:
+		if svc.fbc == nil {
+			return fmt.Errorf("firebase service account required")
+		}
+		return svc.fbc.Download(ctx, artifact.DownloadURL, w)
 	case yolopb.Driver_GitHub:
 		if svc.ghc == nil {
 			return fmt.Errorf("github token required")
@@ -284,7 +394,7 @@ func (svc *service) artifactDownloadFromProvider(artifact *yolopb.Artifact, w io
 		var zipContent []byte
 		{
 			// FIXME: if cachePath != nil -> cache the zip
-			u, err := url.Parse(artifact.GetDownloadURL())
+			u, err := url.Parse(svc.rewriteDownloadURL(artifact))
 			if err != nil {
 				return err
 			}
@@ -301,7 +411,11 @@ func (svc *service) artifactDownloadFromProvider(artifact *yolopb.Artifact, w io
 			if err != nil {
 				return fmt.Errorf("failed to generate download URL: %w", err)
 			}
-			resp, err := http.Get(dlurl.String())
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, dlurl.String(), nil)
+			if err != nil {
+				return err
+			}
+			resp, err := http.DefaultClient.Do(req)
 			if err != nil {
 				return fmt.Errorf("failed to download artifact: %w", err)
 			}
@@ -312,30 +426,21 @@ func (svc *service) artifactDownloadFromProvider(artifact *yolopb.Artifact, w io
 			}
 		}
 
-		// extract file from archive
-		{
-			bytesReader := bytes.NewReader(zipContent)
-			zipReader, err := zip.NewReader(bytesReader, int64(len(zipContent)))
-			if err != nil {
-				return fmt.Errorf("failed to open artifact archive: %w", err)
-			}
-			if len(zipReader.File) != 1 {
-				return fmt.Errorf("artifact archive should only have 1 file")
-			}
-			for _, f := range zipReader.File {
-				fd, err := f.Open()
-				if err != nil {
-					return fmt.Errorf("failed to read file from archive: %w", err)
-				}
-				defer fd.Close()
-				_, err = io.Copy(w, fd)
-				if err != nil {
-					return fmt.Errorf("io error while sending content of the artifact: %w", err)
-				}
-			}
+		return copyArchiveFile(w, zipContent)
+	case yolopb.Driver_AzurePipelines:
+		if svc.azc == nil {
+			return fmt.Errorf("azure devops token required")
 		}
-
-		return nil
+		var archive bytes.Buffer
+		if err := svc.azc.Download(ctx, svc.rewriteDownloadURL(artifact), &archive); err != nil {
+			return err
+		}
+		return copyArchiveFile(w, archive.Bytes())
+	case yolopb.Driver_Jenkins:
+		if svc.jkc == nil {
+			return fmt.Errorf("jenkins token required")
+		}
+		return svc.jkc.Download(ctx, svc.rewriteDownloadURL(artifact), w)
 	}
 	return fmt.Errorf("download not supported for this driver")
 }
@@ -0,0 +1,114 @@
+package yolosvc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArtifactHandlersNotFound(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	svc := api.(*service)
+
+	handlers := []struct {
+		name    string
+		handler http.HandlerFunc
+	}{
+		{"download", svc.ArtifactDownloader},
+		{"plist", svc.PlistGenerator},
+		{"get-file", svc.ArtifactGetFile},
+		{"checksums", svc.ArtifactChecksums},
+		{"sha256", svc.ArtifactSHA256File},
+		{"stats", svc.ArtifactStats},
+	}
+	for _, tt := range handlers {
+		t.Run(tt.name, func(t *testing.T) {
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("artifactID", "does-not-exist")
+			r := httptest.NewRequest("GET", "/", nil)
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+			w := httptest.NewRecorder()
+
+			tt.handler(w, r)
+			assert.Equal(t, http.StatusNotFound, w.Code)
+		})
+	}
+}
+
+func TestArtifactDownloaderETag(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	svc := api.(*service)
+
+	content := "apk content"
+	sum := sha256.Sum256([]byte(content))
+	fetched := 0
+	provider := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetched++
+		_, _ = w.Write([]byte(content))
+	}))
+	defer provider.Close()
+	artifact := &yolopb.Artifact{ID: "apk", LocalPath: "berty.apk", MimeType: "application/vnd.android.package-archive", FileSize: int64(len(content)), Sha256Sum: hex.EncodeToString(sum[:]), Driver: yolopb.Driver_Bintray, DownloadURL: provider.URL, HasBuildID: "https://buildkite.com/berty/berty/builds/2738"}
+	require.NoError(t, svc.store.SaveArtifact(artifact))
+
+	download := func(ifNoneMatch string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("artifactID", "apk")
+		r := httptest.NewRequest("GET", "/", nil)
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+		if ifNoneMatch != "" {
+			r.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		w := httptest.NewRecorder()
+		svc.ArtifactDownloader(w, r)
+		return w
+	}
+
+	w := download("")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, content, w.Body.String())
+	etag := w.Header().Get("ETag")
+	assert.Equal(t, `"`+hex.EncodeToString(sum[:])+`"`, etag)
+	assert.Equal(t, 1, fetched)
+
+	// the same content, not fetched from the provider nor sent again
+	w = download(etag)
+	assert.Equal(t, http.StatusNotModified, w.Code)
+	assert.Empty(t, w.Body.String())
+	assert.Equal(t, etag, w.Header().Get("ETag"))
+	assert.Equal(t, 1, fetched)
+	stats, err := svc.store.GetArtifactDownloadStats("apk")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), stats.Downloads)
+
+	// mismatch
+	w = download(`"outdated", W/"other"`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, content, w.Body.String())
+	assert.Equal(t, 2, fetched)
+}
+
+func TestArtifactETag(t *testing.T) {
+	sha256Sum := "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"
+	assert.Equal(t, `"`+sha256Sum+`"`, artifactETag(&yolopb.Artifact{ID: "apk", LocalPath: "berty.apk", FileSize: 42, Sha256Sum: sha256Sum}))
+
+	// unknown checksum
+	etag := artifactETag(&yolopb.Artifact{ID: "apk", LocalPath: "berty.apk", FileSize: 42})
+	assert.NotEmpty(t, etag)
+	assert.NotEqual(t, etag, artifactETag(&yolopb.Artifact{ID: "apk", LocalPath: "berty.apk", FileSize: 43}))
+	assert.NotEqual(t, etag, artifactETag(&yolopb.Artifact{ID: "other", LocalPath: "berty.apk", FileSize: 42}))
+	assert.Empty(t, artifactETag(&yolopb.Artifact{ID: "apk", LocalPath: "berty.apk"}))
+
+	// the IPAs signed on the fly
+	assert.Empty(t, artifactETag(&yolopb.Artifact{ID: "ipa", LocalPath: "berty.unsigned-ipa", FileSize: 42, Sha256Sum: sha256Sum}))
+}
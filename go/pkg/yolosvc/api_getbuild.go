@@ -0,0 +1,40 @@
+package yolosvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"berty.tech/yolo/v2/go/pkg/yolostore"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetBuild returns a single build with its artifacts, i.e, to deep-link to a build
+func (svc *service) GetBuild(ctx context.Context, req *yolopb.GetBuild_Request) (_ *yolopb.GetBuild_Response, err error) {
+	ctx, span := svc.tracer.Start(ctx, "GetBuild")
+	defer func() { endSpan(span, err) }()
+	if req == nil || req.BuildID == "" {
+		return nil, status.Error(codes.InvalidArgument, "build_id is required")
+	}
+	span.SetAttributes(attribute.String("yolo.build_id", req.BuildID))
+
+	build, err := svc.store.GetBuildByID(req.BuildID)
+	switch {
+	case errors.Is(err, yolostore.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "no such build")
+	case err != nil:
+		return nil, err
+	}
+	if !svc.isStaff(ctx) && svc.isUnpromoted(build) {
+		return nil, status.Error(codes.NotFound, "no such build")
+	}
+	span.SetAttributes(attribute.String("yolo.driver", build.Driver.String()), attribute.Int("yolo.artifacts", len(build.HasArtifacts)))
+	if err := svc.prepareBuildOutput(build, incomingAuthorization(ctx)); err != nil {
+		return nil, fmt.Errorf("failed preparing output")
+	}
+
+	return &yolopb.GetBuild_Response{Build: build}, nil
+}
@@ -0,0 +1,31 @@
+package yolosvc
+
+import (
+	"context"
+	"testing"
+
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServiceGetBuild(t *testing.T) {
+	svc, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+
+	for _, id := range []string{"https://buildkite.com/berty/berty/builds/2738", "b:n5SDir9UzvDbis4sYVB97f1EiAdnv784AAGWwZHWWkN"} {
+		resp, err := svc.GetBuild(context.Background(), &yolopb.GetBuild_Request{BuildID: id})
+		require.NoError(t, err)
+		assert.Equal(t, "https://buildkite.com/berty/berty/builds/2738", resp.Build.ID)
+		require.Len(t, resp.Build.HasArtifacts, 1)
+		assert.Equal(t, "/api/artifact-dl/artif1?sign=08998d42d07339b70870e0e39043844c31831419", resp.Build.HasArtifacts[0].DLArtifactSignedURL)
+	}
+
+	_, err := svc.GetBuild(context.Background(), &yolopb.GetBuild_Request{BuildID: "does-not-exist"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = svc.GetBuild(context.Background(), &yolopb.GetBuild_Request{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,75 @@
+package yolosvc
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"berty.tech/yolo/v2/go/pkg/yolostore"
+	"github.com/go-chi/chi"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+// defaultInstallURLTTL leaves time to install a build and launch it after it was listed
+const defaultInstallURLTTL = 7 * 24 * time.Hour
+
+// InstallCallback records a confirmed install, it is called by the installed app on its first launch.
+// the app should send its install_id form value, the replays of the same app are only counted once.
+func (svc *service) InstallCallback(w http.ResponseWriter, r *http.Request) {
+	// always require the signature, even when basic auth is used, to prevent spoofing
+	if !validSignature(r, svc.authSalts) {
+		httpError(w, fmt.Errorf("invalid signature"), codes.Unauthenticated)
+		return
+	}
+	if r.URL.Query().Get("expires") == "" || signedURLExpired(r) {
+		httpError(w, fmt.Errorf("install URL expired"), codes.Unauthenticated)
+		return
+	}
+
+	id := chi.URLParam(r, "buildID")
+	build, err := svc.store.GetBuildByID(id)
+	switch {
+	case errors.Is(err, yolostore.ErrNotFound):
+		httpError(w, err, codes.NotFound)
+		return
+	case err != nil:
+		httpError(w, err, codes.Internal)
+		return
+	}
+
+	install := yolopb.Install{HasBuildID: build.ID, InstallID: r.PostFormValue("install_id")}
+	created, err := svc.store.CreateInstall(&install)
+	if err != nil {
+		svc.logger.Warn("failed to add install log entry", zap.Error(err))
+		httpError(w, err, codes.Internal)
+		return
+	}
+	svc.logger.Debug("install callback", zap.String("build", build.ID), zap.Bool("replay", !created))
+	if created {
+		svc.metrics.install(build.Driver)
+		svc.clearCache.Set()
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// installURLExpiry returns the expiry of the install URLs of the API responses,
+// it is rounded up like signedURLExpiry
+func (svc *service) installURLExpiry() time.Time {
+	return time.Now().Add(svc.installURLTTL + signedURLExpiryRounding).Truncate(signedURLExpiryRounding)
+}
+
+// prepareBuildOutput signs the artifact and install URLs of a build of an API response,
+// the download URLs carry the download rate override of the caller authorization if any
+func (svc *service) prepareBuildOutput(build *yolopb.Build, authorization string) error {
+	if err := build.PrepareExpiringOutput(svc.authSalt, svc.signedURLExpiry()); err != nil {
+		return err
+	}
+	if err := svc.addDownloadRate(build.HasArtifacts, authorization); err != nil {
+		return err
+	}
+	return build.AddInstallSignedURL(svc.authSalt, svc.installURLExpiry())
+}
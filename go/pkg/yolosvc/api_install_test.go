@@ -0,0 +1,82 @@
+package yolosvc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstallCallback(t *testing.T) {
+	metrics := NewMetrics()
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t), Metrics: metrics})
+	defer cleanup()
+	svc := api.(*service)
+
+	const buildID = "https://buildkite.com/berty/berty/builds/2738"
+	router := chi.NewRouter()
+	router.Post("/api/installed/{buildID}", svc.InstallCallback)
+	post := func(path, installID string) int {
+		form := url.Values{}
+		if installID != "" {
+			form.Set("install_id", installID)
+		}
+		r := httptest.NewRequest("POST", path, strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+		return w.Code
+	}
+	installs := func() int64 {
+		resp, err := svc.BuildList(context.Background(), &yolopb.BuildList_Request{BuildID: []string{buildID}})
+		require.NoError(t, err)
+		require.Len(t, resp.Builds, 1)
+		return resp.Builds[0].InstallsCount
+	}
+
+	resp, err := svc.GetBuild(context.Background(), &yolopb.GetBuild_Request{BuildID: buildID})
+	require.NoError(t, err)
+	installURL := resp.Build.InstallSignedURL
+	u, err := url.Parse(installURL)
+	require.NoError(t, err)
+	require.NotEmpty(t, u.Query().Get("expires"))
+
+	// the replays of an app are only counted once
+	assert.Equal(t, http.StatusNoContent, post(installURL, "app-1"))
+	assert.Equal(t, http.StatusNoContent, post(installURL, "app-1"))
+	assert.Equal(t, int64(1), installs())
+	assert.Equal(t, http.StatusNoContent, post(installURL, "app-2"))
+	assert.Equal(t, http.StatusNoContent, post(installURL, ""))
+	assert.Equal(t, int64(3), installs())
+	assert.Equal(t, int64(3), metrics.Installs(yolopb.Driver_Buildkite))
+
+	// the install URLs are signed and expire
+	assert.Equal(t, http.StatusUnauthorized, post("/api/installed/"+resp.Build.YoloID, "app-3"))
+	expired := yolopb.Build{ID: buildID, YoloID: resp.Build.YoloID}
+	require.NoError(t, expired.AddInstallSignedURL(svc.authSalt, time.Now().Add(-time.Minute)))
+	assert.Equal(t, http.StatusUnauthorized, post(expired.InstallSignedURL, "app-3"))
+	require.NoError(t, expired.AddInstallSignedURL(svc.authSalt, time.Time{}))
+	assert.Equal(t, http.StatusUnauthorized, post(expired.InstallSignedURL, "app-3"))
+	assert.Equal(t, int64(3), installs())
+
+	unknown := yolopb.Build{ID: "unknown"}
+	require.NoError(t, unknown.AddInstallSignedURL(svc.authSalt, time.Now().Add(time.Hour)))
+	assert.Equal(t, http.StatusNotFound, post(unknown.InstallSignedURL, "app-3"))
+
+	// the conversion is relative to the downloads
+	metrics.download(yolopb.Driver_Buildkite)
+	metrics.download(yolopb.Driver_Buildkite)
+	w := httptest.NewRecorder()
+	metrics.ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+	assert.Contains(t, w.Body.String(), `yolo_build_installs_total{driver="Buildkite"} 3`)
+	assert.Contains(t, w.Body.String(), `yolo_install_conversion_ratio{driver="Buildkite"} 1.5`)
+}
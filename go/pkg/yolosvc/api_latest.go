@@ -0,0 +1,115 @@
+package yolosvc
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"berty.tech/yolo/v2/go/pkg/yolostore"
+	"github.com/go-chi/chi"
+	"google.golang.org/grpc/codes"
+)
+
+// latestReleaseKinds are the artifact kinds installed on each platform of the /release/{platform}/latest URLs, by order of preference
+var latestReleaseKinds = map[string][]yolopb.Artifact_Kind{
+	"ios":     {yolopb.Artifact_IPA},
+	"android": {yolopb.Artifact_APK},
+	"mac":     {yolopb.Artifact_DMG},
+	"windows": {yolopb.Artifact_EXE, yolopb.Artifact_MSI},
+}
+
+// LatestRelease redirects to the install link of the most recent build having an artifact of a platform (ios, android, mac or windows),
+// the OTA install of the manifest on iOS, the signed download URL otherwise. it can be bookmarked to always get the newest build,
+// the "project", "branch", "channel" and "arch" parameters restrict the builds.
+func (svc *service) LatestRelease(w http.ResponseWriter, r *http.Request) {
+	platform := chi.URLParam(r, "platform")
+	kinds, found := latestReleaseKinds[platform]
+	if !found {
+		httpError(w, fmt.Errorf("invalid platform %q, expected ios, android, mac or windows", platform), codes.InvalidArgument)
+		return
+	}
+
+	query := r.URL.Query()
+	opts := yolostore.GetBuildListOpts{
+		ArtifactKinds: kinds,
+		Limit:         1,
+	}
+	if arch := query.Get("arch"); arch != "" {
+		switch arch {
+		case archARM64, archAMD64, archUniversal:
+		default:
+			httpError(w, fmt.Errorf("invalid arch %q, expected arm64, amd64 or universal", arch), codes.InvalidArgument)
+			return
+		}
+		opts.ArtifactArch = []string{arch}
+	}
+	if project := query.Get("project"); project != "" {
+		opts.ProjectID = []string{project}
+	}
+	if branch := query.Get("branch"); branch != "" {
+		opts.Branch = []string{branch}
+	}
+	svc.applyChannelFilter(&opts, query.Get("channel"), svc.isStaffRequest(r))
+
+	builds, err := svc.store.GetBuildList(opts)
+	if err != nil {
+		httpError(w, err, codes.Internal)
+		return
+	}
+	var artifact *yolopb.Artifact
+	if len(builds) == 1 {
+		artifact = latestReleaseArtifact(builds[0].HasArtifacts, kinds, query.Get("arch"))
+	}
+	if artifact == nil {
+		httpError(w, fmt.Errorf("no %s build", platform), codes.NotFound)
+		return
+	}
+
+	var location string
+	switch artifact.Kind {
+	case yolopb.Artifact_IPA:
+		// the install starts right away, the manifest TTL leaves enough time to confirm it
+		expiresAt := time.Now().Add(svc.plistManifestTTL)
+		err = artifact.AddExpiringSignedURLs(svc.authSalt, expiresAt)
+		if err == nil {
+			// the device model is signed with the manifest URL, iOS doesn't send it
+			err = artifact.AddDeviceSignedPListURL(svc.authSalt, query.Get("device"), expiresAt, false)
+		}
+		if err != nil {
+			httpError(w, err, codes.Internal)
+			return
+		}
+		location = "itms-services://?action=download-manifest&url=" + requestBaseURL(r) + artifact.PListSignedURL
+	default:
+		if expiresAt := svc.signedURLExpiry(); expiresAt.IsZero() {
+			err = artifact.AddSignedURLs(svc.authSalt)
+		} else {
+			err = artifact.AddExpiringSignedURLs(svc.authSalt, expiresAt)
+		}
+		if err == nil {
+			err = svc.addDownloadRate([]*yolopb.Artifact{artifact}, r.Header.Get("Authorization"))
+		}
+		if err != nil {
+			httpError(w, err, codes.Internal)
+			return
+		}
+		location = requestBaseURL(r) + artifact.DLArtifactSignedURL
+	}
+
+	// the target changes with each new build
+	w.Header().Set("Cache-Control", "no-store")
+	http.Redirect(w, r, location, http.StatusFound)
+}
+
+// latestReleaseArtifact returns the artifact of the preferred kind, and of the requested architecture
+func latestReleaseArtifact(artifacts []*yolopb.Artifact, kinds []yolopb.Artifact_Kind, arch string) *yolopb.Artifact {
+	for _, kind := range kinds {
+		for _, candidate := range artifacts {
+			if candidate.Kind == kind && (arch == "" || candidate.Arch == arch) {
+				return candidate
+			}
+		}
+	}
+	return nil
+}
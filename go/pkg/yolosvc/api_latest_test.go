@@ -0,0 +1,74 @@
+package yolosvc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLatestRelease(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	svc := api.(*service)
+
+	newer := time.Date(2022, 9, 1, 12, 0, 0, 0, time.UTC)
+	older := newer.Add(-24 * time.Hour)
+	err := svc.store.SaveBatch(&yolopb.Batch{
+		Builds: []*yolopb.Build{
+			{ID: "new", CreatedAt: &newer, Branch: "feat", Driver: yolopb.Driver_GitHub, HasProjectID: "https://github.com/berty/berty", HasMergerequestID: testMergeRequestID},
+			{ID: "old", CreatedAt: &older, Branch: "master", Driver: yolopb.Driver_GitHub, HasProjectID: "https://github.com/berty/berty", HasMergerequestID: testMergeRequestID},
+		},
+		Artifacts: []*yolopb.Artifact{
+			{ID: "new-apk", Kind: yolopb.Artifact_APK, HasBuildID: "new"},
+			{ID: "old-apk", Kind: yolopb.Artifact_APK, HasBuildID: "old"},
+			{ID: "old-ipa", Kind: yolopb.Artifact_IPA, HasBuildID: "old"},
+			{ID: "new-exe", Kind: yolopb.Artifact_EXE, Arch: "arm64", HasBuildID: "new"},
+			{ID: "new-msi", Kind: yolopb.Artifact_MSI, Arch: "amd64", HasBuildID: "new"},
+			{ID: "old-exe", Kind: yolopb.Artifact_EXE, Arch: "amd64", HasBuildID: "old"},
+		},
+	})
+	require.NoError(t, err)
+
+	router := chi.NewRouter()
+	router.Get("/release/{platform}/latest", svc.LatestRelease)
+	get := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("GET", path, nil))
+		return w
+	}
+
+	w := get("/release/android/latest")
+	require.Equal(t, http.StatusFound, w.Code)
+	assert.True(t, strings.HasPrefix(w.Header().Get("Location"), "http://example.com/api/artifact-dl/new-apk?"), w.Header().Get("Location"))
+	assert.Contains(t, w.Header().Get("Location"), "sign=")
+	assert.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+
+	w = get("/release/android/latest?branch=master")
+	require.Equal(t, http.StatusFound, w.Code)
+	assert.True(t, strings.HasPrefix(w.Header().Get("Location"), "http://example.com/api/artifact-dl/old-apk?"), w.Header().Get("Location"))
+
+	w = get("/release/ios/latest")
+	require.Equal(t, http.StatusFound, w.Code)
+	assert.True(t, strings.HasPrefix(w.Header().Get("Location"), "itms-services://?action=download-manifest&url=http://example.com%2Fapi%2Fplist-gen%2Fold-ipa.plist"), w.Header().Get("Location"))
+
+	// the installers are preferred to the packages, of the requested architecture
+	w = get("/release/windows/latest")
+	require.Equal(t, http.StatusFound, w.Code)
+	assert.True(t, strings.HasPrefix(w.Header().Get("Location"), "http://example.com/api/artifact-dl/new-exe?"), w.Header().Get("Location"))
+	w = get("/release/windows/latest?arch=amd64")
+	require.Equal(t, http.StatusFound, w.Code)
+	assert.True(t, strings.HasPrefix(w.Header().Get("Location"), "http://example.com/api/artifact-dl/new-msi?"), w.Header().Get("Location"))
+
+	assert.Equal(t, http.StatusNotFound, get("/release/mac/latest").Code)
+	assert.Equal(t, http.StatusNotFound, get("/release/android/latest?project=p:unknown").Code)
+	assert.Equal(t, http.StatusBadRequest, get("/release/linux/latest").Code)
+	assert.Equal(t, http.StatusBadRequest, get("/release/windows/latest?arch=x86").Code)
+}
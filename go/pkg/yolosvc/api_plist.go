@@ -1,33 +1,61 @@
 package yolosvc
 
 import (
+	"errors"
 	"fmt"
 	"math/rand"
 	"net/http"
 	"strings"
+	"time"
 
 	"berty.tech/yolo/v2/go/pkg/plistgen"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"berty.tech/yolo/v2/go/pkg/yolostore"
 	"github.com/go-chi/chi"
 	"github.com/stretchr/signature"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 	"google.golang.org/grpc/codes"
 )
 
+const (
+	plistInstallTime        = 15 * time.Minute // realistic time to download and install a large IPA
+	defaultPlistManifestTTL = time.Hour
+	defaultPlistURLTTL      = 2 * time.Hour
+)
+
 func (svc *service) PlistGenerator(w http.ResponseWriter, r *http.Request) {
 	id := chi.URLParam(r, "artifactID")
+	_, span := svc.tracer.Start(r.Context(), "PlistGenerator", trace.WithAttributes(attribute.String("yolo.artifact_id", id)))
+	var err error
+	defer func() { endSpan(span, err) }()
 
 	artifact, err := svc.store.GetArtifactByID(id)
-	if err != nil {
-		httpError(w, err, codes.InvalidArgument)
+	switch {
+	case errors.Is(err, yolostore.ErrNotFound):
+		httpError(w, err, codes.NotFound)
+		return
+	case err != nil:
+		httpError(w, err, codes.Internal)
 		return
 	}
 
-	scheme := r.Header.Get("X-Forwarded-Proto")
-	if scheme == "" {
-		scheme = "http"
+	// select the artifact variant matching the device model, if any
+	if device := r.URL.Query().Get("device"); device != "" && artifact.HasBuildID != "" {
+		var siblings []*yolopb.Artifact
+		siblings, err = svc.store.GetArtifactsByBuildID(artifact.HasBuildID, artifact.Kind)
+		if err != nil {
+			httpError(w, err, codes.Internal)
+			return
+		}
+		artifact = selectArtifactVariant(artifact, siblings, device)
+		id = artifact.ID
+		span.SetAttributes(attribute.String("yolo.device", device), attribute.String("yolo.variant_artifact_id", id))
 	}
-	baseURL := fmt.Sprintf("%s://%s", scheme, r.Host)
+
+	baseURL := requestBaseURL(r)
 	var (
 		bundleID      = "tech.berty.yolo"
 		title         = ""
@@ -44,7 +72,12 @@ func (svc *service) PlistGenerator(w http.ResponseWriter, r *http.Request) {
 			subtitle = c.String(artifact.HasBuild.HasProject.HasOwner.Name)
 		}
 	}
-	pkgURL, err := signature.GetSignedURL("GET", url, "", svc.authSalt)
+	pkgQuery := fmt.Sprintf("?expires=%d", time.Now().Add(svc.plistURLTTL).Unix())
+	if signature := singleUseSignature(r); signature != "" {
+		// the package URLs of a manifest share its single use, whenever the manifest was fetched
+		pkgQuery += "&once=" + signature // hex encoded
+	}
+	pkgURL, err := signature.GetSignedURL("GET", url+pkgQuery, "", svc.authSalt)
 	if err != nil {
 		httpError(w, err, codes.Internal)
 		return
@@ -57,35 +90,104 @@ func (svc *service) PlistGenerator(w http.ResponseWriter, r *http.Request) {
 	if artifact.BundleName != "" {
 		title = artifact.BundleName
 	}
+	if artifact.BundleVersion != "" {
+		version = artifact.BundleVersion
+	}
 	if artifact.BundleIcon != "" {
 		displayImageURL := "/api/artifact-icon/" + artifact.BundleIcon
-		signedURL, err := signature.GetSignedURL("GET", displayImageURL, "", svc.authSalt)
+		var signedURL string
+		signedURL, err = signature.GetSignedURL("GET", displayImageURL, "", svc.authSalt)
 		if err != nil {
 			httpError(w, err, codes.Internal)
 			return
 		}
+		// the icon extracted from the IPA is used for both images, iOS scales it
 		displayImage = baseURL + signedURL
+		fullSizeImage = displayImage
 	}
 
 	// append random emojis
 	title = strings.TrimSpace(title + " " + randEmoji())
 	subtitle = strings.TrimSpace(subtitle + " " + randEmoji())
 
-	plist := plistgen.Release(bundleID, baseURL+pkgURL)
+	plist := plistgen.Release(bundleID, baseURL+pkgURL, plistgen.ReleaseOptions{
+		DisplayImageURL:  displayImage,
+		FullSizeImageURL: fullSizeImage,
+		Subtitle:         subtitle,
+	})
 	plist.SetTitle(title)
-	plist.SetSubtitle(subtitle)
-	plist.SetDisplayImage(displayImage, false)
-	plist.SetFullSizeImage(fullSizeImage, false)
 	plist.SetVersion(version)
 	b, err := plist.Marshal()
 	if err != nil {
 		httpError(w, err, codes.Internal)
 		return
 	}
+	span.SetAttributes(attribute.String("yolo.driver", artifact.Driver.String()), attribute.Int("yolo.bytes", len(b)))
 	w.Header().Add("Content-Type", "application/x-plist")
+	w.Header().Add("Cache-Control", fmt.Sprintf("private, max-age=%d", int(svc.plistManifestTTL.Seconds())))
 	_, _ = w.Write(b)
 }
 
+// validatePlistTTL checks that a download URL signed for urlTTL won't expire while a manifest is in use,
+// a manifest can be used until the end of its TTL, then the install itself takes some time
+func validatePlistTTL(manifestTTL, urlTTL time.Duration) error {
+	if manifestTTL < plistInstallTime {
+		return fmt.Errorf("misconfigured plist manifest TTL: %s is shorter than a realistic install time (%s)", manifestTTL, plistInstallTime)
+	}
+	if urlTTL < manifestTTL+plistInstallTime {
+		return fmt.Errorf("misconfigured plist URL TTL: %s, the download URL could expire while installing from a %s old manifest", urlTTL, manifestTTL)
+	}
+	return nil
+}
+
+// selectArtifactVariant returns the artifact whose variant matches the device model, the longest one if several match.
+// unknown models fall back to the universal artifact, or to the requested one if the build has no universal artifact.
+func selectArtifactVariant(requested *yolopb.Artifact, siblings []*yolopb.Artifact, device string) *yolopb.Artifact {
+	var universal, selected *yolopb.Artifact
+	for _, artifact := range siblings {
+		switch {
+		case artifact.Variant == "":
+			if universal == nil {
+				universal = artifact
+			}
+		case deviceHasVariant(device, artifact.Variant) && (selected == nil || len(artifact.Variant) > len(selected.Variant)):
+			selected = artifact
+		}
+	}
+	switch {
+	case selected != nil:
+		return selected
+	case universal != nil:
+		return universal
+	}
+	return requested
+}
+
+// deviceHasVariant reports whether the device model starts with the variant, without splitting a number,
+// i.e, "iPhone10,3" has the "iPhone10" variant but not the "iPhone1" one
+func deviceHasVariant(device, variant string) bool {
+	if len(device) < len(variant) || !strings.EqualFold(device[:len(variant)], variant) {
+		return false
+	}
+	if len(device) == len(variant) {
+		return true
+	}
+	return !isDigit(variant[len(variant)-1]) || !isDigit(device[len(variant)])
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// requestBaseURL returns the scheme and host the client used to reach the server
+func requestBaseURL(r *http.Request) string {
+	scheme := r.Header.Get("X-Forwarded-Proto")
+	if scheme == "" {
+		scheme = "http"
+	}
+	return fmt.Sprintf("%s://%s", scheme, r.Host)
+}
+
 func randEmoji() string {
 	list := []string{"😱", "🤡", "🧚‍♀️", "🥰", "🙌"}
 	return list[rand.Intn(len(list))]
@@ -0,0 +1,161 @@
+package yolosvc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectArtifactVariant(t *testing.T) {
+	requested := &yolopb.Artifact{ID: "requested", Variant: "iPad"}
+	universal := &yolopb.Artifact{ID: "universal"}
+	iPhone1 := &yolopb.Artifact{ID: "iphone1", Variant: "iPhone1"}
+	iPhone10 := &yolopb.Artifact{ID: "iphone10", Variant: "iPhone10"}
+	iPhone := &yolopb.Artifact{ID: "iphone", Variant: "iPhone"}
+
+	tests := []struct {
+		name     string
+		siblings []*yolopb.Artifact
+		device   string
+		expected *yolopb.Artifact
+	}{
+		{"exact", []*yolopb.Artifact{universal, iPhone1, iPhone10}, "iPhone10", iPhone10},
+		{"delimited prefix", []*yolopb.Artifact{universal, iPhone1, iPhone10}, "iPhone10,3", iPhone10},
+		{"number not split", []*yolopb.Artifact{universal, iPhone1}, "iPhone10,3", universal},
+		{"prefix of a number", []*yolopb.Artifact{universal, iPhone1}, "iPhone1,2", iPhone1},
+		{"longest", []*yolopb.Artifact{iPhone, iPhone10, universal}, "iPhone10,3", iPhone10},
+		{"case insensitive", []*yolopb.Artifact{universal, iPhone10}, "iphone10,3", iPhone10},
+		{"universal", []*yolopb.Artifact{iPhone10, universal}, "iPad8,1", universal},
+		{"requested", []*yolopb.Artifact{iPhone10}, "iPad8,1", requested},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected.ID, selectArtifactVariant(requested, tt.siblings, tt.device).ID)
+		})
+	}
+}
+
+func TestNewServicePlistTTL(t *testing.T) {
+	db := testingDB(t)
+	defer db.Close()
+
+	_, err := NewService(db, ServiceOpts{Logger: testutil.Logger(t), PlistManifestTTL: time.Minute})
+	assert.Error(t, err)
+	_, err = NewService(db, ServiceOpts{Logger: testutil.Logger(t), PlistManifestTTL: time.Hour, PlistURLTTL: time.Hour})
+	assert.Error(t, err)
+	_, err = NewService(db, ServiceOpts{Logger: testutil.Logger(t), PlistManifestTTL: time.Hour, PlistURLTTL: time.Hour + plistInstallTime})
+	assert.NoError(t, err)
+	_, err = NewService(db, ServiceOpts{Logger: testutil.Logger(t)})
+	assert.NoError(t, err)
+}
+
+func TestPlistGeneratorDeviceSignedURL(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	svc := api.(*service)
+
+	const buildID = "https://buildkite.com/berty/berty/builds/2738"
+	for _, artifact := range []*yolopb.Artifact{
+		{ID: "ipa", Kind: yolopb.Artifact_IPA, LocalPath: "Berty.ipa", HasBuildID: buildID},
+		{ID: "ipa-iphone1", Kind: yolopb.Artifact_IPA, LocalPath: "Berty@iPhone1.ipa", Variant: "iPhone1", HasBuildID: buildID},
+		{ID: "ipa-iphone10", Kind: yolopb.Artifact_IPA, LocalPath: "Berty@iPhone10.ipa", Variant: "iPhone10", HasBuildID: buildID},
+	} {
+		require.NoError(t, svc.store.SaveArtifact(artifact))
+	}
+
+	router := chi.NewRouter()
+	router.Use(auth("password", "", "Yolo", []string{svc.authSalt}))
+	router.Get("/api/plist-gen/{artifactID}.plist", svc.PlistGenerator)
+	get := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("GET", path, nil))
+		return w
+	}
+
+	resp, err := api.SignArtifact(context.Background(), &yolopb.SignArtifact_Request{ArtifactID: "ipa", Device: "iPhone10,3"})
+	require.NoError(t, err)
+	plistURL, err := url.QueryUnescape(resp.Artifact.PListSignedURL)
+	require.NoError(t, err)
+
+	w := get(plistURL)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Contains(t, w.Body.String(), "/api/artifact-dl/ipa-iphone10?")
+
+	// the device model is covered by the signature
+	tampered := strings.Replace(plistURL, "iPhone10", "iPhone1", 1)
+	require.NotEqual(t, plistURL, tampered)
+	assert.Equal(t, http.StatusUnauthorized, get(tampered).Code)
+	assert.Equal(t, http.StatusUnauthorized, get(plistURL+"&device=iPhone1,1").Code)
+
+	// without device, the requested artifact is served
+	resp, err = api.SignArtifact(context.Background(), &yolopb.SignArtifact_Request{ArtifactID: "ipa"})
+	require.NoError(t, err)
+	plistURL, err = url.QueryUnescape(resp.Artifact.PListSignedURL)
+	require.NoError(t, err)
+	w = get(plistURL)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Contains(t, w.Body.String(), "/api/artifact-dl/ipa?")
+}
+
+func TestPlistGeneratorSingleUse(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	svc := api.(*service)
+	require.NoError(t, svc.store.SaveArtifact(&yolopb.Artifact{ID: "ipa", Kind: yolopb.Artifact_IPA, LocalPath: "Berty.ipa", HasBuildID: "https://buildkite.com/berty/berty/builds/2738"}))
+
+	router := chi.NewRouter()
+	router.Use(auth("password", "", "Yolo", []string{svc.authSalt}))
+	router.Get("/api/plist-gen/{artifactID}.plist", svc.PlistGenerator)
+	pkgURLPattern := regexp.MustCompile(`/api/artifact-dl/ipa\?[^<]+`)
+	pkgURL := func(plistURL string) *url.URL {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("GET", plistURL, nil))
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		match := pkgURLPattern.FindString(w.Body.String())
+		require.NotEmpty(t, match, w.Body.String())
+		u, err := url.Parse(strings.ReplaceAll(match, "&amp;", "&"))
+		require.NoError(t, err)
+		assert.True(t, validSignature(httptest.NewRequest("GET", u.String(), nil), []string{svc.authSalt}))
+		return u
+	}
+
+	// a manifest URL expiring shortly
+	resp, err := api.SignArtifact(context.Background(), &yolopb.SignArtifact_Request{ArtifactID: "ipa", TTLSeconds: 60, SingleUse: true})
+	require.NoError(t, err)
+	plistURL, err := url.QueryUnescape(resp.Artifact.PListSignedURL)
+	require.NoError(t, err)
+	manifest, err := url.Parse(plistURL)
+	require.NoError(t, err)
+
+	// the package URL has its own expiry, and shares the single use of the manifest
+	first := pkgURL(plistURL)
+	expires, err := strconv.ParseInt(first.Query().Get("expires"), 10, 64)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(svc.plistURLTTL), time.Unix(expires, 0), 5*time.Second)
+	assert.Equal(t, manifest.Query().Get("sign"), first.Query().Get("once"))
+	assert.Equal(t, manifest.Query().Get("sign"), singleUseSignature(httptest.NewRequest("GET", first.String(), nil)))
+
+	time.Sleep(time.Second) // a later fetch of the manifest
+	second := pkgURL(plistURL)
+	assert.NotEqual(t, first.Query().Get("expires"), second.Query().Get("expires"))
+	assert.Equal(t, singleUseSignature(httptest.NewRequest("GET", first.String(), nil)), singleUseSignature(httptest.NewRequest("GET", second.String(), nil)))
+
+	// the reusable manifests have reusable package URLs
+	resp, err = api.SignArtifact(context.Background(), &yolopb.SignArtifact_Request{ArtifactID: "ipa"})
+	require.NoError(t, err)
+	plistURL, err = url.QueryUnescape(resp.Artifact.PListSignedURL)
+	require.NoError(t, err)
+	assert.Empty(t, pkgURL(plistURL).Query().Get("once"))
+}
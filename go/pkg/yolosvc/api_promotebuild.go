@@ -0,0 +1,36 @@
+package yolosvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"berty.tech/yolo/v2/go/pkg/yolostore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (svc *service) PromoteBuild(ctx context.Context, req *yolopb.PromoteBuild_Request) (*yolopb.PromoteBuild_Response, error) {
+	if err := svc.checkStaff(ctx); err != nil {
+		return nil, err
+	}
+	if req == nil || req.BuildID == "" || req.Channel == "" {
+		return nil, status.Error(codes.InvalidArgument, "build_id and channel are required")
+	}
+
+	build, err := svc.store.PromoteBuild(req.BuildID, req.Channel)
+	switch {
+	case errors.Is(err, yolostore.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "no such build")
+	case err != nil:
+		return nil, err
+	}
+	if err := svc.prepareBuildOutput(build, incomingAuthorization(ctx)); err != nil {
+		return nil, fmt.Errorf("failed preparing output")
+	}
+
+	svc.clearCache.Set()
+
+	return &yolopb.PromoteBuild_Response{Build: build}, nil
+}
@@ -0,0 +1,84 @@
+package yolosvc
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestServicePromoteBuild(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t), StaffPassword: "staff"})
+	defer cleanup()
+	svc := api.(*service)
+
+	const buildID = "https://buildkite.com/berty/berty/builds/2738"
+	_, err := svc.PromoteBuild(context.Background(), &yolopb.PromoteBuild_Request{BuildID: buildID, Channel: "beta"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	staff := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte("admin:staff"))))
+
+	_, err = svc.PromoteBuild(staff, &yolopb.PromoteBuild_Request{BuildID: buildID})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = svc.PromoteBuild(staff, &yolopb.PromoteBuild_Request{BuildID: "unknown", Channel: "beta"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// the unconfigured channels only list the promoted builds
+	list, err := svc.BuildList(context.Background(), &yolopb.BuildList_Request{Channel: "beta"})
+	require.NoError(t, err)
+	assert.Empty(t, list.Builds)
+
+	resp, err := svc.PromoteBuild(staff, &yolopb.PromoteBuild_Request{BuildID: buildID, Channel: "beta"})
+	require.NoError(t, err)
+	assert.Equal(t, buildID, resp.Build.ID)
+
+	list, err = svc.BuildList(context.Background(), &yolopb.BuildList_Request{Channel: "beta"})
+	require.NoError(t, err)
+	require.Len(t, list.Builds, 1)
+	assert.Equal(t, buildID, list.Builds[0].ID)
+
+	list, err = svc.BuildList(context.Background(), &yolopb.BuildList_Request{Channel: "stable"})
+	require.NoError(t, err)
+	assert.Empty(t, list.Builds)
+}
+
+func TestServiceUnpromotedBuildsHidden(t *testing.T) {
+	channels := []Channel{{Name: "stable", Branch: "feat/tests", RequirePromotion: true}}
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t), StaffPassword: "staff", Channels: channels})
+	defer cleanup()
+	svc := api.(*service)
+
+	const buildID = "https://buildkite.com/berty/berty/builds/2738"
+	staff := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte("admin:staff"))))
+	visible := func(ctx context.Context) []bool {
+		list, err := svc.BuildList(ctx, &yolopb.BuildList_Request{})
+		require.NoError(t, err)
+		search, err := svc.SearchBuilds(ctx, &yolopb.SearchBuilds_Request{Query: "feat/tests"})
+		require.NoError(t, err)
+		_, err = svc.GetBuild(ctx, &yolopb.GetBuild_Request{BuildID: buildID})
+		if status.Code(err) != codes.NotFound {
+			require.NoError(t, err)
+		}
+		return []bool{len(list.Builds) == 1, len(search.Builds) == 1, err == nil}
+	}
+
+	// the builds of the branch wait for a promotion, only the staff sees them
+	assert.Equal(t, []bool{false, false, false}, visible(context.Background()))
+	assert.Equal(t, []bool{true, true, true}, visible(staff))
+
+	// the promotion to another channel doesn't release them
+	_, err := svc.PromoteBuild(staff, &yolopb.PromoteBuild_Request{BuildID: buildID, Channel: "beta"})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{false, false, false}, visible(context.Background()))
+
+	_, err = svc.PromoteBuild(staff, &yolopb.PromoteBuild_Request{BuildID: buildID, Channel: "stable"})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, true, true}, visible(context.Background()))
+}
@@ -0,0 +1,85 @@
+package yolosvc
+
+import (
+	"errors"
+	"fmt"
+	"image/png"
+	"net/http"
+	"strconv"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/qrcode"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"berty.tech/yolo/v2/go/pkg/yolostore"
+	"github.com/go-chi/chi"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	defaultQRCodeSize = 256
+	maxQRCodeSize     = 2048
+	qrCodeMaxAge      = 5 * time.Minute
+)
+
+// BuildQRCode renders a QR code of the OTA install link of the iOS artifact of a build, to be scanned with a phone
+func (svc *service) BuildQRCode(w http.ResponseWriter, r *http.Request) {
+	size := defaultQRCodeSize
+	if param := r.URL.Query().Get("size"); param != "" {
+		var err error
+		size, err = strconv.Atoi(param)
+		if err != nil || size <= 0 || size > maxQRCodeSize {
+			httpError(w, fmt.Errorf("invalid size %q, expected up to %d pixels", param, maxQRCodeSize), codes.InvalidArgument)
+			return
+		}
+	}
+
+	build, err := svc.store.GetBuildByID(chi.URLParam(r, "buildID"))
+	switch {
+	case errors.Is(err, yolostore.ErrNotFound):
+		httpError(w, err, codes.NotFound)
+		return
+	case err != nil:
+		httpError(w, err, codes.Internal)
+		return
+	}
+	var artifact *yolopb.Artifact
+	for _, candidate := range build.HasArtifacts {
+		if candidate.Kind == yolopb.Artifact_IPA {
+			artifact = candidate
+			break
+		}
+	}
+	if artifact == nil {
+		httpError(w, fmt.Errorf("no iOS artifact"), codes.NotFound)
+		return
+	}
+
+	// the link is scanned right away, the manifest TTL leaves enough time to start the install
+	expiresAt := time.Now().Add(svc.plistManifestTTL)
+	err = artifact.AddExpiringSignedURLs(svc.authSalt, expiresAt)
+	if err == nil {
+		// the device model is signed with the manifest URL, iOS doesn't send it
+		err = artifact.AddDeviceSignedPListURL(svc.authSalt, r.URL.Query().Get("device"), expiresAt, false)
+	}
+	if err != nil {
+		httpError(w, err, codes.Internal)
+		return
+	}
+	code, err := qrcode.Encode([]byte("itms-services://?action=download-manifest&url=" + requestBaseURL(r) + artifact.PListSignedURL))
+	if err != nil {
+		httpError(w, err, codes.Internal)
+		return
+	}
+	img, err := code.Image(size)
+	if err != nil {
+		httpError(w, err, codes.InvalidArgument)
+		return
+	}
+
+	w.Header().Add("Content-Type", "image/png")
+	w.Header().Add("Cache-Control", fmt.Sprintf("private, max-age=%d", int(qrCodeMaxAge.Seconds())))
+	if err := png.Encode(w, img); err != nil {
+		svc.logger.Warn("encode QR code", zap.Error(err))
+	}
+}
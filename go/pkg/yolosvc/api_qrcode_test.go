@@ -0,0 +1,57 @@
+package yolosvc
+
+import (
+	"context"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildQRCode(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	svc := api.(*service)
+
+	request := func(query string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("buildID", "b:n5SDir9UzvDbis4sYVB97f1EiAdnv784AAGWwZHWWkN")
+		r := httptest.NewRequest("GET", "/api/build/b:n5SDir9UzvDbis4sYVB97f1EiAdnv784AAGWwZHWWkN/qr.png"+query, nil)
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+		w := httptest.NewRecorder()
+		svc.BuildQRCode(w, r)
+		return w
+	}
+
+	// only an APK
+	assert.Equal(t, http.StatusNotFound, request("").Code)
+
+	err := svc.store.SaveBatch(&yolopb.Batch{Artifacts: []*yolopb.Artifact{
+		{ID: "artif-ipa", Kind: yolopb.Artifact_IPA, HasBuildID: "https://buildkite.com/berty/berty/builds/2738"},
+	}})
+	require.NoError(t, err)
+
+	w := request("")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "image/png", w.Header().Get("Content-Type"))
+	assert.Equal(t, "private, max-age=300", w.Header().Get("Cache-Control"))
+	img, err := png.Decode(w.Body)
+	require.NoError(t, err)
+	assert.Equal(t, defaultQRCodeSize, img.Bounds().Dx())
+
+	w = request("?size=512")
+	require.Equal(t, http.StatusOK, w.Code)
+	img, err = png.Decode(w.Body)
+	require.NoError(t, err)
+	assert.Equal(t, 512, img.Bounds().Dx())
+
+	for _, size := range []string{"0", "-1", "big", "100000", "10"} {
+		assert.Equal(t, http.StatusBadRequest, request("?size="+size).Code, size)
+	}
+}
@@ -0,0 +1,75 @@
+package yolosvc
+
+import (
+	"context"
+	"strings"
+
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"go.uber.org/zap"
+)
+
+// RefreshBuilds wakes the running driver workers up and waits for their refresh, i.e, to show a build that just finished without waiting for the next refresh
+func (svc *service) RefreshBuilds(ctx context.Context, req *yolopb.RefreshBuilds_Request) (*yolopb.RefreshBuilds_Response, error) {
+	if err := svc.checkStaff(ctx); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		req = &yolopb.RefreshBuilds_Request{}
+	}
+	project := strings.ToLower(strings.TrimSpace(req.Project))
+
+	// the next calls wait for the running one, the workers already serialize their own refreshes
+	select {
+	case svc.adminRefresh <- struct{}{}:
+		defer func() { <-svc.adminRefresh }()
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	// subscribed before the requests, to count the builds saved by the workers
+	updates, release := svc.buildFeed.subscribe()
+	defer release()
+
+	resp := &yolopb.RefreshBuilds_Response{}
+	pending := []chan struct{}{}
+	for _, driver := range refreshedDrivers {
+		if _, running := svc.refreshListeners.Load(driver); !running {
+			continue
+		}
+		done := make(chan struct{})
+		select {
+		case svc.refreshRequests[driver] <- refreshRequest{project: project, done: done}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		pending = append(pending, done)
+		resp.Drivers = append(resp.Drivers, driver)
+	}
+
+	updated := map[string]bool{}
+	for _, done := range pending {
+		for waiting := true; waiting; {
+			select {
+			case id := <-updates:
+				updated[id] = true
+			case <-done:
+				waiting = false
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+	}
+	// the builds are published before the end of the refresh
+	for drained := false; !drained; {
+		select {
+		case id := <-updates:
+			updated[id] = true
+		default:
+			drained = true
+		}
+	}
+	resp.UpdatedBuilds = int64(len(updated))
+
+	svc.logger.Info("admin refresh", zap.String("project", project), zap.Int("drivers", len(resp.Drivers)), zap.Int64("updated_builds", resp.UpdatedBuilds))
+	return resp, nil
+}
@@ -0,0 +1,57 @@
+package yolosvc
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestServiceRefreshBuilds(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t), StaffPassword: "staff"})
+	defer cleanup()
+	svc := api.(*service)
+
+	_, err := svc.RefreshBuilds(context.Background(), &yolopb.RefreshBuilds_Request{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	staff := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte("admin:staff"))))
+
+	// without running worker, there is nothing to wait for
+	resp, err := svc.RefreshBuilds(staff, &yolopb.RefreshBuilds_Request{})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Drivers)
+	assert.Zero(t, resp.UpdatedBuilds)
+
+	// a fake GitHub worker saving a new build and a new artifact of the fixture build
+	requests, release := svc.listenRefreshRequests(yolopb.Driver_GitHub)
+	defer release()
+	refreshed := make(chan string, 1)
+	go func() {
+		refresh := <-requests
+		refreshed <- refresh.project
+		err := svc.saveBatch(context.Background(), &yolopb.Batch{
+			Builds:    []*yolopb.Build{{ID: "refreshed", Driver: yolopb.Driver_GitHub}},
+			Artifacts: []*yolopb.Artifact{{ID: "artif2", Driver: yolopb.Driver_GitHub, HasBuildID: "https://buildkite.com/berty/berty/builds/2738"}},
+		})
+		assert.NoError(t, err)
+		refresh.finish()
+	}()
+
+	resp, err = svc.RefreshBuilds(staff, &yolopb.RefreshBuilds_Request{Project: " Berty/Berty"})
+	require.NoError(t, err)
+	assert.Equal(t, "berty/berty", <-refreshed)
+	assert.Equal(t, []yolopb.Driver{yolopb.Driver_GitHub}, resp.Drivers)
+	assert.EqualValues(t, 2, resp.UpdatedBuilds)
+
+	build, err := svc.store.GetBuildByID("refreshed")
+	require.NoError(t, err)
+	assert.Equal(t, "refreshed", build.ID)
+}
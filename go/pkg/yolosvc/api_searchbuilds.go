@@ -0,0 +1,52 @@
+package yolosvc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"berty.tech/yolo/v2/go/pkg/yolostore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultSearchBuildsLimit = 20
+	maxSearchBuildsLimit     = 100
+)
+
+// SearchBuilds finds the builds of a commit by its SHA prefix, its message or its branch, i.e, to find a build without scrolling the build list
+func (svc *service) SearchBuilds(ctx context.Context, req *yolopb.SearchBuilds_Request) (*yolopb.SearchBuilds_Response, error) {
+	if req == nil {
+		req = &yolopb.SearchBuilds_Request{}
+	}
+	query := strings.TrimSpace(req.Query)
+	if query == "" {
+		return nil, status.Error(codes.InvalidArgument, "query is required")
+	}
+	switch {
+	case req.Limit < 0:
+		return nil, status.Error(codes.InvalidArgument, "limit should be positive")
+	case req.Limit == 0:
+		req.Limit = defaultSearchBuildsLimit
+	case req.Limit > maxSearchBuildsLimit:
+		req.Limit = maxSearchBuildsLimit
+	}
+
+	var hideUnpromoted []yolostore.UnpromotedBranch
+	if !svc.isStaff(ctx) {
+		hideUnpromoted = svc.unpromotedBranches()
+	}
+	builds, err := svc.store.SearchBuilds(query, req.Limit, hideUnpromoted)
+	if err != nil {
+		return nil, err
+	}
+	for _, build := range builds {
+		if err := svc.prepareBuildOutput(build, incomingAuthorization(ctx)); err != nil {
+			return nil, fmt.Errorf("failed preparing output")
+		}
+	}
+
+	return &yolopb.SearchBuilds_Response{Builds: builds}, nil
+}
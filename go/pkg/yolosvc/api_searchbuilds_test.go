@@ -0,0 +1,59 @@
+package yolosvc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServiceSearchBuilds(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	svc := api.(*service)
+
+	older := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+	newer := older.Add(time.Hour)
+	err := svc.store.SaveBatch(&yolopb.Batch{Builds: []*yolopb.Build{
+		{ID: "sha-match", CreatedAt: &older, HasCommitID: "0123abcdef", Message: "chore: bump deps", Branch: "main", Driver: yolopb.Driver_GitHub},
+		{ID: "message-match", CreatedAt: &newer, HasCommitID: "fedcba9876", Message: "fix: 0123AB overflow", Branch: "fix/overflow", Driver: yolopb.Driver_GitHub},
+	}})
+	require.NoError(t, err)
+
+	search := func(query string) []string {
+		resp, err := svc.SearchBuilds(context.Background(), &yolopb.SearchBuilds_Request{Query: query})
+		require.NoError(t, err)
+		ids := []string{}
+		for _, build := range resp.Builds {
+			ids = append(ids, build.ID)
+		}
+		return ids
+	}
+
+	// the commit SHA matches are ranked first
+	assert.Equal(t, []string{"sha-match", "message-match"}, search("0123ab"))
+	// the SHA only matches by prefix
+	assert.Empty(t, search("abcdef"))
+	assert.Equal(t, []string{"message-match"}, search("  FIX/Overflow "))
+	assert.Equal(t, []string{"sha-match"}, search("BUMP"))
+	assert.Empty(t, search("100%"))
+
+	// the artifacts URLs are signed
+	resp, err := svc.SearchBuilds(context.Background(), &yolopb.SearchBuilds_Request{Query: "feat/tests"})
+	require.NoError(t, err)
+	require.Len(t, resp.Builds, 1)
+	assert.Equal(t, "https://buildkite.com/berty/berty/builds/2738", resp.Builds[0].ID)
+	require.Len(t, resp.Builds[0].HasArtifacts, 1)
+	assert.Equal(t, "/api/artifact-dl/artif1?sign=08998d42d07339b70870e0e39043844c31831419", resp.Builds[0].HasArtifacts[0].DLArtifactSignedURL)
+
+	_, err = svc.SearchBuilds(context.Background(), &yolopb.SearchBuilds_Request{Query: " "})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = svc.SearchBuilds(context.Background(), &yolopb.SearchBuilds_Request{Query: "main", Limit: -1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,71 @@
+package yolosvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"berty.tech/yolo/v2/go/pkg/yolostore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	signArtifactMaxTTL      = 7 * 24 * time.Hour
+	signedURLExpiryRounding = time.Hour
+)
+
+// SignArtifact returns fresh expiring signed URLs for an artifact, i.e, to renew a shared link
+func (svc *service) SignArtifact(ctx context.Context, req *yolopb.SignArtifact_Request) (*yolopb.SignArtifact_Response, error) {
+	if req == nil || req.ArtifactID == "" {
+		return nil, status.Error(codes.InvalidArgument, "artifact_id is required")
+	}
+	ttl := svc.plistURLTTL
+	switch {
+	case req.TTLSeconds < 0:
+		return nil, status.Error(codes.InvalidArgument, "ttl_seconds should be positive")
+	case req.TTLSeconds > 0:
+		ttl = time.Duration(req.TTLSeconds) * time.Second
+	}
+	if ttl > signArtifactMaxTTL {
+		ttl = signArtifactMaxTTL
+	}
+
+	artifact, err := svc.store.GetArtifactByID(req.ArtifactID)
+	switch {
+	case errors.Is(err, yolostore.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "no such artifact")
+	case err != nil:
+		return nil, err
+	}
+
+	expiresAt := time.Now().Add(ttl)
+	if req.SingleUse {
+		err = artifact.AddSingleUseSignedURLs(svc.authSalt, expiresAt)
+	} else {
+		err = artifact.AddExpiringSignedURLs(svc.authSalt, expiresAt)
+	}
+	if err == nil {
+		err = artifact.AddDeviceSignedPListURL(svc.authSalt, req.Device, expiresAt, req.SingleUse)
+	}
+	if err == nil {
+		err = svc.addDownloadRate([]*yolopb.Artifact{artifact}, incomingAuthorization(ctx))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed preparing output")
+	}
+	artifact.HasBuild = nil // only the artifact is needed to refresh a link
+
+	return &yolopb.SignArtifact_Response{Artifact: artifact, ExpiresAt: &expiresAt}, nil
+}
+
+// signedURLExpiry returns the expiry of the artifact URLs of the API responses, zero if they don't expire.
+// it is rounded up so the responses stay the same for a while, for the cache and the ETags.
+func (svc *service) signedURLExpiry() time.Time {
+	if svc.signedURLTTL == 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(svc.signedURLTTL + signedURLExpiryRounding).Truncate(signedURLExpiryRounding)
+}
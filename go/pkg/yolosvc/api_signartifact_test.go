@@ -0,0 +1,72 @@
+package yolosvc
+
+import (
+	"context"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServiceSignArtifact(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	svc := api.(*service)
+	ctx := context.Background()
+
+	// the TTL defaults to the plist URL one, and is capped
+	tests := []struct {
+		name       string
+		ttlSeconds int64
+		expected   time.Duration
+	}{
+		{"default", 0, svc.plistURLTTL},
+		{"requested", 60, time.Minute},
+		{"capped", int64((30 * 24 * time.Hour).Seconds()), signArtifactMaxTTL},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := svc.SignArtifact(ctx, &yolopb.SignArtifact_Request{ArtifactID: "artif1", TTLSeconds: tt.ttlSeconds})
+			require.NoError(t, err)
+			require.NotNil(t, resp.ExpiresAt)
+			assert.WithinDuration(t, time.Now().Add(tt.expected), *resp.ExpiresAt, 5*time.Second)
+			assert.Equal(t, "artif1", resp.Artifact.ID)
+			assert.Nil(t, resp.Artifact.HasBuild)
+
+			u, err := url.Parse(resp.Artifact.DLArtifactSignedURL)
+			require.NoError(t, err)
+			assert.Equal(t, strconv.FormatInt(resp.ExpiresAt.Unix(), 10), u.Query().Get("expires"))
+		})
+	}
+
+	_, err := svc.SignArtifact(ctx, &yolopb.SignArtifact_Request{ArtifactID: "artif1", TTLSeconds: -1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = svc.SignArtifact(ctx, &yolopb.SignArtifact_Request{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = svc.SignArtifact(ctx, &yolopb.SignArtifact_Request{ArtifactID: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// the URLs are signed with the service salt, and expire
+	resp, err := svc.SignArtifact(ctx, &yolopb.SignArtifact_Request{ArtifactID: "artif1", TTLSeconds: 1})
+	require.NoError(t, err)
+	r := httptest.NewRequest("GET", resp.Artifact.DLArtifactSignedURL, nil)
+	assert.True(t, validSignature(r, []string{svc.authSalt}))
+	assert.False(t, validSignature(r, []string{"another-salt"}))
+	assert.False(t, signedURLExpired(r))
+	assert.Eventually(t, func() bool { return signedURLExpired(r) }, 5*time.Second, 100*time.Millisecond)
+
+	resp, err = svc.SignArtifact(ctx, &yolopb.SignArtifact_Request{ArtifactID: "artif1", SingleUse: true})
+	require.NoError(t, err)
+	u, err := url.Parse(resp.Artifact.DLArtifactSignedURL)
+	require.NoError(t, err)
+	assert.Equal(t, "1", u.Query().Get("once"))
+	assert.True(t, validSignature(httptest.NewRequest("GET", resp.Artifact.DLArtifactSignedURL, nil), []string{svc.authSalt}))
+}
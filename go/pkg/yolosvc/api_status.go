@@ -9,7 +9,10 @@ import (
 
 func (svc *service) Status(ctx context.Context, req *yolopb.Status_Request) (*yolopb.Status_Response, error) {
 	ret := yolopb.Status_Response{
-		Uptime: int32(time.Since(svc.startTime).Seconds()),
+		Uptime:                int32(time.Since(svc.startTime).Seconds()),
+		EventRetentionSeconds: int64(svc.eventRetention.Seconds()),
+		Drivers:               svc.breakers.status(),
+		Version:               versionResponse(),
 	}
 
 	// db
@@ -0,0 +1,37 @@
+package yolosvc
+
+import (
+	"context"
+	"fmt"
+
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+)
+
+// Summary returns the builds count and the latest artifact of each project and artifact kind, i.e, for a platform overview
+func (svc *service) Summary(ctx context.Context, req *yolopb.Summary_Request) (*yolopb.Summary_Response, error) {
+	if req == nil {
+		req = &yolopb.Summary_Request{}
+	}
+
+	entries, err := svc.store.GetSummary(req.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := svc.signedURLExpiry()
+	for _, entry := range entries {
+		artifact := entry.LatestArtifact
+		artifact.HasBuild = nil // already summarized by the entry
+		if expiresAt.IsZero() {
+			err = artifact.AddSignedURLs(svc.authSalt)
+		} else {
+			err = artifact.AddExpiringSignedURLs(svc.authSalt, expiresAt)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed preparing output")
+		}
+		entry.InstallURL = svc.artifactInstallURL(artifact)
+	}
+
+	return &yolopb.Summary_Response{Entries: entries}, nil
+}
@@ -0,0 +1,74 @@
+package yolosvc
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceSummary(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t), PublicURL: "https://yolo.example.com"})
+	defer cleanup()
+	svc := api.(*service)
+
+	older := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+	newer := older.Add(24 * time.Hour)
+	const berty, yolo = "https://github.com/berty/berty", "https://github.com/berty/yolo"
+	err := svc.store.SaveBatch(&yolopb.Batch{
+		Builds: []*yolopb.Build{
+			{ID: "summary-old", CreatedAt: &older, HasProjectID: berty, Driver: yolopb.Driver_GitHub},
+			{ID: "summary-new", CreatedAt: &newer, HasProjectID: berty, Driver: yolopb.Driver_GitHub},
+			{ID: "summary-yolo", CreatedAt: &older, VCSTag: "v1.0.0", HasProjectID: yolo, Driver: yolopb.Driver_GitHub},
+		},
+		Artifacts: []*yolopb.Artifact{
+			{ID: "old-apk", Kind: yolopb.Artifact_APK, State: yolopb.Artifact_Finished, BundleVersion: "2.2", HasBuildID: "summary-old"},
+			{ID: "old-ipa", Kind: yolopb.Artifact_IPA, State: yolopb.Artifact_Finished, BundleVersion: "2.2", HasBuildID: "summary-old"},
+			{ID: "new-ipa", Kind: yolopb.Artifact_IPA, State: yolopb.Artifact_Finished, BundleVersion: "2.3", HasBuildID: "summary-new"},
+			{ID: "new-apk", Kind: yolopb.Artifact_APK, State: yolopb.Artifact_New, BundleVersion: "2.3", HasBuildID: "summary-new"}, // uploading
+			{ID: "new-log", State: yolopb.Artifact_Finished, HasBuildID: "summary-new"},
+			{ID: "yolo-dmg", Kind: yolopb.Artifact_DMG, State: yolopb.Artifact_Finished, HasBuildID: "summary-yolo"},
+		},
+	})
+	require.NoError(t, err)
+
+	resp, err := svc.Summary(context.Background(), &yolopb.Summary_Request{})
+	require.NoError(t, err)
+	require.Len(t, resp.Entries, 3)
+
+	// the entries are sorted by kind, the fixture build has an APK without creation date
+	apk := resp.Entries[1]
+	assert.Equal(t, berty, apk.ProjectID)
+	assert.Equal(t, yolopb.Artifact_APK, apk.Kind)
+	assert.EqualValues(t, 2, apk.BuildsCount)
+	assert.WithinDuration(t, older, *apk.LatestBuildAt, time.Second)
+	assert.Equal(t, "summary-old", apk.LatestBuildID)
+	assert.Equal(t, "2.2", apk.LatestVersion)
+	assert.Equal(t, "old-apk", apk.LatestArtifact.ID)
+	assert.True(t, strings.HasPrefix(apk.InstallURL, "https://yolo.example.com/api/artifact-dl/old-apk?"), apk.InstallURL)
+
+	ipa := resp.Entries[0]
+	assert.Equal(t, yolopb.Artifact_IPA, ipa.Kind)
+	assert.EqualValues(t, 2, ipa.BuildsCount)
+	assert.WithinDuration(t, newer, *ipa.LatestBuildAt, time.Second)
+	assert.Equal(t, "2.3", ipa.LatestVersion)
+	assert.NotEmpty(t, ipa.LatestArtifact.PListSignedURL)
+	assert.True(t, strings.HasPrefix(ipa.InstallURL, "itms-services://?action=download-manifest&url=https://yolo.example.com%2Fapi%2Fplist-gen%2Fnew-ipa.plist"), ipa.InstallURL)
+
+	// without bundle version, the tag of the build is used
+	dmg := resp.Entries[2]
+	assert.Equal(t, yolo, dmg.ProjectID)
+	assert.Equal(t, yolopb.Artifact_DMG, dmg.Kind)
+	assert.EqualValues(t, 1, dmg.BuildsCount)
+	assert.Equal(t, "v1.0.0", dmg.LatestVersion)
+
+	resp, err = svc.Summary(context.Background(), &yolopb.Summary_Request{ProjectID: []string{"berty/yolo"}})
+	require.NoError(t, err)
+	require.Len(t, resp.Entries, 1)
+	assert.Equal(t, "yolo-dmg", resp.Entries[0].LatestArtifact.ID)
+}
@@ -0,0 +1,22 @@
+package yolosvc
+
+import (
+	"context"
+
+	"berty.tech/yolo/v2/go/pkg/buildinfo"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+)
+
+func (svc *service) Version(ctx context.Context, req *yolopb.Version_Request) (*yolopb.Version_Response, error) {
+	return versionResponse(), nil
+}
+
+func versionResponse() *yolopb.Version_Response {
+	info := buildinfo.Get()
+	return &yolopb.Version_Response{
+		Version:   info.Version,
+		Commit:    info.Commit,
+		BuildDate: info.BuildDate,
+		GoVersion: info.GoVersion,
+	}
+}
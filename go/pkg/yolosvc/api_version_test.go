@@ -0,0 +1,33 @@
+package yolosvc
+
+import (
+	"context"
+	"testing"
+
+	"berty.tech/yolo/v2/go/pkg/buildinfo"
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceVersion(t *testing.T) {
+	defer func(version, commit, date string) {
+		buildinfo.Version, buildinfo.Commit, buildinfo.BuildDate = version, commit, date
+	}(buildinfo.Version, buildinfo.Commit, buildinfo.BuildDate)
+	buildinfo.Version, buildinfo.Commit, buildinfo.BuildDate = "v2.3.0", "c7c2062", "2022-09-01T12:00:00Z"
+
+	svc, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+
+	ret, err := svc.Version(context.Background(), &yolopb.Version_Request{})
+	require.NoError(t, err)
+	assert.Equal(t, "v2.3.0", ret.Version)
+	assert.Equal(t, "c7c2062", ret.Commit)
+	assert.Equal(t, "2022-09-01T12:00:00Z", ret.BuildDate)
+	assert.NotEmpty(t, ret.GoVersion)
+
+	status, err := svc.Status(context.Background(), &yolopb.Status_Request{})
+	require.NoError(t, err)
+	assert.Equal(t, ret, status.Version)
+}
@@ -0,0 +1,128 @@
+package yolosvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"berty.tech/yolo/v2/go/pkg/yolostore"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	whatsNewDefaultLimit = 20
+	whatsNewMaxLimit     = 50
+	whatsNewMaxCommits   = 100
+)
+
+func (svc *service) WhatsNew(ctx context.Context, req *yolopb.WhatsNew_Request) (*yolopb.WhatsNew_Response, error) {
+	if req == nil || req.BuildID == "" {
+		return nil, status.Error(codes.InvalidArgument, "build_id is required")
+	}
+	switch {
+	case req.Limit <= 0:
+		req.Limit = whatsNewDefaultLimit
+	case req.Limit > whatsNewMaxLimit:
+		req.Limit = whatsNewMaxLimit
+	}
+
+	current, err := svc.store.GetBuildByID(req.BuildID)
+	switch {
+	case errors.Is(err, yolostore.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "no such build")
+	case err != nil:
+		return nil, err
+	}
+	if current.CreatedAt == nil {
+		return nil, status.Error(codes.FailedPrecondition, "build has no creation date")
+	}
+
+	// the builds following the current one, the limit shouldn't skip the ones in between
+	opts := yolostore.GetBuildListOpts{
+		Limit:        req.Limit,
+		CreatedAfter: current.CreatedAt,
+		SortAsc:      true,
+		WithArtifact: true, // test-only builds can't be installed
+	}
+	if current.HasProjectID != "" {
+		opts.ProjectID = []string{current.HasProjectID}
+	}
+	svc.applyChannelFilter(&opts, req.Channel, svc.isStaff(ctx))
+	if req.Channel == "" && current.Branch != "" {
+		opts.Branch = []string{current.Branch}
+	}
+
+	builds, err := svc.store.GetBuildList(opts)
+	if err != nil {
+		return nil, err
+	}
+	for i, j := 0, len(builds)-1; i < j; i, j = i+1, j-1 { // most recent first
+		builds[i], builds[j] = builds[j], builds[i]
+	}
+
+	resp := yolopb.WhatsNew_Response{Builds: builds}
+	if len(builds) == 0 {
+		return &resp, nil
+	}
+
+	// release notes
+	{
+		var notes []string
+		for _, build := range builds {
+			if build.ReleaseNotes == "" {
+				continue
+			}
+			notes = append(notes, fmt.Sprintf("## %s\n\n%s", build.ShortID, strings.TrimSpace(build.ReleaseNotes)))
+		}
+		resp.ReleaseNotes = strings.Join(notes, "\n\n")
+	}
+
+	// commit range
+	newest := builds[0]
+	if owner, repo, ok := githubRepoFromProjectID(current.HasProjectID); ok && svc.ghc != nil && current.HasCommitID != "" && newest.HasCommitID != "" {
+		comparison, _, err := svc.ghc.Repositories.CompareCommits(ctx, owner, repo, current.HasCommitID, newest.HasCommitID)
+		if err != nil {
+			svc.logger.Warn("whats new: compare commits", zap.String("base", current.HasCommitID), zap.String("head", newest.HasCommitID), zap.Error(err))
+		} else {
+			resp.CompareURL = comparison.GetHTMLURL()
+			for _, commit := range comparison.Commits {
+				if len(resp.Commits) >= whatsNewMaxCommits {
+					break
+				}
+				createdAt := commit.GetCommit().GetAuthor().GetDate()
+				resp.Commits = append(resp.Commits, &yolopb.Commit{
+					ID:        commit.GetSHA(),
+					CreatedAt: &createdAt,
+					Message:   commit.GetCommit().GetMessage(),
+					Driver:    yolopb.Driver_GitHub,
+					Branch:    newest.Branch,
+				})
+			}
+		}
+	}
+
+	for _, build := range resp.Builds {
+		if err := svc.prepareBuildOutput(build, incomingAuthorization(ctx)); err != nil {
+			return nil, fmt.Errorf("failed preparing output")
+		}
+	}
+
+	return &resp, nil
+}
+
+// githubRepoFromProjectID extracts the owner and repo from IDs like https://github.com/berty/berty
+func githubRepoFromProjectID(projectID string) (string, string, bool) {
+	const prefix = "https://github.com/"
+	if !strings.HasPrefix(projectID, prefix) {
+		return "", "", false
+	}
+	parts := strings.Split(strings.TrimPrefix(projectID, prefix), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
@@ -0,0 +1,65 @@
+package yolosvc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServiceWhatsNew(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	svc := api.(*service)
+
+	// the current build, then 4 newer ones, and a newer build of another branch
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	batch := &yolopb.Batch{}
+	for i := 0; i <= 4; i++ {
+		createdAt := start.Add(time.Duration(i) * time.Hour)
+		id := fmt.Sprintf("build-%d", i)
+		batch.Builds = append(batch.Builds, &yolopb.Build{ID: id, ShortID: fmt.Sprint(i), CreatedAt: &createdAt, Branch: "main", ReleaseNotes: fmt.Sprintf("notes %d", i), Driver: yolopb.Driver_GitHub, HasMergerequestID: testMergeRequestID})
+		batch.Artifacts = append(batch.Artifacts, &yolopb.Artifact{ID: "artif-" + id, Kind: yolopb.Artifact_APK, HasBuildID: id})
+	}
+	// a test-only build, without artifacts to install
+	testOnly := start.Add(90 * time.Minute)
+	batch.Builds = append(batch.Builds, &yolopb.Build{ID: "build-tests", CreatedAt: &testOnly, Branch: "main", ReleaseNotes: "tests", Driver: yolopb.Driver_GitHub, HasMergerequestID: testMergeRequestID})
+	other := start.Add(time.Hour)
+	batch.Builds = append(batch.Builds, &yolopb.Build{ID: "build-other", CreatedAt: &other, Branch: "feat", Driver: yolopb.Driver_GitHub, HasMergerequestID: testMergeRequestID})
+	batch.Artifacts = append(batch.Artifacts, &yolopb.Artifact{ID: "artif-build-other", Kind: yolopb.Artifact_APK, HasBuildID: "build-other"})
+	require.NoError(t, svc.store.SaveBatch(batch))
+
+	ids := func(builds []*yolopb.Build) []string {
+		ids := []string{}
+		for _, build := range builds {
+			ids = append(ids, build.ID)
+		}
+		return ids
+	}
+
+	resp, err := svc.WhatsNew(context.Background(), &yolopb.WhatsNew_Request{BuildID: "build-0"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"build-4", "build-3", "build-2", "build-1"}, ids(resp.Builds))
+	assert.Equal(t, "## 4\n\nnotes 4\n\n## 3\n\nnotes 3\n\n## 2\n\nnotes 2\n\n## 1\n\nnotes 1", resp.ReleaseNotes)
+
+	// the limit keeps the builds following the current one, most recent first
+	resp, err = svc.WhatsNew(context.Background(), &yolopb.WhatsNew_Request{BuildID: "build-0", Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"build-2", "build-1"}, ids(resp.Builds))
+
+	resp, err = svc.WhatsNew(context.Background(), &yolopb.WhatsNew_Request{BuildID: "build-4"})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Builds)
+
+	_, err = svc.WhatsNew(context.Background(), &yolopb.WhatsNew_Request{BuildID: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = svc.WhatsNew(context.Background(), &yolopb.WhatsNew_Request{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,95 @@
+package yolosvc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthSignedURLExpiry(t *testing.T) {
+	const salt = "salt"
+	handler := auth("password", "", "Yolo", []string{salt})(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	get := func(path string) int {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest("GET", path, nil))
+		return w.Code
+	}
+	sign := func(expiresAt time.Time) string {
+		artifact := yolopb.Artifact{ID: "artif1"}
+		require.NoError(t, artifact.AddExpiringSignedURLs(salt, expiresAt))
+		return artifact.DLArtifactSignedURL
+	}
+
+	valid := sign(time.Now().Add(time.Hour))
+	assert.Equal(t, http.StatusOK, get(valid))
+	assert.Equal(t, http.StatusUnauthorized, get(sign(time.Now().Add(-time.Hour))))
+
+	// the expiry is covered by the signature
+	expiresAt := strings.TrimPrefix(strings.Split(valid, "&")[0], "/api/artifact-dl/artif1?expires=")
+	tampered := strings.Replace(valid, expiresAt, fmt.Sprint(time.Now().Add(365*24*time.Hour).Unix()), 1)
+	require.NotEqual(t, valid, tampered)
+	assert.Equal(t, http.StatusUnauthorized, get(tampered))
+}
+
+func TestSignedURLTTL(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t), SignedURLTTL: 24 * time.Hour})
+	defer cleanup()
+
+	resp, err := api.BuildList(context.Background(), &yolopb.BuildList_Request{})
+	require.NoError(t, err)
+	require.Len(t, resp.Builds, 1)
+	require.Len(t, resp.Builds[0].HasArtifacts, 1)
+
+	u, err := url.Parse(resp.Builds[0].HasArtifacts[0].DLArtifactSignedURL)
+	require.NoError(t, err)
+	ts, err := strconv.ParseInt(u.Query().Get("expires"), 10, 64)
+	require.NoError(t, err)
+	expires := time.Unix(ts, 0)
+	assert.True(t, expires.After(time.Now().Add(24*time.Hour)))
+	assert.True(t, expires.Before(time.Now().Add(25*time.Hour)))
+	assert.NotEmpty(t, u.Query().Get("sign"))
+}
+
+func TestAuthSaltRotation(t *testing.T) {
+	handler := auth("password", "", "Yolo", []string{"new-salt", "old-salt"})(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	get := func(salt string) int {
+		artifact := yolopb.Artifact{ID: "artif1"}
+		require.NoError(t, artifact.AddSignedURLs(salt))
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest("GET", artifact.DLArtifactSignedURL, nil))
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusOK, get("new-salt"))
+	assert.Equal(t, http.StatusOK, get("old-salt"))
+	assert.Equal(t, http.StatusUnauthorized, get("dropped-salt"))
+}
+
+func TestServiceSignsWithFirstSalt(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t), AuthSalts: []string{"new-salt", "old-salt"}})
+	defer cleanup()
+
+	resp, err := api.BuildList(context.Background(), &yolopb.BuildList_Request{})
+	require.NoError(t, err)
+	require.Len(t, resp.Builds, 1)
+	require.Len(t, resp.Builds[0].HasArtifacts, 1)
+
+	expected := yolopb.Artifact{ID: "artif1"}
+	require.NoError(t, expected.AddSignedURLs("new-salt"))
+	assert.Equal(t, expected.DLArtifactSignedURL, resp.Builds[0].HasArtifacts[0].DLArtifactSignedURL)
+}
@@ -0,0 +1,42 @@
+package yolosvc
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+
+	"berty.tech/yolo/v2/go/pkg/yolostore"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/signature"
+	"go.uber.org/zap"
+)
+
+const authSaltSetting = "auth_salt"
+
+// LoadAuthSalt returns the auth salt persisted in the database, a random one is generated on first use.
+// it keeps the signed URLs valid across restarts when no salt is configured.
+func LoadAuthSalt(db *gorm.DB, logger *zap.Logger) (string, error) {
+	store, err := yolostore.NewStore(db, logger)
+	if err != nil {
+		return "", err
+	}
+
+	random := make([]byte, 32)
+	if _, err := rand.Read(random); err != nil {
+		return "", fmt.Errorf("generate auth salt: %w", err)
+	}
+
+	return store.GetOrCreateSetting(authSaltSetting, hex.EncodeToString(random))
+}
+
+// validSignature returns true if the URL of the request is signed with any of the salts.
+// only the first salt signs the new URLs, the next ones keep the URLs signed before a rotation valid.
+func validSignature(r *http.Request, salts []string) bool {
+	for _, salt := range salts {
+		if valid, _ := signature.ValidateSignature(r.Method, r.URL.String(), "", salt); valid {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,30 @@
+package yolosvc
+
+import (
+	"testing"
+
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"github.com/stretchr/signature"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadAuthSalt(t *testing.T) {
+	db := testingDB(t)
+	defer db.Close()
+	logger := testutil.Logger(t)
+
+	salt, err := LoadAuthSalt(db, logger)
+	require.NoError(t, err)
+	require.Len(t, salt, 64)
+	signedURL, err := signature.GetSignedURL("GET", "/api/artifact-dl/artif1", "", salt)
+	require.NoError(t, err)
+
+	// restart
+	restartedSalt, err := LoadAuthSalt(db, logger)
+	require.NoError(t, err)
+	assert.Equal(t, salt, restartedSalt)
+	valid, err := signature.ValidateSignature("GET", signedURL, "", restartedSalt)
+	require.NoError(t, err)
+	assert.True(t, valid)
+}
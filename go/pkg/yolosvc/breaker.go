@@ -0,0 +1,162 @@
+package yolosvc
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultCircuitBreakerBackoff    = time.Minute
+	defaultCircuitBreakerMaxBackoff = 30 * time.Minute
+)
+
+// circuitBreakers holds the breaker of each driver, created by its worker
+type circuitBreakers struct {
+	mutex      sync.Mutex
+	breakers   map[yolopb.Driver]*circuitBreaker
+	threshold  int
+	backoff    time.Duration
+	maxBackoff time.Duration
+	logger     *zap.Logger
+}
+
+func newCircuitBreakers(threshold int, backoff, maxBackoff time.Duration, logger *zap.Logger) *circuitBreakers {
+	return &circuitBreakers{
+		breakers:   map[yolopb.Driver]*circuitBreaker{},
+		threshold:  threshold,
+		backoff:    backoff,
+		maxBackoff: maxBackoff,
+		logger:     logger,
+	}
+}
+
+func (b *circuitBreakers) get(driver yolopb.Driver) *circuitBreaker {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	breaker, found := b.breakers[driver]
+	if !found {
+		breaker = &circuitBreaker{
+			driver:     driver,
+			threshold:  b.threshold,
+			backoff:    b.backoff,
+			maxBackoff: b.maxBackoff,
+			logger:     b.logger,
+			now:        time.Now,
+		}
+		b.breakers[driver] = breaker
+	}
+	return breaker
+}
+
+// status returns the state of the breakers, sorted by driver
+func (b *circuitBreakers) status() []*yolopb.Status_DriverStatus {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	ret := make([]*yolopb.Status_DriverStatus, 0, len(b.breakers))
+	for _, breaker := range b.breakers {
+		ret = append(ret, breaker.status())
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Driver < ret[j].Driver })
+	return ret
+}
+
+// circuitBreaker pauses the refreshes of a driver after consecutive failures, so a CI provider being down
+// doesn't keep its worker busy retrying and logging, then half-opens to probe it with a single refresh.
+// the wait doubles after each failed probe, up to maxBackoff.
+type circuitBreaker struct {
+	mutex      sync.Mutex
+	driver     yolopb.Driver
+	threshold  int // 0 disables the breaker
+	backoff    time.Duration
+	maxBackoff time.Duration
+	logger     *zap.Logger
+	now        func() time.Time
+
+	state     yolopb.Status_CircuitState
+	failures  int // consecutive failed refreshes
+	wait      time.Duration
+	openUntil time.Time
+}
+
+// allow returns the remaining time before the next refresh is allowed, half-opening the circuit once elapsed
+func (b *circuitBreaker) allow() time.Duration {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if b.state != yolopb.Status_Open {
+		return 0
+	}
+	if remaining := b.openUntil.Sub(b.now()); remaining > 0 {
+		return remaining
+	}
+	b.state = yolopb.Status_HalfOpen
+	b.logger.Info("circuit half-open, probing the driver", zap.String("driver", b.driver.String()))
+	return 0
+}
+
+// waitAllowed blocks while the circuit is open, it returns false if ctx is done
+func (b *circuitBreaker) waitAllowed(ctx context.Context) bool {
+	for {
+		remaining := b.allow()
+		if remaining == 0 {
+			return true
+		}
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(remaining):
+		}
+	}
+}
+
+// record updates the circuit with the outcome of a refresh, err is nil if the driver responded
+func (b *circuitBreaker) record(err error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if err == nil {
+		if b.state != yolopb.Status_Closed {
+			b.logger.Info("circuit closed, the driver recovered", zap.String("driver", b.driver.String()))
+		}
+		b.state = yolopb.Status_Closed
+		b.failures = 0
+		b.wait = 0
+		return
+	}
+
+	b.failures++
+	switch {
+	case b.threshold <= 0:
+		return
+	case b.state == yolopb.Status_HalfOpen: // the probe failed
+		b.wait *= 2
+		if b.wait > b.maxBackoff {
+			b.wait = b.maxBackoff
+		}
+	case b.failures >= b.threshold:
+		b.wait = b.backoff
+	default:
+		return
+	}
+	b.state = yolopb.Status_Open
+	b.openUntil = b.now().Add(b.wait)
+	b.logger.Warn("circuit open, refreshes paused", zap.String("driver", b.driver.String()), zap.Int("failures", b.failures), zap.Duration("wait", b.wait), zap.Error(err))
+}
+
+func (b *circuitBreaker) status() *yolopb.Status_DriverStatus {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	ret := &yolopb.Status_DriverStatus{
+		Driver:              b.driver,
+		Circuit:             b.state,
+		ConsecutiveFailures: int32(b.failures),
+	}
+	if b.state == yolopb.Status_Open {
+		openUntil := b.openUntil
+		ret.RetryAt = &openUntil
+	}
+	return ret
+}
@@ -0,0 +1,76 @@
+package yolosvc
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Date(2022, 9, 1, 0, 0, 0, 0, time.UTC)
+	breakers := newCircuitBreakers(3, time.Minute, 3*time.Minute, testutil.Logger(t))
+	breaker := breakers.get(yolopb.Driver_Buildkite)
+	breaker.now = func() time.Time { return now }
+	errDown := fmt.Errorf("503 Service Unavailable")
+
+	// below the threshold, the refreshes keep going
+	breaker.record(errDown)
+	breaker.record(errDown)
+	assert.Equal(t, yolopb.Status_Closed, breaker.status().Circuit)
+	assert.Equal(t, time.Duration(0), breaker.allow())
+
+	// the threshold opens the circuit
+	breaker.record(errDown)
+	status := breaker.status()
+	assert.Equal(t, yolopb.Status_Open, status.Circuit)
+	assert.Equal(t, int32(3), status.ConsecutiveFailures)
+	require.NotNil(t, status.RetryAt)
+	assert.Equal(t, now.Add(time.Minute), *status.RetryAt)
+	assert.Equal(t, time.Minute, breaker.allow())
+
+	// once elapsed, the circuit half-opens for a probe
+	now = now.Add(time.Minute)
+	assert.Equal(t, time.Duration(0), breaker.allow())
+	assert.Equal(t, yolopb.Status_HalfOpen, breaker.status().Circuit)
+	assert.Nil(t, breaker.status().RetryAt)
+
+	// the failed probes double the wait, up to the max backoff
+	breaker.record(errDown)
+	assert.Equal(t, yolopb.Status_Open, breaker.status().Circuit)
+	assert.Equal(t, 2*time.Minute, breaker.allow())
+	now = now.Add(2 * time.Minute)
+	assert.Equal(t, time.Duration(0), breaker.allow())
+	breaker.record(errDown)
+	assert.Equal(t, 3*time.Minute, breaker.allow())
+
+	// a successful probe closes the circuit
+	now = now.Add(3 * time.Minute)
+	assert.Equal(t, time.Duration(0), breaker.allow())
+	breaker.record(nil)
+	status = breaker.status()
+	assert.Equal(t, yolopb.Status_Closed, status.Circuit)
+	assert.Equal(t, int32(0), status.ConsecutiveFailures)
+	assert.Nil(t, status.RetryAt)
+
+	// the other drivers are not affected
+	breakers.get(yolopb.Driver_GitHub).record(nil)
+	statuses := breakers.status()
+	require.Len(t, statuses, 2)
+	assert.Equal(t, yolopb.Driver_Buildkite, statuses[0].Driver)
+	assert.Equal(t, yolopb.Driver_GitHub, statuses[1].Driver)
+}
+
+func TestCircuitBreakerDisabled(t *testing.T) {
+	breaker := newCircuitBreakers(0, time.Minute, time.Hour, testutil.Logger(t)).get(yolopb.Driver_CircleCI)
+	for i := 0; i < 10; i++ {
+		breaker.record(fmt.Errorf("timeout"))
+	}
+	assert.Equal(t, yolopb.Status_Closed, breaker.status().Circuit)
+	assert.Equal(t, int32(10), breaker.status().ConsecutiveFailures)
+	assert.Equal(t, time.Duration(0), breaker.allow())
+}
@@ -0,0 +1,51 @@
+package yolosvc
+
+import (
+	"io"
+	"sync"
+)
+
+const defaultCopyBufferSize = 32 * 1024
+
+// bufferPool provides reusable buffers used to stream artifacts from the providers
+type bufferPool struct {
+	size int
+	pool sync.Pool
+}
+
+func newBufferPool(size int) *bufferPool {
+	if size <= 0 {
+		size = defaultCopyBufferSize
+	}
+	p := &bufferPool{size: size}
+	p.pool.New = func() interface{} {
+		buf := make([]byte, size)
+		return &buf
+	}
+	return p
+}
+
+func (p *bufferPool) copy(dst io.Writer, src io.Reader) (int64, error) {
+	buf := p.pool.Get().(*[]byte)
+	defer p.pool.Put(buf)
+	// hide the optional ReaderFrom/WriterTo interfaces so the buffer is actually used
+	return io.CopyBuffer(writerOnly{dst}, readerOnly{src}, *buf)
+}
+
+// writer wraps w so the io.Copy calls made by the provider clients use the pooled buffers
+func (p *bufferPool) writer(w io.Writer) io.Writer {
+	return &pooledWriter{Writer: w, pool: p}
+}
+
+type pooledWriter struct {
+	io.Writer
+	pool *bufferPool
+}
+
+func (w *pooledWriter) ReadFrom(r io.Reader) (int64, error) {
+	return w.pool.copy(w.Writer, r)
+}
+
+type writerOnly struct{ io.Writer }
+
+type readerOnly struct{ io.Reader }
@@ -0,0 +1,34 @@
+package yolosvc
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+)
+
+// slowWriter simulates a fixed per-write cost (syscall, TLS record, ...) of a high-latency link
+type slowWriter struct{}
+
+func (slowWriter) Write(p []byte) (int, error) {
+	time.Sleep(10 * time.Microsecond)
+	return len(p), nil
+}
+
+func BenchmarkArtifactCopyBuffer(b *testing.B) {
+	payload := bytes.Repeat([]byte("yolo"), 4*1024*1024) // 16MB
+	for _, size := range []int{4 * 1024, 32 * 1024, 256 * 1024, 1024 * 1024} {
+		pool := newBufferPool(size)
+		b.Run(fmt.Sprintf("%dKB", size/1024), func(b *testing.B) {
+			b.SetBytes(int64(len(payload)))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, err := io.Copy(pool.writer(slowWriter{}), readerOnly{bytes.NewReader(payload)})
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package yolosvc
+
+import (
+	"sync"
+
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+)
+
+// buildFeedBuffer is the amount of pending updates of a subscriber, the next ones are dropped until it catches up
+const buildFeedBuffer = 64
+
+// buildFeed fans the IDs of the saved builds out to the build update streams
+type buildFeed struct {
+	mutex       sync.Mutex
+	subscribers map[chan string]struct{}
+}
+
+func newBuildFeed() *buildFeed {
+	return &buildFeed{subscribers: map[chan string]struct{}{}}
+}
+
+// subscribe returns the channel of the updated build IDs and a function releasing it
+func (f *buildFeed) subscribe() (<-chan string, func()) {
+	updates := make(chan string, buildFeedBuffer)
+	f.mutex.Lock()
+	f.subscribers[updates] = struct{}{}
+	f.mutex.Unlock()
+
+	return updates, func() {
+		f.mutex.Lock()
+		delete(f.subscribers, updates)
+		f.mutex.Unlock()
+	}
+}
+
+func (f *buildFeed) publish(buildIDs []string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for updates := range f.subscribers {
+		for _, id := range buildIDs {
+			select {
+			case updates <- id:
+			default: // never block the ingestion on a slow stream
+			}
+		}
+	}
+}
+
+// pendingBuildIDs returns an update with the ones already waiting in the channel, to be handled together
+func pendingBuildIDs(first string, updates <-chan string) []string {
+	ids := []string{first}
+	for {
+		select {
+		case id := <-updates:
+			ids = append(ids, id)
+		default:
+			return ids
+		}
+	}
+}
+
+// updatedBuildIDs returns the builds created or updated by a batch, including the ones with new artifacts
+func updatedBuildIDs(batch *yolopb.Batch) []string {
+	seen := map[string]bool{}
+	ids := []string{}
+	add := func(id string) {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	for _, build := range batch.Builds {
+		add(build.ID)
+	}
+	for _, artifact := range batch.Artifacts {
+		add(artifact.HasBuildID)
+	}
+	return ids
+}
@@ -21,7 +21,9 @@ func cacheMiddleware(next http.Handler, c *cache.Cache, logger *zap.Logger) http
 		if r.Method == http.MethodGet {
 			err := func() error {
 				sortURLParams(r.URL)
+				// the responses depend on the caller, i.e, the builds waiting for a promotion are only listed to the staff
 				h := fnv.New64a()
+				_, _ = h.Write([]byte(r.Header.Get("Authorization")))
 				key := string(h.Sum([]byte(r.URL.String())))
 				res, found := c.Get(key)
 				if found {
@@ -0,0 +1,36 @@
+package yolosvc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	cache "github.com/patrickmn/go-cache"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestCacheMiddlewarePerCaller(t *testing.T) {
+	calls := 0
+	handler := cacheMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		_, _ = w.Write([]byte(r.Header.Get("Authorization")))
+	}), cache.New(time.Minute, time.Minute), zap.NewNop())
+	get := func(authorization string) string {
+		r := httptest.NewRequest("GET", "/api/build-list?limit=1", nil)
+		if authorization != "" {
+			r.Header.Set("Authorization", authorization)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w.Body.String()
+	}
+
+	assert.Equal(t, "Basic staff", get("Basic staff"))
+	assert.Equal(t, "Basic staff", get("Basic staff"))
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, "Basic member", get("Basic member"))
+	assert.Equal(t, "", get(""))
+	assert.Equal(t, 3, calls)
+}
@@ -0,0 +1,79 @@
+package yolosvc
+
+import (
+	"fmt"
+	"strings"
+
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"berty.tech/yolo/v2/go/pkg/yolostore"
+)
+
+// Channel is a release channel, i.e., "production" for the builds of the main branch
+type Channel struct {
+	Name   string
+	Branch string
+	// RequirePromotion hides the builds of the channel until they are promoted with PromoteBuild
+	RequirePromotion bool
+}
+
+// ParseChannels parses a comma-separated list of "name:branch[:promote]" channel definitions
+func ParseChannels(input string) ([]Channel, error) {
+	var channels []Channel
+	for _, def := range strings.Split(input, ",") {
+		def = strings.TrimSpace(def)
+		if def == "" {
+			continue
+		}
+		parts := strings.Split(def, ":")
+		if len(parts) < 2 || len(parts) > 3 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid channel definition: %q", def)
+		}
+		channel := Channel{Name: parts[0], Branch: parts[1]}
+		if len(parts) == 3 {
+			if parts[2] != "promote" {
+				return nil, fmt.Errorf("invalid channel option: %q", parts[2])
+			}
+			channel.RequirePromotion = true
+		}
+		channels = append(channels, channel)
+	}
+	return channels, nil
+}
+
+// unpromotedBranches returns the branches of the channels requiring a promotion,
+// their builds are hidden from the non-staff callers until they are promoted
+func (svc *service) unpromotedBranches() []yolostore.UnpromotedBranch {
+	var branches []yolostore.UnpromotedBranch
+	for _, channel := range svc.channels {
+		if channel.RequirePromotion && channel.Branch != "" {
+			branches = append(branches, yolostore.UnpromotedBranch{Branch: channel.Branch, Channel: channel.Name})
+		}
+	}
+	return branches
+}
+
+// isUnpromoted reports whether a build is hidden from the non-staff callers, see unpromotedBranches
+func (svc *service) isUnpromoted(build *yolopb.Build) bool {
+	for _, branch := range svc.unpromotedBranches() {
+		if build.Branch != branch.Branch {
+			continue
+		}
+		promoted := false
+		for _, channel := range build.Channels {
+			promoted = promoted || channel == branch.Channel
+		}
+		if !promoted {
+			return true
+		}
+	}
+	return false
+}
+
+func (svc *service) getChannel(name string) (Channel, bool) {
+	for _, channel := range svc.channels {
+		if channel.Name == name {
+			return channel, true
+		}
+	}
+	return Channel{}, false
+}
@@ -0,0 +1,207 @@
+package yolosvc
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"berty.tech/yolo/v2/go/pkg/yolostore"
+	"github.com/go-chi/chi"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+type artifactChecksums struct {
+	MD5    string `json:"md5,omitempty"`
+	SHA256 string `json:"sha256"`
+}
+
+// ArtifactChecksums returns the checksums of an artifact as JSON, they are computed on the first request if unknown
+func (svc *service) ArtifactChecksums(w http.ResponseWriter, r *http.Request) {
+	artifact, ok := svc.checksummedArtifact(w, r)
+	if !ok {
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewEncoder(w).Encode(artifactChecksums{MD5: artifact.Md5Sum, SHA256: artifact.Sha256Sum})
+	if err != nil {
+		svc.logger.Warn("failed to send checksums", zap.Error(err))
+	}
+}
+
+// ArtifactSHA256File returns the checksum of an artifact in the sha256sum format, to be verified with `sha256sum -c`
+func (svc *service) ArtifactSHA256File(w http.ResponseWriter, r *http.Request) {
+	artifact, ok := svc.checksummedArtifact(w, r)
+	if !ok {
+		return
+	}
+	filename := path.Base(artifact.LocalPath)
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s.sha256", filename))
+	_, err := fmt.Fprintf(w, "%s  %s\n", artifact.Sha256Sum, filename)
+	if err != nil {
+		svc.logger.Warn("failed to send checksums", zap.Error(err))
+	}
+}
+
+func (svc *service) checksummedArtifact(w http.ResponseWriter, r *http.Request) (*yolopb.Artifact, bool) {
+	artifact, err := svc.store.GetArtifactByID(chi.URLParam(r, "artifactID"))
+	switch {
+	case errors.Is(err, yolostore.ErrNotFound):
+		httpError(w, err, codes.NotFound)
+		return nil, false
+	case err != nil:
+		httpError(w, err, codes.Internal)
+		return nil, false
+	}
+
+	if artifact.Sha256Sum == "" {
+		sums := newChecksumWriter()
+		err := svc.streamMayCache(artifactCacheKey(artifact), sums, func(w io.Writer) error {
+			return svc.artifactDownloadFromProvider(r.Context(), artifact, w)
+		})
+		if err != nil {
+			httpError(w, fmt.Errorf("compute checksums: %w", err), codes.Unavailable)
+			return nil, false
+		}
+		svc.saveChecksums(artifact, sums)
+	}
+	return artifact, true
+}
+
+// resolveChecksums keeps the checksums computed during a previous download of the ingested artifacts,
+// the ones reported by the providers are trusted.
+func (svc *service) resolveChecksums(batch *yolopb.Batch) {
+	ids := []string{}
+	for _, artifact := range batch.Artifacts {
+		if artifact.Sha256Sum == "" {
+			ids = append(ids, artifact.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return
+	}
+
+	stored, err := svc.store.GetArtifactChecksums(ids)
+	if err != nil {
+		svc.logger.Warn("failed to get stored checksums", zap.Error(err))
+		return
+	}
+	for _, artifact := range batch.Artifacts {
+		if checksums, found := stored[artifact.ID]; found && artifact.Sha256Sum == "" {
+			artifact.Md5Sum = checksums.Md5Sum
+			artifact.Sha256Sum = checksums.Sha256Sum
+		}
+	}
+}
+
+// saveChecksums stores the checksums computed while streaming an artifact, so it is only hashed once
+func (svc *service) saveChecksums(artifact *yolopb.Artifact, sums *checksumWriter) {
+	md5Sum, sha256Sum := sums.sums()
+	if err := svc.store.SetArtifactChecksums(artifact.ID, md5Sum, sha256Sum); err != nil {
+		svc.logger.Warn("failed to save checksums", zap.String("artifact", artifact.ID), zap.Error(err))
+		return
+	}
+	artifact.Md5Sum = md5Sum
+	artifact.Sha256Sum = sha256Sum
+	if artifact.DuplicateOfID == "" {
+		svc.markDuplicate(artifact)
+	}
+}
+
+// verifyDownload compares the size and the checksum of a proxied download to the stored ones. the response is already sent,
+// so a mismatch (i.e, a truncated or altered upstream response) is only logged and counted, it returns false in this case
+func (svc *service) verifyDownload(artifact *yolopb.Artifact, sent *checksumResponseWriter) bool {
+	_, sha256Sum := sent.sums.sums()
+	sizeMismatch := artifact.FileSize > 0 && sent.size != artifact.FileSize
+	sumMismatch := digestHeader(artifact.Sha256Sum) != "" && !strings.EqualFold(sha256Sum, artifact.Sha256Sum)
+	if !sizeMismatch && !sumMismatch {
+		return true
+	}
+	svc.logger.Warn("corrupt artifact download",
+		zap.String("artifact", artifact.ID),
+		zap.Stringer("driver", artifact.Driver),
+		zap.Int64("expected_size", artifact.FileSize),
+		zap.Int64("size", sent.size),
+		zap.String("expected_sha256", artifact.Sha256Sum),
+		zap.String("sha256", sha256Sum),
+	)
+	svc.metrics.corruptDownload(artifact.Driver)
+	return false
+}
+
+// fileChecksums hashes a mirrored artifact
+func fileChecksums(path string) (*checksumWriter, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	sums := newChecksumWriter()
+	if _, err := io.Copy(sums, f); err != nil {
+		return nil, err
+	}
+	return sums, nil
+}
+
+// digestHeader formats a hex SHA-256 checksum as the value of a Digest header (RFC 3230)
+func digestHeader(sha256Sum string) string {
+	raw, err := hex.DecodeString(sha256Sum)
+	if err != nil || len(raw) != sha256.Size {
+		return ""
+	}
+	return "sha-256=" + base64.StdEncoding.EncodeToString(raw)
+}
+
+type checksumWriter struct {
+	md5    hash.Hash
+	sha256 hash.Hash
+}
+
+func newChecksumWriter() *checksumWriter {
+	return &checksumWriter{
+		md5:    md5.New(),
+		sha256: sha256.New(),
+	}
+}
+
+func (w *checksumWriter) Write(p []byte) (int, error) {
+	_, _ = w.md5.Write(p)
+	return w.sha256.Write(p)
+}
+
+func (w *checksumWriter) sums() (string, string) {
+	return hex.EncodeToString(w.md5.Sum(nil)), hex.EncodeToString(w.sha256.Sum(nil))
+}
+
+// checksumResponseWriter hashes and counts the content sent to the client
+type checksumResponseWriter struct {
+	http.ResponseWriter
+	sums *checksumWriter
+	size int64
+}
+
+func (w *checksumResponseWriter) Write(p []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(p)
+	_, _ = w.sums.Write(p[:n])
+	w.size += int64(n)
+	return n, err
+}
+
+func (w *checksumResponseWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
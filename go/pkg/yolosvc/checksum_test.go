@@ -0,0 +1,138 @@
+package yolosvc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	helloMD5    = "5d41402abc4b2a76b9719d911017c592"
+	helloSHA256 = "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+)
+
+func TestArtifactChecksums(t *testing.T) {
+	cachePath := t.TempDir()
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t), ArtifactsCachePath: cachePath})
+	defer cleanup()
+	svc := api.(*service)
+
+	// the provider is not configured, the checksums are computed from the mirrored artifact
+	require.NoError(t, os.WriteFile(filepath.Join(cachePath, "artif1"), []byte("hello"), 0o600))
+
+	request := func(handler http.HandlerFunc) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("artifactID", "artif1")
+		r := httptest.NewRequest("GET", "/", nil)
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+		w := httptest.NewRecorder()
+		handler(w, r)
+		return w
+	}
+
+	w := request(svc.ArtifactChecksums)
+	require.Equal(t, http.StatusOK, w.Code)
+	var checksums artifactChecksums
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&checksums))
+	assert.Equal(t, artifactChecksums{MD5: helloMD5, SHA256: helloSHA256}, checksums)
+
+	artifact, err := svc.store.GetArtifactByID("artif1")
+	require.NoError(t, err)
+	assert.Equal(t, helloMD5, artifact.Md5Sum)
+	assert.Equal(t, helloSHA256, artifact.Sha256Sum)
+
+	w = request(svc.ArtifactSHA256File)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, helloSHA256+"  bla\n", w.Body.String())
+
+	w = request(svc.ArtifactDownloader)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "sha-256=LPJNul+wow4m6DsqxbninhsWHlwfp0JecwQzYpOLmCQ=", w.Header().Get("Digest"))
+}
+
+func TestArtifactDownloaderComputesChecksums(t *testing.T) {
+	cachePath := t.TempDir()
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t), ArtifactsCachePath: cachePath})
+	defer cleanup()
+	svc := api.(*service)
+	require.NoError(t, os.WriteFile(filepath.Join(cachePath, "artif1"), []byte("hello"), 0o600))
+
+	download := func() *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("artifactID", "artif1")
+		r := httptest.NewRequest("GET", "/", nil)
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+		w := httptest.NewRecorder()
+		svc.ArtifactDownloader(w, r)
+		return w
+	}
+
+	w := download()
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "hello", w.Body.String())
+	assert.Empty(t, w.Header().Get("Digest"))
+
+	w = download()
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.NotEmpty(t, w.Header().Get("Digest"))
+
+	// re-ingesting the artifact keeps its checksums
+	err := svc.saveBatch(context.Background(), &yolopb.Batch{Artifacts: []*yolopb.Artifact{
+		{ID: "artif1", LocalPath: "js/packages/bla", HasBuildID: "https://buildkite.com/berty/berty/builds/2738"},
+	}})
+	require.NoError(t, err)
+	artifact, err := svc.store.GetArtifactByID("artif1")
+	require.NoError(t, err)
+	assert.Equal(t, helloSHA256, artifact.Sha256Sum)
+}
+
+func TestArtifactDownloaderVerify(t *testing.T) {
+	cachePath := t.TempDir()
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t), ArtifactsCachePath: cachePath, VerifyDownloads: true})
+	defer cleanup()
+	svc := api.(*service)
+	require.NoError(t, os.WriteFile(filepath.Join(cachePath, "artif1"), []byte("hello"), 0o600))
+
+	download := func() *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("artifactID", "artif1")
+		r := httptest.NewRequest("GET", "/", nil)
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+		w := httptest.NewRecorder()
+		svc.ArtifactDownloader(w, r)
+		return w
+	}
+
+	// the stored size is 80 bytes, the checksums of a corrupt download are not kept
+	w := download()
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "hello", w.Body.String())
+	assert.Equal(t, int64(1), svc.metrics.CorruptDownloads(yolopb.Driver_Buildkite))
+	artifact, err := svc.store.GetArtifactByID("artif1")
+	require.NoError(t, err)
+	assert.Empty(t, artifact.Sha256Sum)
+
+	artifact.FileSize = 5
+	artifact.Sha256Sum = helloSHA256
+	require.NoError(t, svc.store.SaveArtifact(artifact))
+	w = download()
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, int64(1), svc.metrics.CorruptDownloads(yolopb.Driver_Buildkite))
+
+	artifact.Sha256Sum = strings.Repeat("0", 64)
+	require.NoError(t, svc.store.SaveArtifact(artifact))
+	w = download()
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, int64(2), svc.metrics.CorruptDownloads(yolopb.Driver_Buildkite))
+}
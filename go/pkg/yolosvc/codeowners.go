@@ -0,0 +1,232 @@
+package yolosvc
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/google/go-github/v32/github"
+	"go.uber.org/zap"
+)
+
+const (
+	codeownersCacheTTL      = time.Hour
+	ownerTeamsCommitsMaxLen = 10000
+)
+
+// codeownersPaths are the locations supported by GitHub, by order of precedence
+var codeownersPaths = []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"}
+
+type codeownersRule struct {
+	pattern *regexp.Regexp
+	owners  []string
+}
+
+type codeownersCacheEntry struct {
+	rules     []codeownersRule
+	fetchedAt time.Time
+}
+
+// ownerTeamsCache avoids querying GitHub again for known CODEOWNERS files and already resolved commits
+type ownerTeamsCache struct {
+	mutex   sync.Mutex
+	rules   map[string]codeownersCacheEntry // by "owner/repo@ref"
+	commits map[string][]string             // by commit ID
+}
+
+func newOwnerTeamsCache() *ownerTeamsCache {
+	return &ownerTeamsCache{
+		rules:   map[string]codeownersCacheEntry{},
+		commits: map[string][]string{},
+	}
+}
+
+// resolveOwnerTeams sets the OwnerTeams of the builds from the files changed by their commit.
+// builds whose ownership can't be resolved get an empty list, this should never prevent saving them.
+func (svc *service) resolveOwnerTeams(ctx context.Context, builds []*yolopb.Build) {
+	for _, build := range builds {
+		owner, repo, ok := githubRepoFromProjectID(build.HasProjectID)
+		if !ok || build.HasCommitID == "" {
+			continue
+		}
+		teams, err := svc.commitOwnerTeams(ctx, owner, repo, build.Branch, build.HasCommitID)
+		if err != nil {
+			svc.logger.Warn("resolve owner teams", zap.String("build", build.ID), zap.String("commit", build.HasCommitID), zap.Error(err))
+			continue
+		}
+		build.OwnerTeams = teams
+	}
+}
+
+func (svc *service) commitOwnerTeams(ctx context.Context, owner, repo, ref, commitID string) ([]string, error) {
+	cache := svc.ownerTeamsCache
+	cache.mutex.Lock()
+	teams, found := cache.commits[commitID]
+	cache.mutex.Unlock()
+	if found {
+		return teams, nil
+	}
+
+	rules, err := svc.codeownersRules(ctx, owner, repo, ref)
+	if err != nil {
+		return nil, err
+	}
+	if len(rules) > 0 {
+		commit, _, err := svc.ghc.Repositories.GetCommit(ctx, owner, repo, commitID)
+		if err != nil {
+			return nil, fmt.Errorf("get commit: %w", err)
+		}
+		files := make([]string, 0, len(commit.Files))
+		for _, file := range commit.Files {
+			files = append(files, file.GetFilename())
+		}
+		teams = codeownersTeams(rules, files)
+	}
+
+	cache.mutex.Lock()
+	if len(cache.commits) >= ownerTeamsCommitsMaxLen {
+		cache.commits = map[string][]string{}
+	}
+	cache.commits[commitID] = teams
+	cache.mutex.Unlock()
+	return teams, nil
+}
+
+// codeownersRules returns the parsed CODEOWNERS of a repo at ref, falling back to the default branch.
+// a repo without CODEOWNERS file has no rules.
+func (svc *service) codeownersRules(ctx context.Context, owner, repo, ref string) ([]codeownersRule, error) {
+	cache := svc.ownerTeamsCache
+	key := fmt.Sprintf("%s/%s@%s", owner, repo, ref)
+	cache.mutex.Lock()
+	entry, found := cache.rules[key]
+	cache.mutex.Unlock()
+	if found && time.Since(entry.fetchedAt) < codeownersCacheTTL {
+		return entry.rules, nil
+	}
+
+	content, err := svc.fetchCodeowners(ctx, owner, repo, ref)
+	if err != nil && ref != "" {
+		content, err = svc.fetchCodeowners(ctx, owner, repo, "") // the branch may have been deleted
+	}
+	if err != nil {
+		return nil, err
+	}
+	rules := parseCodeowners(content)
+
+	cache.mutex.Lock()
+	cache.rules[key] = codeownersCacheEntry{rules: rules, fetchedAt: time.Now()}
+	cache.mutex.Unlock()
+	return rules, nil
+}
+
+func (svc *service) fetchCodeowners(ctx context.Context, owner, repo, ref string) (string, error) {
+	opts := &github.RepositoryContentGetOptions{Ref: ref}
+	for _, path := range codeownersPaths {
+		file, _, resp, err := svc.ghc.Repositories.GetContents(ctx, owner, repo, path, opts)
+		if resp != nil && resp.StatusCode == 404 {
+			continue
+		}
+		if err != nil {
+			return "", fmt.Errorf("get %s: %w", path, err)
+		}
+		return file.GetContent()
+	}
+	return "", nil
+}
+
+// parseCodeowners parses a CODEOWNERS file, invalid patterns are ignored
+func parseCodeowners(content string) []codeownersRule {
+	var rules []codeownersRule
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		pattern, err := codeownersPattern(fields[0])
+		if err != nil {
+			continue
+		}
+		rule := codeownersRule{pattern: pattern}
+		for _, owner := range fields[1:] {
+			if strings.HasPrefix(owner, "#") {
+				break
+			}
+			rule.owners = append(rule.owners, owner)
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// codeownersPattern converts a gitignore-style CODEOWNERS pattern to a regexp matching file paths
+func codeownersPattern(pattern string) (*regexp.Regexp, error) {
+	dirOnly := strings.HasSuffix(pattern, "/")
+	pattern = strings.TrimSuffix(pattern, "/")
+	// patterns containing a slash are relative to the root of the repo
+	anchored := strings.Contains(pattern, "/")
+	pattern = strings.TrimPrefix(pattern, "/")
+	if pattern == "" {
+		return nil, fmt.Errorf("empty pattern")
+	}
+
+	var expr strings.Builder
+	if anchored {
+		expr.WriteString("^")
+	} else {
+		expr.WriteString("^(?:.*/)?")
+	}
+	for i := 0; i < len(pattern); i++ {
+		switch {
+		case strings.HasPrefix(pattern[i:], "**/"):
+			expr.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(pattern[i:], "**"):
+			expr.WriteString(".*")
+			i++
+		case pattern[i] == '*':
+			expr.WriteString("[^/]*")
+		case pattern[i] == '?':
+			expr.WriteString("[^/]")
+		default:
+			expr.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		}
+	}
+	if dirOnly {
+		expr.WriteString("/.*$")
+	} else {
+		expr.WriteString("(?:/.*)?$")
+	}
+	return regexp.Compile(expr.String())
+}
+
+// codeownersTeams returns the sorted teams (i.e, @berty/core) owning the files, users and emails are ignored.
+// like on GitHub, the last matching rule takes precedence.
+func codeownersTeams(rules []codeownersRule, files []string) []string {
+	set := map[string]bool{}
+	for _, file := range files {
+		for i := len(rules) - 1; i >= 0; i-- {
+			if !rules[i].pattern.MatchString(file) {
+				continue
+			}
+			for _, owner := range rules[i].owners {
+				if strings.HasPrefix(owner, "@") && strings.Contains(owner, "/") {
+					set[owner] = true
+				}
+			}
+			break
+		}
+	}
+	teams := make([]string, 0, len(set))
+	for team := range set {
+		teams = append(teams, team)
+	}
+	sort.Strings(teams)
+	return teams
+}
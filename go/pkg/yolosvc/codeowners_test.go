@@ -0,0 +1,42 @@
+package yolosvc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCodeownersTeams(t *testing.T) {
+	rules := parseCodeowners(`
+# global owners
+*             @berty/core @moul
+
+*.go          @berty/go   # inline comment @berty/ignored
+/js/          @berty/js
+docs/         @berty/docs
+/go/pkg/**/bintray* @berty/bintray
+README.md     someone@example.com
+`)
+	assert.Len(t, rules, 6)
+
+	tests := []struct {
+		name     string
+		files    []string
+		expected []string
+	}{
+		{"none", nil, []string{}},
+		{"fallback", []string{"Makefile"}, []string{"@berty/core"}},
+		{"extension", []string{"go/cmd/yolo/main.go"}, []string{"@berty/go"}},
+		{"anchored-dir", []string{"js/web/src/index.js"}, []string{"@berty/js"}},
+		{"anchored-dir-elsewhere", []string{"go/js/index.js"}, []string{"@berty/core"}},
+		{"unanchored-dir", []string{"go/docs/README"}, []string{"@berty/docs"}},
+		{"double-star", []string{"go/pkg/bintray/client.go"}, []string{"@berty/bintray"}},
+		{"no-team", []string{"README.md"}, []string{}},
+		{"multiple", []string{"js/index.js", "main.go", "docs/index.md"}, []string{"@berty/docs", "@berty/go", "@berty/js"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, codeownersTeams(rules, tt.files))
+		})
+	}
+}
@@ -44,12 +44,27 @@ func (svc *service) saveBatch(ctx context.Context, batch *yolopb.Batch) error {
 		log.Debug("saveBatch")
 	}
 
+	if svc.ownerTeamsEnabled && svc.ghc != nil {
+		svc.resolveOwnerTeams(ctx, batch.Builds)
+	}
+
+	if len(svc.sizeBudgets) > 0 {
+		svc.applySizeBudgets(ctx, batch)
+	}
+
+	svc.resolveMimetypes(batch)
+	svc.resolveChecksums(batch)
+	svc.resolveDuplicates(batch)
+	newArtifacts := svc.newInstallableArtifacts(batch)
+
 	err := svc.store.SaveBatch(batch)
 	if err != nil {
 		return err
 	}
 
 	svc.clearCache.Set()
+	svc.buildFeed.publish(updatedBuildIDs(batch))
+	svc.notifyNewArtifacts(ctx, newArtifacts)
 
 	return nil
 }
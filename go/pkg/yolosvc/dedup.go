@@ -0,0 +1,96 @@
+package yolosvc
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"go.uber.org/zap"
+	"moul.io/u"
+)
+
+// resolveDuplicates links the ingested artifacts to the first artifact with the same content, they are then mirrored only once.
+// The checksums are only known when reported by the provider or computed during a previous download.
+func (svc *service) resolveDuplicates(batch *yolopb.Batch) {
+	sums := []string{}
+	for _, artifact := range batch.Artifacts {
+		if artifact.Sha256Sum != "" {
+			sums = append(sums, artifact.Sha256Sum)
+		}
+	}
+	if len(sums) == 0 {
+		return
+	}
+
+	canonicals, err := svc.store.GetArtifactsBySHA256(sums)
+	if err != nil {
+		svc.logger.Warn("failed to get the artifacts by checksum", zap.Error(err))
+		return
+	}
+	// the duplicates of the batch are linked to the first one in the order of the store, the batch order is lost by Optimize
+	artifacts := append([]*yolopb.Artifact{}, batch.Artifacts...)
+	sort.SliceStable(artifacts, func(i, j int) bool {
+		a, b := artifacts[i].CreatedAt, artifacts[j].CreatedAt
+		if a == nil || b == nil || a.Equal(*b) {
+			if (a == nil) != (b == nil) {
+				return a == nil // sorted first by sqlite
+			}
+			return artifacts[i].ID < artifacts[j].ID
+		}
+		return a.Before(*b)
+	})
+	for _, artifact := range artifacts {
+		if artifact.Sha256Sum == "" {
+			continue
+		}
+		canonical, found := canonicals[artifact.Sha256Sum]
+		switch {
+		case !found: // the first one of the batch is kept
+			canonicals[artifact.Sha256Sum] = artifact
+		case canonical.ID != artifact.ID:
+			artifact.DuplicateOfID = canonical.ID
+		}
+	}
+}
+
+// markDuplicate links an artifact to the first one with the same content once its checksums are computed
+func (svc *service) markDuplicate(artifact *yolopb.Artifact) {
+	canonicals, err := svc.store.GetArtifactsBySHA256([]string{artifact.Sha256Sum})
+	if err != nil {
+		svc.logger.Warn("failed to get the artifacts by checksum", zap.Error(err))
+		return
+	}
+	canonical, found := canonicals[artifact.Sha256Sum]
+	if !found || canonical.ID == artifact.ID {
+		return
+	}
+	if err := svc.store.SetArtifactDuplicateOf(artifact.ID, canonical.ID); err != nil {
+		svc.logger.Warn("failed to save duplicate", zap.String("artifact", artifact.ID), zap.Error(err))
+		return
+	}
+	artifact.DuplicateOfID = canonical.ID
+
+	// the content was already mirrored under its own name
+	if svc.artifactsCachePath == "" {
+		return
+	}
+	own := filepath.Join(svc.artifactsCachePath, artifact.ID)
+	shared := filepath.Join(svc.artifactsCachePath, canonical.ID)
+	if u.FileExists(shared) {
+		err = os.Remove(own)
+	} else {
+		err = os.Rename(own, shared)
+	}
+	if err != nil && !os.IsNotExist(err) {
+		svc.logger.Warn("failed to share the mirrored duplicate", zap.String("artifact", artifact.ID), zap.Error(err))
+	}
+}
+
+// artifactCacheKey returns the name of the mirrored file of an artifact, shared with the artifacts of the same content
+func artifactCacheKey(artifact *yolopb.Artifact) string {
+	if artifact.DuplicateOfID != "" {
+		return artifact.DuplicateOfID
+	}
+	return artifact.ID
+}
@@ -0,0 +1,96 @@
+package yolosvc
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveDuplicates(t *testing.T) {
+	cachePath := t.TempDir()
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t), ArtifactsCachePath: cachePath})
+	defer cleanup()
+	svc := api.(*service)
+	ctx := context.Background()
+
+	ingest := func(buildID string, artifacts ...*yolopb.Artifact) {
+		for _, artifact := range artifacts {
+			artifact.HasBuildID = buildID
+			artifact.Kind = yolopb.Artifact_APK
+		}
+		batch := &yolopb.Batch{Builds: []*yolopb.Build{{ID: buildID, State: yolopb.Build_Passed, HasMergerequestID: testMergeRequestID}}, Artifacts: artifacts}
+		require.NoError(t, svc.saveBatch(ctx, batch))
+	}
+	duplicateOf := func(id string) string {
+		artifact, err := svc.store.GetArtifactByID(id)
+		require.NoError(t, err)
+		return artifact.DuplicateOfID
+	}
+
+	// the same file ingested by a re-run is linked to the first one
+	ingest("run-1", &yolopb.Artifact{ID: "first", Sha256Sum: helloSHA256})
+	ingest("run-2", &yolopb.Artifact{ID: "rerun", Sha256Sum: helloSHA256})
+	assert.Empty(t, duplicateOf("first"))
+	assert.Equal(t, "first", duplicateOf("rerun"))
+
+	// both builds list their artifact, sharing the same mirrored file
+	resp, err := svc.BuildList(ctx, &yolopb.BuildList_Request{BuildID: []string{"run-1", "run-2"}})
+	require.NoError(t, err)
+	require.Len(t, resp.Builds, 2)
+	for _, build := range resp.Builds {
+		require.Len(t, build.HasArtifacts, 1)
+		assert.Equal(t, "first", artifactCacheKey(build.HasArtifacts[0]))
+	}
+
+	// the link is kept when the drivers refresh the artifacts
+	ingest("run-2", &yolopb.Artifact{ID: "rerun", Sha256Sum: helloSHA256})
+	ingest("run-1", &yolopb.Artifact{ID: "first", Sha256Sum: helloSHA256})
+	assert.Empty(t, duplicateOf("first"))
+	assert.Equal(t, "first", duplicateOf("rerun"))
+
+	// duplicates of the same batch
+	ingest("run-3", &yolopb.Artifact{ID: "batch-1", Sha256Sum: "abcd"}, &yolopb.Artifact{ID: "batch-2", Sha256Sum: "abcd"})
+	assert.Empty(t, duplicateOf("batch-1"))
+	assert.Equal(t, "batch-1", duplicateOf("batch-2"))
+
+	// the checksums computed on the first download also reveal duplicates
+	ingest("run-4", &yolopb.Artifact{ID: "unhashed"})
+	require.NoError(t, os.WriteFile(filepath.Join(cachePath, "unhashed"), []byte("hello"), 0o600))
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("artifactID", "unhashed")
+	r := httptest.NewRequest("GET", "/", nil)
+	r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+	w := httptest.NewRecorder()
+	svc.ArtifactChecksums(w, r)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "first", duplicateOf("unhashed"))
+	assert.NoFileExists(t, filepath.Join(cachePath, "unhashed"))
+	content, err := os.ReadFile(filepath.Join(cachePath, "first"))
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(content))
+
+	// the unsigned IPAs are re-signed from the mirrored file of their original
+	fetched := 0
+	provider := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetched++
+		_, _ = w.Write([]byte("hello"))
+	}))
+	defer provider.Close()
+	ingest("run-5", &yolopb.Artifact{ID: "ipa-rerun", LocalPath: "app.unsigned-ipa", Sha256Sum: helloSHA256, Driver: yolopb.Driver_HTTP, DownloadURL: provider.URL})
+	ipa, err := svc.store.GetArtifactByID("ipa-rerun")
+	require.NoError(t, err)
+	require.Equal(t, "first", ipa.DuplicateOfID)
+	_ = svc.signAndStreamIPA(ctx, *ipa, io.Discard) // zsign fails on the test content, after reading the unsigned IPA
+	assert.Equal(t, 0, fetched)
+	assert.NoFileExists(t, filepath.Join(cachePath, "ipa-rerun"))
+}
@@ -0,0 +1,206 @@
+package yolosvc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/azure"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/tevino/abool"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+type AzurePipelinesWorkerOpts struct {
+	Logger     *zap.Logger
+	MaxBuilds  int
+	LoopAfter  time.Duration
+	ClearCache *abool.AtomicBool
+	Once       bool
+	// Projects are the Azure DevOps projects whose pipeline runs are fetched, by name or ID
+	Projects []string
+}
+
+// AzurePipelinesWorker goals is to manage the Azure Pipelines update routine, it should try to support as much errors as possible by itself
+func (svc *service) AzurePipelinesWorker(ctx context.Context, opts AzurePipelinesWorkerOpts) error {
+	opts.applyDefaults()
+
+	logger := opts.Logger.Named("azur")
+	breaker := svc.breakers.get(yolopb.Driver_AzurePipelines)
+
+	for iteration := 0; ; iteration++ {
+		if !breaker.waitAllowed(ctx) {
+			return nil
+		}
+		since, err := lastBuildCreatedTime(ctx, svc.store, yolopb.Driver_AzurePipelines)
+		if err != nil {
+			logger.Warn("get last azure pipelines build created time", zap.Error(err))
+		}
+		logger.Debug("azure pipelines: refresh", zap.Int("iteration", iteration), zap.Time("since", since))
+		failed := false
+		var fetchErr error
+		batch := yolopb.NewBatch()
+		for _, project := range opts.Projects {
+			// the recently finished runs, and the running ones even if they are already known (to update their state)
+			for _, listOpts := range []azure.ListBuildsOptions{
+				{Top: opts.MaxBuilds, MinTime: since},
+				{Top: opts.MaxBuilds, StatusFilter: "inProgress,notStarted,cancelling,postponed"},
+			} {
+				projectBatch, err := svc.traceFetch(ctx, yolopb.Driver_AzurePipelines, func(ctx context.Context) (*yolopb.Batch, error) {
+					return fetchAzureBuilds(ctx, svc.azc, project, listOpts, logger)
+				}, attribute.String("yolo.project", project))
+				if err != nil {
+					logger.Warn("fetch azure pipelines", zap.String("project", project), zap.Error(err))
+					failed = true
+					fetchErr = err
+					continue
+				}
+				batch.Merge(projectBatch)
+			}
+		}
+		if err := svc.saveBatch(ctx, batch); err != nil {
+			logger.Warn("save batch", zap.Error(err))
+		} else if !failed {
+			svc.metrics.refreshed(yolopb.Driver_AzurePipelines)
+		}
+		breaker.record(fetchErr)
+
+		if opts.Once {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(withJitter(opts.LoopAfter)):
+		}
+	}
+}
+
+func fetchAzureBuilds(ctx context.Context, azc *azure.Client, project string, opts azure.ListBuildsOptions, logger *zap.Logger) (*yolopb.Batch, error) {
+	batch := yolopb.NewBatch()
+	before := time.Now()
+	builds, err := azc.ListBuilds(ctx, project, opts)
+	if err != nil {
+		return nil, fmt.Errorf("list builds: %w", err)
+	}
+	logger.Debug("azure.ListBuilds", zap.String("project", project), zap.Int("builds", len(builds)), zap.Duration("duration", time.Since(before)))
+
+	for _, build := range builds {
+		newBuild := azureBuildToBuild(azc.OrgURL(), build)
+		batch.Builds = append(batch.Builds, newBuild)
+
+		artifacts, err := azc.ListBuildArtifacts(ctx, project, build.ID)
+		if err != nil {
+			return nil, fmt.Errorf("list build artifacts: %w", err)
+		}
+		logger.Debug("azure.ListBuildArtifacts", zap.Int("build", build.ID), zap.Int("len", len(artifacts)))
+		batch.Artifacts = append(batch.Artifacts, azureArtifactsToArtifacts(build, newBuild, artifacts)...)
+	}
+	return batch, nil
+}
+
+func azureBuildToBuild(orgURL string, build *azure.Build) *yolopb.Build {
+	buildID := build.Links.Web.Href
+	if buildID == "" {
+		buildID = fmt.Sprintf("%s/%s/_build/results?buildId=%d", orgURL, build.Project.Name, build.ID)
+	}
+	newBuild := yolopb.Build{
+		ID:             buildID,
+		ShortID:        build.BuildNumber,
+		CreatedAt:      build.QueueTime,
+		StartedAt:      build.StartTime,
+		FinishedAt:     build.FinishTime,
+		Branch:         strings.TrimPrefix(build.SourceBranch, "refs/heads/"),
+		Message:        build.TriggerInfo["ci.message"],
+		HasCommitID:    build.SourceVersion,
+		HasRawCommitID: build.SourceVersion,
+		State:          azureBuildState(build.Status, build.Result),
+		Driver:         yolopb.Driver_AzurePipelines,
+	}
+	if strings.HasPrefix(build.SourceBranch, "refs/tags/") {
+		newBuild.VCSTag = strings.TrimPrefix(build.SourceBranch, "refs/tags/")
+		newBuild.Branch = newBuild.VCSTag
+	}
+	// the GitHub repositories are shared with the GitHub driver, by their URL
+	if build.Repository.Type == "GitHub" {
+		newBuild.HasProjectID = "https://github.com/" + build.Repository.ID
+		if build.SourceVersion != "" {
+			newBuild.CommitURL = newBuild.HasProjectID + "/commit/" + build.SourceVersion
+		}
+	} else {
+		newBuild.HasProjectID = build.Repository.URL
+	}
+	newBuild.HasRawProjectID = newBuild.HasProjectID
+
+	guessMissingBuildInfo(&newBuild)
+	return &newBuild
+}
+
+// azureBuildState normalizes the status and the result of an Azure Pipelines build
+func azureBuildState(status, result string) yolopb.Build_State {
+	switch status {
+	case "notStarted", "postponed":
+		return yolopb.Build_Scheduled
+	case "inProgress":
+		return yolopb.Build_Running
+	case "cancelling":
+		return yolopb.Build_Canceled
+	case "completed":
+		switch result {
+		case "succeeded", "partiallySucceeded": // some steps failed but were allowed to, the artifacts are published
+			return yolopb.Build_Passed
+		case "failed":
+			return yolopb.Build_Failed
+		case "canceled":
+			return yolopb.Build_Canceled
+		}
+	}
+	return yolopb.Build_UnknownState
+}
+
+// azureArtifactsToArtifacts maps the published artifacts, like on GitHub, an artifact is downloaded as a .zip of a single file named like the artifact
+func azureArtifactsToArtifacts(build *azure.Build, newBuild *yolopb.Build, artifacts []*azure.BuildArtifact) []*yolopb.Artifact {
+	// the artifacts have no timestamp, they are published by the build steps
+	createdAt := build.FinishTime
+	if createdAt == nil {
+		createdAt = build.QueueTime
+	}
+	ret := []*yolopb.Artifact{}
+	for _, artifact := range artifacts {
+		if artifact.Resource.DownloadURL == "" {
+			continue
+		}
+		ret = append(ret, &yolopb.Artifact{
+			ID:          fmt.Sprintf("azure_%d_%d", build.ID, artifact.ID),
+			CreatedAt:   createdAt,
+			LocalPath:   artifact.Name,
+			DownloadURL: artifact.Resource.DownloadURL,
+			HasBuildID:  newBuild.ID,
+			Driver:      yolopb.Driver_AzurePipelines,
+			Kind:        artifactKindByPath(artifact.Name),
+			Variant:     artifactVariantByPath(artifact.Name),
+			Arch:        artifactArchByPath(artifact.Name),
+			MimeType:    mimetypeByPath(artifact.Name),
+			State:       yolopb.Artifact_Finished,
+		})
+	}
+	return ret
+}
+
+func (o *AzurePipelinesWorkerOpts) applyDefaults() {
+	if o.Logger == nil {
+		o.Logger = zap.NewNop()
+	}
+	if o.MaxBuilds == 0 {
+		o.MaxBuilds = 100
+	}
+	if o.LoopAfter == 0 {
+		o.LoopAfter = time.Minute
+	}
+	if o.ClearCache == nil {
+		o.ClearCache = abool.New()
+	}
+}
@@ -0,0 +1,106 @@
+package yolosvc
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/azure"
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAzureBuildToBatch(t *testing.T) {
+	queued := time.Date(2022, 9, 1, 0, 0, 0, 0, time.UTC)
+	finished := queued.Add(10 * time.Minute)
+	build := &azure.Build{
+		ID:            42,
+		BuildNumber:   "20220901.1",
+		Status:        "completed",
+		Result:        "partiallySucceeded",
+		QueueTime:     &queued,
+		FinishTime:    &finished,
+		SourceBranch:  "refs/heads/main",
+		SourceVersion: "0123abcdef",
+		Repository:    azure.Repository{ID: "berty/berty", Type: "GitHub"},
+		TriggerInfo:   map[string]string{"ci.message": "feat: azure"},
+		Links:         azure.Links{Web: azure.Link{Href: "https://dev.azure.com/berty/yolo/_build/results?buildId=42"}},
+	}
+
+	newBuild := azureBuildToBuild("https://dev.azure.com/berty", build)
+	assert.Equal(t, "https://dev.azure.com/berty/yolo/_build/results?buildId=42", newBuild.ID)
+	assert.Equal(t, "20220901.1", newBuild.ShortID)
+	assert.Equal(t, "main", newBuild.Branch)
+	assert.Equal(t, "feat: azure", newBuild.Message)
+	assert.Equal(t, "https://github.com/berty/berty", newBuild.HasProjectID)
+	assert.Equal(t, "https://github.com/berty/berty/commit/0123abcdef", newBuild.CommitURL)
+	assert.Equal(t, yolopb.Build_Passed, newBuild.State)
+	assert.Equal(t, yolopb.Driver_AzurePipelines, newBuild.Driver)
+
+	artifacts := azureArtifactsToArtifacts(build, newBuild, []*azure.BuildArtifact{
+		{ID: 1, Name: "berty.apk", Resource: azure.ArtifactResource{Type: "PipelineArtifact", DownloadURL: "https://dev.azure.com/berty/download/1"}},
+		{ID: 2, Name: "logs"}, // not downloadable
+	})
+	require.Len(t, artifacts, 1)
+	assert.Equal(t, "azure_42_1", artifacts[0].ID)
+	assert.Equal(t, yolopb.Artifact_APK, artifacts[0].Kind)
+	assert.Equal(t, newBuild.ID, artifacts[0].HasBuildID)
+	assert.Equal(t, &finished, artifacts[0].CreatedAt)
+
+	build.Links = azure.Links{}
+	build.Project = azure.Project{Name: "yolo"}
+	assert.Equal(t, "https://dev.azure.com/berty/yolo/_build/results?buildId=42", azureBuildToBuild("https://dev.azure.com/berty", build).ID)
+
+	build.SourceBranch = "refs/tags/v1.2.3"
+	assert.Equal(t, "v1.2.3", azureBuildToBuild("https://dev.azure.com/berty", build).VCSTag)
+}
+
+func TestAzureBuildState(t *testing.T) {
+	assert.Equal(t, yolopb.Build_Scheduled, azureBuildState("notStarted", ""))
+	assert.Equal(t, yolopb.Build_Running, azureBuildState("inProgress", ""))
+	assert.Equal(t, yolopb.Build_Canceled, azureBuildState("cancelling", ""))
+	assert.Equal(t, yolopb.Build_Passed, azureBuildState("completed", "succeeded"))
+	assert.Equal(t, yolopb.Build_Failed, azureBuildState("completed", "failed"))
+	assert.Equal(t, yolopb.Build_Canceled, azureBuildState("completed", "canceled"))
+	assert.Equal(t, yolopb.Build_UnknownState, azureBuildState("completed", "none"))
+}
+
+func TestAzureDownload(t *testing.T) {
+	// the archives have the file in a directory named like the artifact
+	var archive bytes.Buffer
+	zw := zip.NewWriter(&archive)
+	_, err := zw.Create("berty.apk/")
+	require.NoError(t, err)
+	f, err := zw.Create("berty.apk/berty.apk")
+	require.NoError(t, err)
+	_, err = f.Write([]byte("apk content"))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, pat, _ := r.BasicAuth(); pat != "pat" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/zip")
+		_, _ = w.Write(archive.Bytes())
+	}))
+	defer server.Close()
+	azc, err := azure.New(server.URL+"/berty", "pat")
+	require.NoError(t, err)
+
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t), AzureClient: azc})
+	defer cleanup()
+	svc := api.(*service)
+
+	var buf bytes.Buffer
+	err = svc.artifactDownloadFromProvider(context.Background(), &yolopb.Artifact{ID: "azure_42_1", LocalPath: "berty.apk", Driver: yolopb.Driver_AzurePipelines, DownloadURL: server.URL + "/berty/download"}, &buf)
+	require.NoError(t, err)
+	assert.Equal(t, "apk content", buf.String())
+}
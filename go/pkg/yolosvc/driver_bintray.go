@@ -24,18 +24,27 @@ func (svc *service) BintrayWorker(ctx context.Context, opts BintrayWorkerOpts) e
 	opts.applyDefaults()
 
 	logger := opts.Logger.Named("btry")
+	breaker := svc.breakers.get(yolopb.Driver_Bintray)
 
 	for iteration := 0; ; iteration++ {
+		if !breaker.waitAllowed(ctx) {
+			return nil
+		}
 		logger.Debug("bintray: refresh", zap.Int("iteration", iteration))
 		// FIXME: only fetch builds since most recent known
-		batch, err := fetchBintray(svc.btc, logger)
+		batch, err := svc.traceFetch(ctx, yolopb.Driver_Bintray, func(context.Context) (*yolopb.Batch, error) {
+			return fetchBintray(svc.btc, logger)
+		})
 		if err != nil {
 			logger.Warn("fetch bintray", zap.Error(err))
 		} else {
 			if err := svc.saveBatch(ctx, batch); err != nil {
 				logger.Warn("save batch", zap.Error(err))
+			} else {
+				svc.metrics.refreshed(yolopb.Driver_Bintray)
 			}
 		}
+		breaker.record(err)
 
 		if opts.Once {
 			return nil
@@ -44,7 +53,7 @@ func (svc *service) BintrayWorker(ctx context.Context, opts BintrayWorkerOpts) e
 		select {
 		case <-ctx.Done():
 			return nil
-		case <-time.After(opts.LoopAfter):
+		case <-time.After(withJitter(opts.LoopAfter)):
 		}
 	}
 }
@@ -159,6 +168,8 @@ func bintrayFilesToBatch(files bintray.GetPackageFilesResponse) *yolopb.Batch {
 			State:       yolopb.Artifact_Finished,
 			Driver:      yolopb.Driver_Bintray,
 			Kind:        artifactKindByPath(file.Path),
+			Variant:     artifactVariantByPath(file.Path),
+			Arch:        artifactArchByPath(file.Path),
 			MimeType:    mimetypeByPath(file.Path),
 		}
 		batch.Artifacts = append(batch.Artifacts, &newArtifact)
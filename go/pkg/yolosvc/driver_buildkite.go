@@ -10,6 +10,7 @@ import (
 	"berty.tech/yolo/v2/go/pkg/yolopb"
 	"github.com/buildkite/go-buildkite/buildkite"
 	"github.com/tevino/abool"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 )
 
@@ -30,23 +31,38 @@ func (svc *service) BuildkiteWorker(ctx context.Context, opts BuildkiteWorkerOpt
 		maxPages = int(math.Ceil(float64(opts.MaxBuilds) / 30))
 	)
 
+	refreshRequests, release := svc.listenRefreshRequests(yolopb.Driver_Buildkite)
+	defer release()
+	var refresh refreshRequest // set by the webhooks and the admin refreshes
+	breaker := svc.breakers.get(yolopb.Driver_Buildkite)
+
 	for iteration := 0; ; iteration++ {
+		if !breaker.waitAllowed(ctx) {
+			return nil
+		}
 		since, err := lastBuildCreatedTime(ctx, svc.store, yolopb.Driver_Buildkite)
 		if err != nil {
 			logger.Warn("get last buildkite build created time", zap.Error(err))
 		}
 		logger.Debug("buildkite: refresh", zap.Int("iteration", iteration), zap.Time("since", since))
 
+		var fetchErr error
+
 		// fetch recent builds
 		callOpts := buildkite.BuildsListOptions{
 			FinishedFrom: since,
 		}
-		batch, err := fetchBuildkiteBuilds(ctx, svc.bkc, since, maxPages, callOpts, logger)
+		batch, err := svc.traceFetch(ctx, yolopb.Driver_Buildkite, func(ctx context.Context) (*yolopb.Batch, error) {
+			return fetchBuildkiteBuilds(ctx, svc.bkc, since, maxPages, callOpts, svc.buildkiteFilter, svc.buildConfigKeys, logger)
+		})
 		if err != nil {
 			logger.Warn("fetch buildkite", zap.Error(err))
+			fetchErr = err
 		} else {
 			if err := svc.saveBatch(ctx, batch); err != nil {
 				logger.Warn("save batch", zap.Error(err))
+			} else {
+				svc.metrics.refreshed(yolopb.Driver_Buildkite)
 			}
 		}
 
@@ -54,30 +70,41 @@ func (svc *service) BuildkiteWorker(ctx context.Context, opts BuildkiteWorkerOpt
 		callOpts = buildkite.BuildsListOptions{
 			State: []string{"running", "scheduled"},
 		}
-		batch, err = fetchBuildkiteBuilds(ctx, svc.bkc, since, maxPages, callOpts, logger)
+		batch, err = svc.traceFetch(ctx, yolopb.Driver_Buildkite, func(ctx context.Context) (*yolopb.Batch, error) {
+			return fetchBuildkiteBuilds(ctx, svc.bkc, since, maxPages, callOpts, svc.buildkiteFilter, svc.buildConfigKeys, logger)
+		}, attribute.Bool("yolo.running", true))
 		if err != nil {
 			logger.Warn("fetch buildkite", zap.Error(err))
+			fetchErr = err
 		} else {
 			if err := svc.saveBatch(ctx, batch); err != nil {
 				logger.Warn("save batch", zap.Error(err))
+			} else {
+				svc.metrics.refreshed(yolopb.Driver_Buildkite)
 			}
 		}
 
 		// FIXME: fetch artifacts for builds with job that are successful and have a not empty artifact path
+		breaker.record(fetchErr)
+		refresh.finish()
 
 		if opts.Once {
 			return nil
 		}
 
+		refresh = refreshRequest{}
 		select {
 		case <-ctx.Done():
 			return nil
-		case <-time.After(opts.LoopAfter):
+		case <-time.After(withJitter(opts.LoopAfter)):
+		case refresh = <-refreshRequests:
+			refresh.receive()
+			logger.Debug("refresh requested", zap.String("project", refresh.project))
 		}
 	}
 }
 
-func fetchBuildkiteBuilds(ctx context.Context, bkc *buildkite.Client, since time.Time, maxPages int, callOpts buildkite.BuildsListOptions, logger *zap.Logger) (*yolopb.Batch, error) {
+func fetchBuildkiteBuilds(ctx context.Context, bkc *buildkite.Client, since time.Time, maxPages int, callOpts buildkite.BuildsListOptions, filter buildkiteFilter, configKeys []string, logger *zap.Logger) (*yolopb.Batch, error) {
 	batch := yolopb.NewBatch()
 	total := 0
 	for i := 0; i < maxPages; i++ {
@@ -88,6 +115,7 @@ func fetchBuildkiteBuilds(ctx context.Context, bkc *buildkite.Client, since time
 		}
 		total += len(builds)
 		logger.Debug("buildkite.Builds.List", zap.Int("total", total), zap.Duration("duration", time.Since(before)))
+		builds = filter.apply(builds)
 		for _, build := range builds {
 			hasArtifacts := false
 			for _, job := range build.Jobs {
@@ -114,7 +142,7 @@ func fetchBuildkiteBuilds(ctx context.Context, bkc *buildkite.Client, since time
 			}
 		}
 		for _, build := range builds {
-			batch.Builds = append(batch.Builds, buildFromBuildkiteBuild(build, logger))
+			batch.Builds = append(batch.Builds, buildFromBuildkiteBuild(build, configKeys, logger))
 		}
 		if resp.NextPage == 0 {
 			break
@@ -124,7 +152,60 @@ func fetchBuildkiteBuilds(ctx context.Context, bkc *buildkite.Client, since time
 	return batch, nil
 }
 
-func buildFromBuildkiteBuild(build buildkite.Build, logger *zap.Logger) *yolopb.Build {
+// buildkiteFilter restricts the ingested builds to some pipelines and branches, empty sets allow everything
+type buildkiteFilter struct {
+	pipelines map[string]bool
+	branches  map[string]bool
+}
+
+func newBuildkiteFilter(pipelines, branches []string) buildkiteFilter {
+	set := func(values []string) map[string]bool {
+		ret := map[string]bool{}
+		for _, value := range values {
+			if value = strings.TrimSpace(value); value != "" {
+				ret[value] = true
+			}
+		}
+		return ret
+	}
+	return buildkiteFilter{pipelines: set(pipelines), branches: set(branches)}
+}
+
+// apply returns the builds allowed by the filter
+func (f buildkiteFilter) apply(builds []buildkite.Build) []buildkite.Build {
+	if len(f.pipelines) == 0 && len(f.branches) == 0 {
+		return builds
+	}
+	ret := []buildkite.Build{}
+	for _, build := range builds {
+		if f.allows(build) {
+			ret = append(ret, build)
+		}
+	}
+	return ret
+}
+
+func (f buildkiteFilter) allows(build buildkite.Build) bool {
+	if len(f.pipelines) > 0 {
+		slug := ""
+		if build.Pipeline != nil && build.Pipeline.Slug != nil {
+			slug = *build.Pipeline.Slug
+		} else if build.WebURL != nil {
+			if parts := strings.Split(*build.WebURL, "/"); len(parts) > 4 {
+				slug = parts[4]
+			}
+		}
+		if !f.pipelines[slug] {
+			return false
+		}
+	}
+	if len(f.branches) > 0 && (build.Branch == nil || !f.branches[*build.Branch]) {
+		return false
+	}
+	return true
+}
+
+func buildFromBuildkiteBuild(build buildkite.Build, configKeys []string, logger *zap.Logger) *yolopb.Build {
 	newBuild := yolopb.Build{
 		ID:          *build.WebURL,
 		ShortID:     fmt.Sprintf("%d", *build.Number),
@@ -133,7 +214,20 @@ func buildFromBuildkiteBuild(build buildkite.Build, logger *zap.Logger) *yolopb.
 		HasCommitID: *build.Commit,
 		Branch:      *build.Branch,
 		Driver:      yolopb.Driver_Buildkite,
-		// FIXME: Creator: build.Creator...
+	}
+	// the creator is the author of the commit for the webhook-triggered builds
+	if build.Creator != nil {
+		newBuild.CommitAuthor = build.Creator.Name
+		newBuild.CommitEmail = build.Creator.Email
+		newBuild.CommitAuthorAvatarURL = build.Creator.AvatarURL
+	}
+
+	if len(build.Env) > 0 {
+		env := map[string]string{}
+		for key, value := range build.Env {
+			env[key] = fmt.Sprint(value)
+		}
+		newBuild.BuildConfig = buildConfigFromEnv(env, configKeys)
 	}
 
 	switch provider := build.Pipeline.Provider.ID; provider {
@@ -160,22 +254,8 @@ func buildFromBuildkiteBuild(build buildkite.Build, logger *zap.Logger) *yolopb.
 	if build.StartedAt != nil {
 		newBuild.StartedAt = &build.StartedAt.Time
 	}
-	switch *build.State {
-	case "running":
-		newBuild.State = yolopb.Build_Running
-	case "failed":
-		newBuild.State = yolopb.Build_Failed
-	case "passed":
-		newBuild.State = yolopb.Build_Passed
-	case "not_run":
-		newBuild.State = yolopb.Build_NotRun
-	case "skipped":
-		newBuild.State = yolopb.Build_Skipped
-	case "canceled":
-		newBuild.State = yolopb.Build_Canceled
-	case "scheduled":
-		newBuild.State = yolopb.Build_Scheduled
-	default:
+	newBuild.State = buildkiteBuildState(*build.State)
+	if newBuild.State == yolopb.Build_UnknownState {
 		fmt.Println("unknown state: ", *build.State)
 	}
 
@@ -187,7 +267,7 @@ func artifactFromBuildkiteArtifact(artifact buildkite.Artifact, build buildkite.
 	id := "buildkite_" + md5Sum([]byte(*artifact.DownloadURL))
 	newArtifact := yolopb.Artifact{
 		ID:          id,
-		CreatedAt:   &build.CreatedAt.Time,
+		CreatedAt:   buildkiteArtifactCreatedAt(artifact, build),
 		FileSize:    *artifact.FileSize,
 		LocalPath:   *artifact.Path,
 		DownloadURL: *artifact.DownloadURL,
@@ -195,6 +275,8 @@ func artifactFromBuildkiteArtifact(artifact buildkite.Artifact, build buildkite.
 		// FIXME: hasRelease
 		Driver:   yolopb.Driver_Buildkite,
 		Kind:     artifactKindByPath(*artifact.Path),
+		Variant:  artifactVariantByPath(*artifact.Path),
+		Arch:     artifactArchByPath(*artifact.Path),
 		MimeType: mimetypeByPath(*artifact.Path), // *artifact.MimeType,
 		// FIXME: Sha1Sum:     *artifact.Sha1Sum,
 	}
@@ -214,6 +296,40 @@ func artifactFromBuildkiteArtifact(artifact buildkite.Artifact, build buildkite.
 	return &newArtifact
 }
 
+// buildkiteBuildState normalizes the state of a Buildkite build
+func buildkiteBuildState(state string) yolopb.Build_State {
+	switch state {
+	case "running", "failing": // failing builds are still running
+		return yolopb.Build_Running
+	case "failed":
+		return yolopb.Build_Failed
+	case "passed":
+		return yolopb.Build_Passed
+	case "not_run":
+		return yolopb.Build_NotRun
+	case "skipped":
+		return yolopb.Build_Skipped
+	case "canceled", "canceling":
+		return yolopb.Build_Canceled
+	case "scheduled", "creating", "blocked": // blocked builds wait for a manual unblock
+		return yolopb.Build_Scheduled
+	default:
+		return yolopb.Build_UnknownState
+	}
+}
+
+// buildkiteArtifactCreatedAt returns the end of the job uploading an artifact, the artifacts have no timestamp of their own
+func buildkiteArtifactCreatedAt(artifact buildkite.Artifact, build buildkite.Build) *time.Time {
+	if artifact.JobID != nil {
+		for _, job := range build.Jobs {
+			if job.ID != nil && *job.ID == *artifact.JobID && job.FinishedAt != nil {
+				return &job.FinishedAt.Time
+			}
+		}
+	}
+	return &build.CreatedAt.Time
+}
+
 func (o *BuildkiteWorkerOpts) applyDefaults() {
 	if o.Logger == nil {
 		o.Logger = zap.NewNop()
@@ -0,0 +1,64 @@
+package yolosvc
+
+import (
+	"testing"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/buildkite/go-buildkite/buildkite"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildkiteFilter(t *testing.T) {
+	build := func(pipeline, branch string) buildkite.Build {
+		return buildkite.Build{
+			WebURL: buildkite.String("https://buildkite.com/berty/" + pipeline + "/builds/42"),
+			Branch: buildkite.String(branch),
+		}
+	}
+	builds := []buildkite.Build{build("berty", "master"), build("berty", "feat"), build("yolo", "master")}
+
+	// empty lists ingest everything, including the empty entries of the CLI flags
+	assert.Len(t, newBuildkiteFilter(nil, nil).apply(builds), 3)
+	assert.Len(t, newBuildkiteFilter([]string{""}, []string{""}).apply(builds), 3)
+
+	assert.Equal(t, builds[:2], newBuildkiteFilter([]string{"berty"}, nil).apply(builds))
+	assert.Equal(t, []buildkite.Build{builds[0], builds[2]}, newBuildkiteFilter(nil, []string{"master"}).apply(builds))
+	assert.Equal(t, builds[:1], newBuildkiteFilter([]string{"berty"}, []string{"master"}).apply(builds))
+
+	// the pipeline slug is preferred to the URL
+	withPipeline := build("berty", "master")
+	withPipeline.Pipeline = &buildkite.Pipeline{Slug: buildkite.String("other")}
+	assert.Empty(t, newBuildkiteFilter([]string{"berty"}, nil).apply([]buildkite.Build{withPipeline}))
+}
+
+func TestBuildkiteArtifactCreatedAt(t *testing.T) {
+	created := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+	finished := created.Add(10 * time.Minute)
+	build := buildkite.Build{
+		CreatedAt: &buildkite.Timestamp{Time: created},
+		Jobs: []*buildkite.Job{
+			{ID: buildkite.String("job-1")},
+			{ID: buildkite.String("job-2"), FinishedAt: &buildkite.Timestamp{Time: finished}},
+		},
+	}
+
+	assert.Equal(t, finished, *buildkiteArtifactCreatedAt(buildkite.Artifact{JobID: buildkite.String("job-2")}, build))
+	// unfinished or unknown jobs fall back to the build creation
+	assert.Equal(t, created, *buildkiteArtifactCreatedAt(buildkite.Artifact{JobID: buildkite.String("job-1")}, build))
+	assert.Equal(t, created, *buildkiteArtifactCreatedAt(buildkite.Artifact{}, build))
+}
+
+func TestBuildkiteBuildState(t *testing.T) {
+	for state, expected := range map[string]yolopb.Build_State{
+		"running":   yolopb.Build_Running,
+		"failing":   yolopb.Build_Running,
+		"passed":    yolopb.Build_Passed,
+		"failed":    yolopb.Build_Failed,
+		"canceling": yolopb.Build_Canceled,
+		"blocked":   yolopb.Build_Scheduled,
+		"unknown":   yolopb.Build_UnknownState,
+	} {
+		assert.Equal(t, expected, buildkiteBuildState(state), state)
+	}
+}
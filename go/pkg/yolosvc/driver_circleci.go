@@ -3,6 +3,10 @@ package yolosvc
 import (
 	"context"
 	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
 	"time"
 
 	"berty.tech/yolo/v2/go/pkg/yolopb"
@@ -21,104 +25,206 @@ type CircleciWorkerOpts struct {
 
 const circleciMaxPerPage = 30
 
+// circleciInitialFetchConcurrency is the number of pages fetched at the same time to fill an empty database
+const circleciInitialFetchConcurrency = 5
+
 // CircleciWorker goals is to manage the github update routine, it should try to support as much errors as possible by itself
 func (svc *service) CircleciWorker(ctx context.Context, opts CircleciWorkerOpts) error {
 	opts.applyDefaults()
 
 	logger := opts.Logger.Named("circ")
 
+	refreshRequests, release := svc.listenRefreshRequests(yolopb.Driver_CircleCI)
+	defer release()
+	var refresh refreshRequest // set by the webhooks and the admin refreshes
+	breaker := svc.breakers.get(yolopb.Driver_CircleCI)
+
 	for iteration := 0; ; iteration++ {
+		if !breaker.waitAllowed(ctx) {
+			return nil
+		}
 		since, err := lastBuildCreatedTime(ctx, svc.store, yolopb.Driver_CircleCI)
 		if err != nil {
 			logger.Warn("get last circleci build created time", zap.Error(err))
 		}
 		logger.Debug("circleci: refresh", zap.Int("iteration", iteration), zap.Time("since", since))
-		batch, err := fetchCircleciBuilds(svc.ccc, since, opts.MaxBuilds, logger)
+		batch, err := svc.traceFetch(ctx, yolopb.Driver_CircleCI, func(ctx context.Context) (*yolopb.Batch, error) {
+			return fetchCircleciBuilds(ctx, svc.ccc, newRetrier(logger), since, opts.MaxBuilds, svc.buildConfigKeys, logger)
+		})
 		if err != nil {
 			logger.Warn("fetch circleci", zap.Error(err))
 		} else {
 			if err := svc.saveBatch(ctx, batch); err != nil {
 				logger.Warn("save batch", zap.Error(err))
+			} else {
+				svc.metrics.refreshed(yolopb.Driver_CircleCI)
 			}
 		}
+		breaker.record(err)
 		// FIXME: fetch artifacts for builds with job that are successful and have a not empty artifact path
+		refresh.finish()
 
 		if opts.Once {
 			return nil
 		}
 
+		refresh = refreshRequest{}
 		select {
 		case <-ctx.Done():
 			return nil
-		case <-time.After(opts.LoopAfter):
+		case <-time.After(withJitter(opts.LoopAfter)):
+		case refresh = <-refreshRequests:
+			refresh.receive()
+			logger.Debug("refresh requested", zap.String("project", refresh.project))
 		}
 	}
 }
 
-func fetchCircleciBuilds(ccc *circleci.Client, since time.Time, maxBuilds int, logger *zap.Logger) (*yolopb.Batch, error) {
+// circleciClient is the subset of the CircleCI API used by the worker
+type circleciClient interface {
+	ListRecentBuilds(limit, offset int) ([]*circleci.Build, error)
+	ListBuildArtifacts(account, repo string, buildNum int) ([]*circleci.Artifact, error)
+}
+
+func fetchCircleciBuilds(ctx context.Context, ccc circleciClient, retry retrier, since time.Time, maxBuilds int, configKeys []string, logger *zap.Logger) (*yolopb.Batch, error) {
+	perPage := maxBuilds
+	if perPage > circleciMaxPerPage {
+		perPage = circleciMaxPerPage
+	}
+	if since.IsZero() {
+		return fetchCircleciInitialBuilds(ctx, ccc, retry, maxBuilds, perPage, configKeys, logger)
+	}
+
+	// the next fetches stop when reaching the already known builds
 	batch := yolopb.NewBatch()
-	if since.IsZero() { // initial fetch
-		// FIXME: handle circleciMaxPerPage
-		before := time.Now()
-		builds, err := ccc.ListRecentBuilds(maxBuilds, 0)
-		if err != nil {
-			return nil, fmt.Errorf("list recent builds: %w", err)
-		}
-		logger.Debug("circleci.ListRecentBuilds", zap.Int("builds", len(builds)), zap.Duration("duration", time.Since(before)))
-		newBatch, err := handleCircleciBuilds(ccc, builds, logger)
+	for offset := 0; ; offset += perPage {
+		newBatch, listed, err := fetchCircleciPage(ctx, ccc, retry, since, perPage, offset, configKeys, logger)
 		if err != nil {
-			return nil, fmt.Errorf("handle circle builds: %w", err)
+			return nil, err
 		}
 		batch.Merge(newBatch)
-	} else { // only recents
-		perPage := maxBuilds
-		if perPage > circleciMaxPerPage {
-			perPage = circleciMaxPerPage
-		}
-		offset := 0
-
-		for {
-			before := time.Now()
-			builds, err := ccc.ListRecentBuilds(perPage, offset)
-			if err != nil {
-				return nil, fmt.Errorf("list recent builds: %w", err)
-			}
-			newBuilds := make([]*circleci.Build, len(builds))
-			i := 0
-			for _, build := range builds {
-				if build.AuthorDate != nil && build.AuthorDate.After(since) {
-					newBuilds[i] = build
-					i++
+		if listed < perPage || len(newBatch.Builds) < listed {
+			break
+		}
+	}
+	return batch, nil
+}
+
+// fetchCircleciInitialBuilds fetches the maxBuilds last builds with several pages at a time, the database is empty and
+// the dashboard has no data until they are all fetched. no new page is started once a short one reached the end.
+func fetchCircleciInitialBuilds(ctx context.Context, ccc circleciClient, retry retrier, maxBuilds, perPage int, configKeys []string, logger *zap.Logger) (*yolopb.Batch, error) {
+	if maxBuilds <= 0 {
+		return yolopb.NewBatch(), nil
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pages := (maxBuilds + perPage - 1) / perPage
+	var (
+		mutex    sync.Mutex
+		wg       sync.WaitGroup
+		batches  = make([]*yolopb.Batch, pages)
+		lastPage = pages - 1
+		firstErr error
+		slots    = make(chan struct{}, circleciInitialFetchConcurrency)
+	)
+	for page := 0; page < pages; page++ {
+		slots <- struct{}{}
+		mutex.Lock()
+		done := page > lastPage || firstErr != nil
+		mutex.Unlock()
+		if done {
+			break
+		}
+
+		offset := page * perPage
+		limit := perPage
+		if offset+limit > maxBuilds {
+			limit = maxBuilds - offset
+		}
+		wg.Add(1)
+		go func(page, offset, limit int) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			batch, listed, err := fetchCircleciPage(ctx, ccc, retry, time.Time{}, limit, offset, configKeys, logger)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			switch {
+			case err != nil:
+				if firstErr == nil {
+					firstErr = err
+					cancel() // the other pages would be dropped
 				}
-			}
-			logger.Debug("circleci.ListRecentBuilds", zap.Int("builds", len(builds)), zap.Int("new builds", i), zap.Duration("duration", time.Since(before)))
-			if i > 0 {
-				newBatch, err := handleCircleciBuilds(ccc, newBuilds, logger)
-				if err != nil {
-					return nil, fmt.Errorf("handle circle builds: %w", err)
+			default:
+				batches[page] = batch
+				if listed < limit && page < lastPage {
+					lastPage = page
 				}
-				batch.Merge(newBatch)
 			}
-			if i == 0 || i < perPage {
-				break
-			}
-			offset += perPage
-		}
+		}(page, offset, limit)
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
 	}
 
+	// merged in the order of the pages, the ones started before reaching the end are ignored
+	batch := yolopb.NewBatch()
+	for _, pageBatch := range batches[:lastPage+1] {
+		batch.Merge(pageBatch)
+	}
 	return batch, nil
 }
 
-func handleCircleciBuilds(ccc *circleci.Client, builds []*circleci.Build, logger *zap.Logger) (*yolopb.Batch, error) {
+// fetchCircleciPage fetches a page of builds with their artifacts, it returns the builds created after since and the number of listed builds.
+// the page is retried by itself, so a failure doesn't restart the fetch from the first page
+func fetchCircleciPage(ctx context.Context, ccc circleciClient, retry retrier, since time.Time, limit, offset int, configKeys []string, logger *zap.Logger) (*yolopb.Batch, int, error) {
+	before := time.Now()
+	var builds []*circleci.Build
+	err := retry.do(ctx, "circleci.ListRecentBuilds", func() error {
+		var err error
+		builds, err = ccc.ListRecentBuilds(limit, offset)
+		return err
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("list recent builds: %w", err)
+	}
+
+	newBuilds := make([]*circleci.Build, 0, len(builds))
+	for _, build := range builds {
+		if since.IsZero() || build.AuthorDate != nil && build.AuthorDate.After(since) {
+			newBuilds = append(newBuilds, build)
+		}
+	}
+	logger.Debug("circleci.ListRecentBuilds", zap.Int("offset", offset), zap.Int("builds", len(builds)), zap.Int("new builds", len(newBuilds)), zap.Duration("duration", time.Since(before)))
+	if len(newBuilds) == 0 {
+		return yolopb.NewBatch(), len(builds), nil
+	}
+	batch, err := handleCircleciBuilds(ctx, ccc, retry, newBuilds, configKeys, logger)
+	if err != nil {
+		return nil, 0, fmt.Errorf("handle circle builds: %w", err)
+	}
+	return batch, len(builds), nil
+}
+
+func handleCircleciBuilds(ctx context.Context, ccc circleciClient, retry retrier, builds []*circleci.Build, configKeys []string, logger *zap.Logger) (*yolopb.Batch, error) {
 	batch := yolopb.NewBatch()
 	for _, build := range builds {
 		if build == nil {
 			continue
 		}
-		b := circleciBuildToBatch(build)
+		b := circleciBuildToBatch(build, configKeys)
 		batch.Builds = append(batch.Builds, &b)
 
-		artifacts, err := ccc.ListBuildArtifacts(build.Username, build.Reponame, build.BuildNum)
+		var artifacts []*circleci.Artifact
+		err := retry.do(ctx, "circleci.ListBuildArtifacts", func() error {
+			var err error
+			artifacts, err = ccc.ListBuildArtifacts(build.Username, build.Reponame, build.BuildNum)
+			return err
+		})
 		if err != nil {
 			return batch, fmt.Errorf("list build artifacts: %w", err)
 		}
@@ -131,48 +237,26 @@ func handleCircleciBuilds(ccc *circleci.Client, builds []*circleci.Build, logger
 	return batch, nil
 }
 
-func circleciBuildToBatch(build *circleci.Build) yolopb.Build {
+func circleciBuildToBatch(build *circleci.Build, configKeys []string) yolopb.Build {
 	newBuild := yolopb.Build{
-		ID:          build.BuildURL,
-		ShortID:     fmt.Sprintf("%d", build.BuildNum),
-		Driver:      yolopb.Driver_CircleCI,
-		CreatedAt:   build.AuthorDate,
-		FinishedAt:  build.StopTime,
-		StartedAt:   build.StartTime,
-		Branch:      build.Branch,
-		Message:     build.Body,
-		HasCommitID: build.VcsRevision,
+		ID:           build.BuildURL,
+		ShortID:      fmt.Sprintf("%d", build.BuildNum),
+		Driver:       yolopb.Driver_CircleCI,
+		CreatedAt:    build.AuthorDate,
+		FinishedAt:   build.StopTime,
+		StartedAt:    build.StartTime,
+		Branch:       build.Branch,
+		Message:      strings.TrimSpace(build.Subject + "\n\n" + build.Body),
+		CommitAuthor: build.AuthorName,
+		CommitEmail:  build.AuthorEmail,
+		HasCommitID:  build.VcsRevision,
+		VCSTag:       build.VcsTag,
 		// FIXME: CommitURL
 		// duration
 	}
-	// FIXME: Creator: build.Creator...
-	switch build.Status {
-	case "failed":
-		newBuild.State = yolopb.Build_Failed
-	case "success":
-		newBuild.State = yolopb.Build_Passed
-	// case "retried":
-	case "canceled":
-		newBuild.State = yolopb.Build_Canceled
-	case "infrastructure_fail":
-		newBuild.State = yolopb.Build_Failed
-	case "timedout":
-		newBuild.State = yolopb.Build_Timedout
-	case "not_run":
-		newBuild.State = yolopb.Build_NotRun
-	case "running":
-		newBuild.State = yolopb.Build_Running
-	case "queued":
-		newBuild.State = yolopb.Build_Scheduled
-	case "scheduled":
-		newBuild.State = yolopb.Build_Scheduled
-	case "not_running":
-		newBuild.State = yolopb.Build_NotRun
-	case "no_tests":
-		newBuild.State = yolopb.Build_Skipped
-	case "fixed":
-		newBuild.State = yolopb.Build_Passed
-	default:
+	newBuild.BuildConfig = buildConfigFromEnv(build.BuildParameters, configKeys)
+	newBuild.State = circleciBuildState(build.Status)
+	if newBuild.State == yolopb.Build_UnknownState {
 		fmt.Println("unknown state: ", build.Status)
 	}
 
@@ -180,18 +264,49 @@ func circleciBuildToBatch(build *circleci.Build) yolopb.Build {
 	return newBuild
 }
 
+// circleciBuildState normalizes the status of a CircleCI build
+func circleciBuildState(status string) yolopb.Build_State {
+	switch status {
+	case "failed", "infrastructure_fail":
+		return yolopb.Build_Failed
+	case "success", "fixed":
+		return yolopb.Build_Passed
+	case "canceled", "retried": // retried builds are superseded by a new one
+		return yolopb.Build_Canceled
+	case "timedout":
+		return yolopb.Build_Timedout
+	case "not_run", "not_running":
+		return yolopb.Build_NotRun
+	case "running":
+		return yolopb.Build_Running
+	case "queued", "scheduled":
+		return yolopb.Build_Scheduled
+	case "no_tests":
+		return yolopb.Build_Skipped
+	default:
+		return yolopb.Build_UnknownState
+	}
+}
+
 func circleciArtifactsToBatch(artifacts []*circleci.Artifact, build *circleci.Build) *yolopb.Batch {
 	batch := yolopb.NewBatch()
+	// the artifacts have no timestamp, they are uploaded by the build steps
+	createdAt := build.StopTime
+	if createdAt == nil {
+		createdAt = build.AuthorDate
+	}
 	for _, artifact := range artifacts {
 		id := "circleci_" + md5Sum([]byte(artifact.URL))
 		newArtifact := yolopb.Artifact{
 			ID:          id,
-			CreatedAt:   build.AuthorDate,
+			CreatedAt:   createdAt,
 			LocalPath:   artifact.PrettyPath,
 			DownloadURL: artifact.URL,
 			HasBuildID:  build.BuildURL,
 			Driver:      yolopb.Driver_CircleCI,
 			Kind:        artifactKindByPath(artifact.PrettyPath),
+			Variant:     artifactVariantByPath(artifact.PrettyPath),
+			Arch:        artifactArchByPath(artifact.PrettyPath),
 			State:       yolopb.Artifact_Finished,
 			MimeType:    mimetypeByPath(artifact.PrettyPath),
 			// FIXME: Sha1Sum
@@ -202,6 +317,42 @@ func circleciArtifactsToBatch(artifacts []*circleci.Artifact, build *circleci.Bu
 	return batch
 }
 
+// circleciDownloadArtifact streams an artifact, the token is only sent to CircleCI and not to the storage it redirects to
+func circleciDownloadArtifact(ctx context.Context, ccc *circleci.Client, artifactURL string, w io.Writer) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, artifactURL, nil)
+	if err != nil {
+		return fmt.Errorf("invalid download URL: %w", err)
+	}
+	req.Header.Set("Circle-Token", ccc.Token)
+	injectTraceContext(req)
+
+	client := http.Client{}
+	if ccc.HTTPClient != nil {
+		client = *ccc.HTTPClient
+	}
+	client.CheckRedirect = func(redirect *http.Request, via []*http.Request) error {
+		if len(via) >= 10 {
+			return fmt.Errorf("stopped after 10 redirects")
+		}
+		if redirect.URL.Host != req.URL.Host {
+			redirect.Header.Del("Circle-Token")
+		}
+		return nil
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to download artifact: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to download artifact: %s", resp.Status)
+	}
+
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
 func (o *CircleciWorkerOpts) applyDefaults() {
 	if o.Logger == nil {
 		o.Logger = zap.NewNop()
@@ -0,0 +1,212 @@
+package yolosvc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/jszwedko/go-circleci"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestCircleciDownloadArtifact(t *testing.T) {
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Circle-Token"))
+		_, _ = w.Write([]byte("apk content"))
+	}))
+	defer storage.Close()
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Circle-Token") != "token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		http.Redirect(w, r, storage.URL+"/artifact.apk", http.StatusFound)
+	}))
+	defer api.Close()
+
+	var buf bytes.Buffer
+	err := circleciDownloadArtifact(context.Background(), &circleci.Client{Token: "token"}, api.URL+"/0/artifact.apk", &buf)
+	require.NoError(t, err)
+	assert.Equal(t, "apk content", buf.String())
+
+	buf.Reset()
+	err = circleciDownloadArtifact(context.Background(), &circleci.Client{Token: "invalid"}, api.URL+"/0/artifact.apk", &buf)
+	assert.Error(t, err)
+	assert.Empty(t, buf.String())
+}
+
+func TestCircleciBuildToBatch(t *testing.T) {
+	build := circleciBuildToBatch(&circleci.Build{
+		BuildURL:    "https://circleci.com/gh/berty/berty/42",
+		BuildNum:    42,
+		Subject:     "fix login bug",
+		Body:        "closes #1",
+		AuthorName:  "Alice",
+		AuthorEmail: "alice@example.com",
+		Status:      "success",
+	}, nil)
+	assert.Equal(t, "fix login bug\n\ncloses #1", build.Message)
+	assert.Equal(t, "Alice", build.CommitAuthor)
+	assert.Equal(t, "alice@example.com", build.CommitEmail)
+	assert.Equal(t, yolopb.Build_Passed, build.State)
+}
+
+// flakyCircleciClient fails the first calls of each page, then returns builds numbered from their offset
+type flakyCircleciClient struct {
+	failures int
+	err      error
+	total    int
+	calls    map[int]int
+	mutex    sync.Mutex
+}
+
+func (c *flakyCircleciClient) ListRecentBuilds(limit, offset int) ([]*circleci.Build, error) {
+	c.mutex.Lock()
+	c.calls[offset]++
+	calls := c.calls[offset]
+	c.mutex.Unlock()
+	if calls <= c.failures {
+		return nil, c.err
+	}
+	builds := []*circleci.Build{}
+	for i := offset; i < offset+limit && i < c.total; i++ {
+		builds = append(builds, &circleci.Build{BuildURL: fmt.Sprintf("https://circleci.com/gh/berty/berty/%d", i), BuildNum: i, Username: "berty", Reponame: "berty"})
+	}
+	return builds, nil
+}
+
+func (c *flakyCircleciClient) ListBuildArtifacts(account, repo string, buildNum int) ([]*circleci.Artifact, error) {
+	return nil, nil
+}
+
+func TestFetchCircleciBuildsRetry(t *testing.T) {
+	retry := retrier{attempts: 3, base: time.Millisecond, max: 10 * time.Millisecond, logger: zap.NewNop()}
+
+	// each page fails twice then succeeds, the already fetched pages are not requested again
+	ccc := &flakyCircleciClient{failures: 2, err: &circleci.APIError{HTTPStatusCode: http.StatusBadGateway}, total: 100, calls: map[int]int{}}
+	batch, err := fetchCircleciBuilds(context.Background(), ccc, retry, time.Time{}, 70, nil, zap.NewNop())
+	require.NoError(t, err)
+	assert.Len(t, batch.Builds, 70)
+	assert.Equal(t, map[int]int{0: 3, 30: 3, 60: 3}, ccc.calls)
+
+	// the rate limits are retried too, the initial pages are all started at once
+	ccc = &flakyCircleciClient{failures: 1, err: &RateLimitError{RetryAfter: time.Millisecond}, total: 10, calls: map[int]int{}}
+	batch, err = fetchCircleciBuilds(context.Background(), ccc, retry, time.Time{}, 70, nil, zap.NewNop())
+	require.NoError(t, err)
+	assert.Len(t, batch.Builds, 10)
+	assert.Equal(t, 2, ccc.calls[0])
+
+	// too many failures, the other pages are canceled. the concurrent pages fail together, the first one to give up
+	// interrupts the retries of the others
+	ccc = &flakyCircleciClient{failures: 3, err: &circleci.APIError{HTTPStatusCode: http.StatusInternalServerError}, total: 10, calls: map[int]int{}}
+	_, err = fetchCircleciBuilds(context.Background(), ccc, retry, time.Time{}, 70, nil, zap.NewNop())
+	assert.Error(t, err)
+	maxCalls := 0
+	for _, calls := range ccc.calls {
+		if calls > maxCalls {
+			maxCalls = calls
+		}
+	}
+	assert.Equal(t, 3, maxCalls)
+
+	// the client errors are not retried
+	ccc = &flakyCircleciClient{failures: 1, err: &circleci.APIError{HTTPStatusCode: http.StatusNotFound}, total: 10, calls: map[int]int{}}
+	_, err = fetchCircleciBuilds(context.Background(), ccc, retry, time.Time{}, 70, nil, zap.NewNop())
+	assert.Error(t, err)
+	assert.Equal(t, 1, ccc.calls[0])
+
+	// the next fetches are sequential, they stop at the first page with already known builds
+	since := time.Date(2022, 9, 1, 0, 0, 0, 0, time.UTC)
+	ccc = &flakyCircleciClient{total: 100, calls: map[int]int{}}
+	batch, err = fetchCircleciBuilds(context.Background(), ccc, retry, since, 70, nil, zap.NewNop())
+	require.NoError(t, err)
+	assert.Empty(t, batch.Builds)
+	assert.Equal(t, map[int]int{0: 1}, ccc.calls)
+}
+
+// slowCircleciClient lists total builds, slowly, and records how many pages are listed at the same time
+type slowCircleciClient struct {
+	total       int
+	delay       time.Duration
+	mutex       sync.Mutex
+	inFlight    int
+	maxInFlight int
+	offsets     []int
+}
+
+func (c *slowCircleciClient) ListRecentBuilds(limit, offset int) ([]*circleci.Build, error) {
+	c.mutex.Lock()
+	c.inFlight++
+	if c.inFlight > c.maxInFlight {
+		c.maxInFlight = c.inFlight
+	}
+	c.offsets = append(c.offsets, offset)
+	c.mutex.Unlock()
+	defer func() {
+		c.mutex.Lock()
+		c.inFlight--
+		c.mutex.Unlock()
+	}()
+
+	time.Sleep(c.delay)
+	builds := []*circleci.Build{}
+	for i := offset; i < offset+limit && i < c.total; i++ {
+		builds = append(builds, &circleci.Build{BuildURL: fmt.Sprintf("https://circleci.com/gh/berty/berty/%d", i), BuildNum: i})
+	}
+	return builds, nil
+}
+
+func (c *slowCircleciClient) ListBuildArtifacts(account, repo string, buildNum int) ([]*circleci.Artifact, error) {
+	return nil, nil
+}
+
+func TestFetchCircleciInitialBuildsConcurrency(t *testing.T) {
+	retry := retrier{attempts: 1, logger: zap.NewNop()}
+
+	ccc := &slowCircleciClient{total: 1000, delay: 20 * time.Millisecond}
+	batch, err := fetchCircleciBuilds(context.Background(), ccc, retry, time.Time{}, 300, nil, zap.NewNop())
+	require.NoError(t, err)
+	require.Len(t, batch.Builds, 300)
+	assert.Equal(t, circleciInitialFetchConcurrency, ccc.maxInFlight)
+	assert.Len(t, ccc.offsets, 10)
+	// merged in the order of the pages
+	for i, build := range batch.Builds {
+		assert.Equal(t, fmt.Sprintf("https://circleci.com/gh/berty/berty/%d", i), build.ID)
+	}
+
+	// a short page stops the fetch, the pages started before it are dropped
+	ccc = &slowCircleciClient{total: 45, delay: 20 * time.Millisecond}
+	batch, err = fetchCircleciBuilds(context.Background(), ccc, retry, time.Time{}, 300, nil, zap.NewNop())
+	require.NoError(t, err)
+	assert.Len(t, batch.Builds, 45)
+	assert.True(t, ccc.maxInFlight > 1)
+	assert.True(t, len(ccc.offsets) < 10, ccc.offsets)
+}
+
+func TestRateLimitTransport(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "7")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer api.Close()
+
+	client := &http.Client{Transport: NewRateLimitTransport(nil)}
+	_, err := client.Get(api.URL)
+	var rateLimited *RateLimitError
+	require.True(t, errors.As(err, &rateLimited), err)
+	assert.Equal(t, 7*time.Second, rateLimited.RetryAfter)
+
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, 30*time.Second, parseRetryAfter(now.Add(30*time.Second).Format(http.TimeFormat), now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter(now.Add(-time.Minute).Format(http.TimeFormat), now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("soon", now))
+}
@@ -0,0 +1,168 @@
+package yolosvc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/firebase"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/tevino/abool"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+type FirebaseWorkerOpts struct {
+	Logger     *zap.Logger
+	MaxBuilds  int
+	LoopAfter  time.Duration
+	ClearCache *abool.AtomicBool
+	Once       bool
+	// AppIDs are the Firebase apps whose releases are fetched (i.e, 1:1234567890:android:0a1b2c3d4e5f67890)
+	AppIDs []string
+}
+
+const firebaseMaxPerPage = 100
+
+// FirebaseWorker goals is to manage the Firebase App Distribution update routine, it should try to support as much errors as possible by itself
+func (svc *service) FirebaseWorker(ctx context.Context, opts FirebaseWorkerOpts) error {
+	opts.applyDefaults()
+
+	logger := opts.Logger.Named("fbad")
+	breaker := svc.breakers.get(yolopb.Driver_FirebaseAppDistribution)
+
+	for iteration := 0; ; iteration++ {
+		if !breaker.waitAllowed(ctx) {
+			return nil
+		}
+		since, err := lastBuildCreatedTime(ctx, svc.store, yolopb.Driver_FirebaseAppDistribution)
+		if err != nil {
+			logger.Warn("get last firebase build created time", zap.Error(err))
+		}
+		logger.Debug("firebase: refresh", zap.Int("iteration", iteration), zap.Time("since", since))
+		failed := false
+		var fetchErr error
+		batch := yolopb.NewBatch()
+		for _, appID := range opts.AppIDs {
+			appBatch, err := svc.traceFetch(ctx, yolopb.Driver_FirebaseAppDistribution, func(ctx context.Context) (*yolopb.Batch, error) {
+				return fetchFirebaseReleases(ctx, svc.fbc, appID, since, opts.MaxBuilds, logger)
+			}, attribute.String("yolo.app_id", appID))
+			if err != nil {
+				logger.Warn("fetch firebase", zap.String("app", appID), zap.Error(err))
+				failed = true
+				fetchErr = err
+				continue
+			}
+			batch.Merge(appBatch)
+		}
+		if err := svc.saveBatch(ctx, batch); err != nil {
+			logger.Warn("save batch", zap.Error(err))
+		} else if !failed {
+			svc.metrics.refreshed(yolopb.Driver_FirebaseAppDistribution)
+		}
+		breaker.record(fetchErr)
+
+		if opts.Once {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(withJitter(opts.LoopAfter)):
+		}
+	}
+}
+
+// fetchFirebaseReleases returns the releases of an app created after since, most recent first
+func fetchFirebaseReleases(ctx context.Context, fbc *firebase.Client, appID string, since time.Time, maxBuilds int, logger *zap.Logger) (*yolopb.Batch, error) {
+	batch := yolopb.NewBatch()
+	perPage := maxBuilds
+	if perPage > firebaseMaxPerPage {
+		perPage = firebaseMaxPerPage
+	}
+
+	fetched := 0
+	pageToken := ""
+	for {
+		before := time.Now()
+		resp, err := fbc.ListReleases(ctx, appID, perPage, pageToken)
+		if err != nil {
+			return nil, fmt.Errorf("list releases: %w", err)
+		}
+		logger.Debug("firebase.ListReleases", zap.String("app", appID), zap.Int("releases", len(resp.Releases)), zap.Duration("duration", time.Since(before)))
+		for _, release := range resp.Releases {
+			if !release.CreateTime.After(since) || fetched >= maxBuilds {
+				return batch, nil
+			}
+			batch.Merge(firebaseReleaseToBatch(appID, release))
+			fetched++
+		}
+		if resp.NextPageToken == "" {
+			return batch, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+func firebaseReleaseToBatch(appID string, release *firebase.Release) *yolopb.Batch {
+	batch := yolopb.NewBatch()
+
+	buildID := release.FirebaseConsoleURI
+	if buildID == "" {
+		buildID = release.Name
+	}
+	createdAt := release.CreateTime
+	newBuild := yolopb.Build{
+		ID:         buildID,
+		ShortID:    release.BuildVersion,
+		CreatedAt:  &createdAt,
+		FinishedAt: &createdAt,
+		Message:    release.ReleaseNotes.Text,
+		VCSTag:     release.DisplayVersion,
+		State:      yolopb.Build_Passed, // only the successfully processed uploads are listed
+		Driver:     yolopb.Driver_FirebaseAppDistribution,
+	}
+	batch.Builds = append(batch.Builds, &newBuild)
+
+	// the releases don't have a filename, guess it from the platform of the app
+	var ext string
+	switch firebase.Platform(appID) {
+	case "android":
+		ext = ".apk"
+	case "ios":
+		ext = ".ipa"
+	default:
+		return batch
+	}
+	localPath := fmt.Sprintf("%s-%s%s", release.DisplayVersion, release.BuildVersion, ext)
+	newArtifact := yolopb.Artifact{
+		ID:          "firebase_" + md5Sum([]byte(release.Name)),
+		CreatedAt:   &createdAt,
+		LocalPath:   localPath,
+		DownloadURL: release.Name, // the download link is minted on demand, it expires after one hour
+		HasBuildID:  buildID,
+		State:       yolopb.Artifact_Finished,
+		Driver:      yolopb.Driver_FirebaseAppDistribution,
+		Kind:        artifactKindByPath(localPath),
+		MimeType:    mimetypeByPath(localPath),
+	}
+	batch.Artifacts = append(batch.Artifacts, &newArtifact)
+
+	return batch
+}
+
+func (o *FirebaseWorkerOpts) applyDefaults() {
+	if o.Logger == nil {
+		o.Logger = zap.NewNop()
+	}
+	if o.MaxBuilds == 0 {
+		o.MaxBuilds = 100
+	}
+	if o.LoopAfter == 0 {
+		o.LoopAfter = time.Minute
+	}
+	if o.ClearCache == nil {
+		o.ClearCache = abool.New()
+	}
+}
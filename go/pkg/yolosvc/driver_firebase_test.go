@@ -0,0 +1,39 @@
+package yolosvc
+
+import (
+	"testing"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/firebase"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFirebaseReleaseToBatch(t *testing.T) {
+	release := &firebase.Release{
+		Name:               "projects/42/apps/1:42:android:abc/releases/r1",
+		ReleaseNotes:       firebase.ReleaseNotes{Text: "fix login bug"},
+		DisplayVersion:     "1.2.3",
+		BuildVersion:       "45",
+		CreateTime:         time.Date(2022, 9, 1, 0, 0, 0, 0, time.UTC),
+		FirebaseConsoleURI: "https://console.firebase.google.com/project/yolo/appdistribution/app/android:tech.berty/releases/r1",
+	}
+
+	batch := firebaseReleaseToBatch("1:42:android:abc", release)
+	require.Len(t, batch.Builds, 1)
+	build := batch.Builds[0]
+	assert.Equal(t, release.FirebaseConsoleURI, build.ID)
+	assert.Equal(t, "45", build.ShortID)
+	assert.Equal(t, "fix login bug", build.Message)
+	assert.Equal(t, yolopb.Driver_FirebaseAppDistribution, build.Driver)
+	require.Len(t, batch.Artifacts, 1)
+	artifact := batch.Artifacts[0]
+	assert.Equal(t, yolopb.Artifact_APK, artifact.Kind)
+	assert.Equal(t, release.Name, artifact.DownloadURL)
+	assert.Equal(t, build.ID, artifact.HasBuildID)
+
+	batch = firebaseReleaseToBatch("1:42:web:abc", release)
+	assert.Len(t, batch.Builds, 1)
+	assert.Empty(t, batch.Artifacts)
+}
@@ -14,6 +14,7 @@ import (
 	//nolint:staticcheck
 
 	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 
 	"github.com/google/go-github/v32/github"
@@ -87,7 +88,7 @@ func (svc *service) GitHubWorker(ctx context.Context, opts GithubWorkerOpts) err
 	// fetch GitHub base objects (the ones that don't change very often).
 	// this is done only once (for now).
 	{
-		batch, err := worker.fetchBaseObjects(ctx)
+		batch, err := svc.traceFetch(ctx, yolopb.Driver_GitHub, worker.fetchBaseObjects)
 		if err != nil {
 			worker.logger.Warn("fetch GitHub base", zap.Error(err))
 		} else {
@@ -100,7 +101,14 @@ func (svc *service) GitHubWorker(ctx context.Context, opts GithubWorkerOpts) err
 	// FIXME: create an helper that takes a batch and automatically detect missing entities, then fetch them, and finally, add them to the batch
 
 	// fetch recent activity in a loop
+	refreshRequests, release := svc.listenRefreshRequests(yolopb.Driver_GitHub)
+	defer release()
+	var refresh refreshRequest // if set by a webhook or an admin refresh, only its project is refreshed
+	breaker := svc.breakers.get(yolopb.Driver_GitHub)
 	for iteration := 0; ; iteration++ {
+		if !breaker.waitAllowed(ctx) {
+			return nil
+		}
 		since, err := lastBuildCreatedTime(ctx, svc.store, yolopb.Driver_GitHub)
 		if err != nil {
 			svc.logger.Warn("get last github build created time", zap.Error(err))
@@ -108,18 +116,30 @@ func (svc *service) GitHubWorker(ctx context.Context, opts GithubWorkerOpts) err
 		svc.logger.Debug("github: refresh", zap.Int("iteration", iteration), zap.Time("since", since))
 
 		// fetch repo activity
+		var fetchErr error
 		for _, repo := range worker.repoConfigs {
+			if refresh.project != "" && !strings.EqualFold(repo.owner+"/"+repo.repo, refresh.project) {
+				continue
+			}
 			// FIXME: support "since"
-			batch, err := worker.fetchRepoActivity(ctx, repo, iteration, since)
+			batch, err := svc.traceFetch(ctx, yolopb.Driver_GitHub, func(ctx context.Context) (*yolopb.Batch, error) {
+				return worker.fetchRepoActivity(ctx, repo, iteration, since)
+			}, attribute.String("yolo.project", repo.owner+"/"+repo.repo))
 			if err != nil {
 				worker.logger.Warn("fetch", zap.Error(err))
+				fetchErr = err
 			} else {
 				if err := svc.saveBatch(ctx, batch); err != nil {
 					worker.logger.Warn("save batch", zap.Error(err))
+				} else {
+					svc.metrics.refreshed(yolopb.Driver_GitHub)
 				}
 			}
 		}
 
+		breaker.record(fetchErr)
+		refresh.finish()
+
 		// FIXME: subscribe to orgs' events
 
 		limits, _, err := svc.ghc.RateLimits(ctx)
@@ -136,10 +156,14 @@ func (svc *service) GitHubWorker(ctx context.Context, opts GithubWorkerOpts) err
 		if opts.Once {
 			return nil
 		}
+		refresh = refreshRequest{}
 		select {
 		case <-ctx.Done():
 			return nil
-		case <-time.After(opts.LoopAfter):
+		case <-time.After(withJitter(opts.LoopAfter)):
+		case refresh = <-refreshRequests:
+			refresh.receive()
+			worker.logger.Debug("refresh requested", zap.String("project", refresh.project))
 		}
 	}
 }
@@ -199,10 +223,23 @@ func (worker *githubWorker) fetchBaseObjects(ctx context.Context) (*yolopb.Batch
 func getOverrideBuildpb(owner string, repo string, artifactID int64, token string) (*yolopb.MetadataOverride, error) {
 	override := &yolopb.MetadataOverride{}
 
+	content, err := downloadGithubArtifactFile(owner, repo, artifactID, token, "yolo.json")
+	if err != nil {
+		return nil, fmt.Errorf("fetch yolo.json: %w", err)
+	}
+	err = jsonpb.Unmarshal(bytes.NewReader(content), override)
+	if err != nil {
+		return nil, fmt.Errorf("fetch yolo.json: %w", err)
+	}
+	return override, nil
+}
+
+// downloadGithubArtifactFile returns the content of the single file of a small artifact
+func downloadGithubArtifactFile(owner string, repo string, artifactID int64, token string, name string) ([]byte, error) {
 	// https://github.com/actions/upload-artifact#zipped-artifact-downloads
 	req, err := http.NewRequest("GET", fmt.Sprintf("https://api.github.com/repos/%s/%s/actions/artifacts/%d/zip", owner, repo, artifactID), nil)
 	if err != nil {
-		return nil, fmt.Errorf("fetch yolo.json: %w", err)
+		return nil, err
 	}
 
 	req.Header.Set("Accept", "application/vnd.github+json")
@@ -210,7 +247,7 @@ func getOverrideBuildpb(owner string, repo string, artifactID int64, token strin
 
 	res, err := http.DefaultClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("fetch yolo.json: %w", err)
+		return nil, err
 	}
 	defer res.Body.Close()
 
@@ -225,19 +262,10 @@ func getOverrideBuildpb(owner string, repo string, artifactID int64, token strin
 		return nil, err
 	}
 
-	file := zipReader.File[0]
-	if file != nil && file.Name == "yolo.json" {
-		unzippedFileBytes, err := readZipFile(file)
-		if err != nil {
-			return nil, fmt.Errorf("fetch yolo.json: %w", err)
-		}
-		err = jsonpb.Unmarshal(bytes.NewReader(unzippedFileBytes), override)
-		if err != nil {
-			return nil, fmt.Errorf("fetch yolo.json: %w", err)
-		}
-		return override, nil
+	if len(zipReader.File) > 0 && zipReader.File[0].Name == name {
+		return readZipFile(zipReader.File[0])
 	}
-	return nil, fmt.Errorf("fetch yolo.json: missing yolo.json inside artifact")
+	return nil, fmt.Errorf("missing %s inside artifact", name)
 }
 
 func (worker *githubWorker) fetchRepoActivity(ctx context.Context, repo githubRepoConfig, iteration int, lastFinishedBuild time.Time) (*yolopb.Batch, error) {
@@ -303,7 +331,7 @@ func (worker *githubWorker) fetchRepoActivity(ctx context.Context, repo githubRe
 
 			// FIXME: parallelize?
 			for _, run := range ret.WorkflowRuns {
-				overridepb, err := worker.getOverridepb(ctx, repo, *run.ID)
+				overridepb, flags, err := worker.getRunMetadata(ctx, repo, *run.ID)
 				if err != nil {
 					worker.svc.logger.Warn("parsing yolo.json", zap.Error(err))
 				}
@@ -326,9 +354,9 @@ func (worker *githubWorker) fetchRepoActivity(ctx context.Context, repo githubRe
 					if err != nil {
 						return nil, err
 					}
-					batch.Merge(worker.batchFromWorkflowRun(run, ret, overridepb))
+					batch.Merge(worker.batchFromWorkflowRun(run, ret, overridepb, flags))
 				} else {
-					batch.Merge(worker.batchFromWorkflowRun(run, nil, overridepb))
+					batch.Merge(worker.batchFromWorkflowRun(run, nil, overridepb, flags))
 				}
 			}
 		}
@@ -381,19 +409,38 @@ func (worker *githubWorker) fetchRepoActivity(ctx context.Context, repo githubRe
 	return batch, nil
 }
 
-func (worker *githubWorker) getOverridepb(ctx context.Context, repo githubRepoConfig, runID int64) (*yolopb.MetadataOverride, error) {
-	// check for yolo.json
+// getRunMetadata returns the optional yolo.json override and flags manifest of a workflow run
+func (worker *githubWorker) getRunMetadata(ctx context.Context, repo githubRepoConfig, runID int64) (*yolopb.MetadataOverride, map[string]string, error) {
 	opts := &github.ListOptions{}
 	retArti, _, err := worker.svc.ghc.Actions.ListWorkflowRunArtifacts(ctx, repo.owner, repo.repo, runID, opts)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
+	var (
+		override *yolopb.MetadataOverride
+		flags    map[string]string
+	)
 	for _, arti := range retArti.Artifacts {
-		if arti.GetName() == "yolo.json" {
-			return getOverrideBuildpb(repo.owner, repo.repo, *arti.ID, worker.opts.Token)
+		switch name := arti.GetName(); {
+		case name == "yolo.json":
+			override, err = getOverrideBuildpb(repo.owner, repo.repo, arti.GetID(), worker.opts.Token)
+			if err != nil {
+				return nil, nil, err
+			}
+		case name == worker.svc.flagsManifest && arti.GetSizeInBytes() <= flagsManifestMaxSize:
+			// an invalid manifest shouldn't prevent ingesting the build
+			content, err := downloadGithubArtifactFile(repo.owner, repo.repo, arti.GetID(), worker.opts.Token, name)
+			if err != nil {
+				worker.logger.Warn("fetch flags manifest", zap.Int64("run", runID), zap.Error(err))
+				continue
+			}
+			flags, err = parseFlagsManifest(content)
+			if err != nil {
+				worker.logger.Warn("parse flags manifest", zap.Int64("run", runID), zap.Error(err))
+			}
 		}
 	}
-	return nil, nil
+	return override, flags, nil
 }
 
 func (worker *githubWorker) batchFromWorkflowRunArtifact(run *github.WorkflowRun, artifact *github.Artifact) *yolopb.Batch {
@@ -410,6 +457,8 @@ func (worker *githubWorker) batchFromWorkflowRunArtifact(run *github.WorkflowRun
 		HasBuildID:  run.GetHTMLURL(),
 		Driver:      yolopb.Driver_GitHub,
 		Kind:        artifactKindByPath(artifact.GetName()),
+		Variant:     artifactVariantByPath(artifact.GetName()),
+		Arch:        artifactArchByPath(artifact.GetName()),
 		MimeType:    mimetypeByPath(artifact.GetName()),
 		State:       yolopb.Artifact_Finished,
 	}
@@ -418,7 +467,7 @@ func (worker *githubWorker) batchFromWorkflowRunArtifact(run *github.WorkflowRun
 	return batch
 }
 
-func (worker *githubWorker) batchFromWorkflowRun(run *github.WorkflowRun, prs []*github.PullRequest, override *yolopb.MetadataOverride) *yolopb.Batch {
+func (worker *githubWorker) batchFromWorkflowRun(run *github.WorkflowRun, prs []*github.PullRequest, override *yolopb.MetadataOverride, flags map[string]string) *yolopb.Batch {
 	batch := yolopb.NewBatch()
 	createdAt := run.GetCreatedAt().Time
 	updatedAt := run.GetUpdatedAt().Time
@@ -440,6 +489,9 @@ func (worker *githubWorker) batchFromWorkflowRun(run *github.WorkflowRun, prs []
 		HasRawProjectID: run.GetRepository().GetHTMLURL(),
 		HasProjectID:    run.GetRepository().GetHTMLURL(),
 		Message:         run.GetHeadCommit().GetMessage(),
+		CommitAuthor:    run.GetHeadCommit().GetAuthor().GetName(),
+		CommitEmail:     run.GetHeadCommit().GetAuthor().GetEmail(),
+		Flags:           flags,
 	}
 
 	newCommit := yolopb.Commit{}
@@ -477,13 +529,13 @@ func (worker *githubWorker) batchFromWorkflowRun(run *github.WorkflowRun, prs []
 		case event == "schedule":
 			// skip
 			return batch
-		case status == "queued":
+		case status == "queued", status == "waiting", status == "requested", status == "pending":
 			newBuild.State = yolopb.Build_Scheduled
 		case status == "in_progress":
 			newBuild.State = yolopb.Build_Running
 		case conclusion == "success":
 			newBuild.State = yolopb.Build_Passed
-		case conclusion == "failure":
+		case conclusion == "failure", conclusion == "startup_failure":
 			newBuild.State = yolopb.Build_Failed
 		case conclusion == "cancelled": // nolint:misspell // this is how GitHub spells it
 			newBuild.State = yolopb.Build_Canceled
@@ -0,0 +1,94 @@
+package yolosvc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+)
+
+// HTTPAuth are the credentials sent with the downloads of the generic HTTP driver, a bearer token or a basic auth
+type HTTPAuth struct {
+	Token    string
+	Username string
+	Password string
+}
+
+// ParseHTTPAuths parses a comma-separated list of "driver=bearer:token" or "driver=basic:username:password" credentials
+func ParseHTTPAuths(input string) (map[yolopb.Driver]HTTPAuth, error) {
+	auths := map[yolopb.Driver]HTTPAuth{}
+	for _, def := range strings.Split(input, ",") {
+		def = strings.TrimSpace(def)
+		if def == "" {
+			continue
+		}
+		parts := strings.SplitN(def, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid download auth: %q, expected driver=bearer:token or driver=basic:username:password", def)
+		}
+		driver, err := parseDriver(parts[0])
+		if err != nil {
+			return nil, err
+		}
+		var auth HTTPAuth
+		creds := strings.SplitN(parts[1], ":", 3)
+		switch {
+		case creds[0] == "bearer" && len(creds) >= 2 && creds[1] != "":
+			auth.Token = strings.TrimPrefix(parts[1], "bearer:")
+		case creds[0] == "basic" && len(creds) == 3 && creds[1] != "":
+			auth.Username, auth.Password = creds[1], creds[2]
+		default:
+			return nil, fmt.Errorf("invalid download auth of %s, expected bearer:token or basic:username:password", driver)
+		}
+		auths[driver] = auth
+	}
+	return auths, nil
+}
+
+// Secrets returns the values to redact from the logs
+func (a HTTPAuth) Secrets() []string {
+	return []string{a.Token, a.Password}
+}
+
+func (a HTTPAuth) apply(req *http.Request) {
+	switch {
+	case a.Token != "":
+		req.Header.Set("Authorization", "Bearer "+a.Token)
+	case a.Username != "":
+		req.SetBasicAuth(a.Username, a.Password)
+	}
+}
+
+// httpDownload downloads an artifact from its absolute URL, with the credentials configured for its driver.
+// it serves the Driver_HTTP artifacts, and the ones of the sunset Bintray
+func (svc *service) httpDownload(ctx context.Context, artifact *yolopb.Artifact, w io.Writer) error {
+	downloadURL := svc.rewriteDownloadURL(artifact)
+	u, err := url.Parse(downloadURL)
+	if err != nil {
+		return fmt.Errorf("invalid download URL: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("unsupported download URL: %q", downloadURL)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, downloadURL, nil)
+	if err != nil {
+		return err
+	}
+	svc.httpAuths[artifact.Driver].apply(req)
+	injectTraceContext(req)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("download %s: %s", artifact.Driver, resp.Status)
+	}
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
@@ -0,0 +1,82 @@
+package yolosvc
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseHTTPAuths(t *testing.T) {
+	auths, err := ParseHTTPAuths("http=bearer:abc:def, bintray=basic:yolo:secret:pass,")
+	require.NoError(t, err)
+	assert.Equal(t, map[yolopb.Driver]HTTPAuth{
+		yolopb.Driver_HTTP:    {Token: "abc:def"},
+		yolopb.Driver_Bintray: {Username: "yolo", Password: "secret:pass"},
+	}, auths)
+
+	auths, err = ParseHTTPAuths("")
+	require.NoError(t, err)
+	assert.Empty(t, auths)
+
+	for _, input := range []string{"http", "travis=bearer:abc", "http=bearer:", "http=basic:yolo", "http=token:abc"} {
+		_, err = ParseHTTPAuths(input)
+		assert.Error(t, err, input)
+	}
+}
+
+func TestHTTPDownload(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/public.apk":
+			_, _ = w.Write([]byte("public content"))
+		case "/private.apk":
+			if r.Header.Get("Authorization") != "Bearer token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte("private content"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer upstream.Close()
+
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t), HTTPAuths: map[yolopb.Driver]HTTPAuth{yolopb.Driver_HTTP: {Token: "token"}}})
+	defer cleanup()
+	svc := api.(*service)
+
+	download := func(driver yolopb.Driver, downloadURL string) (string, error) {
+		var buf bytes.Buffer
+		err := svc.artifactDownloadFromProvider(context.Background(), &yolopb.Artifact{ID: "http", LocalPath: "app.apk", Driver: driver, DownloadURL: downloadURL}, &buf)
+		return buf.String(), err
+	}
+
+	content, err := download(yolopb.Driver_HTTP, upstream.URL+"/private.apk")
+	require.NoError(t, err)
+	assert.Equal(t, "private content", content)
+
+	// the Bintray artifacts are downloaded from their stored URL, without the credentials of the HTTP driver
+	content, err = download(yolopb.Driver_Bintray, upstream.URL+"/public.apk")
+	require.NoError(t, err)
+	assert.Equal(t, "public content", content)
+	_, err = download(yolopb.Driver_Bintray, upstream.URL+"/private.apk")
+	assert.Error(t, err)
+
+	_, err = download(yolopb.Driver_HTTP, upstream.URL+"/missing.apk")
+	assert.Error(t, err)
+	_, err = download(yolopb.Driver_HTTP, "js/packages/app.apk")
+	assert.Error(t, err)
+
+	// the permanent URLs are always proxied
+	_, err = svc.downloadLocation(context.Background(), &yolopb.Artifact{Driver: yolopb.Driver_HTTP, DownloadURL: upstream.URL + "/private.apk"}, "app.apk")
+	assert.Error(t, err)
+	_, err = svc.downloadLocation(context.Background(), &yolopb.Artifact{Driver: yolopb.Driver_Bintray, DownloadURL: upstream.URL + "/public.apk"}, "app.apk")
+	assert.Error(t, err)
+}
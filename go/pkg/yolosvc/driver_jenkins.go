@@ -0,0 +1,228 @@
+package yolosvc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/jenkins"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/tevino/abool"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+type JenkinsWorkerOpts struct {
+	Logger     *zap.Logger
+	MaxBuilds  int
+	LoopAfter  time.Duration
+	ClearCache *abool.AtomicBool
+	Once       bool
+	// Jobs are the Jenkins jobs whose builds are fetched, the jobs of a folder are separated by slashes (i.e, "berty/android")
+	Jobs []string
+}
+
+// JenkinsWorker goals is to manage the Jenkins update routine, it should try to support as much errors as possible by itself
+func (svc *service) JenkinsWorker(ctx context.Context, opts JenkinsWorkerOpts) error {
+	opts.applyDefaults()
+
+	logger := opts.Logger.Named("jenk")
+	breaker := svc.breakers.get(yolopb.Driver_Jenkins)
+
+	for iteration := 0; ; iteration++ {
+		if !breaker.waitAllowed(ctx) {
+			return nil
+		}
+		logger.Debug("jenkins: refresh", zap.Int("iteration", iteration))
+		failed := false
+		var fetchErr error
+		batch := yolopb.NewBatch()
+		// the API can't filter the builds by date, the last ones are fetched at each refresh to update their state
+		for _, job := range opts.Jobs {
+			jobBatch, err := svc.traceFetch(ctx, yolopb.Driver_Jenkins, func(ctx context.Context) (*yolopb.Batch, error) {
+				return fetchJenkinsBuilds(ctx, svc.jkc, job, opts.MaxBuilds, logger)
+			}, attribute.String("yolo.job", job))
+			if err != nil {
+				logger.Warn("fetch jenkins", zap.String("job", job), zap.Error(err))
+				failed = true
+				fetchErr = err
+				continue
+			}
+			batch.Merge(jobBatch)
+		}
+		if err := svc.saveBatch(ctx, batch); err != nil {
+			logger.Warn("save batch", zap.Error(err))
+		} else if !failed {
+			svc.metrics.refreshed(yolopb.Driver_Jenkins)
+		}
+		breaker.record(fetchErr)
+
+		if opts.Once {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(withJitter(opts.LoopAfter)):
+		}
+	}
+}
+
+func fetchJenkinsBuilds(ctx context.Context, jkc *jenkins.Client, job string, maxBuilds int, logger *zap.Logger) (*yolopb.Batch, error) {
+	batch := yolopb.NewBatch()
+	before := time.Now()
+	builds, err := jkc.ListBuilds(ctx, job, maxBuilds)
+	if err != nil {
+		return nil, fmt.Errorf("list builds: %w", err)
+	}
+	logger.Debug("jenkins.ListBuilds", zap.String("job", job), zap.Int("builds", len(builds)), zap.Duration("duration", time.Since(before)))
+
+	for _, build := range builds {
+		newBuild := jenkinsBuildToBuild(jkc.JobURL(job), build)
+		batch.Builds = append(batch.Builds, newBuild)
+		batch.Artifacts = append(batch.Artifacts, jenkinsArtifactsToArtifacts(build, newBuild)...)
+	}
+	return batch, nil
+}
+
+func jenkinsBuildToBuild(jobURL string, build *jenkins.Build) *yolopb.Build {
+	buildID := build.URL
+	if buildID == "" {
+		buildID = fmt.Sprintf("%s%d/", jobURL, build.Number)
+	}
+	startedAt := build.StartedAt()
+	newBuild := yolopb.Build{
+		ID:        buildID,
+		ShortID:   fmt.Sprint(build.Number),
+		CreatedAt: &startedAt,
+		StartedAt: &startedAt,
+		State:     jenkinsBuildState(build.Building, build.Result),
+		Driver:    yolopb.Driver_Jenkins,
+	}
+	if !build.Building && build.Duration > 0 {
+		finishedAt := startedAt.Add(time.Duration(build.Duration) * time.Millisecond)
+		newBuild.FinishedAt = &finishedAt
+	}
+	// the most recent change is the last one
+	for _, changeSet := range build.ChangeSets {
+		for _, item := range changeSet.Items {
+			newBuild.Message = item.Msg
+			newBuild.CommitAuthor = item.Author.FullName
+		}
+	}
+	if newBuild.Message == "" {
+		newBuild.Message = build.Description
+	}
+
+	revision, remoteURL := build.Git()
+	if revision != nil {
+		newBuild.HasCommitID = revision.SHA1
+		newBuild.HasRawCommitID = revision.SHA1
+		if len(revision.Branch) > 0 {
+			name := revision.Branch[0].Name
+			if strings.HasPrefix(name, "refs/tags/") {
+				newBuild.VCSTag = strings.TrimPrefix(name, "refs/tags/")
+				newBuild.Branch = newBuild.VCSTag
+			} else {
+				newBuild.Branch = jenkinsBranchName(name)
+			}
+		}
+	}
+	// the GitHub repositories are shared with the GitHub driver, by their URL; the jobs without git checkout are their own project
+	switch {
+	case remoteURL != "":
+		newBuild.HasProjectID = jenkinsProjectURL(remoteURL)
+		if strings.HasPrefix(newBuild.HasProjectID, "https://github.com/") && newBuild.HasCommitID != "" {
+			newBuild.CommitURL = newBuild.HasProjectID + "/commit/" + newBuild.HasCommitID
+		}
+	default:
+		newBuild.HasProjectID = strings.TrimSuffix(jobURL, "/")
+	}
+	newBuild.HasRawProjectID = newBuild.HasProjectID
+
+	guessMissingBuildInfo(&newBuild)
+	return &newBuild
+}
+
+// jenkinsBuildState normalizes the result of a Jenkins build
+func jenkinsBuildState(building bool, result string) yolopb.Build_State {
+	if building {
+		return yolopb.Build_Running
+	}
+	switch result {
+	case "SUCCESS", "UNSTABLE": // some tests failed, the artifacts are archived
+		return yolopb.Build_Passed
+	case "FAILURE":
+		return yolopb.Build_Failed
+	case "ABORTED":
+		return yolopb.Build_Canceled
+	case "NOT_BUILT":
+		return yolopb.Build_Skipped
+	}
+	return yolopb.Build_UnknownState
+}
+
+// jenkinsBranchName removes the remote from the branch name reported by the Git plugin (i.e, "refs/remotes/origin/main" or "origin/main")
+func jenkinsBranchName(name string) string {
+	name = strings.TrimPrefix(name, "refs/remotes/")
+	if i := strings.Index(name, "/"); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+// jenkinsProjectURL returns the URL of a git repository from its remote URL, the GitHub SSH remotes are converted to HTTPS
+func jenkinsProjectURL(remoteURL string) string {
+	projectURL := strings.TrimSuffix(strings.TrimSuffix(remoteURL, "/"), ".git")
+	if strings.HasPrefix(projectURL, "git@github.com:") {
+		return "https://github.com/" + strings.TrimPrefix(projectURL, "git@github.com:")
+	}
+	return projectURL
+}
+
+// jenkinsArtifactsToArtifacts maps the archived artifacts, identified by their path relative to the workspace
+func jenkinsArtifactsToArtifacts(build *jenkins.Build, newBuild *yolopb.Build) []*yolopb.Artifact {
+	// the artifacts have no timestamp, they are archived at the end of the build
+	createdAt := newBuild.FinishedAt
+	if createdAt == nil {
+		createdAt = newBuild.CreatedAt
+	}
+	ret := []*yolopb.Artifact{}
+	for _, artifact := range build.Artifacts {
+		if artifact.RelativePath == "" {
+			continue
+		}
+		downloadURL := build.ArtifactURL(artifact)
+		ret = append(ret, &yolopb.Artifact{
+			ID:          "jenkins_" + md5Sum([]byte(downloadURL)),
+			CreatedAt:   createdAt,
+			LocalPath:   artifact.RelativePath,
+			DownloadURL: downloadURL,
+			HasBuildID:  newBuild.ID,
+			Driver:      yolopb.Driver_Jenkins,
+			Kind:        artifactKindByPath(artifact.RelativePath),
+			Variant:     artifactVariantByPath(artifact.RelativePath),
+			Arch:        artifactArchByPath(artifact.RelativePath),
+			MimeType:    mimetypeByPath(artifact.RelativePath),
+			State:       yolopb.Artifact_Finished,
+		})
+	}
+	return ret
+}
+
+func (o *JenkinsWorkerOpts) applyDefaults() {
+	if o.Logger == nil {
+		o.Logger = zap.NewNop()
+	}
+	if o.MaxBuilds == 0 {
+		o.MaxBuilds = 100
+	}
+	if o.LoopAfter == 0 {
+		o.LoopAfter = time.Minute
+	}
+	if o.ClearCache == nil {
+		o.ClearCache = abool.New()
+	}
+}
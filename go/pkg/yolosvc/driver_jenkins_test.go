@@ -0,0 +1,107 @@
+package yolosvc
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/jenkins"
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJenkinsBuildToBatch(t *testing.T) {
+	started := time.Date(2022, 9, 1, 0, 0, 0, 0, time.UTC)
+	build := &jenkins.Build{
+		Number:    42,
+		URL:       "https://jenkins.berty.tech/job/berty/job/android/42/",
+		Result:    "UNSTABLE",
+		Timestamp: started.UnixNano() / int64(time.Millisecond),
+		Duration:  (10 * time.Minute).Milliseconds(),
+		Artifacts: []*jenkins.Artifact{
+			{FileName: "berty.apk", RelativePath: "app/build/outputs/berty.apk"},
+			{FileName: "", RelativePath: ""},
+		},
+		Actions: []*jenkins.Action{
+			{Class: "hudson.plugins.git.util.BuildData", LastBuiltRevision: &jenkins.Revision{SHA1: "0123abcdef", Branch: []*jenkins.Branch{{SHA1: "0123abcdef", Name: "refs/remotes/origin/feat/jenkins"}}}, RemoteURLs: []string{"git@github.com:berty/berty.git"}},
+		},
+		ChangeSets: []*jenkins.ChangeSet{{Items: []*jenkins.ChangeSetItem{{CommitID: "0123abcdef", Msg: "feat: jenkins"}}}},
+	}
+
+	newBuild := jenkinsBuildToBuild("https://jenkins.berty.tech/job/berty/job/android/", build)
+	assert.Equal(t, "https://jenkins.berty.tech/job/berty/job/android/42/", newBuild.ID)
+	assert.Equal(t, "42", newBuild.ShortID)
+	assert.Equal(t, "feat/jenkins", newBuild.Branch)
+	assert.Equal(t, "feat: jenkins", newBuild.Message)
+	assert.Equal(t, "https://github.com/berty/berty", newBuild.HasProjectID)
+	assert.Equal(t, "https://github.com/berty/berty/commit/0123abcdef", newBuild.CommitURL)
+	assert.Equal(t, &started, newBuild.CreatedAt)
+	finished := started.Add(10 * time.Minute)
+	assert.Equal(t, &finished, newBuild.FinishedAt)
+	assert.Equal(t, yolopb.Build_Passed, newBuild.State)
+	assert.Equal(t, yolopb.Driver_Jenkins, newBuild.Driver)
+
+	artifacts := jenkinsArtifactsToArtifacts(build, newBuild)
+	require.Len(t, artifacts, 1)
+	assert.Equal(t, "https://jenkins.berty.tech/job/berty/job/android/42/artifact/app/build/outputs/berty.apk", artifacts[0].DownloadURL)
+	assert.Equal(t, "app/build/outputs/berty.apk", artifacts[0].LocalPath)
+	assert.Equal(t, yolopb.Artifact_APK, artifacts[0].Kind)
+	assert.Equal(t, newBuild.ID, artifacts[0].HasBuildID)
+	assert.Equal(t, &finished, artifacts[0].CreatedAt)
+
+	// a running build of a job without git checkout
+	build = &jenkins.Build{Number: 43, Building: true, Timestamp: started.UnixNano() / int64(time.Millisecond)}
+	newBuild = jenkinsBuildToBuild("https://jenkins.berty.tech/job/berty/job/android/", build)
+	assert.Equal(t, "https://jenkins.berty.tech/job/berty/job/android/43/", newBuild.ID)
+	assert.Equal(t, "https://jenkins.berty.tech/job/berty/job/android", newBuild.HasProjectID)
+	assert.Equal(t, yolopb.Build_Running, newBuild.State)
+	assert.Nil(t, newBuild.FinishedAt)
+}
+
+func TestJenkinsBuildState(t *testing.T) {
+	assert.Equal(t, yolopb.Build_Running, jenkinsBuildState(true, ""))
+	assert.Equal(t, yolopb.Build_Passed, jenkinsBuildState(false, "SUCCESS"))
+	assert.Equal(t, yolopb.Build_Failed, jenkinsBuildState(false, "FAILURE"))
+	assert.Equal(t, yolopb.Build_Canceled, jenkinsBuildState(false, "ABORTED"))
+	assert.Equal(t, yolopb.Build_Skipped, jenkinsBuildState(false, "NOT_BUILT"))
+	assert.Equal(t, yolopb.Build_UnknownState, jenkinsBuildState(false, ""))
+}
+
+func TestJenkinsProjectURL(t *testing.T) {
+	assert.Equal(t, "https://github.com/berty/berty", jenkinsProjectURL("https://github.com/berty/berty.git"))
+	assert.Equal(t, "https://github.com/berty/berty", jenkinsProjectURL("git@github.com:berty/berty.git"))
+	assert.Equal(t, "https://git.berty.tech/yolo", jenkinsProjectURL("https://git.berty.tech/yolo/"))
+	assert.Equal(t, "main", jenkinsBranchName("origin/main"))
+	assert.Equal(t, "main", jenkinsBranchName("main"))
+}
+
+func TestJenkinsDownload(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, token, _ := r.BasicAuth(); user != "yolo" || token != "token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path != "/job/android/42/artifact/out/berty.apk" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte("apk content"))
+	}))
+	defer server.Close()
+	jkc, err := jenkins.New(server.URL, "yolo", "token")
+	require.NoError(t, err)
+
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t), JenkinsClient: jkc})
+	defer cleanup()
+	svc := api.(*service)
+
+	var buf bytes.Buffer
+	err = svc.artifactDownloadFromProvider(context.Background(), &yolopb.Artifact{ID: "jenkins_1", LocalPath: "out/berty.apk", Driver: yolopb.Driver_Jenkins, DownloadURL: server.URL + "/job/android/42/artifact/out/berty.apk"}, &buf)
+	require.NoError(t, err)
+	assert.Equal(t, "apk content", buf.String())
+}
@@ -5,9 +5,12 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"strings"
+	"strconv"
 	"time"
 
+	"berty.tech/yolo/v2/go/pkg/apkparse"
+	"berty.tech/yolo/v2/go/pkg/appcast"
+	"berty.tech/yolo/v2/go/pkg/ipaparse"
 	"berty.tech/yolo/v2/go/pkg/yolopb"
 	"github.com/tevino/abool"
 	"go.uber.org/zap"
@@ -35,10 +38,18 @@ func (svc *service) PkgmanWorker(ctx context.Context, opts PkgmanWorkerOpts) err
 		}
 
 		for _, artifact := range artifacts {
-			cache := filepath.Join(svc.artifactsCachePath, artifact.ID)
+			cache := filepath.Join(svc.artifactsCachePath, artifactCacheKey(artifact))
 			if !u.FileExists(cache) {
 				continue
 			}
+			if artifact.Sha256Sum == "" {
+				sums, err := fileChecksums(cache)
+				if err != nil {
+					logger.Warn("failed to compute checksums", zap.String("path", cache), zap.Error(err))
+				} else {
+					svc.saveChecksums(artifact, sums)
+				}
+			}
 			err = svc.pkgmanParseArtifactFile(artifact, cache)
 			if err != nil {
 				logger.Warn("failed to parse package", zap.String("path", cache), zap.Error(err))
@@ -70,52 +81,58 @@ func (o *PkgmanWorkerOpts) applyDefaults() {
 func (svc *service) pkgmanParseArtifactFile(artifact *yolopb.Artifact, artifactPath string) error {
 	switch artifact.Kind {
 	case yolopb.Artifact_IPA:
-		pkg, err := ipa.Open(artifactPath)
+		info, err := ipaparse.ParseFile(artifactPath)
 		if err != nil {
 			return err
 		}
-		defer pkg.Close()
-		apps := pkg.Apps()
-		if len(apps) != 1 {
-			return fmt.Errorf("pkgman: ipa should contain only 1 app, got %d", len(apps))
+		artifact.BundleName = info.DisplayName
+		artifact.BundleID = info.BundleID
+		artifact.BundleVersion = info.ShortVersion
+		artifact.BundleBuildVersion = info.BundleVersion
+		appIcon, err := svc.pkgmanExtractIPAAppIcon(artifactPath)
+		if err != nil {
+			svc.logger.Debug("failed to extract IPA app icon", zap.Error(err))
+		} else {
+			artifact.BundleIcon = appIcon
 		}
-		app := apps[0]
-		plist, err := app.Plist()
+		err = svc.store.SaveArtifact(artifact)
 		if err != nil {
 			return err
 		}
-		artifact.BundleName = plist.CFBundleDisplayName
-		if artifact.BundleName == "" {
-			artifact.BundleName = plist.CFBundleName
+	case yolopb.Artifact_APK:
+		info, err := apkparse.ParseFile(artifactPath)
+		if err != nil {
+			return err
 		}
-		artifact.BundleID = plist.CFBundleIdentifier
-		artifact.BundleVersion = plist.CFBundleShortVersionString
-		appIcon, err := svc.pkgmanExtractIPAAppIcon(app)
+		artifact.BundleID = info.PackageName
+		artifact.BundleVersion = info.VersionName
+		artifact.BundleBuildVersion = strconv.FormatInt(info.VersionCode, 10)
+		// the label is usually a resource reference, resolved by pkgman
+		label, err := pkgmanAPKLabel(artifactPath)
 		if err != nil {
-			svc.logger.Debug("failed to extract IPA app icon", zap.Error(err))
+			svc.logger.Debug("failed to extract APK label", zap.Error(err))
 		} else {
-			artifact.BundleIcon = appIcon
+			artifact.BundleName = label
 		}
+		// FIXME: extract icon
 		err = svc.store.SaveArtifact(artifact)
 		if err != nil {
 			return err
 		}
-	case yolopb.Artifact_APK:
-		pkg, err := apk.Open(artifactPath)
+	case yolopb.Artifact_DMG:
+		// the .dmg are not parsed, they are signed once for the Sparkle appcasts
+		if svc.sparkleKey == nil || artifact.SparkleEdSignature != "" {
+			return nil
+		}
+		f, err := os.Open(artifactPath)
 		if err != nil {
 			return err
 		}
-		defer pkg.Close()
-		manifest, err := pkg.Manifest()
+		defer f.Close()
+		artifact.SparkleEdSignature, err = appcast.Sign(svc.sparkleKey, f)
 		if err != nil {
 			return err
 		}
-		if mainActivity := manifest.MainActivity(); mainActivity != nil {
-			artifact.BundleName = mainActivity.Label
-			artifact.BundleID = strings.TrimSuffix(mainActivity.Name, ".MainActivity")
-			artifact.BundleVersion = manifest.VersionName
-		}
-		// FIXME: extract icon
 		err = svc.store.SaveArtifact(artifact)
 		if err != nil {
 			return err
@@ -131,7 +148,35 @@ func (svc *service) pkgmanParseArtifactFile(artifact *yolopb.Artifact, artifactP
 	return nil
 }
 
-func (svc *service) pkgmanExtractIPAAppIcon(app *ipa.App) (string, error) {
+func pkgmanAPKLabel(artifactPath string) (string, error) {
+	pkg, err := apk.Open(artifactPath)
+	if err != nil {
+		return "", err
+	}
+	defer pkg.Close()
+	manifest, err := pkg.Manifest()
+	if err != nil {
+		return "", err
+	}
+	mainActivity := manifest.MainActivity()
+	if mainActivity == nil {
+		return "", fmt.Errorf("pkgman: no main activity")
+	}
+	return mainActivity.Label, nil
+}
+
+func (svc *service) pkgmanExtractIPAAppIcon(artifactPath string) (string, error) {
+	pkg, err := ipa.Open(artifactPath)
+	if err != nil {
+		return "", err
+	}
+	defer pkg.Close()
+	apps := pkg.Apps()
+	if len(apps) != 1 {
+		return "", fmt.Errorf("pkgman: ipa should contain only 1 app, got %d", len(apps))
+	}
+	app := apps[0]
+
 	b, err := app.FileBytes("AppIcon60x60@3x.png")
 	if err != nil {
 		b, err = app.FileBytes("AppIcon60x60@2x.png")
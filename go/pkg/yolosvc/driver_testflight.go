@@ -0,0 +1,147 @@
+package yolosvc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/appstoreconnect"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/tevino/abool"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+type TestflightWorkerOpts struct {
+	Logger     *zap.Logger
+	MaxBuilds  int
+	LoopAfter  time.Duration
+	ClearCache *abool.AtomicBool
+	Once       bool
+	// AppIDs are the Apple IDs of the App Store Connect apps whose builds are fetched (i.e, 1234567890)
+	AppIDs []string
+}
+
+const testflightMaxPerPage = 200
+
+// TestflightWorker goals is to surface the processing and beta review states of the TestFlight builds, it should try to support as much errors as possible by itself
+func (svc *service) TestflightWorker(ctx context.Context, opts TestflightWorkerOpts) error {
+	opts.applyDefaults()
+
+	logger := opts.Logger.Named("tfli")
+	breaker := svc.breakers.get(yolopb.Driver_TestFlight)
+
+	for iteration := 0; ; iteration++ {
+		if !breaker.waitAllowed(ctx) {
+			return nil
+		}
+		// the states of the known builds keep changing, the most recent ones are always fetched again
+		logger.Debug("testflight: refresh", zap.Int("iteration", iteration))
+		failed := false
+		var fetchErr error
+		batch := yolopb.NewBatch()
+		for _, appID := range opts.AppIDs {
+			appBatch, err := svc.traceFetch(ctx, yolopb.Driver_TestFlight, func(ctx context.Context) (*yolopb.Batch, error) {
+				return fetchTestflightBuilds(ctx, svc.asc, appID, opts.MaxBuilds, logger)
+			}, attribute.String("yolo.app_id", appID))
+			if err != nil {
+				logger.Warn("fetch testflight", zap.String("app", appID), zap.Error(err))
+				failed = true
+				fetchErr = err
+				continue
+			}
+			batch.Merge(appBatch)
+		}
+		if err := svc.saveBatch(ctx, batch); err != nil {
+			logger.Warn("save batch", zap.Error(err))
+		} else if !failed {
+			svc.metrics.refreshed(yolopb.Driver_TestFlight)
+		}
+		breaker.record(fetchErr)
+
+		if opts.Once {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(withJitter(opts.LoopAfter)):
+		}
+	}
+}
+
+// fetchTestflightBuilds returns the most recently uploaded builds of an app
+func fetchTestflightBuilds(ctx context.Context, asc *appstoreconnect.Client, appID string, maxBuilds int, logger *zap.Logger) (*yolopb.Batch, error) {
+	batch := yolopb.NewBatch()
+	perPage := maxBuilds
+	if perPage > testflightMaxPerPage {
+		perPage = testflightMaxPerPage
+	}
+
+	fetched := 0
+	next := ""
+	for {
+		before := time.Now()
+		resp, err := asc.ListBuilds(ctx, appID, perPage, next)
+		if err != nil {
+			return nil, fmt.Errorf("list builds: %w", err)
+		}
+		logger.Debug("appstoreconnect.ListBuilds", zap.String("app", appID), zap.Int("builds", len(resp.Data)), zap.Duration("duration", time.Since(before)))
+		for _, build := range resp.Data {
+			if fetched >= maxBuilds {
+				return batch, nil
+			}
+			batch.Builds = append(batch.Builds, buildFromTestflightBuild(appID, build, resp))
+			fetched++
+		}
+		if resp.Links.Next == "" {
+			return batch, nil
+		}
+		next = resp.Links.Next
+	}
+}
+
+func buildFromTestflightBuild(appID string, build *appstoreconnect.Build, resp *appstoreconnect.ListBuildsResponse) *yolopb.Build {
+	uploadedAt := build.Attributes.UploadedDate
+	newBuild := yolopb.Build{
+		ID:            appstoreconnect.BuildURL(appID, build.ID),
+		ShortID:       build.Attributes.Version,
+		CreatedAt:     &uploadedAt,
+		Driver:        yolopb.Driver_TestFlight,
+		ExternalState: build.Attributes.ProcessingState,
+	}
+	if version := resp.Related(build.Relationships.PreReleaseVersion); version != nil {
+		newBuild.VCSTag = version.Attributes.Version
+	}
+	if details := resp.Related(build.Relationships.BuildBetaDetail); details != nil && details.Attributes.ExternalBuildState != "" {
+		newBuild.ExternalState = details.Attributes.ExternalBuildState
+	}
+
+	switch build.Attributes.ProcessingState {
+	case "PROCESSING":
+		newBuild.State = yolopb.Build_Running
+	case "VALID":
+		newBuild.State = yolopb.Build_Passed
+		newBuild.FinishedAt = &uploadedAt
+	case "FAILED", "INVALID":
+		newBuild.State = yolopb.Build_Failed
+		newBuild.FinishedAt = &uploadedAt
+	}
+	return &newBuild
+}
+
+func (o *TestflightWorkerOpts) applyDefaults() {
+	if o.Logger == nil {
+		o.Logger = zap.NewNop()
+	}
+	if o.MaxBuilds == 0 {
+		o.MaxBuilds = 100
+	}
+	if o.LoopAfter == 0 {
+		o.LoopAfter = 5 * time.Minute
+	}
+	if o.ClearCache == nil {
+		o.ClearCache = abool.New()
+	}
+}
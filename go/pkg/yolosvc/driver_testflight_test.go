@@ -0,0 +1,72 @@
+package yolosvc
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"berty.tech/yolo/v2/go/pkg/appstoreconnect"
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildFromTestflightBuild(t *testing.T) {
+	var resp appstoreconnect.ListBuildsResponse
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"data": [
+			{
+				"type": "builds", "id": "b1",
+				"attributes": {"version": "45", "uploadedDate": "2021-03-04T05:06:07Z", "processingState": "VALID"},
+				"relationships": {
+					"buildBetaDetail": {"data": {"type": "buildBetaDetails", "id": "d1"}},
+					"preReleaseVersion": {"data": {"type": "preReleaseVersions", "id": "v1"}}
+				}
+			},
+			{
+				"type": "builds", "id": "b2",
+				"attributes": {"version": "46", "uploadedDate": "2021-03-05T05:06:07Z", "processingState": "PROCESSING"}
+			}
+		],
+		"included": [
+			{"type": "buildBetaDetails", "id": "d1", "attributes": {"externalBuildState": "READY_FOR_BETA_TESTING"}},
+			{"type": "preReleaseVersions", "id": "v1", "attributes": {"version": "1.2.3", "platform": "IOS"}}
+		]
+	}`), &resp))
+
+	build := buildFromTestflightBuild("1234", resp.Data[0], &resp)
+	assert.Equal(t, "https://appstoreconnect.apple.com/apps/1234/testflight/ios/b1", build.ID)
+	assert.Equal(t, "45", build.ShortID)
+	assert.Equal(t, "1.2.3", build.VCSTag)
+	assert.Equal(t, yolopb.Build_Passed, build.State)
+	assert.Equal(t, yolopb.Driver_TestFlight, build.Driver)
+	assert.Equal(t, "READY_FOR_BETA_TESTING", build.ExternalState)
+
+	// without beta details, the processing state is used
+	build = buildFromTestflightBuild("1234", resp.Data[1], &resp)
+	assert.Equal(t, yolopb.Build_Running, build.State)
+	assert.Equal(t, "PROCESSING", build.ExternalState)
+	assert.Empty(t, build.VCSTag)
+}
+
+func TestServiceBuildListExternalState(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	svc := api.(*service)
+
+	err := svc.store.SaveBatch(&yolopb.Batch{Builds: []*yolopb.Build{
+		{ID: "tf-ready", State: yolopb.Build_Passed, Driver: yolopb.Driver_TestFlight, ExternalState: "READY_FOR_BETA_TESTING", HasMergerequestID: testMergeRequestID},
+		{ID: "tf-processing", State: yolopb.Build_Running, Driver: yolopb.Driver_TestFlight, ExternalState: "PROCESSING", HasMergerequestID: testMergeRequestID},
+	}})
+	require.NoError(t, err)
+
+	resp, err := svc.BuildList(context.Background(), &yolopb.BuildList_Request{ExternalState: []string{"READY_FOR_BETA_TESTING"}})
+	require.NoError(t, err)
+	require.Len(t, resp.Builds, 1)
+	assert.Equal(t, "tf-ready", resp.Builds[0].ID)
+
+	resp, err = svc.BuildList(context.Background(), &yolopb.BuildList_Request{BuildDriver: []yolopb.Driver{yolopb.Driver_TestFlight}})
+	require.NoError(t, err)
+	assert.Len(t, resp.Builds, 2)
+}
@@ -0,0 +1,58 @@
+package yolosvc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestETagMiddleware(t *testing.T) {
+	body := `{"builds":[]}`
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	})
+	handler := etagMiddleware(next, "salt", "/build-list")
+
+	get := func(handler http.Handler, path, ifNoneMatch string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest("GET", path, nil)
+		if ifNoneMatch != "" {
+			r.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	w := get(handler, "/build-list", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, body, w.Body.String())
+	etag := w.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	// unchanged
+	w = get(handler, "/build-list", etag)
+	assert.Equal(t, http.StatusNotModified, w.Code)
+	assert.Empty(t, w.Body.String())
+	w = get(handler, "/build-list", `"other", W/`+etag)
+	assert.Equal(t, http.StatusNotModified, w.Code)
+
+	// new builds
+	body = `{"builds":[{"id":"1"}]}`
+	w = get(handler, "/build-list", etag)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NotEqual(t, etag, w.Header().Get("ETag"))
+
+	// rotated signatures
+	w = get(etagMiddleware(next, "new-salt", "/build-list"), "/build-list", "")
+	assert.NotEqual(t, etag, w.Header().Get("ETag"))
+	assert.NotEqual(t, get(handler, "/build-list", "").Header().Get("ETag"), w.Header().Get("ETag"))
+
+	// other endpoints
+	w = get(handler, "/build-list-filters", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Header().Get("ETag"))
+}
@@ -0,0 +1,38 @@
+package yolosvc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	defaultFlagsManifest = "yolo-flags.json"
+	flagsManifestMaxSize = 64 * 1024
+)
+
+// parseFlagsManifest parses a JSON object of feature flags, i.e, {"new_onboarding": true, "theme": "dark"}.
+// non-string values are kept in their JSON representation.
+func parseFlagsManifest(content []byte) (map[string]string, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(content, &raw); err != nil {
+		return nil, fmt.Errorf("invalid flags manifest: %w", err)
+	}
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	flags := make(map[string]string, len(raw))
+	for key, value := range raw {
+		var str string
+		if err := json.Unmarshal(value, &str); err == nil {
+			flags[key] = str
+			continue
+		}
+		var compact bytes.Buffer
+		if err := json.Compact(&compact, value); err != nil {
+			return nil, fmt.Errorf("invalid flags manifest: %w", err)
+		}
+		flags[key] = compact.String()
+	}
+	return flags, nil
+}
@@ -0,0 +1,29 @@
+package yolosvc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFlagsManifest(t *testing.T) {
+	flags, err := parseFlagsManifest([]byte(`{"new_onboarding": true, "theme": "dark", "max_peers": 42, "rollout": {"percent": 10}}`))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"new_onboarding": "true",
+		"theme":          "dark",
+		"max_peers":      "42",
+		"rollout":        `{"percent":10}`,
+	}, flags)
+
+	flags, err = parseFlagsManifest([]byte(`{}`))
+	require.NoError(t, err)
+	assert.Nil(t, flags)
+
+	for _, invalid := range []string{``, `[]`, `{"a": }`, `"flag"`} {
+		flags, err = parseFlagsManifest([]byte(invalid))
+		assert.Error(t, err, invalid)
+		assert.Nil(t, flags)
+	}
+}
@@ -0,0 +1,167 @@
+package yolosvc
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/tevino/abool"
+	"go.uber.org/zap"
+)
+
+type GCWorkerOpts struct {
+	Logger     *zap.Logger
+	LoopAfter  time.Duration
+	ClearCache *abool.AtomicBool
+	Once       bool
+}
+
+type GCReport struct {
+	ExpiredBuilds     int
+	OrphanArtifacts   int
+	ExpiredSignatures int64
+	TrimmedEvents     int64
+}
+
+// GCWorker periodically removes the objects that are not reachable anymore
+func (svc *service) GCWorker(ctx context.Context, opts GCWorkerOpts) error {
+	opts.applyDefaults()
+
+	logger := opts.Logger.Named("gc")
+
+	for iteration := 0; ; iteration++ {
+		report, err := svc.collectGarbage(logger)
+		if err != nil {
+			logger.Warn("collect garbage", zap.Error(err))
+		} else {
+			logger.Info("gc: done", zap.Int("iteration", iteration), zap.Int("expired_builds", report.ExpiredBuilds), zap.Int("orphan_artifacts", report.OrphanArtifacts), zap.Int64("expired_signatures", report.ExpiredSignatures), zap.Int64("trimmed_events", report.TrimmedEvents))
+		}
+
+		if opts.Once {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(opts.LoopAfter):
+		}
+	}
+}
+
+func (svc *service) collectGarbage(logger *zap.Logger) (*GCReport, error) {
+	report := GCReport{}
+
+	// builds out of the retention, their artifacts are collected as orphans below
+	if svc.buildRetention > 0 || svc.buildRetentionCount > 0 {
+		var before time.Time
+		if svc.buildRetention > 0 {
+			before = time.Now().Add(-svc.buildRetention)
+		}
+		ids, err := svc.store.GetExpiredBuildIDs(before, svc.buildRetentionCount)
+		if err != nil {
+			return nil, err
+		}
+		if svc.buildRetentionDryRun {
+			for _, id := range ids {
+				logger.Info("gc: dry run, would remove expired build", zap.String("id", id))
+			}
+		} else {
+			for _, id := range ids {
+				logger.Debug("gc: expired build", zap.String("id", id))
+			}
+			if err := svc.store.DeleteBuilds(ids); err != nil {
+				return nil, err
+			}
+			report.ExpiredBuilds = len(ids)
+		}
+	}
+
+	// orphan artifacts
+	{
+		artifacts, err := svc.store.GetOrphanArtifacts()
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]string, len(artifacts))
+		for i, artifact := range artifacts {
+			ids[i] = artifact.ID
+			logger.Debug("gc: orphan artifact", zap.String("id", artifact.ID), zap.String("build", artifact.HasBuildID))
+		}
+		successors, err := svc.store.DeleteArtifacts(ids)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			if successor, found := successors[id]; found {
+				svc.moveArtifactCache(id, successor, logger)
+			} else {
+				svc.removeArtifactCache(id, logger)
+			}
+		}
+		report.OrphanArtifacts = len(ids)
+	}
+
+	// spent signatures of expired single-use URLs
+	{
+		deleted, err := svc.store.DeleteExpiredSpentSignatures(time.Now())
+		if err != nil {
+			return nil, err
+		}
+		report.ExpiredSignatures = deleted
+	}
+
+	// download and install events out of the retention window
+	if svc.eventRetention > 0 {
+		trimmed, err := svc.store.TrimEvents(time.Now().Add(-svc.eventRetention))
+		if err != nil {
+			return nil, err
+		}
+		report.TrimmedEvents = trimmed
+	}
+
+	if report.ExpiredBuilds > 0 || report.OrphanArtifacts > 0 {
+		svc.clearCache.Set()
+	}
+
+	return &report, nil
+}
+
+// removeArtifactCache removes the mirrored blobs of an artifact from the artifacts cache
+func (svc *service) removeArtifactCache(id string, logger *zap.Logger) {
+	if svc.artifactsCachePath == "" {
+		return
+	}
+	for _, cacheKey := range []string{id, id + ".signed"} {
+		err := os.Remove(filepath.Join(svc.artifactsCachePath, cacheKey))
+		if err != nil && !os.IsNotExist(err) {
+			logger.Warn("gc: remove cache", zap.String("key", cacheKey), zap.Error(err))
+		}
+	}
+}
+
+// moveArtifactCache hands the mirrored blobs of a removed artifact over to the duplicate now sharing them
+func (svc *service) moveArtifactCache(id, successor string, logger *zap.Logger) {
+	if svc.artifactsCachePath == "" {
+		return
+	}
+	for _, suffix := range []string{"", ".signed"} {
+		err := os.Rename(filepath.Join(svc.artifactsCachePath, id+suffix), filepath.Join(svc.artifactsCachePath, successor+suffix))
+		if err != nil && !os.IsNotExist(err) {
+			logger.Warn("gc: move cache", zap.String("key", id+suffix), zap.String("successor", successor), zap.Error(err))
+		}
+	}
+}
+
+func (o *GCWorkerOpts) applyDefaults() {
+	if o.Logger == nil {
+		o.Logger = zap.NewNop()
+	}
+	if o.LoopAfter == 0 {
+		o.LoopAfter = time.Hour
+	}
+	if o.ClearCache == nil {
+		o.ClearCache = abool.New()
+	}
+}
@@ -0,0 +1,207 @@
+package yolosvc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectGarbageTrimEvents(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t), EventRetention: time.Hour})
+	defer cleanup()
+	svc := api.(*service)
+	req := &yolopb.BuildList_Request{Limit: 1, WithArtifacts: true}
+
+	// the fixture download is undated, like the events saved before the retention window existed
+	require.NoError(t, svc.store.CreateDownload(&yolopb.Download{HasArtifactID: "artif1"}))
+	resp, err := svc.BuildList(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, resp.Builds, 1)
+	require.Equal(t, int64(2), resp.Builds[0].DownloadsCount)
+
+	// recent events are kept
+	report, err := svc.collectGarbage(svc.logger)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), report.TrimmedEvents)
+	downloads, err := svc.store.GetDumpWithPreloading()
+	require.NoError(t, err)
+	require.Len(t, downloads, 1)
+	assert.NotNil(t, downloads[0].CreatedAt)
+
+	// trimmed events still count
+	trimmed, err := svc.store.TrimEvents(time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), trimmed)
+	downloads, err = svc.store.GetDumpWithPreloading()
+	require.NoError(t, err)
+	assert.Empty(t, downloads)
+
+	resp, err = svc.BuildList(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, resp.Builds, 1)
+	assert.Equal(t, int64(2), resp.Builds[0].DownloadsCount)
+	assert.Equal(t, int64(2), resp.Builds[0].HasArtifacts[0].DownloadsCount)
+
+	status, err := svc.Status(context.Background(), &yolopb.Status_Request{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3600), status.EventRetentionSeconds)
+}
+
+func TestCollectGarbageBuildRetention(t *testing.T) {
+	for _, dryRun := range []bool{true, false} {
+		api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t), BuildRetentionCount: 1, BuildRetentionDryRun: dryRun})
+		svc := api.(*service)
+
+		oldest, newest := time.Now().Add(-48*time.Hour), time.Now().Add(-time.Hour)
+		err := svc.store.SaveBatch(&yolopb.Batch{
+			Builds: []*yolopb.Build{
+				{ID: "old", CreatedAt: &oldest, HasProjectID: "https://github.com/berty/berty", Driver: yolopb.Driver_GitHub},
+				{ID: "new", CreatedAt: &newest, HasProjectID: "https://github.com/berty/berty", Driver: yolopb.Driver_GitHub},
+				{ID: "promoted", CreatedAt: &oldest, HasProjectID: "https://github.com/berty/berty", Driver: yolopb.Driver_GitHub},
+			},
+			Artifacts: []*yolopb.Artifact{
+				{ID: "artif-old", HasBuildID: "old"},
+				{ID: "artif-new", HasBuildID: "new"},
+			},
+		})
+		require.NoError(t, err)
+		_, err = svc.store.PromoteBuild("promoted", "stable")
+		require.NoError(t, err)
+
+		status, err := svc.Status(context.Background(), &yolopb.Status_Request{})
+		require.NoError(t, err)
+		require.Equal(t, int32(4), status.NbBuilds)
+
+		report, err := svc.collectGarbage(svc.logger)
+		require.NoError(t, err)
+
+		status, err = svc.Status(context.Background(), &yolopb.Status_Request{})
+		require.NoError(t, err)
+		batch, err := svc.store.GetBatch()
+		require.NoError(t, err)
+		if dryRun {
+			assert.Equal(t, 0, report.ExpiredBuilds)
+			assert.Equal(t, int32(4), status.NbBuilds)
+			assert.Len(t, batch.Artifacts, 3)
+		} else {
+			// the fixture build and "old" are beyond the most recent build of the project, "promoted" is kept
+			assert.Equal(t, 2, report.ExpiredBuilds)
+			assert.Equal(t, 2, report.OrphanArtifacts)
+			assert.Equal(t, int32(2), status.NbBuilds)
+			require.Len(t, batch.Artifacts, 1)
+			assert.Equal(t, "artif-new", batch.Artifacts[0].ID)
+		}
+		cleanup()
+	}
+}
+
+func TestCollectGarbageOrphanArtifacts(t *testing.T) {
+	cachePath := t.TempDir()
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t), ArtifactsCachePath: cachePath})
+	defer cleanup()
+	svc := api.(*service)
+
+	// an artifact whose build was removed, with its downloads and mirrored blobs
+	require.NoError(t, svc.store.SaveArtifact(&yolopb.Artifact{ID: "orphan", HasBuildID: "removed"}))
+	require.NoError(t, svc.store.CreateDownload(&yolopb.Download{HasArtifactID: "orphan"}))
+	for _, key := range []string{"orphan", "orphan.signed", "artif1"} {
+		require.NoError(t, os.WriteFile(filepath.Join(cachePath, key), []byte("blob"), 0o600))
+	}
+
+	report, err := svc.collectGarbage(svc.logger)
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.OrphanArtifacts)
+	assert.Equal(t, 0, report.ExpiredBuilds)
+	assert.True(t, svc.clearCache.IsSet())
+
+	batch, err := svc.store.GetBatch()
+	require.NoError(t, err)
+	require.Len(t, batch.Artifacts, 1)
+	assert.Equal(t, "artif1", batch.Artifacts[0].ID)
+	downloads, err := svc.store.GetDumpWithPreloading()
+	require.NoError(t, err)
+	for _, download := range downloads {
+		assert.NotEqual(t, "orphan", download.HasArtifactID)
+	}
+	for _, key := range []string{"orphan", "orphan.signed"} {
+		_, err := os.Stat(filepath.Join(cachePath, key))
+		assert.True(t, os.IsNotExist(err), key)
+	}
+	_, err = os.Stat(filepath.Join(cachePath, "artif1"))
+	assert.NoError(t, err)
+
+	// nothing left to collect
+	report, err = svc.collectGarbage(svc.logger)
+	require.NoError(t, err)
+	assert.Equal(t, 0, report.OrphanArtifacts)
+}
+
+func TestCollectGarbageDuplicateArtifacts(t *testing.T) {
+	cachePath := t.TempDir()
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t), ArtifactsCachePath: cachePath, BuildRetention: 24 * time.Hour})
+	defer cleanup()
+	svc := api.(*service)
+
+	// two builds sharing the same mirrored blob, owned by the artifact of the expired one
+	oldest, newest := time.Now().Add(-48*time.Hour), time.Now().Add(-time.Hour)
+	err := svc.store.SaveBatch(&yolopb.Batch{
+		Builds: []*yolopb.Build{
+			{ID: "old", CreatedAt: &oldest, Driver: yolopb.Driver_Buildkite},
+			{ID: "new", CreatedAt: &newest, Driver: yolopb.Driver_Buildkite},
+		},
+		Artifacts: []*yolopb.Artifact{
+			{ID: "artif-old", CreatedAt: &oldest, HasBuildID: "old", Kind: yolopb.Artifact_APK, Driver: yolopb.Driver_Buildkite, Sha256Sum: helloSHA256},
+			{ID: "artif-new", CreatedAt: &newest, HasBuildID: "new", Kind: yolopb.Artifact_APK, Driver: yolopb.Driver_Buildkite, Sha256Sum: helloSHA256, DuplicateOfID: "artif-old"},
+			{ID: "artif-new-2", CreatedAt: &newest, HasBuildID: "new", Kind: yolopb.Artifact_APK, Driver: yolopb.Driver_Buildkite, Sha256Sum: helloSHA256, DuplicateOfID: "artif-old"},
+		},
+	})
+	require.NoError(t, err)
+	for _, key := range []string{"artif-old", "artif-old.signed"} {
+		require.NoError(t, os.WriteFile(filepath.Join(cachePath, key), []byte("hello"), 0o600))
+	}
+
+	report, err := svc.collectGarbage(svc.logger)
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.ExpiredBuilds)
+	assert.Equal(t, 1, report.OrphanArtifacts)
+
+	// the first surviving duplicate owns the blob, the other one is linked to it
+	duplicateOf := func(id string) string {
+		artifact, err := svc.store.GetArtifactByID(id)
+		require.NoError(t, err)
+		return artifact.DuplicateOfID
+	}
+	assert.Empty(t, duplicateOf("artif-new"))
+	assert.Equal(t, "artif-new", duplicateOf("artif-new-2"))
+	assert.NoFileExists(t, filepath.Join(cachePath, "artif-old"))
+	assert.NoFileExists(t, filepath.Join(cachePath, "artif-old.signed"))
+	assert.FileExists(t, filepath.Join(cachePath, "artif-new"))
+	assert.FileExists(t, filepath.Join(cachePath, "artif-new.signed"))
+
+	// still downloaded from the cache, the Buildkite driver has no client
+	for _, id := range []string{"artif-new", "artif-new-2"} {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("artifactID", id)
+		r := httptest.NewRequest("GET", "/", nil)
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+		w := httptest.NewRecorder()
+		svc.ArtifactDownloader(w, r)
+		assert.Equal(t, http.StatusOK, w.Code, id)
+		assert.Equal(t, "hello", w.Body.String(), id)
+	}
+
+	// new ingestions of the same content are linked to the surviving artifact
+	canonicals, err := svc.store.GetArtifactsBySHA256([]string{helloSHA256})
+	require.NoError(t, err)
+	assert.Equal(t, "artif-new", canonicals[helloSHA256].ID)
+}
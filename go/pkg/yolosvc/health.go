@@ -0,0 +1,123 @@
+package yolosvc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/buildkite/go-buildkite/buildkite"
+	"go.uber.org/zap"
+)
+
+// readinessTimeout bounds the dependency checks, so the probes never hang on an unreachable API
+const readinessTimeout = 3 * time.Second
+
+type dependencyStatus struct {
+	OK       bool   `json:"ok"`
+	Critical bool   `json:"critical"`
+	Error    string `json:"error,omitempty"`
+}
+
+type readiness struct {
+	Status string                      `json:"status"`
+	Checks map[string]dependencyStatus `json:"checks"`
+}
+
+type dependencyCheck struct {
+	name     string
+	critical bool
+	check    func(ctx context.Context) error
+}
+
+// Healthz is the liveness probe, it only reports that the server is responding
+func (svc *service) Healthz(w http.ResponseWriter, r *http.Request) {
+	svc.sendHealth(w, http.StatusOK, readiness{Status: "ok"})
+}
+
+// Readyz is the readiness probe, it fails with 503 if a critical dependency (the database) is unavailable,
+// the CI providers are only checked with ReadinessCheckDrivers and don't make the server unready
+func (svc *service) Readyz(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), readinessTimeout)
+	defer cancel()
+
+	var (
+		checks = svc.dependencyChecks()
+		ret    = readiness{Status: "ok", Checks: make(map[string]dependencyStatus, len(checks))}
+		mutex  sync.Mutex
+		wg     sync.WaitGroup
+	)
+	for _, dep := range checks {
+		wg.Add(1)
+		go func(dep dependencyCheck) {
+			defer wg.Done()
+			// some clients don't support contexts, the check is abandoned on timeout
+			done := make(chan error, 1)
+			go func() { done <- dep.check(ctx) }()
+			var err error
+			select {
+			case err = <-done:
+			case <-ctx.Done():
+				err = ctx.Err()
+			}
+
+			status := dependencyStatus{OK: err == nil, Critical: dep.critical}
+			if err != nil {
+				status.Error = err.Error()
+				svc.logger.Warn("readiness check failed", zap.String("dependency", dep.name), zap.Error(err))
+			}
+			mutex.Lock()
+			ret.Checks[dep.name] = status
+			mutex.Unlock()
+		}(dep)
+	}
+	wg.Wait()
+
+	code := http.StatusOK
+	for _, status := range ret.Checks {
+		if !status.OK && status.Critical {
+			ret.Status = "unavailable"
+			code = http.StatusServiceUnavailable
+		}
+	}
+	svc.sendHealth(w, code, ret)
+}
+
+func (svc *service) dependencyChecks() []dependencyCheck {
+	checks := []dependencyCheck{{name: "db", critical: true, check: func(ctx context.Context) error {
+		var one int
+		return svc.store.DB().DB().QueryRowContext(ctx, "SELECT 1").Scan(&one)
+	}}}
+	if !svc.readinessCheckDrivers {
+		return checks
+	}
+	if svc.ghc != nil {
+		checks = append(checks, dependencyCheck{name: "github", check: func(ctx context.Context) error {
+			_, _, err := svc.ghc.RateLimits(ctx)
+			return err
+		}})
+	}
+	if svc.bkc != nil {
+		checks = append(checks, dependencyCheck{name: "buildkite", check: func(context.Context) error {
+			_, _, err := svc.bkc.Organizations.List(&buildkite.OrganizationListOptions{ListOptions: buildkite.ListOptions{PerPage: 1}})
+			return err
+		}})
+	}
+	if svc.ccc != nil {
+		checks = append(checks, dependencyCheck{name: "circleci", check: func(context.Context) error {
+			_, err := svc.ccc.Me()
+			return err
+		}})
+	}
+	return checks
+}
+
+func (svc *service) sendHealth(w http.ResponseWriter, code int, ret readiness) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(ret); err != nil {
+		svc.logger.Warn("failed to send health status", zap.Error(err))
+	}
+}
@@ -0,0 +1,54 @@
+package yolosvc
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"github.com/google/go-github/v32/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthProbes(t *testing.T) {
+	githubAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer githubAPI.Close()
+	ghc := github.NewClient(nil)
+	ghc.BaseURL, _ = url.Parse(githubAPI.URL + "/")
+
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t), GithubClient: ghc, ReadinessCheckDrivers: true})
+	defer cleanup()
+	svc := api.(*service)
+
+	probe := func(handler http.HandlerFunc) (int, readiness) {
+		w := httptest.NewRecorder()
+		handler(w, httptest.NewRequest("GET", "/", nil))
+		var ret readiness
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&ret))
+		return w.Code, ret
+	}
+
+	code, ret := probe(svc.Healthz)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "ok", ret.Status)
+
+	// the unreachable drivers are reported without making the server unready
+	code, ret = probe(svc.Readyz)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "ok", ret.Status)
+	assert.Equal(t, dependencyStatus{OK: true, Critical: true}, ret.Checks["db"])
+	assert.False(t, ret.Checks["github"].OK)
+	assert.NotEmpty(t, ret.Checks["github"].Error)
+
+	// the database is critical
+	require.NoError(t, svc.store.DB().Close())
+	code, ret = probe(svc.Readyz)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, "unavailable", ret.Status)
+	assert.False(t, ret.Checks["db"].OK)
+}
@@ -0,0 +1,154 @@
+package yolosvc
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+type logExclusions struct {
+	userAgents []*regexp.Regexp
+	networks   []*net.IPNet
+	proxies    trustedProxies
+}
+
+// parseLogExclusions parses comma-separated lists of user-agent patterns and IP ranges (CIDR or single IPs),
+// the client IPs are read behind the trusted proxies
+func parseLogExclusions(userAgents, ips string, proxies trustedProxies) (*logExclusions, error) {
+	exclusions := logExclusions{proxies: proxies}
+	for _, pattern := range strings.Split(userAgents, ",") {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid user-agent pattern %q: %w", pattern, err)
+		}
+		exclusions.userAgents = append(exclusions.userAgents, re)
+	}
+	var err error
+	exclusions.networks, err = parseIPNetworks(ips)
+	if err != nil {
+		return nil, err
+	}
+	return &exclusions, nil
+}
+
+// parseIPNetworks parses a comma-separated list of IP ranges (CIDR or single IPs)
+func parseIPNetworks(ips string) ([]*net.IPNet, error) {
+	var networks []*net.IPNet
+	for _, cidr := range strings.Split(ips, ",") {
+		cidr = strings.TrimSpace(cidr)
+		if cidr == "" {
+			continue
+		}
+		if !strings.Contains(cidr, "/") {
+			if strings.Contains(cidr, ":") {
+				cidr += "/128"
+			} else {
+				cidr += "/32"
+			}
+		}
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid IP range %q: %w", cidr, err)
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
+
+// trustedProxies are the reverse proxies whose X-Forwarded-For header is trusted
+type trustedProxies []*net.IPNet
+
+// parseTrustedProxies parses a comma-separated list of IP ranges (CIDR or single IPs)
+func parseTrustedProxies(ips string) (trustedProxies, error) {
+	networks, err := parseIPNetworks(ips)
+	if err != nil {
+		return nil, fmt.Errorf("trusted proxies: %w", err)
+	}
+	return trustedProxies(networks), nil
+}
+
+func (p trustedProxies) contains(ip string) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, network := range p {
+		if network.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *logExclusions) empty() bool {
+	return len(e.userAgents) == 0 && len(e.networks) == 0
+}
+
+func (e *logExclusions) match(r *http.Request) bool {
+	userAgent := r.UserAgent()
+	for _, re := range e.userAgents {
+		if re.MatchString(userAgent) {
+			return true
+		}
+	}
+	if len(e.networks) > 0 {
+		if ip := net.ParseIP(e.proxies.clientIP(r)); ip != nil {
+			for _, network := range e.networks {
+				if network.Contains(ip) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// clientIP returns the remote address, or the X-Forwarded-For entry added by the outermost trusted proxy.
+// the entries are read from the right, the ones on the left of the first untrusted address can be forged by the client.
+func (p trustedProxies) clientIP(r *http.Request) string {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+	if !p.contains(ip) {
+		return ip
+	}
+	forwarded := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		entry := strings.TrimSpace(forwarded[i])
+		if entry == "" {
+			continue
+		}
+		ip = entry
+		if !p.contains(ip) {
+			break
+		}
+	}
+	return ip
+}
+
+// logFilter bypasses the access log middleware for excluded requests (health checks, bots, ...),
+// unless the logger is configured with debug verbosity.
+func logFilter(logMiddleware func(http.Handler) http.Handler, exclusions *logExclusions, logger *zap.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		logged := logMiddleware(next)
+		if exclusions.empty() || logger.Core().Enabled(zap.DebugLevel) {
+			return logged
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if exclusions.match(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			logged.ServeHTTP(w, r)
+		})
+	}
+}
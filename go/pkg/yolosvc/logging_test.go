@@ -0,0 +1,82 @@
+package yolosvc
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogExclusions(t *testing.T) {
+	proxies, err := parseTrustedProxies("172.16.0.1")
+	require.NoError(t, err)
+	exclusions, err := parseLogExclusions("(?i)uptimerobot, Googlebot", "10.0.0.0/8,192.168.1.42", proxies)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		userAgent  string
+		remoteAddr string
+		forwarded  string
+		expected   bool
+	}{
+		{"browser", "Mozilla/5.0 (iPhone)", "1.2.3.4:1234", "", false},
+		{"uptime-robot", "Mozilla/5.0+(compatible; UptimeRobot/2.0)", "1.2.3.4:1234", "", true},
+		{"googlebot", "Googlebot/2.1", "1.2.3.4:1234", "", true},
+		{"private-range", "curl/7.68.0", "10.1.2.3:1234", "", true},
+		{"single-ip", "curl/7.68.0", "192.168.1.42:1234", "", true},
+		{"other-ip", "curl/7.68.0", "192.168.1.43:1234", "", false},
+		{"forwarded", "curl/7.68.0", "172.16.0.1:1234", "10.0.0.1", true},
+		{"untrusted-forwarded", "curl/7.68.0", "1.2.3.4:1234", "10.0.0.1", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/api/build-list", nil)
+			r.Header.Set("User-Agent", tt.userAgent)
+			r.RemoteAddr = tt.remoteAddr
+			if tt.forwarded != "" {
+				r.Header.Set("X-Forwarded-For", tt.forwarded)
+			}
+			assert.Equal(t, tt.expected, exclusions.match(r))
+		})
+	}
+
+	_, err = parseLogExclusions("", "not-an-ip", nil)
+	require.Error(t, err)
+}
+
+func TestTrustedProxiesClientIP(t *testing.T) {
+	proxies, err := parseTrustedProxies("10.0.0.0/8, 172.16.0.1")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		proxies    trustedProxies
+		remoteAddr string
+		forwarded  string
+		expected   string
+	}{
+		{"direct", proxies, "1.2.3.4:1234", "", "1.2.3.4"},
+		{"untrusted-forwarded", proxies, "1.2.3.4:1234", "5.6.7.8", "1.2.3.4"},
+		{"no-trusted-proxies", nil, "10.0.0.1:1234", "5.6.7.8", "10.0.0.1"},
+		{"trusted-forwarded", proxies, "10.0.0.1:1234", "5.6.7.8", "5.6.7.8"},
+		{"forged-entries", proxies, "10.0.0.1:1234", "6.6.6.6, 5.6.7.8", "5.6.7.8"},
+		{"proxy-chain", proxies, "10.0.0.1:1234", "6.6.6.6, 5.6.7.8, 172.16.0.1", "5.6.7.8"},
+		{"trusted-only", proxies, "10.0.0.1:1234", "172.16.0.1", "172.16.0.1"},
+		{"trusted-without-header", proxies, "10.0.0.1:1234", "", "10.0.0.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/api/build-list", nil)
+			r.RemoteAddr = tt.remoteAddr
+			if tt.forwarded != "" {
+				r.Header.Set("X-Forwarded-For", tt.forwarded)
+			}
+			assert.Equal(t, tt.expected, tt.proxies.clientIP(r))
+		})
+	}
+
+	_, err = parseTrustedProxies("not-an-ip")
+	require.Error(t, err)
+}
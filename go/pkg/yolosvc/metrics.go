@@ -0,0 +1,217 @@
+package yolosvc
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+)
+
+// defaultLatencyBuckets are the upper bounds in seconds of the latency histograms
+var defaultLatencyBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// Metrics collects the service counters, exposed in the Prometheus text format by ServeHTTP
+type Metrics struct {
+	mutex             sync.Mutex
+	downloads         map[yolopb.Driver]int64
+	downloadBytes     map[yolopb.Driver]int64
+	corruptDownloads  map[yolopb.Driver]int64
+	installs          map[yolopb.Driver]int64
+	buildListDuration *histogram
+	buildListQuery    *histogram
+	lastRefresh       map[yolopb.Driver]time.Time
+}
+
+func NewMetrics() *Metrics {
+	return &Metrics{
+		downloads:         map[yolopb.Driver]int64{},
+		downloadBytes:     map[yolopb.Driver]int64{},
+		corruptDownloads:  map[yolopb.Driver]int64{},
+		installs:          map[yolopb.Driver]int64{},
+		buildListDuration: newHistogram(defaultLatencyBuckets),
+		buildListQuery:    newHistogram(defaultLatencyBuckets),
+		lastRefresh:       map[yolopb.Driver]time.Time{},
+	}
+}
+
+// Downloads returns the number of artifact downloads of a driver
+func (m *Metrics) Downloads(driver yolopb.Driver) int64 {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.downloads[driver]
+}
+
+// DownloadBytes returns the number of bytes sent for the artifacts of a driver
+func (m *Metrics) DownloadBytes(driver yolopb.Driver) int64 {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.downloadBytes[driver]
+}
+
+// CorruptDownloads returns the number of downloads of a driver whose size or checksum didn't match the stored ones
+func (m *Metrics) CorruptDownloads(driver yolopb.Driver) int64 {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.corruptDownloads[driver]
+}
+
+// Installs returns the number of installs confirmed by the apps of the builds of a driver
+func (m *Metrics) Installs(driver yolopb.Driver) int64 {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.installs[driver]
+}
+
+// LastRefresh returns when the builds of a driver were last fetched successfully
+func (m *Metrics) LastRefresh(driver yolopb.Driver) time.Time {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.lastRefresh[driver]
+}
+
+func (m *Metrics) download(driver yolopb.Driver) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.downloads[driver]++
+}
+
+func (m *Metrics) sent(driver yolopb.Driver, n int64) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.downloadBytes[driver] += n
+}
+
+func (m *Metrics) corruptDownload(driver yolopb.Driver) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.corruptDownloads[driver]++
+}
+
+func (m *Metrics) install(driver yolopb.Driver) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.installs[driver]++
+}
+
+func (m *Metrics) refreshed(driver yolopb.Driver) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.lastRefresh[driver] = time.Now()
+}
+
+func (m *Metrics) observeBuildList(since time.Time) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.buildListDuration.observe(time.Since(since).Seconds())
+}
+
+func (m *Metrics) observeBuildListQuery(since time.Time) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.buildListQuery.observe(time.Since(since).Seconds())
+}
+
+func (m *Metrics) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	writeDriverMetric(w, "yolo_artifact_downloads_total", "counter", "Artifact downloads by driver.", m.downloads)
+	writeDriverMetric(w, "yolo_artifact_download_bytes_total", "counter", "Bytes sent for the artifact downloads by driver.", m.downloadBytes)
+	writeDriverMetric(w, "yolo_artifact_corrupt_downloads_total", "counter", "Artifact downloads whose size or checksum didn't match the stored ones, by driver.", m.corruptDownloads)
+	writeDriverMetric(w, "yolo_build_installs_total", "counter", "Installs confirmed by the apps, by driver.", m.installs)
+	writeConversionMetric(w, m.installs, m.downloads)
+	m.buildListDuration.write(w, "yolo_buildlist_duration_seconds", "Latency of the BuildList requests.")
+	m.buildListQuery.write(w, "yolo_buildlist_query_duration_seconds", "Duration of the build list database queries.")
+	refreshes := make(map[yolopb.Driver]int64, len(m.lastRefresh))
+	for driver, at := range m.lastRefresh {
+		refreshes[driver] = at.Unix()
+	}
+	writeDriverMetric(w, "yolo_last_successful_refresh_timestamp_seconds", "gauge", "Last time the builds of a driver were fetched successfully.", refreshes)
+}
+
+func writeDriverMetric(w io.Writer, name, kind, help string, values map[yolopb.Driver]int64) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+	drivers := make([]yolopb.Driver, 0, len(values))
+	for driver := range values {
+		drivers = append(drivers, driver)
+	}
+	sort.Slice(drivers, func(i, j int) bool { return drivers[i] < drivers[j] })
+	for _, driver := range drivers {
+		fmt.Fprintf(w, "%s{driver=%q} %d\n", name, driver.String(), values[driver])
+	}
+}
+
+// writeConversionMetric writes the ratio of the confirmed installs to the downloads of each driver
+func writeConversionMetric(w io.Writer, installs, downloads map[yolopb.Driver]int64) {
+	const name = "yolo_install_conversion_ratio"
+	fmt.Fprintf(w, "# HELP %s Installs confirmed by the apps per artifact download, by driver.\n# TYPE %s gauge\n", name, name)
+	drivers := make([]yolopb.Driver, 0, len(downloads))
+	for driver, count := range downloads {
+		if count > 0 {
+			drivers = append(drivers, driver)
+		}
+	}
+	sort.Slice(drivers, func(i, j int) bool { return drivers[i] < drivers[j] })
+	for _, driver := range drivers {
+		ratio := float64(installs[driver]) / float64(downloads[driver])
+		fmt.Fprintf(w, "%s{driver=%q} %s\n", name, driver.String(), strconv.FormatFloat(ratio, 'g', -1, 64))
+	}
+}
+
+type histogram struct {
+	buckets []float64
+	counts  []int64 // per bucket, not cumulative
+	sum     float64
+	count   int64
+}
+
+func newHistogram(buckets []float64) *histogram {
+	return &histogram{buckets: buckets, counts: make([]int64, len(buckets))}
+}
+
+func (h *histogram) observe(value float64) {
+	h.sum += value
+	h.count++
+	for i, bound := range h.buckets {
+		if value <= bound {
+			h.counts[i]++
+			return
+		}
+	}
+}
+
+func (h *histogram) write(w io.Writer, name, help string) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s histogram\n", name, help, name)
+	cumulative := int64(0)
+	for i, bound := range h.buckets {
+		cumulative += h.counts[i]
+		fmt.Fprintf(w, "%s_bucket{le=%q} %d\n", name, strconv.FormatFloat(bound, 'g', -1, 64), cumulative)
+	}
+	fmt.Fprintf(w, "%s_bucket{le=\"+Inf\"} %d\n", name, h.count)
+	fmt.Fprintf(w, "%s_sum %s\n", name, strconv.FormatFloat(h.sum, 'g', -1, 64))
+	fmt.Fprintf(w, "%s_count %d\n", name, h.count)
+}
+
+// countingResponseWriter reports the bytes sent to the client
+type countingResponseWriter struct {
+	http.ResponseWriter
+	count func(n int64)
+}
+
+func (w *countingResponseWriter) Write(p []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(p)
+	w.count(int64(n))
+	return n, err
+}
+
+func (w *countingResponseWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
@@ -0,0 +1,67 @@
+package yolosvc
+
+import (
+	"context"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetrics(t *testing.T) {
+	metrics := NewMetrics()
+	cachePath := t.TempDir()
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t), Metrics: metrics, ArtifactsCachePath: cachePath})
+	defer cleanup()
+	svc := api.(*service)
+	require.NoError(t, os.WriteFile(filepath.Join(cachePath, "artif1"), []byte("hello"), 0o600))
+
+	_, err := svc.BuildList(context.Background(), &yolopb.BuildList_Request{})
+	require.NoError(t, err)
+
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("artifactID", "artif1")
+	r := httptest.NewRequest("GET", "/", nil)
+	r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+	svc.ArtifactDownloader(httptest.NewRecorder(), r)
+	assert.Equal(t, int64(1), metrics.Downloads(yolopb.Driver_Buildkite))
+	assert.Equal(t, int64(0), metrics.Downloads(yolopb.Driver_GitHub))
+
+	assert.True(t, metrics.LastRefresh(yolopb.Driver_GitHub).IsZero())
+	metrics.refreshed(yolopb.Driver_GitHub)
+	assert.False(t, metrics.LastRefresh(yolopb.Driver_GitHub).IsZero())
+
+	w := httptest.NewRecorder()
+	metrics.ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+	body := w.Body.String()
+	assert.Contains(t, body, "# TYPE yolo_artifact_downloads_total counter\n")
+	assert.Contains(t, body, `yolo_artifact_downloads_total{driver="Buildkite"} 1`)
+	assert.Contains(t, body, `yolo_buildlist_duration_seconds_bucket{le="+Inf"} 1`)
+	assert.Contains(t, body, "yolo_buildlist_duration_seconds_count 1\n")
+	assert.Contains(t, body, "yolo_buildlist_query_duration_seconds_count 1\n")
+	assert.Contains(t, body, `yolo_last_successful_refresh_timestamp_seconds{driver="GitHub"}`)
+}
+
+func TestHistogram(t *testing.T) {
+	h := newHistogram([]float64{0.1, 1})
+	h.observe(0.05)
+	h.observe(0.5)
+	h.observe(5)
+
+	w := httptest.NewRecorder()
+	h.write(w, "test_seconds", "Test.")
+	assert.Equal(t, `# HELP test_seconds Test.
+# TYPE test_seconds histogram
+test_seconds_bucket{le="0.1"} 1
+test_seconds_bucket{le="1"} 2
+test_seconds_bucket{le="+Inf"} 3
+test_seconds_sum 5.55
+test_seconds_count 3
+`, w.Body.String())
+}
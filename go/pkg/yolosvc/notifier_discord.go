@@ -0,0 +1,99 @@
+package yolosvc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// embed limits, see https://discord.com/developers/docs/resources/channel#embed-object-embed-limits
+const (
+	discordTitleLimit       = 256
+	discordDescriptionLimit = 4096
+	discordEmbedColor       = 0x3845e5
+)
+
+// discordNotifier posts the new artifacts to a Discord webhook
+type discordNotifier struct {
+	webhookURL string
+	client     *http.Client
+}
+
+func newDiscordNotifier(webhookURL string) *discordNotifier {
+	return &discordNotifier{
+		webhookURL: webhookURL,
+		client:     &http.Client{Timeout: notifierTimeout},
+	}
+}
+
+func (n *discordNotifier) name() string { return "discord" }
+
+func (n *discordNotifier) notify(ctx context.Context, notification *artifactsNotification) error {
+	body, err := json.Marshal(discordMessage(notification))
+	if err != nil {
+		return err
+	}
+	return postWebhook(ctx, n.client, n.webhookURL, body)
+}
+
+type discordField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline"`
+}
+
+type discordEmbed struct {
+	Title       string         `json:"title"`
+	URL         string         `json:"url,omitempty"`
+	Description string         `json:"description,omitempty"`
+	Color       int            `json:"color"`
+	Fields      []discordField `json:"fields"`
+}
+
+type discordPayload struct {
+	Content string         `json:"content"`
+	Embeds  []discordEmbed `json:"embeds"`
+}
+
+func discordMessage(notification *artifactsNotification) discordPayload {
+	build := notification.Build
+
+	embed := discordEmbed{
+		Title:       truncate(notificationTitle(build), discordTitleLimit),
+		URL:         notificationBuildURL(build),
+		Description: truncate(strings.TrimSpace(build.Message), discordDescriptionLimit),
+		Color:       discordEmbedColor,
+		Fields:      []discordField{{Name: "Project", Value: notificationProject(build), Inline: true}},
+	}
+	if build.Branch != "" {
+		embed.Fields = append(embed.Fields, discordField{Name: "Branch", Value: build.Branch, Inline: true})
+	}
+	for _, artifact := range notification.Artifacts {
+		downloadURL := notification.DownloadURLs[artifact.ID]
+		if downloadURL == "" {
+			continue
+		}
+		links := []string{fmt.Sprintf("[Download](%s)", downloadURL)}
+		// Discord only renders http links, the iOS install link is kept as text to be copied
+		if installURL := notification.InstallURLs[artifact.ID]; installURL != downloadURL {
+			links = append(links, fmt.Sprintf("Install: `%s`", installURL))
+		}
+		embed.Fields = append(embed.Fields, discordField{Name: artifactPlatform(artifact.Kind), Value: strings.Join(links, "\n")})
+	}
+
+	return discordPayload{
+		Content: notificationSummary(notification),
+		Embeds:  []discordEmbed{embed},
+	}
+}
+
+// truncate shortens a text to a number of runes, ending it with an ellipsis
+func truncate(text string, limit int) string {
+	runes := []rune(text)
+	if len(runes) <= limit {
+		return text
+	}
+	return string(runes[:limit-1]) + "…"
+}
@@ -0,0 +1,90 @@
+package yolosvc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// slackNotifier posts the new artifacts to a Slack incoming webhook
+type slackNotifier struct {
+	webhookURL string
+	client     *http.Client
+}
+
+func newSlackNotifier(webhookURL string) *slackNotifier {
+	return &slackNotifier{
+		webhookURL: webhookURL,
+		client:     &http.Client{Timeout: notifierTimeout},
+	}
+}
+
+func (n *slackNotifier) name() string { return "slack" }
+
+func (n *slackNotifier) notify(ctx context.Context, notification *artifactsNotification) error {
+	body, err := json.Marshal(slackMessage(notification))
+	if err != nil {
+		return err
+	}
+	return postWebhook(ctx, n.client, n.webhookURL, body)
+}
+
+type slackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type slackElement struct {
+	Type string     `json:"type"`
+	Text *slackText `json:"text"`
+	URL  string     `json:"url"`
+}
+
+type slackBlock struct {
+	Type     string         `json:"type"`
+	Text     *slackText     `json:"text,omitempty"`
+	Elements []slackElement `json:"elements,omitempty"`
+}
+
+type slackPayload struct {
+	Text   string       `json:"text"`
+	Blocks []slackBlock `json:"blocks"`
+}
+
+func slackMessage(notification *artifactsNotification) slackPayload {
+	build := notification.Build
+
+	buttons := []slackElement{}
+	for _, artifact := range notification.Artifacts {
+		platform := artifactPlatform(artifact.Kind)
+		if url := notification.InstallURLs[artifact.ID]; url != "" {
+			buttons = append(buttons, slackElement{
+				Type: "button",
+				Text: &slackText{Type: "plain_text", Text: "Install " + platform},
+				URL:  url,
+			})
+		}
+	}
+
+	summary := notificationSummary(notification)
+	lines := []string{"*" + summary + "*"}
+	if build.Branch != "" {
+		lines[0] += fmt.Sprintf(" on `%s`", build.Branch)
+	}
+	title := notificationTitle(build)
+	if buildURL := notificationBuildURL(build); buildURL != "" {
+		title = fmt.Sprintf("<%s|%s>", buildURL, title)
+	}
+	lines = append(lines, title)
+
+	payload := slackPayload{
+		Text:   summary,
+		Blocks: []slackBlock{{Type: "section", Text: &slackText{Type: "mrkdwn", Text: strings.Join(lines, "\n")}}},
+	}
+	if len(buttons) > 0 {
+		payload.Blocks = append(payload.Blocks, slackBlock{Type: "actions", Elements: buttons})
+	}
+	return payload
+}
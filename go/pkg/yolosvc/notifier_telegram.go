@@ -0,0 +1,117 @@
+package yolosvc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"strings"
+
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+)
+
+const (
+	telegramAPI = "https://api.telegram.org"
+	// telegramTitleLimit keeps the messages far from the 4096 characters limit of Telegram
+	telegramTitleLimit = 256
+)
+
+// telegramNotifier posts the new artifacts to a Telegram chat with a bot
+type telegramNotifier struct {
+	apiURL   string
+	botToken string
+	chatID   string
+	client   *http.Client
+}
+
+func newTelegramNotifier(botToken, chatID string) *telegramNotifier {
+	return &telegramNotifier{
+		apiURL:   telegramAPI,
+		botToken: botToken,
+		chatID:   chatID,
+		client:   &http.Client{Timeout: notifierTimeout},
+	}
+}
+
+func (n *telegramNotifier) name() string { return "telegram" }
+
+func (n *telegramNotifier) notify(ctx context.Context, notification *artifactsNotification) error {
+	body, err := json.Marshal(telegramPayload{
+		ChatID:                n.chatID,
+		Text:                  telegramMessage(notification),
+		ParseMode:             "HTML",
+		DisableWebPagePreview: true,
+	})
+	if err != nil {
+		return err
+	}
+	return postWebhook(ctx, n.client, fmt.Sprintf("%s/bot%s/sendMessage", n.apiURL, n.botToken), body)
+}
+
+type telegramPayload struct {
+	ChatID                string `json:"chat_id"`
+	Text                  string `json:"text"`
+	ParseMode             string `json:"parse_mode"`
+	DisableWebPagePreview bool   `json:"disable_web_page_preview"`
+}
+
+// telegramMessage returns the HTML text of a notification, the links are in the text because the
+// inline keyboard buttons only accept http links, not the itms-services install link of iOS
+func telegramMessage(notification *artifactsNotification) string {
+	build := notification.Build
+	lines := []string{"<b>" + html.EscapeString(notificationSummary(notification)) + "</b>"}
+
+	title := html.EscapeString(truncate(notificationTitle(build), telegramTitleLimit))
+	if buildURL := notificationBuildURL(build); buildURL != "" {
+		title = telegramLink(buildURL, title)
+	}
+	lines = append(lines, title)
+
+	details := []string{}
+	if version := telegramVersion(build); version != "" {
+		details = append(details, "Version: "+html.EscapeString(version))
+	}
+	if build.Branch != "" {
+		details = append(details, "Branch: "+html.EscapeString(build.Branch))
+	}
+	if len(details) > 0 {
+		lines = append(lines, strings.Join(details, " · "))
+	}
+
+	for _, artifact := range notification.Artifacts {
+		downloadURL := notification.DownloadURLs[artifact.ID]
+		if downloadURL == "" {
+			continue
+		}
+		var link string
+		switch artifact.Kind {
+		case yolopb.Artifact_IPA:
+			link = telegramLink(notification.InstallURLs[artifact.ID], "Install")
+		case yolopb.Artifact_APK:
+			link = telegramLink(downloadURL, "Download APK")
+		default:
+			link = telegramLink(downloadURL, "Download")
+		}
+		lines = append(lines, artifactPlatform(artifact.Kind)+": "+link)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// telegramVersion returns the version of a build, i.e, "1.2.3 (#45)", or its build number without tag
+func telegramVersion(build *yolopb.Build) string {
+	switch {
+	case build.VCSTag != "" && build.ShortID != "":
+		return fmt.Sprintf("%s (#%s)", build.VCSTag, build.ShortID)
+	case build.VCSTag != "":
+		return build.VCSTag
+	case build.ShortID != "":
+		return "#" + build.ShortID
+	}
+	return ""
+}
+
+func telegramLink(url, text string) string {
+	return fmt.Sprintf(`<a href="%s">%s</a>`, html.EscapeString(url), text)
+}
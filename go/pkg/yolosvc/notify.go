@@ -0,0 +1,221 @@
+package yolosvc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"go.uber.org/zap"
+)
+
+const (
+	// notificationMaxAge skips the old artifacts found by the first refresh of a driver, only the recent ones are announced
+	notificationMaxAge = 24 * time.Hour
+	notifierTimeout    = 10 * time.Second
+)
+
+// artifactsNotification announces the new installable artifacts of a build
+type artifactsNotification struct {
+	Build     *yolopb.Build
+	Artifacts []*yolopb.Artifact
+	// InstallURLs are the absolute signed install links of the artifacts, indexed by ID, empty without a public URL
+	InstallURLs map[string]string
+	// DownloadURLs are the absolute signed download links of the artifacts, the same as the install links except for iOS
+	DownloadURLs map[string]string
+}
+
+// notifier is a target of the notifications, i.e, a chat webhook
+type notifier interface {
+	name() string
+	notify(ctx context.Context, notification *artifactsNotification) error
+}
+
+func newNotifiers(opts ServiceOpts) []notifier {
+	var notifiers []notifier
+	if opts.SlackWebhookURL != "" && !opts.SlackMute {
+		notifiers = append(notifiers, newSlackNotifier(opts.SlackWebhookURL))
+	}
+	if opts.DiscordWebhookURL != "" && !opts.DiscordMute {
+		notifiers = append(notifiers, newDiscordNotifier(opts.DiscordWebhookURL))
+	}
+	if opts.TelegramEnabled && opts.TelegramBotToken != "" && opts.TelegramChatID != "" {
+		notifiers = append(notifiers, newTelegramNotifier(opts.TelegramBotToken, opts.TelegramChatID))
+	}
+	return notifiers
+}
+
+// newInstallableArtifacts returns the finished installable artifacts (IPA, APK, DMG, EXE and MSI) of the batch that were not stored as finished yet,
+// it is called before saving the batch, so a restart doesn't announce them again.
+func (svc *service) newInstallableArtifacts(batch *yolopb.Batch) []*yolopb.Artifact {
+	if len(svc.notifiers) == 0 {
+		return nil
+	}
+
+	candidates := []*yolopb.Artifact{}
+	ids := []string{}
+	for _, artifact := range batch.Artifacts {
+		switch {
+		case artifact.State != yolopb.Artifact_Finished,
+			artifact.Kind == yolopb.Artifact_UnknownKind,
+			artifact.CreatedAt != nil && time.Since(*artifact.CreatedAt) > notificationMaxAge:
+			continue
+		}
+		candidates = append(candidates, artifact)
+		ids = append(ids, artifact.ID)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	stored, err := svc.store.GetArtifactStates(ids)
+	if err != nil {
+		svc.logger.Warn("failed to get stored artifact states", zap.Error(err))
+		return nil
+	}
+	artifacts := []*yolopb.Artifact{}
+	for _, artifact := range candidates {
+		if stored[artifact.ID] != yolopb.Artifact_Finished {
+			artifacts = append(artifacts, artifact)
+		}
+	}
+	return artifacts
+}
+
+// notifyNewArtifacts sends a notification per build to every notifier
+func (svc *service) notifyNewArtifacts(ctx context.Context, artifacts []*yolopb.Artifact) {
+	buildIDs := []string{}
+	byBuild := map[string][]string{}
+	for _, artifact := range artifacts {
+		if _, found := byBuild[artifact.HasBuildID]; !found {
+			buildIDs = append(buildIDs, artifact.HasBuildID)
+		}
+		byBuild[artifact.HasBuildID] = append(byBuild[artifact.HasBuildID], artifact.ID)
+	}
+
+	for _, buildID := range buildIDs {
+		notification, err := svc.artifactsNotification(buildID, byBuild[buildID])
+		if err != nil {
+			svc.logger.Warn("failed to prepare notification", zap.String("build", buildID), zap.Error(err))
+			continue
+		}
+		for _, notifier := range svc.notifiers {
+			if err := notifier.notify(ctx, notification); err != nil {
+				svc.logger.Warn("failed to send notification", zap.String("notifier", notifier.name()), zap.String("build", buildID), zap.Error(err))
+			}
+		}
+	}
+}
+
+func (svc *service) artifactsNotification(buildID string, artifactIDs []string) (*artifactsNotification, error) {
+	build, err := svc.store.GetBuildByID(buildID)
+	if err != nil {
+		return nil, err
+	}
+	wanted := map[string]bool{}
+	for _, id := range artifactIDs {
+		wanted[id] = true
+	}
+	notification := artifactsNotification{Build: build, InstallURLs: map[string]string{}, DownloadURLs: map[string]string{}}
+	expiresAt := time.Now().Add(svc.plistURLTTL)
+	for _, artifact := range build.HasArtifacts {
+		if !wanted[artifact.ID] {
+			continue
+		}
+		if err := artifact.AddExpiringSignedURLs(svc.authSalt, expiresAt); err != nil {
+			return nil, err
+		}
+		notification.Artifacts = append(notification.Artifacts, artifact)
+		if svc.publicURL == "" {
+			continue
+		}
+		notification.DownloadURLs[artifact.ID] = svc.publicURL + artifact.DLArtifactSignedURL
+		notification.InstallURLs[artifact.ID] = svc.artifactInstallURL(artifact)
+	}
+	sort.SliceStable(notification.Artifacts, func(i, j int) bool {
+		return notification.Artifacts[i].Kind < notification.Artifacts[j].Kind
+	})
+	return &notification, nil
+}
+
+// artifactInstallURL returns the absolute install link of an artifact with signed URLs, the itms-services link for iOS
+// and the download link otherwise, it is empty without a public URL
+func (svc *service) artifactInstallURL(artifact *yolopb.Artifact) string {
+	switch {
+	case svc.publicURL == "":
+		return ""
+	case artifact.Kind == yolopb.Artifact_IPA:
+		return "itms-services://?action=download-manifest&url=" + svc.publicURL + artifact.PListSignedURL
+	default:
+		return svc.publicURL + artifact.DLArtifactSignedURL
+	}
+}
+
+// postWebhook sends a JSON payload to a chat webhook
+func postWebhook(ctx context.Context, client *http.Client, webhookURL string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		details, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("webhook: %s: %s", resp.Status, strings.TrimSpace(string(details)))
+	}
+	return nil
+}
+
+// notificationSummary returns a one-line description of the notification, i.e, "New iOS, Android build of berty/berty"
+func notificationSummary(notification *artifactsNotification) string {
+	platforms := make([]string, len(notification.Artifacts))
+	for i, artifact := range notification.Artifacts {
+		platforms[i] = artifactPlatform(artifact.Kind)
+	}
+	return fmt.Sprintf("New %s build of %s", strings.Join(platforms, ", "), notificationProject(notification.Build))
+}
+
+// notificationProject returns a short name of the project of a build, i.e, berty/berty
+func notificationProject(build *yolopb.Build) string {
+	return strings.TrimPrefix(build.HasProjectID, "https://github.com/")
+}
+
+// notificationBuildURL returns the link of a build on its CI provider, if its ID is one
+func notificationBuildURL(build *yolopb.Build) string {
+	if strings.HasPrefix(build.ID, "https://") {
+		return build.ID
+	}
+	return ""
+}
+
+// notificationTitle returns the first line of the commit message of a build
+func notificationTitle(build *yolopb.Build) string {
+	title := strings.SplitN(strings.TrimSpace(build.Message), "\n", 2)[0]
+	if title == "" {
+		title = build.ShortID
+	}
+	return title
+}
+
+func artifactPlatform(kind yolopb.Artifact_Kind) string {
+	switch kind {
+	case yolopb.Artifact_IPA:
+		return "iOS"
+	case yolopb.Artifact_APK:
+		return "Android"
+	case yolopb.Artifact_DMG:
+		return "macOS"
+	case yolopb.Artifact_EXE, yolopb.Artifact_MSI:
+		return "Windows"
+	}
+	return kind.String()
+}
@@ -0,0 +1,178 @@
+package yolosvc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlackNotifications(t *testing.T) {
+	var (
+		mutex    sync.Mutex
+		messages []slackPayload
+	)
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var message slackPayload
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&message))
+		mutex.Lock()
+		messages = append(messages, message)
+		mutex.Unlock()
+	}))
+	defer webhook.Close()
+
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t), SlackWebhookURL: webhook.URL, PublicURL: "https://yolo.example.com/"})
+	defer cleanup()
+	svc := api.(*service)
+
+	now, old := time.Now(), time.Now().Add(-2*notificationMaxAge)
+	build := &yolopb.Build{ID: "https://buildkite.com/berty/berty/builds/3000", Message: "feat: notify\n\nbody", Branch: "main", HasProjectID: "https://github.com/berty/berty", Driver: yolopb.Driver_Buildkite}
+	batch := func(state yolopb.Artifact_State) *yolopb.Batch {
+		return &yolopb.Batch{
+			Builds: []*yolopb.Build{build},
+			Artifacts: []*yolopb.Artifact{
+				{ID: "notify-ipa", LocalPath: "Berty.ipa", Kind: yolopb.Artifact_IPA, State: state, CreatedAt: &now, HasBuildID: build.ID},
+				{ID: "notify-apk", LocalPath: "Berty.apk", Kind: yolopb.Artifact_APK, State: state, CreatedAt: &now, HasBuildID: build.ID},
+				{ID: "notify-old", LocalPath: "Berty.dmg", Kind: yolopb.Artifact_DMG, State: state, CreatedAt: &old, HasBuildID: build.ID},
+				{ID: "notify-log", LocalPath: "build.log", State: state, CreatedAt: &now, HasBuildID: build.ID},
+			},
+		}
+	}
+
+	// uploading
+	require.NoError(t, svc.saveBatch(context.Background(), batch(yolopb.Artifact_New)))
+	assert.Empty(t, messages)
+
+	// finished, only the recent installable artifacts are announced, once
+	for i := 0; i < 2; i++ {
+		require.NoError(t, svc.saveBatch(context.Background(), batch(yolopb.Artifact_Finished)))
+	}
+	require.Len(t, messages, 1)
+	message := messages[0]
+	assert.Equal(t, "New iOS, Android build of berty/berty", message.Text)
+	require.Len(t, message.Blocks, 2)
+	assert.Equal(t, "*New iOS, Android build of berty/berty* on `main`\n<https://buildkite.com/berty/berty/builds/3000|feat: notify>", message.Blocks[0].Text.Text)
+	buttons := message.Blocks[1].Elements
+	require.Len(t, buttons, 2)
+	assert.Equal(t, "Install iOS", buttons[0].Text.Text)
+	assert.True(t, strings.HasPrefix(buttons[0].URL, "itms-services://?action=download-manifest&url=https://yolo.example.com%2Fapi%2Fplist-gen%2Fnotify-ipa.plist"), buttons[0].URL)
+	assert.Equal(t, "Install Android", buttons[1].Text.Text)
+	assert.True(t, strings.HasPrefix(buttons[1].URL, "https://yolo.example.com/api/artifact-dl/notify-apk?"), buttons[1].URL)
+}
+
+func TestSlackMute(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t), SlackWebhookURL: "https://hooks.slack.com/services/x", SlackMute: true})
+	defer cleanup()
+	assert.Empty(t, api.(*service).notifiers)
+}
+
+func TestDiscordNotifications(t *testing.T) {
+	var (
+		mutex    sync.Mutex
+		messages []discordPayload
+	)
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var message discordPayload
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&message))
+		mutex.Lock()
+		messages = append(messages, message)
+		mutex.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer webhook.Close()
+
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t), DiscordWebhookURL: webhook.URL, PublicURL: "https://yolo.example.com"})
+	defer cleanup()
+	svc := api.(*service)
+
+	now := time.Now()
+	err := svc.saveBatch(context.Background(), &yolopb.Batch{
+		Builds: []*yolopb.Build{{ID: "https://buildkite.com/berty/berty/builds/3001", Message: "feat: discord", Branch: "main", HasProjectID: "https://github.com/berty/berty", Driver: yolopb.Driver_Buildkite}},
+		Artifacts: []*yolopb.Artifact{
+			{ID: "discord-apk", LocalPath: "Berty.apk", Kind: yolopb.Artifact_APK, State: yolopb.Artifact_Finished, CreatedAt: &now, HasBuildID: "https://buildkite.com/berty/berty/builds/3001"},
+			{ID: "discord-ipa", LocalPath: "Berty.ipa", Kind: yolopb.Artifact_IPA, State: yolopb.Artifact_Finished, CreatedAt: &now, HasBuildID: "https://buildkite.com/berty/berty/builds/3001"},
+		},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, messages, 1)
+	assert.Equal(t, "New iOS, Android build of berty/berty", messages[0].Content)
+	require.Len(t, messages[0].Embeds, 1)
+	embed := messages[0].Embeds[0]
+	assert.Equal(t, "feat: discord", embed.Title)
+	assert.Equal(t, "https://buildkite.com/berty/berty/builds/3001", embed.URL)
+	require.Len(t, embed.Fields, 4)
+	assert.Equal(t, discordField{Name: "Project", Value: "berty/berty", Inline: true}, embed.Fields[0])
+	assert.Equal(t, discordField{Name: "Branch", Value: "main", Inline: true}, embed.Fields[1])
+	assert.Equal(t, "iOS", embed.Fields[2].Name)
+	assert.Contains(t, embed.Fields[2].Value, "itms-services://")
+	assert.Equal(t, "Android", embed.Fields[3].Name)
+	assert.True(t, strings.HasPrefix(embed.Fields[3].Value, "[Download](https://yolo.example.com/api/artifact-dl/discord-apk?"), embed.Fields[3].Value)
+	assert.NotContains(t, embed.Fields[3].Value, "Install")
+}
+
+func TestTelegramNotifications(t *testing.T) {
+	var (
+		mutex    sync.Mutex
+		paths    []string
+		messages []telegramPayload
+	)
+	botAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var message telegramPayload
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&message))
+		mutex.Lock()
+		paths = append(paths, r.URL.Path)
+		messages = append(messages, message)
+		mutex.Unlock()
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer botAPI.Close()
+
+	// disabled by default
+	assert.Empty(t, newNotifiers(ServiceOpts{TelegramBotToken: "123:secret", TelegramChatID: "@berty_builds"}))
+
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t), TelegramBotToken: "123:secret", TelegramChatID: "@berty_builds", TelegramEnabled: true, PublicURL: "https://yolo.example.com"})
+	defer cleanup()
+	svc := api.(*service)
+	require.Len(t, svc.notifiers, 1)
+	svc.notifiers[0].(*telegramNotifier).apiURL = botAPI.URL
+
+	now := time.Now()
+	err := svc.saveBatch(context.Background(), &yolopb.Batch{
+		Builds: []*yolopb.Build{{ID: "https://buildkite.com/berty/berty/builds/3002", Message: "feat: <telegram>", Branch: "main", ShortID: "3002", VCSTag: "v1.2.3", HasProjectID: "https://github.com/berty/berty", Driver: yolopb.Driver_Buildkite}},
+		Artifacts: []*yolopb.Artifact{
+			{ID: "telegram-apk", LocalPath: "Berty.apk", Kind: yolopb.Artifact_APK, State: yolopb.Artifact_Finished, CreatedAt: &now, HasBuildID: "https://buildkite.com/berty/berty/builds/3002"},
+			{ID: "telegram-ipa", LocalPath: "Berty.ipa", Kind: yolopb.Artifact_IPA, State: yolopb.Artifact_Finished, CreatedAt: &now, HasBuildID: "https://buildkite.com/berty/berty/builds/3002"},
+		},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, messages, 1)
+	assert.Equal(t, "/bot123:secret/sendMessage", paths[0])
+	message := messages[0]
+	assert.Equal(t, "@berty_builds", message.ChatID)
+	assert.Equal(t, "HTML", message.ParseMode)
+	lines := strings.Split(message.Text, "\n")
+	require.Len(t, lines, 5)
+	assert.Equal(t, "<b>New iOS, Android build of berty/berty</b>", lines[0])
+	assert.Equal(t, `<a href="https://buildkite.com/berty/berty/builds/3002">feat: &lt;telegram&gt;</a>`, lines[1])
+	assert.Equal(t, "Version: v1.2.3 (#3002) · Branch: main", lines[2])
+	assert.True(t, strings.HasPrefix(lines[3], `iOS: <a href="itms-services://?action=download-manifest&amp;url=https://yolo.example.com%2Fapi%2Fplist-gen%2Ftelegram-ipa.plist`), lines[3])
+	assert.True(t, strings.HasPrefix(lines[4], `Android: <a href="https://yolo.example.com/api/artifact-dl/telegram-apk?`), lines[4])
+	assert.True(t, strings.HasSuffix(lines[4], `">Download APK</a>`), lines[4])
+}
+
+func TestTruncate(t *testing.T) {
+	assert.Equal(t, "short", truncate("short", 5))
+	assert.Equal(t, "sho…", truncate("shorter", 4))
+	assert.Equal(t, "éé…", truncate("ééééé", 3))
+}
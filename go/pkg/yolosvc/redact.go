@@ -0,0 +1,155 @@
+package yolosvc
+
+import (
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+const redacted = "[REDACTED]"
+
+var (
+	redactBearerRegex   = regexp.MustCompile(`(?i)\b(bearer|token|basic)(\s+)[a-z0-9._~+/=-]{16,}`)
+	redactUserinfoRegex = regexp.MustCompile(`(://)[^/@\s:]+:[^/@\s]+@`)
+	redactQueryRegex    = regexp.MustCompile(`(?i)([?&](?:x-amz-signature|x-amz-credential|x-amz-security-token|signature|sig|token|access_token|api_key|apikey|key|password|secret)=)[^&\s"'#]+`)
+)
+
+// Redactor scrubs known secrets (tokens, credentials in URLs, auth salt, ...) from strings
+type Redactor struct {
+	secrets *strings.Replacer
+}
+
+// NewRedactor returns a Redactor removing the well known secret patterns and the given secret values
+func NewRedactor(secrets ...string) *Redactor {
+	pairs := []string{}
+	for _, secret := range secrets {
+		if len(secret) < 4 { // avoid redacting every occurrence of a trivial string
+			continue
+		}
+		pairs = append(pairs, secret, redacted)
+	}
+	r := Redactor{}
+	if len(pairs) > 0 {
+		r.secrets = strings.NewReplacer(pairs...)
+	}
+	return &r
+}
+
+// Redact returns s without the secrets
+func (r *Redactor) Redact(s string) string {
+	if r == nil {
+		return s
+	}
+	if r.secrets != nil {
+		s = r.secrets.Replace(s)
+	}
+	s = redactBearerRegex.ReplaceAllString(s, "${1}${2}"+redacted)
+	s = redactUserinfoRegex.ReplaceAllString(s, "${1}"+redacted+"@")
+	s = redactQueryRegex.ReplaceAllString(s, "${1}"+redacted)
+	return s
+}
+
+// WrapCore returns a zap option scrubbing the log messages, string and error fields
+func (r *Redactor) WrapCore() zap.Option {
+	return zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		return &redactCore{Core: core, redactor: r}
+	})
+}
+
+type redactCore struct {
+	zapcore.Core
+	redactor *Redactor
+}
+
+func (c *redactCore) With(fields []zapcore.Field) zapcore.Core {
+	return &redactCore{Core: c.Core.With(c.redactFields(fields)), redactor: c.redactor}
+}
+
+func (c *redactCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+func (c *redactCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	entry.Message = c.redactor.Redact(entry.Message)
+	entry.Stack = c.redactor.Redact(entry.Stack)
+	return c.Core.Write(entry, c.redactFields(fields))
+}
+
+func (c *redactCore) redactFields(fields []zapcore.Field) []zapcore.Field {
+	ret := make([]zapcore.Field, len(fields))
+	for i, field := range fields {
+		switch field.Type {
+		case zapcore.StringType:
+			field.String = c.redactor.Redact(field.String)
+		case zapcore.ErrorType:
+			if err, ok := field.Interface.(error); ok {
+				field = zap.String(field.Key, c.redactor.Redact(err.Error()))
+			}
+		}
+		ret[i] = field
+	}
+	return ret
+}
+
+// redactErrors is an HTTP middleware scrubbing the bodies of the error responses
+func redactErrors(redactor *Redactor) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(&redactResponseWriter{ResponseWriter: w, redactor: redactor}, r)
+		})
+	}
+}
+
+type redactResponseWriter struct {
+	http.ResponseWriter
+	redactor *Redactor
+	status   int
+}
+
+func (w *redactResponseWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+	if status >= 400 {
+		w.Header().Del("Content-Length") // the body length may change
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *redactResponseWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.status < 400 {
+		return w.ResponseWriter.Write(b)
+	}
+	if _, err := w.ResponseWriter.Write([]byte(w.redactor.Redact(string(b)))); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+// ReadFrom keeps the sendfile optimization of the underlying writer for successful responses
+func (w *redactResponseWriter) ReadFrom(src io.Reader) (int64, error) {
+	if w.status == 0 {
+		w.WriteHeader(http.StatusOK)
+	}
+	if rf, ok := w.ResponseWriter.(io.ReaderFrom); ok && w.status < 400 {
+		return rf.ReadFrom(src)
+	}
+	return io.Copy(writerOnly{w}, src)
+}
+
+// Flush keeps streaming working through the wrapper
+func (w *redactResponseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
@@ -0,0 +1,62 @@
+package yolosvc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+)
+
+// ParseDrivers parses a comma-separated list of driver names, i.e, "s3,buildkite"
+func ParseDrivers(input string) ([]yolopb.Driver, error) {
+	var drivers []yolopb.Driver
+	for _, name := range strings.Split(input, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		driver, err := parseDriver(name)
+		if err != nil {
+			return nil, err
+		}
+		drivers = append(drivers, driver)
+	}
+	return drivers, nil
+}
+
+// downloadLocation returns the short-lived upstream URL the download of an artifact can be redirected to.
+// The permanent URLs of the other drivers would outlive the expiry of the signed link they are redirected from.
+func (svc *service) downloadLocation(ctx context.Context, artifact *yolopb.Artifact, filename string) (string, error) {
+	switch artifact.Driver {
+	case yolopb.Driver_S3:
+		return svc.s3DownloadLocation(artifact, filename)
+	case yolopb.Driver_Buildkite:
+		if svc.buildkiteToken == "" {
+			return "", fmt.Errorf("buildkite token required")
+		}
+		return buildkiteArtifactLocation(ctx, svc.buildkiteToken, svc.rewriteDownloadURL(artifact))
+	}
+	return "", fmt.Errorf("the %s downloads can't be redirected", artifact.Driver)
+}
+
+// buildkiteArtifactLocation resolves the presigned URL an artifact download of the Buildkite API redirects to, without following it
+func buildkiteArtifactLocation(ctx context.Context, token, downloadURL string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, downloadURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	client := http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("buildkite: %w", err)
+	}
+	defer resp.Body.Close()
+	location, err := resp.Location()
+	if err != nil {
+		return "", fmt.Errorf("buildkite: no redirection: %s", resp.Status)
+	}
+	return location.String(), nil
+}
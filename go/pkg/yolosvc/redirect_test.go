@@ -0,0 +1,137 @@
+package yolosvc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/buildkite/go-buildkite/buildkite"
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDrivers(t *testing.T) {
+	drivers, err := ParseDrivers("s3, Buildkite,,bintray")
+	require.NoError(t, err)
+	assert.Equal(t, []yolopb.Driver{yolopb.Driver_S3, yolopb.Driver_Buildkite, yolopb.Driver_Bintray}, drivers)
+
+	drivers, err = ParseDrivers("")
+	require.NoError(t, err)
+	assert.Empty(t, drivers)
+
+	_, err = ParseDrivers("s3,travis")
+	assert.Error(t, err)
+}
+
+// redirectTestUpstream serves a Buildkite artifact download redirecting to a presigned URL, and a Bintray file
+func redirectTestUpstream() *httptest.Server {
+	var upstream *httptest.Server
+	upstream = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/artifacts/1/download":
+			if r.Header.Get("Authorization") != "Bearer token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			http.Redirect(w, r, upstream.URL+"/presigned/app.apk?X-Amz-Signature=abc", http.StatusFound)
+		case "/presigned/app.apk", "/bintray/app.apk":
+			_, _ = w.Write([]byte("apk content"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return upstream
+}
+
+func redirectTestDownload(svc *service, id, query string) *httptest.ResponseRecorder {
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("artifactID", id)
+	r := httptest.NewRequest("GET", "/"+query, nil)
+	r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+	w := httptest.NewRecorder()
+	svc.ArtifactDownloader(w, r)
+	return w
+}
+
+func TestArtifactDownloaderRedirect(t *testing.T) {
+	upstream := redirectTestUpstream()
+	defer upstream.Close()
+	config, err := buildkite.NewTokenConfig("token", false)
+	require.NoError(t, err)
+
+	bkc := buildkite.NewClient(config.Client())
+	config.APIHost = strings.TrimPrefix(upstream.URL, "http://") // the client only authenticates the requests of its API host
+
+	api, cleanup := TestingService(t, ServiceOpts{
+		Logger:          testutil.Logger(t),
+		BuildkiteClient: bkc,
+		BuildkiteToken:  "token",
+		PreferRedirect:  []yolopb.Driver{yolopb.Driver_Buildkite, yolopb.Driver_Bintray},
+	})
+	defer cleanup()
+	svc := api.(*service)
+	err = svc.store.SaveBatch(&yolopb.Batch{Artifacts: []*yolopb.Artifact{
+		{ID: "bk-artifact", Driver: yolopb.Driver_Buildkite, DownloadURL: upstream.URL + "/artifacts/1/download", LocalPath: "app.apk", HasBuildID: "https://buildkite.com/berty/berty/builds/2738"},
+		{ID: "bt-artifact", Driver: yolopb.Driver_Bintray, DownloadURL: upstream.URL + "/bintray/app.apk", LocalPath: "app.apk", HasBuildID: "https://buildkite.com/berty/berty/builds/2738"},
+	}})
+	require.NoError(t, err)
+
+	w := redirectTestDownload(svc, "bk-artifact", "")
+	assert.Equal(t, http.StatusFound, w.Code)
+	assert.Equal(t, upstream.URL+"/presigned/app.apk?X-Amz-Signature=abc", w.Header().Get("Location"))
+
+	// the redirections aren't counted as downloads, the client may not follow them
+	stats, err := svc.store.GetArtifactDownloadStats("bk-artifact")
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), stats.Downloads)
+
+	// the permanent Bintray URLs would outlive the signed link, they are proxied
+	w = redirectTestDownload(svc, "bt-artifact", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Header().Get("Location"))
+	assert.Equal(t, "apk content", w.Body.String())
+
+	// the single-use downloads are proxied, the presigned URL could be reused until it expires
+	w = redirectTestDownload(svc, "bk-artifact", "?expires=4102444800&once=1&sign=abcdef")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Header().Get("Location"))
+	assert.Equal(t, "apk content", w.Body.String())
+	stats, err = svc.store.GetArtifactDownloadStats("bk-artifact")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), stats.Downloads)
+	spent, err := svc.store.IsSignatureSpent("abcdef")
+	require.NoError(t, err)
+	assert.True(t, spent)
+}
+
+func TestArtifactDownloaderRedirectFallback(t *testing.T) {
+	upstream := redirectTestUpstream()
+	defer upstream.Close()
+	config, err := buildkite.NewTokenConfig("token", false)
+	require.NoError(t, err)
+
+	bkc := buildkite.NewClient(config.Client())
+	config.APIHost = strings.TrimPrefix(upstream.URL, "http://") // the client only authenticates the requests of its API host
+
+	// without the token, the presigned URL can't be resolved
+	api, cleanup := TestingService(t, ServiceOpts{
+		Logger:          testutil.Logger(t),
+		BuildkiteClient: bkc,
+		PreferRedirect:  []yolopb.Driver{yolopb.Driver_Buildkite},
+	})
+	defer cleanup()
+	svc := api.(*service)
+	err = svc.store.SaveBatch(&yolopb.Batch{Artifacts: []*yolopb.Artifact{
+		{ID: "bk-artifact", Driver: yolopb.Driver_Buildkite, DownloadURL: upstream.URL + "/artifacts/1/download", LocalPath: "app.apk", HasBuildID: "https://buildkite.com/berty/berty/builds/2738"},
+	}})
+	require.NoError(t, err)
+
+	w := redirectTestDownload(svc, "bk-artifact", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "apk content", w.Body.String())
+}
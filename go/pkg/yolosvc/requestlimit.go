@@ -0,0 +1,98 @@
+package yolosvc
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+// requestLimiterSweep is the interval between the removals of the buckets back to their full burst
+const requestLimiterSweep = time.Minute
+
+// requestLimiter is a token bucket rate limiter of the requests of each client
+type requestLimiter struct {
+	rate      float64 // tokens per second
+	burst     float64
+	now       func() time.Time
+	mutex     sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// newRequestLimiter returns a limiter allowing a burst of requests, then perMinute requests per minute
+func newRequestLimiter(perMinute, burst int) *requestLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &requestLimiter{
+		rate:    float64(perMinute) / 60,
+		burst:   float64(burst),
+		now:     time.Now,
+		buckets: map[string]*tokenBucket{},
+	}
+}
+
+// allow takes a token of the client, or returns how long to wait for the next one
+func (l *requestLimiter) allow(key string) (bool, time.Duration) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+	bucket, found := l.buckets[key]
+	if !found {
+		bucket = &tokenBucket{tokens: l.burst, last: now}
+		l.buckets[key] = bucket
+	}
+	bucket.tokens = math.Min(l.burst, bucket.tokens+now.Sub(bucket.last).Seconds()*l.rate)
+	bucket.last = now
+
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+		return true, 0
+	}
+	wait := time.Duration((1 - bucket.tokens) / l.rate * float64(time.Second))
+	return false, wait
+}
+
+// sweep forgets the clients whose bucket is full again, they are recreated as is on their next request
+func (l *requestLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < requestLimiterSweep {
+		return
+	}
+	l.lastSweep = now
+	for key, bucket := range l.buckets {
+		if bucket.tokens+now.Sub(bucket.last).Seconds()*l.rate >= l.burst {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// limitRequests rejects the requests of the clients over their rate with a 429 and a Retry-After, a nil limiter disables it
+func limitRequests(limiter *requestLimiter, proxies trustedProxies) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if limiter == nil {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// by IP, the basic auth user isn't verified at this point and could be changed at will to get a new bucket
+			allowed, wait := limiter.allow(proxies.clientIP(r))
+			if !allowed {
+				w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+				httpError(w, fmt.Errorf("too many downloads, retry in %s", wait.Round(time.Second)), codes.ResourceExhausted)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package yolosvc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestLimiter(t *testing.T) {
+	now := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+	limiter := newRequestLimiter(6, 3) // a token every 10s
+	limiter.now = func() time.Time { return now }
+
+	// the burst is allowed, then the client is blocked
+	for i := 0; i < 3; i++ {
+		allowed, _ := limiter.allow("a")
+		assert.True(t, allowed, i)
+	}
+	allowed, wait := limiter.allow("a")
+	assert.False(t, allowed)
+	assert.Equal(t, 10*time.Second, wait)
+
+	// the other clients have their own bucket
+	allowed, _ = limiter.allow("b")
+	assert.True(t, allowed)
+
+	// the bucket refills at the rate
+	now = now.Add(5 * time.Second)
+	allowed, wait = limiter.allow("a")
+	assert.False(t, allowed)
+	assert.Equal(t, 5*time.Second, wait)
+	now = now.Add(5 * time.Second)
+	allowed, _ = limiter.allow("a")
+	assert.True(t, allowed)
+	allowed, _ = limiter.allow("a")
+	assert.False(t, allowed)
+
+	// the full buckets are forgotten
+	now = now.Add(time.Hour)
+	allowed, _ = limiter.allow("c")
+	assert.True(t, allowed)
+	assert.Len(t, limiter.buckets, 1)
+}
+
+func TestLimitRequests(t *testing.T) {
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	request := func(handler http.Handler, ip, user string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest("GET", "/api/artifact-dl/artif1", nil)
+		r.RemoteAddr = ip + ":1234"
+		if user != "" {
+			r.SetBasicAuth(user, "secret")
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	// disabled by default
+	handler := limitRequests(nil, nil)(ok)
+	for i := 0; i < 100; i++ {
+		assert.Equal(t, http.StatusOK, request(handler, "192.0.2.1", "").Code)
+	}
+
+	handler = limitRequests(newRequestLimiter(1, 2), nil)(ok)
+	assert.Equal(t, http.StatusOK, request(handler, "192.0.2.1", "").Code)
+	assert.Equal(t, http.StatusOK, request(handler, "192.0.2.1", "").Code)
+	w := request(handler, "192.0.2.1", "")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "60", w.Header().Get("Retry-After"))
+
+	// the unverified basic auth users don't get their own bucket
+	assert.Equal(t, http.StatusTooManyRequests, request(handler, "192.0.2.1", "alice").Code)
+	assert.Equal(t, http.StatusTooManyRequests, request(handler, "192.0.2.1", "bob").Code)
+
+	// the other IPs have their own bucket
+	assert.Equal(t, http.StatusOK, request(handler, "192.0.2.2", "alice").Code)
+
+	// behind a trusted proxy, limited by forwarded client IP
+	proxies, err := parseTrustedProxies("192.0.2.1")
+	require.NoError(t, err)
+	handler = limitRequests(newRequestLimiter(1, 1), proxies)(ok)
+	forwarded := func(client string) int {
+		r := httptest.NewRequest("GET", "/api/artifact-dl/artif1", nil)
+		r.RemoteAddr = "192.0.2.1:1234"
+		r.Header.Set("X-Forwarded-For", client)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w.Code
+	}
+	assert.Equal(t, http.StatusOK, forwarded("198.51.100.1"))
+	assert.Equal(t, http.StatusTooManyRequests, forwarded("198.51.100.1"))
+	assert.Equal(t, http.StatusOK, forwarded("198.51.100.2"))
+}
@@ -0,0 +1,112 @@
+package yolosvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jszwedko/go-circleci"
+	"go.uber.org/zap"
+)
+
+// retrier retries the calls to the CI providers failing with a transient error, with an exponential backoff
+type retrier struct {
+	attempts int
+	base     time.Duration
+	max      time.Duration
+	logger   *zap.Logger
+}
+
+func newRetrier(logger *zap.Logger) retrier {
+	return retrier{attempts: 5, base: time.Second, max: time.Minute, logger: logger}
+}
+
+// do calls fn until it succeeds, fails with a permanent error or runs out of attempts
+func (r retrier) do(ctx context.Context, name string, fn func() error) error {
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = fn()
+		if err == nil || !isTransientError(err) || attempt >= r.attempts {
+			return err
+		}
+
+		wait := r.backoff(attempt)
+		var rateLimited *RateLimitError
+		if errors.As(err, &rateLimited) && rateLimited.RetryAfter > 0 {
+			wait = rateLimited.RetryAfter
+		}
+		r.logger.Debug("retry", zap.String("call", name), zap.Int("attempt", attempt), zap.Duration("wait", wait), zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// backoff returns the wait before the next attempt, doubled after each attempt and jittered
+func (r retrier) backoff(attempt int) time.Duration {
+	wait := r.base << (attempt - 1)
+	if wait > r.max || wait <= 0 {
+		wait = r.max
+	}
+	return withJitter(wait)
+}
+
+// isTransientError returns false for the client errors (i.e, invalid token, unknown project), retrying won't help
+func isTransientError(err error) bool {
+	var apiErr *circleci.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.HTTPStatusCode == http.StatusTooManyRequests || apiErr.HTTPStatusCode >= 500
+	}
+	return !errors.Is(err, context.Canceled)
+}
+
+// RateLimitError is returned for the 429 responses, with the delay requested by the server
+type RateLimitError struct {
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("rate limited, retry after %s", e.RetryAfter)
+}
+
+// NewRateLimitTransport returns a transport turning the 429 responses into RateLimitErrors,
+// so the Retry-After header is available to the callers of the clients not exposing the responses
+func NewRateLimitTransport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &rateLimitTransport{next: next}
+}
+
+type rateLimitTransport struct {
+	next http.RoundTripper
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusTooManyRequests {
+		return resp, err
+	}
+	resp.Body.Close()
+	return nil, &RateLimitError{RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())}
+}
+
+// parseRetryAfter supports the delays in seconds and the HTTP dates, 0 if unset or invalid
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil && date.After(now) {
+		return date.Sub(now)
+	}
+	return 0
+}
@@ -0,0 +1,26 @@
+package yolosvc
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+)
+
+// s3PresignTTL is the validity of the presigned URLs the S3 downloads are redirected to
+const s3PresignTTL = 15 * time.Minute
+
+// s3DownloadLocation returns a presigned URL of the artifact, so large files are not proxied by yolo
+func (svc *service) s3DownloadLocation(artifact *yolopb.Artifact, filename string) (string, error) {
+	if svc.s3c == nil {
+		return "", fmt.Errorf("s3 configuration required")
+	}
+
+	params := url.Values{}
+	params.Set("response-content-disposition", fmt.Sprintf("attachment; filename=%s", filename))
+	if artifact.MimeType != "" {
+		params.Set("response-content-type", artifact.MimeType)
+	}
+	return svc.s3c.PresignGet(svc.rewriteDownloadURL(artifact), s3PresignTTL, params)
+}
@@ -0,0 +1,73 @@
+package yolosvc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"berty.tech/yolo/v2/go/pkg/s3"
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArtifactDownloaderS3(t *testing.T) {
+	bucket := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/artifacts/builds/42/app.apk" || r.Header.Get("Authorization") == "" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte("apk content"))
+	}))
+	defer bucket.Close()
+	s3c, err := s3.New(s3.Config{Bucket: "artifacts", Endpoint: bucket.URL, AccessKeyID: "key", SecretAccessKey: "secret"})
+	require.NoError(t, err)
+
+	download := func(svc *service) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("artifactID", "s3-artifact")
+		r := httptest.NewRequest("GET", "/", nil)
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+		w := httptest.NewRecorder()
+		svc.ArtifactDownloader(w, r)
+		return w
+	}
+
+	for _, redirect := range []bool{false, true} {
+		redirect := redirect
+		t.Run(fmt.Sprintf("redirect=%t", redirect), func(t *testing.T) {
+			api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t), S3Client: s3c, S3Redirect: redirect})
+			defer cleanup()
+			svc := api.(*service)
+			err := svc.store.SaveBatch(&yolopb.Batch{Artifacts: []*yolopb.Artifact{{
+				ID:          "s3-artifact",
+				Driver:      yolopb.Driver_S3,
+				DownloadURL: "builds/42/app.apk",
+				LocalPath:   "app.apk",
+				MimeType:    "application/vnd.android.package-archive",
+				FileSize:    11,
+				HasBuildID:  "https://buildkite.com/berty/berty/builds/2738",
+			}}})
+			require.NoError(t, err)
+
+			w := download(svc)
+			if !redirect {
+				assert.Equal(t, http.StatusOK, w.Code)
+				assert.Equal(t, "apk content", w.Body.String())
+				assert.Equal(t, "application/vnd.android.package-archive", w.Header().Get("Content-Type"))
+				assert.Equal(t, "11", w.Header().Get("Content-Length"))
+				return
+			}
+			assert.Equal(t, http.StatusFound, w.Code)
+			location := w.Header().Get("Location")
+			assert.True(t, strings.HasPrefix(location, bucket.URL+"/artifacts/builds/42/app.apk?"), location)
+			assert.Contains(t, location, "X-Amz-Signature=")
+			assert.Contains(t, location, "response-content-type=application%2Fvnd.android.package-archive")
+		})
+	}
+}
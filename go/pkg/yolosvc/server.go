@@ -4,18 +4,21 @@ import (
 	"context"
 	"crypto/subtle"
 	"fmt"
+	"io/fs"
 	"net"
 	"net/http"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"google.golang.org/grpc/credentials/insecure"
 
 	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"berty.tech/yolo/v2/web"
 	"github.com/go-chi/chi"
 	"github.com/go-chi/chi/middleware"
 	"github.com/go-chi/jsonp"
-	packr "github.com/gobuffalo/packr/v2"
 	"github.com/gogo/gateway"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
@@ -24,7 +27,6 @@ import (
 	"github.com/oklog/run"
 	cache "github.com/patrickmn/go-cache"
 	"github.com/rs/cors"
-	"github.com/stretchr/signature"
 	"github.com/tevino/abool"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -44,6 +46,12 @@ type Server struct {
 	cache            *cache.Cache
 	clearCache       *abool.AtomicBool
 	withCache        bool
+	httpServer       *http.Server
+	shutdownTimeout  time.Duration
+	stopGateway      context.CancelFunc
+	shuttingDown     chan struct{}
+	shutdownOnce     sync.Once
+	shutdownErr      error
 }
 
 type ServerOpts struct {
@@ -54,11 +62,25 @@ type ServerOpts struct {
 	RequestTimeout     time.Duration
 	ShutdownTimeout    time.Duration
 	BasicAuth          string
+	StaffPassword      string
 	Realm              string
-	AuthSalt           string
+	AuthSalts          []string
 	DevMode            bool
 	ClearCache         *abool.AtomicBool
 	WithCache          bool
+	LogExcludeAgents   string
+	LogExcludeIPs      string
+	Redactor           *Redactor
+	// WithETag enables the ETag/If-None-Match support of the build list, for the dashboards polling it
+	WithETag bool
+	// Metrics are exposed on /metrics (behind the basic authentication) if set
+	Metrics *Metrics
+	// DownloadRequestsPerMinute limits the download and plist requests of each client IP, 0 means unlimited
+	DownloadRequestsPerMinute int
+	// DownloadRequestBurst is the number of download and plist requests allowed at once before the rate applies
+	DownloadRequestBurst int
+	// TrustedProxies are the IP ranges of the reverse proxies whose X-Forwarded-For header identifies the clients
+	TrustedProxies string
 }
 
 func NewServer(ctx context.Context, svc Service, opts ServerOpts) (*Server, error) {
@@ -66,10 +88,12 @@ func NewServer(ctx context.Context, svc Service, opts ServerOpts) (*Server, erro
 
 	// gRPC internal server
 	srv := Server{
-		logger:     opts.Logger,
-		devMode:    opts.DevMode,
-		clearCache: opts.ClearCache,
-		withCache:  opts.WithCache,
+		logger:          opts.Logger,
+		devMode:         opts.DevMode,
+		clearCache:      opts.ClearCache,
+		withCache:       opts.WithCache,
+		shutdownTimeout: opts.ShutdownTimeout,
+		shuttingDown:    make(chan struct{}),
 	}
 
 	// gRPC interceptors
@@ -77,8 +101,8 @@ func NewServer(ctx context.Context, svc Service, opts ServerOpts) (*Server, erro
 		return status.Errorf(codes.Unknown, "panic triggered: %v", p)
 	}
 	recoveryOpts := []grpc_recovery.Option{grpc_recovery.WithRecoveryHandler(recoveryHandler)}
-	serverStreamOpts := []grpc.StreamServerInterceptor{}
-	serverUnaryOpts := []grpc.UnaryServerInterceptor{}
+	serverStreamOpts := []grpc.StreamServerInterceptor{streamTraceContextInterceptor}
+	serverUnaryOpts := []grpc.UnaryServerInterceptor{unaryTraceContextInterceptor}
 	if !srv.devMode {
 		serverStreamOpts = append(serverStreamOpts, grpc_recovery.StreamServerInterceptor(recoveryOpts...))
 		serverUnaryOpts = append(serverUnaryOpts, grpc_recovery.UnaryServerInterceptor(recoveryOpts...))
@@ -107,9 +131,7 @@ func NewServer(ctx context.Context, svc Service, opts ServerOpts) (*Server, erro
 		srv.logger.Info("starting gRPC server", zap.String("bind", srv.grpcListenerAddr))
 		return srv.grpcServer.Serve(grpcListener)
 	}, func(_ error) {
-		if err := grpcListener.Close(); err != nil {
-			srv.logger.Warn("close gRPC listener", zap.Error(err))
-		}
+		srv.Stop()
 	})
 
 	// HTTP exposed server
@@ -125,16 +147,26 @@ func NewServer(ctx context.Context, svc Service, opts ServerOpts) (*Server, erro
 		})
 		r.Use(cors.Handler)
 	}
-	r.Use(chizap.New(srv.logger, &chizap.Opts{WithUserAgent: true, WithReferer: true}))
-	r.Use(middleware.Timeout(opts.RequestTimeout))
+	proxies, err := parseTrustedProxies(opts.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
+	logExclusions, err := parseLogExclusions(opts.LogExcludeAgents, opts.LogExcludeIPs, proxies)
+	if err != nil {
+		return nil, err
+	}
+	r.Use(extractTraceContext)
+	r.Use(logFilter(chizap.New(srv.logger, &chizap.Opts{WithUserAgent: true, WithReferer: true}), logExclusions, srv.logger))
 	r.Use(middleware.Recoverer)
+	r.Use(redactErrors(opts.Redactor))
 
-	gwmux := runtime.NewServeMux(
-		runtime.WithMarshalerOption(runtime.MIMEWildcard, &gateway.JSONPb{EmitDefaults: false, Indent: "  ", OrigName: true}),
-		runtime.WithProtoErrorHandler(runtime.DefaultHTTPProtoErrorHandler),
-	)
+	// the gateway connection outlives ctx, the in-flight API calls are drained on shutdown
+	gwmux := newGatewayMux()
 	grpcDialOpts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
-	if err := yolopb.RegisterYoloServiceHandlerFromEndpoint(ctx, gwmux, srv.grpcListenerAddr, grpcDialOpts); err != nil {
+	var gatewayCtx context.Context
+	gatewayCtx, srv.stopGateway = context.WithCancel(context.Background())
+	if err := yolopb.RegisterYoloServiceHandlerFromEndpoint(gatewayCtx, gwmux, srv.grpcListenerAddr, grpcDialOpts); err != nil {
+		srv.stopGateway()
 		return nil, err
 	}
 
@@ -150,77 +182,199 @@ func NewServer(ctx context.Context, svc Service, opts ServerOpts) (*Server, erro
 					srv.cache.Flush()
 					srv.clearCache.UnSet()
 				}
-				time.Sleep(time.Second)
+				select {
+				case <-srv.shuttingDown:
+					return nil
+				case <-time.After(time.Second):
+				}
 			}
-		}, func(_ error) {})
+		}, func(_ error) {
+			srv.Stop()
+		})
 	}
 
+	if opts.WithETag {
+		handler = etagMiddleware(handler, opts.AuthSalts[0], "/build-list")
+	}
+
+	timeout := middleware.Timeout(opts.RequestTimeout)
+
+	// only the JSON and static routes are compressed, the artifacts are already compressed and
+	// the downloads keep their Content-Length
+	compress := middleware.Compress(5)
+
+	// a single limiter for both endpoints, an iOS install requests the plist then the IPA
+	var downloadLimiter *requestLimiter
+	if opts.DownloadRequestsPerMinute > 0 {
+		downloadLimiter = newRequestLimiter(opts.DownloadRequestsPerMinute, opts.DownloadRequestBurst)
+	}
+	limitDownloads := limitRequests(downloadLimiter, proxies)
+
 	r.Route("/api", func(r chi.Router) {
-		r.Use(auth(opts.BasicAuth, opts.Realm, opts.AuthSalt))
+		r.Use(auth(opts.BasicAuth, opts.StaffPassword, opts.Realm, opts.AuthSalts))
 		r.Use(jsonp.Handler)
-		r.Mount("/", http.StripPrefix("/api", handler))
-		r.Get("/plist-gen/{artifactID}.plist", svc.PlistGenerator)
-		r.Get("/artifact-dl/{artifactID}", svc.ArtifactDownloader)
-		r.Get("/artifact-icon/{name}", svc.ArtifactIcon)
-		r.Get("/artifact-get-file/{artifactID}/*", svc.ArtifactGetFile)
+
+		// long-lived streams, not subject to the request timeout
+		r.With(srv.endOnShutdown).Get("/builds/stream", svc.BuildStreamer)
+		r.With(srv.endOnShutdown).Get("/builds/events", svc.BuildEvents)
+
+		// the downloads aren't subject to the request timeout either, i.e, when capped by --download-rate-limit,
+		// they are drained on shutdown
+		r.With(limitDownloads).Get("/artifact-dl/{artifactID}", svc.ArtifactDownloader)
+
+		r.Group(func(r chi.Router) {
+			r.Use(timeout)
+			r.With(compress).Mount("/", http.StripPrefix("/api", handler))
+			r.With(limitDownloads).Get("/plist-gen/{artifactID}.plist", svc.PlistGenerator)
+			r.Get("/artifact-icon/{name}", svc.ArtifactIcon)
+			r.Get("/artifact-get-file/{artifactID}/*", svc.ArtifactGetFile)
+			r.Get("/artifact/{artifactID}/checksums", svc.ArtifactChecksums)
+			r.Get("/artifact/{artifactID}/checksums.sha256", svc.ArtifactSHA256File)
+			r.Get("/artifact/{artifactID}/stats", svc.ArtifactStats)
+			r.Post("/installed/{buildID}", svc.InstallCallback)
+			r.Get("/build/{buildID}/qr.png", svc.BuildQRCode)
+			r.Get("/appcast/{projectID}.xml", svc.SparkleAppcast)
+		})
 	})
 
-	box := packr.New("web", "../../../web/dist")
+	// bookmarkable links to the most recent build of a platform, authenticated like the build list
+	r.With(timeout, auth(opts.BasicAuth, opts.StaffPassword, opts.Realm, opts.AuthSalts)).Get("/release/{platform}/latest", svc.LatestRelease)
+
+	if opts.Metrics != nil {
+		r.With(timeout, auth(opts.BasicAuth, opts.StaffPassword, opts.Realm, opts.AuthSalts)).Get("/metrics", opts.Metrics.ServeHTTP)
+	}
+
+	// probes, unauthenticated for the load balancers
+	r.With(timeout).Get("/healthz", svc.Healthz)
+	r.With(timeout).Get("/readyz", svc.Readyz)
+
+	// webhooks are authenticated with their own signature
+	r.With(timeout).Post("/api/webhooks/github", svc.GitHubWebhook)
 
 	// static files and 404 handler
-	fs := http.StripPrefix("/", http.FileServer(box))
-	r.Get("/*", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.URL.Path != "/" {
-			_, err := box.FindString(r.URL.Path)
-			if err != nil {
-				r.URL.Path = "/" // 404 redirects to index.html
-			}
-		}
-		fs.ServeHTTP(w, r)
-	}))
+	r.With(timeout, compress).Get("/*", staticHandler(web.FS()))
 
 	httpListener, err := net.Listen("tcp", opts.HTTPBind)
 	if err != nil {
 		return nil, err
 	}
 	srv.httpListenerAddr = httpListener.Addr().String()
-	httpServer := http.Server{Handler: r}
+	srv.httpServer = &http.Server{Handler: r}
 	srv.workers.Add(func() error {
 		srv.logger.Info("starting HTTP server", zap.String("bind", srv.httpListenerAddr))
-		return httpServer.Serve(httpListener)
-	}, func(_ error) {
-		ctx, cancel := context.WithTimeout(ctx, opts.ShutdownTimeout)
-		if err := httpServer.Shutdown(ctx); err != nil {
-			srv.logger.Warn("shutdown HTTP server", zap.Error(err))
+		if err := srv.httpServer.Serve(httpListener); err != http.ErrServerClosed {
+			return err
 		}
-		defer cancel()
-		if err := httpListener.Close(); err != nil {
-			srv.logger.Warn("close HTTP listener", zap.Error(err))
+		return nil
+	}, func(_ error) {
+		srv.Stop()
+	})
+
+	// the server is shut down gracefully when ctx is done
+	srv.workers.Add(func() error {
+		select {
+		case <-ctx.Done():
+		case <-srv.shuttingDown:
 		}
+		return nil
+	}, func(_ error) {
+		srv.Stop()
 	})
 
 	return &srv, nil
 }
 
+// Start serves the HTTP and gRPC APIs, it returns once the server is shut down and its in-flight requests are drained
 func (srv *Server) Start() error {
 	return srv.workers.Run()
 }
 
+// Stop shuts the server down, waiting for the in-flight requests up to the shutdown timeout
 func (srv *Server) Stop() {
-	srv.grpcServer.GracefulStop()
+	ctx, cancel := context.WithTimeout(context.Background(), srv.shutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(ctx); err != nil {
+		srv.logger.Warn("shutdown", zap.Error(err))
+	}
+}
+
+// Shutdown stops accepting new connections, ends the build streams, then waits for the other in-flight
+// requests (i.e, the downloads) until ctx is done. The next calls wait for the first one and return its result.
+func (srv *Server) Shutdown(ctx context.Context) error {
+	srv.shutdownOnce.Do(func() {
+		srv.logger.Info("shutting down", zap.Duration("timeout", srv.shutdownTimeout))
+		close(srv.shuttingDown)
+
+		// the HTTP gateway calls the gRPC server, it is stopped first
+		if err := srv.httpServer.Shutdown(ctx); err != nil {
+			srv.shutdownErr = fmt.Errorf("shutdown HTTP server: %w", err)
+		}
+		srv.stopGateway()
+
+		stopped := make(chan struct{})
+		go func() {
+			srv.grpcServer.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-ctx.Done(): // the gRPC streams don't end by themselves
+			srv.grpcServer.Stop()
+			<-stopped
+		}
+	})
+	return srv.shutdownErr
+}
+
+// endOnShutdown cancels the requests of the long-lived streams when the server shuts down, they would hold it until the timeout
+func (srv *Server) endOnShutdown(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+		go func() {
+			select {
+			case <-srv.shuttingDown:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// newGatewayMux returns the JSON gateway of the YoloService, mounted under /api.
+//
+// The RPCs are exposed on the paths of their google.api.http options, the fields of
+// the requests are read from the query parameters, i.e:
+//
+//	GET /api/ping
+//	GET /api/status
+//	GET /api/build-list?artifact_kinds=2&limit=50
+//	GET /api/build?build_id=<ID or yolo_id>
+func newGatewayMux() *runtime.ServeMux {
+	return runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &gateway.JSONPb{EmitDefaults: false, Indent: "  ", OrigName: true}),
+		runtime.WithProtoErrorHandler(runtime.DefaultHTTPProtoErrorHandler),
+		runtime.WithIncomingHeaderMatcher(gatewayHeaderMatcher),
+	)
 }
 
-func auth(basicAuth, realm, salt string) func(http.Handler) http.Handler {
+func auth(basicAuth, staffPassword, realm string, salts []string) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			ret, _ := signature.ValidateSignature(r.Method, r.URL.String(), "", salt)
-			if ret {
+			if validSignature(r, salts) {
+				if signedURLExpired(r) {
+					httpError(w, fmt.Errorf("signed URL expired"), codes.Unauthenticated)
+					return
+				}
 				next.ServeHTTP(w, r)
 				return
 			}
 			if basicAuth != "" {
 				_, password, ok := r.BasicAuth()
-				if !ok || subtle.ConstantTimeCompare([]byte(password), []byte(basicAuth)) != 1 {
+				isMember := ok && subtle.ConstantTimeCompare([]byte(password), []byte(basicAuth)) == 1
+				isStaff := ok && staffPassword != "" && subtle.ConstantTimeCompare([]byte(password), []byte(staffPassword)) == 1
+				if !isMember && !isStaff {
 					if r.Header.Get("Referer") == "" { // if referer is unset, someone is calling the API directly (without ajax)
 						w.Header().Add("WWW-Authenticate", fmt.Sprintf(`Basic realm="%s"`, realm))
 					}
@@ -235,7 +389,39 @@ func auth(basicAuth, realm, salt string) func(http.Handler) http.Handler {
 	}
 }
 
+// staticHandler serves the files of the web UI, the unknown paths are served index.html for the client-side routing
+func staticHandler(static fs.FS) http.HandlerFunc {
+	fileServer := http.FileServer(http.FS(static))
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			if _, err := fs.Stat(static, strings.TrimPrefix(r.URL.Path, "/")); err != nil {
+				r.URL.Path = "/" // 404 redirects to index.html
+			}
+		}
+		fileServer.ServeHTTP(w, r)
+	}
+}
+
+// signedURLExpired returns true if the request has an "expires" parameter (unix timestamp) in the past.
+// the parameter is covered by the signature, it can't be changed without invalidating the URL.
+func signedURLExpired(r *http.Request) bool {
+	expires := r.URL.Query().Get("expires")
+	if expires == "" {
+		return false
+	}
+	ts, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return true
+	}
+	return time.Now().After(time.Unix(ts, 0))
+}
+
 func httpError(w http.ResponseWriter, err error, code codes.Code) {
+	httpErrorWithStatus(w, err, code, runtime.HTTPStatusFromCode(code))
+}
+
+// httpErrorWithStatus is like httpError, for HTTP statuses without gRPC equivalent
+func httpErrorWithStatus(w http.ResponseWriter, err error, code codes.Code, status int) {
 	msg := struct {
 		Code    codes.Code `json:"code"`
 		Message string     `json:"message"`
@@ -245,13 +431,19 @@ func httpError(w http.ResponseWriter, err error, code codes.Code) {
 		Message: code.String(),
 		Details: fmt.Sprintf("%v", err),
 	}
-	http.Error(w, u.PrettyJSON(msg), runtime.HTTPStatusFromCode(code))
+	http.Error(w, u.PrettyJSON(msg), status)
 }
 
 func (o *ServerOpts) applyDefaults() {
 	if o.Logger == nil {
 		o.Logger = zap.NewNop()
 	}
+	if len(o.AuthSalts) == 0 {
+		o.AuthSalts = []string{""}
+	}
+	if o.Redactor == nil {
+		o.Redactor = NewRedactor(append([]string{o.BasicAuth, o.StaffPassword}, o.AuthSalts...)...)
+	}
 	if o.HTTPBind == "" {
 		o.HTTPBind = ":0"
 	}
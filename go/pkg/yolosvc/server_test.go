@@ -0,0 +1,258 @@
+package yolosvc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"berty.tech/yolo/v2/web"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGatewayRoutes(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+
+	gwmux := newGatewayMux()
+	require.NoError(t, yolopb.RegisterYoloServiceHandlerServer(context.Background(), gwmux, api))
+	handler := http.StripPrefix("/api", gwmux)
+
+	get := func(path string) (int, map[string]interface{}) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		var body map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body), w.Body.String())
+		return w.Code, body
+	}
+	buildIDs := func(body map[string]interface{}) []string {
+		ids := []string{}
+		builds, _ := body["builds"].([]interface{})
+		for _, build := range builds {
+			ids = append(ids, build.(map[string]interface{})["id"].(string))
+		}
+		return ids
+	}
+
+	// the documented route table of newGatewayMux
+	code, body := get("/api/ping")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Empty(t, body)
+
+	code, body = get("/api/status")
+	assert.Equal(t, http.StatusOK, code)
+	assert.EqualValues(t, 1, body["nb_builds"])
+	assert.EqualValues(t, 1, body["nb_projects"])
+
+	code, body = get("/api/build-list?artifact_kinds=2&limit=50")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, []string{"https://buildkite.com/berty/berty/builds/2738"}, buildIDs(body))
+
+	code, body = get("/api/build-list?artifact_kinds=1")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Empty(t, buildIDs(body))
+
+	code, _ = get("/api/build-list?limit=-1")
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	code, body = get("/api/build?build_id=b:n5SDir9UzvDbis4sYVB97f1EiAdnv784AAGWwZHWWkN")
+	assert.Equal(t, http.StatusOK, code)
+	build := body["build"].(map[string]interface{})
+	assert.Equal(t, "https://buildkite.com/berty/berty/builds/2738", build["id"])
+	assert.Equal(t, "feat: tests", build["message"])
+
+	code, _ = get("/api/build?build_id=does-not-exist")
+	assert.Equal(t, http.StatusNotFound, code)
+	code, _ = get("/api/build")
+	assert.Equal(t, http.StatusBadRequest, code)
+}
+
+func TestServerShutdownDrainsDownloads(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	svc := api.(*service)
+
+	// the provider sends the end of the artifact once released
+	started := make(chan struct{})
+	release := make(chan struct{})
+	provider := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("hello "))
+		w.(http.Flusher).Flush()
+		close(started)
+		<-release
+		_, _ = w.Write([]byte("world"))
+	}))
+	defer provider.Close()
+	require.NoError(t, svc.store.SaveArtifact(&yolopb.Artifact{ID: "slow", LocalPath: "slow.apk", Driver: yolopb.Driver_Bintray, DownloadURL: provider.URL, HasBuildID: "https://buildkite.com/berty/berty/builds/2738"}))
+
+	server, err := NewServer(context.Background(), api, ServerOpts{Logger: testutil.Logger(t), ShutdownTimeout: 10 * time.Second})
+	require.NoError(t, err)
+	served := make(chan error, 1)
+	go func() { served <- server.Start() }()
+	baseURL := fmt.Sprintf("http://%s", server.httpListenerAddr)
+	client := &http.Client{Transport: &http.Transport{DisableKeepAlives: true}}
+
+	downloaded := make(chan string, 1)
+	go func() {
+		resp, err := client.Get(baseURL + "/api/artifact-dl/slow")
+		if err != nil {
+			downloaded <- err.Error()
+			return
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		downloaded <- string(body)
+	}()
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "download not started")
+	}
+
+	shutdown := make(chan error, 1)
+	go func() { shutdown <- server.Shutdown(context.Background()) }()
+
+	// the new connections are refused while the download is drained
+	assert.Eventually(t, func() bool {
+		_, err := client.Get(baseURL + "/healthz")
+		return err != nil
+	}, 5*time.Second, 10*time.Millisecond)
+	select {
+	case err := <-shutdown:
+		require.FailNow(t, "shutdown before the end of the download", "%v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	close(release)
+	select {
+	case body := <-downloaded:
+		assert.Equal(t, "hello world", body)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "download not completed")
+	}
+	require.NoError(t, <-shutdown)
+	require.NoError(t, <-served)
+
+	// the next calls return once the first one is done
+	require.NoError(t, server.Shutdown(context.Background()))
+}
+
+func TestServerThrottledDownloadTimeout(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t), DownloadRateLimit: 100})
+	defer cleanup()
+	svc := api.(*service)
+
+	content := strings.Repeat("a", 50) // sent in 500ms at the capped rate
+	provider := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(content))
+	}))
+	defer provider.Close()
+	require.NoError(t, svc.store.SaveArtifact(&yolopb.Artifact{ID: "capped", LocalPath: "capped.apk", Driver: yolopb.Driver_Bintray, DownloadURL: provider.URL, HasBuildID: "https://buildkite.com/berty/berty/builds/2738"}))
+
+	server, err := NewServer(context.Background(), api, ServerOpts{Logger: testutil.Logger(t), RequestTimeout: 100 * time.Millisecond})
+	require.NoError(t, err)
+	served := make(chan error, 1)
+	go func() { served <- server.Start() }()
+	defer func() {
+		require.NoError(t, server.Shutdown(context.Background()))
+		require.NoError(t, <-served)
+	}()
+
+	// the capped download lasts longer than the request timeout
+	start := time.Now()
+	resp, err := http.Get(fmt.Sprintf("http://%s/api/artifact-dl/capped", server.httpListenerAddr))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, content, string(body))
+	assert.Greater(t, time.Since(start), 300*time.Millisecond)
+}
+
+func TestServerCompression(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	svc := api.(*service)
+
+	content := strings.Repeat("release notes\n", 100)
+	provider := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(content))
+	}))
+	defer provider.Close()
+	require.NoError(t, svc.store.SaveArtifact(&yolopb.Artifact{ID: "notes", LocalPath: "notes.txt", MimeType: "text/plain", FileSize: int64(len(content)), Driver: yolopb.Driver_Bintray, DownloadURL: provider.URL, HasBuildID: "https://buildkite.com/berty/berty/builds/2738"}))
+
+	server, err := NewServer(context.Background(), api, ServerOpts{Logger: testutil.Logger(t)})
+	require.NoError(t, err)
+	served := make(chan error, 1)
+	go func() { served <- server.Start() }()
+	defer func() {
+		require.NoError(t, server.Shutdown(context.Background()))
+		require.NoError(t, <-served)
+	}()
+	baseURL := fmt.Sprintf("http://%s", server.httpListenerAddr)
+
+	// with an explicit Accept-Encoding, the transport does not decompress the responses
+	get := func(path string) *http.Response {
+		req, err := http.NewRequest(http.MethodGet, baseURL+path, nil)
+		require.NoError(t, err)
+		req.Header.Set("Accept-Encoding", "gzip, deflate")
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		return resp
+	}
+
+	resp := get("/api/build-list")
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "gzip", resp.Header.Get("Content-Encoding"))
+
+	// even with a compressible mimetype, the downloads are sent as is
+	resp = get("/api/artifact-dl/notes")
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Empty(t, resp.Header.Get("Content-Encoding"))
+	assert.Equal(t, fmt.Sprintf("%d", len(content)), resp.Header.Get("Content-Length"))
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, content, string(body))
+}
+
+func TestStaticHandler(t *testing.T) {
+	handler := staticHandler(fstest.MapFS{
+		"index.html":       {Data: []byte("<html>index</html>")},
+		"static/js/app.js": {Data: []byte("console.log('app')")},
+	})
+	get := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest("GET", path, nil))
+		return w
+	}
+
+	w := get("/")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "<html>index</html>", w.Body.String())
+	w = get("/static/js/app.js")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "console.log('app')", w.Body.String())
+	// the client-side routes are served index.html
+	w = get("/build/42")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "<html>index</html>", w.Body.String())
+
+	// the embedded build is rooted in the dist directory
+	_, err := fs.ReadDir(web.FS(), ".")
+	assert.NoError(t, err)
+	_, err = fs.Stat(web.FS(), "dist")
+	assert.Error(t, err)
+}
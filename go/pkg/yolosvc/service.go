@@ -2,11 +2,21 @@ package yolosvc
 
 import (
 	"context"
+	"crypto/ed25519"
+	"fmt"
 	"net/http"
+	"os"
+	"strings"
 	"sync"
 	"time"
 
+	"berty.tech/yolo/v2/go/pkg/appcast"
+	"berty.tech/yolo/v2/go/pkg/appstoreconnect"
+	"berty.tech/yolo/v2/go/pkg/azure"
 	"berty.tech/yolo/v2/go/pkg/bintray"
+	"berty.tech/yolo/v2/go/pkg/firebase"
+	"berty.tech/yolo/v2/go/pkg/jenkins"
+	"berty.tech/yolo/v2/go/pkg/s3"
 	"berty.tech/yolo/v2/go/pkg/yolopb"
 	"berty.tech/yolo/v2/go/pkg/yolostore"
 	"github.com/buildkite/go-buildkite/buildkite"
@@ -14,6 +24,8 @@ import (
 	"github.com/jinzhu/gorm"
 	"github.com/jszwedko/go-circleci"
 	"github.com/tevino/abool"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"moul.io/u"
 )
@@ -24,12 +36,32 @@ type Service interface {
 	ArtifactDownloader(w http.ResponseWriter, r *http.Request)
 	ArtifactIcon(w http.ResponseWriter, r *http.Request)
 	ArtifactGetFile(w http.ResponseWriter, r *http.Request)
+	ArtifactChecksums(w http.ResponseWriter, r *http.Request)
+	ArtifactSHA256File(w http.ResponseWriter, r *http.Request)
+	ArtifactStats(w http.ResponseWriter, r *http.Request)
+	Healthz(w http.ResponseWriter, r *http.Request)
+	Readyz(w http.ResponseWriter, r *http.Request)
+	BuildQRCode(w http.ResponseWriter, r *http.Request)
+	SparkleAppcast(w http.ResponseWriter, r *http.Request)
+	LatestRelease(w http.ResponseWriter, r *http.Request)
+	InstallCallback(w http.ResponseWriter, r *http.Request)
+	BuildStreamer(w http.ResponseWriter, r *http.Request)
+	BuildEvents(w http.ResponseWriter, r *http.Request)
+
+	GitHubWebhook(w http.ResponseWriter, r *http.Request)
 
 	GitHubWorker(ctx context.Context, opts GithubWorkerOpts) error
 	BuildkiteWorker(ctx context.Context, opts BuildkiteWorkerOpts) error
 	CircleciWorker(ctx context.Context, opts CircleciWorkerOpts) error
 	BintrayWorker(ctx context.Context, opts BintrayWorkerOpts) error
+	FirebaseWorker(ctx context.Context, opts FirebaseWorkerOpts) error
+	AzurePipelinesWorker(ctx context.Context, opts AzurePipelinesWorkerOpts) error
+	JenkinsWorker(ctx context.Context, opts JenkinsWorkerOpts) error
+	TestflightWorker(ctx context.Context, opts TestflightWorkerOpts) error
 	PkgmanWorker(ctx context.Context, opts PkgmanWorkerOpts) error
+	GCWorker(ctx context.Context, opts GCWorkerOpts) error
+
+	Shutdown(ctx context.Context) error
 }
 
 type service struct {
@@ -40,7 +72,13 @@ type service struct {
 	btc                    *bintray.Client
 	ccc                    *circleci.Client
 	ghc                    *github.Client
-	authSalt               string
+	s3c                    *s3.Client
+	fbc                    *firebase.Client
+	azc                    *azure.Client
+	jkc                    *jenkins.Client
+	asc                    *appstoreconnect.Client
+	authSalt               string   // signs the new URLs
+	authSalts              []string // accepted when validating the signatures
 	devMode                bool
 	clearCache             *abool.AtomicBool
 	artifactsCachePath     string
@@ -49,6 +87,48 @@ type service struct {
 	iosPrivkeyPath         string
 	iosProvPath            string
 	iosPrivkeyPass         string
+	channels               []Channel
+	staffPassword          string
+	bufferPool             *bufferPool
+	buildConfigKeys        []string
+	plistManifestTTL       time.Duration
+	plistURLTTL            time.Duration
+	downloadRateLimit      int64
+	downloadRateOverrides  map[string]int64
+	githubWebhookSecret    string
+	refreshRequests        map[yolopb.Driver]chan refreshRequest // per-driver projects to refresh
+	pendingRefreshes       *pendingRefreshes                     // webhook refreshes not picked yet
+	refreshListeners       sync.Map                              // drivers with a running worker
+	adminRefresh           chan struct{}                         // only one admin refresh at a time
+	breakers               *circuitBreakers
+	urlRewrites            []URLRewrite
+	ownerTeamsEnabled      bool
+	ownerTeamsCache        *ownerTeamsCache
+	singleUseDownloads     *singleUseDownloads
+	mimeSniffLimit         int
+	sizeBudgets            []SizeBudget
+	sizeBudgetStatus       bool
+	sizeStatusPosted       sync.Map // artifact IDs
+	eventRetention         time.Duration
+	buildRetention         time.Duration
+	buildRetentionCount    int
+	buildRetentionDryRun   bool
+	flagsManifest          string
+	preferRedirect         map[yolopb.Driver]bool
+	buildkiteToken         string
+	verifyDownloads        bool
+	httpAuths              map[yolopb.Driver]HTTPAuth
+	metrics                *Metrics
+	buildFeed              *buildFeed
+	signedURLTTL           time.Duration
+	installURLTTL          time.Duration
+	publicURL              string
+	notifiers              []notifier
+	readinessCheckDrivers  bool
+	buildkiteFilter        buildkiteFilter
+	sparkleKey             ed25519.PrivateKey
+	tracerProvider         *sdktrace.TracerProvider // nil if the tracing is disabled
+	tracer                 trace.Tracer
 }
 
 type ServiceOpts struct {
@@ -56,24 +136,158 @@ type ServiceOpts struct {
 	CircleciClient     *circleci.Client
 	BintrayClient      *bintray.Client
 	GithubClient       *github.Client
+	S3Client           *s3.Client
+	FirebaseClient     *firebase.Client
+	AzureClient        *azure.Client
+	JenkinsClient      *jenkins.Client
 	Logger             *zap.Logger
-	AuthSalt           string
+	AuthSalts          []string
 	DevMode            bool
 	ClearCache         *abool.AtomicBool
 	ArtifactsCachePath string
 	IOSPrivkeyPath     string
 	IOSProvPath        string
 	IOSPrivkeyPass     string
+	Channels           []Channel
+	StaffPassword      string
+	CopyBufferSize     int
+	BuildConfigKeys    []string
+	PlistManifestTTL   time.Duration
+	PlistURLTTL        time.Duration
+	// DownloadRateLimit caps the bandwidth of each artifact download in bytes per second, 0 means unlimited
+	DownloadRateLimit int64
+	// DownloadRateOverrides are per-token (basic auth password) download rate limits, also signed into the download URLs listed to the token
+	DownloadRateOverrides map[string]int64
+	// GithubWebhookSecret enables the GitHub webhook receiver, used to refresh the drivers on new activity
+	GithubWebhookSecret string
+	// URLRewrites are applied to the artifact download URLs before fetching them
+	URLRewrites []URLRewrite
+	// ResolveOwnerTeams enables the resolution of the teams owning the builds from the CODEOWNERS of their GitHub repo
+	ResolveOwnerTeams bool
+	// MimeSniffLimit is the number of bytes read to guess the mimetype of artifacts without a reliable one
+	MimeSniffLimit int
+	// SizeBudgets flag the artifacts bigger than the budget of their project
+	SizeBudgets []SizeBudget
+	// SizeBudgetStatus posts a failing GitHub commit status for the artifacts over budget
+	SizeBudgetStatus bool
+	// EventRetention is how long the download and install events are kept by the GC worker, their totals are kept forever
+	EventRetention time.Duration
+	// BuildRetention is how long the builds are kept by the GC worker, with their artifacts, the promoted builds are always kept
+	BuildRetention time.Duration
+	// BuildRetentionCount is the number of most recent builds kept per project by the GC worker
+	BuildRetentionCount int
+	// BuildRetentionDryRun only logs the builds that would be removed by the retention
+	BuildRetentionDryRun bool
+	// FlagsManifest is the name of the GitHub artifact listing the feature flags of a build
+	FlagsManifest string
+	// S3Redirect redirects the downloads of the S3 artifacts to presigned URLs instead of proxying them, like adding S3 to PreferRedirect
+	S3Redirect bool
+	// PreferRedirect are the drivers whose downloads are redirected to upstream URLs instead of proxied (S3 and Buildkite), except the single-use ones, they are proxied if the redirection fails
+	PreferRedirect []yolopb.Driver
+	// BuildkiteToken resolves the presigned URLs of the Buildkite artifacts, to redirect their downloads
+	BuildkiteToken string
+	// VerifyDownloads checks the size and the checksum of the proxied downloads against the stored ones, a mismatch is logged and counted
+	VerifyDownloads bool
+	// HTTPAuths are the credentials of the downloads from plain URLs, per driver (HTTP and Bintray)
+	HTTPAuths map[yolopb.Driver]HTTPAuth
+	// Metrics collects the download, build list and refresh metrics, a private one is used if unset
+	Metrics *Metrics
+	// SignedURLTTL is the validity of the artifact URLs signed in the API responses, 0 means they never expire
+	SignedURLTTL time.Duration
+	// InstallURLTTL is the validity of the install callback URLs signed in the API responses, defaults to 7 days
+	InstallURLTTL time.Duration
+	// PublicURL is the address of the server used in the notifications links (i.e, https://yolo.berty.io)
+	PublicURL string
+	// SlackWebhookURL enables the notifications of the new installable artifacts (IPA, APK, DMG, EXE and MSI) on Slack
+	SlackWebhookURL string
+	// SlackMute disables the Slack notifications without removing the webhook
+	SlackMute bool
+	// DiscordWebhookURL enables the notifications of the new installable artifacts (IPA, APK, DMG, EXE and MSI) on Discord
+	DiscordWebhookURL string
+	// DiscordMute disables the Discord notifications without removing the webhook
+	DiscordMute bool
+	// TelegramBotToken and TelegramChatID are the bot posting the new installable artifacts (IPA, APK, DMG, EXE and MSI) on Telegram, and its chat (i.e, -1001234567890 or @channel)
+	TelegramBotToken string
+	TelegramChatID   string
+	// TelegramEnabled sends the Telegram notifications, they are disabled by default
+	TelegramEnabled bool
+	// ReadinessCheckDrivers adds the CI provider APIs to the readiness probe, they are reported without making the server unready
+	ReadinessCheckDrivers bool
+	// BuildkitePipelines are the slugs of the only Buildkite pipelines ingested, all of them are ingested if empty
+	BuildkitePipelines []string
+	// BuildkiteBranches are the only branches of the Buildkite builds ingested, all of them are ingested if empty
+	BuildkiteBranches []string
+	// AppStoreConnectKeyID enables the TestFlight driver, with the issuer ID and the private key (.p8) of the API key
+	AppStoreConnectKeyID    string
+	AppStoreConnectIssuerID string
+	AppStoreConnectKeyPath  string
+	// CircuitBreakerThreshold is the number of consecutive failed refreshes pausing the refreshes of a driver, 0 disables the circuit breakers
+	CircuitBreakerThreshold int
+	// CircuitBreakerBackoff is the first pause of an open circuit, doubled after each failed probe up to CircuitBreakerMaxBackoff
+	CircuitBreakerBackoff    time.Duration
+	CircuitBreakerMaxBackoff time.Duration
+	// SparkleKeyPath is the EdDSA private key exported by the generate_keys tool of Sparkle, signing the .dmg artifacts of the appcasts
+	SparkleKeyPath string
+	// TracingEndpoint enables the tracing, the spans are exported to this OTLP gRPC collector (i.e, localhost:4317)
+	TracingEndpoint string
+	// TracingInsecure disables the TLS of the connection to the OTLP collector
+	TracingInsecure bool
+	// TracingServiceName is the service name of the exported spans, defaults to "yolo"
+	TracingServiceName string
+	// TracingExporter replaces the OTLP exporter, i.e, to record the spans in tests
+	TracingExporter sdktrace.SpanExporter
 }
 
 func NewService(db *gorm.DB, opts ServiceOpts) (Service, error) {
 	opts.applyDefaults()
 
+	// iOS keeps using the manifest during the whole install, the embedded URL should outlive it
+	if err := validatePlistTTL(opts.PlistManifestTTL, opts.PlistURLTTL); err != nil {
+		return nil, err
+	}
+
 	store, err := yolostore.NewStore(db, opts.Logger)
 	if err != nil {
 		return nil, err
 	}
 
+	var asc *appstoreconnect.Client
+	if opts.AppStoreConnectKeyID != "" {
+		privateKey, err := os.ReadFile(u.MustExpandUser(opts.AppStoreConnectKeyPath))
+		if err != nil {
+			return nil, fmt.Errorf("read App Store Connect private key: %w", err)
+		}
+		asc, err = appstoreconnect.New(opts.AppStoreConnectKeyID, opts.AppStoreConnectIssuerID, privateKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	preferRedirect := map[yolopb.Driver]bool{}
+	for _, driver := range opts.PreferRedirect {
+		preferRedirect[driver] = true
+	}
+	if opts.S3Redirect {
+		preferRedirect[yolopb.Driver_S3] = true
+	}
+
+	var sparkleKey ed25519.PrivateKey
+	if opts.SparkleKeyPath != "" {
+		encoded, err := os.ReadFile(u.MustExpandUser(opts.SparkleKeyPath))
+		if err != nil {
+			return nil, fmt.Errorf("read Sparkle private key: %w", err)
+		}
+		sparkleKey, err = appcast.ParsePrivateKey(string(encoded))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tracerProvider, err := newTracerProvider(opts)
+	if err != nil {
+		return nil, err
+	}
+
 	return &service{
 		startTime:              time.Now(),
 		store:                  store,
@@ -82,7 +296,13 @@ func NewService(db *gorm.DB, opts ServiceOpts) (Service, error) {
 		btc:                    opts.BintrayClient,
 		ccc:                    opts.CircleciClient,
 		ghc:                    opts.GithubClient,
-		authSalt:               opts.AuthSalt,
+		s3c:                    opts.S3Client,
+		fbc:                    opts.FirebaseClient,
+		azc:                    opts.AzureClient,
+		jkc:                    opts.JenkinsClient,
+		asc:                    asc,
+		authSalt:               opts.AuthSalts[0],
+		authSalts:              opts.AuthSalts,
 		devMode:                opts.DevMode,
 		clearCache:             opts.ClearCache,
 		artifactsCachePath:     opts.ArtifactsCachePath,
@@ -90,6 +310,46 @@ func NewService(db *gorm.DB, opts ServiceOpts) (Service, error) {
 		iosProvPath:            u.MustExpandUser(opts.IOSProvPath),
 		iosPrivkeyPass:         opts.IOSPrivkeyPass,
 		artifactsCacheMapMutex: map[string]*sync.Mutex{},
+		channels:               opts.Channels,
+		staffPassword:          opts.StaffPassword,
+		bufferPool:             newBufferPool(opts.CopyBufferSize),
+		buildConfigKeys:        opts.BuildConfigKeys,
+		plistManifestTTL:       opts.PlistManifestTTL,
+		plistURLTTL:            opts.PlistURLTTL,
+		downloadRateLimit:      opts.DownloadRateLimit,
+		downloadRateOverrides:  opts.DownloadRateOverrides,
+		githubWebhookSecret:    opts.GithubWebhookSecret,
+		refreshRequests:        newRefreshRequests(),
+		pendingRefreshes:       newPendingRefreshes(),
+		adminRefresh:           make(chan struct{}, 1),
+		breakers:               newCircuitBreakers(opts.CircuitBreakerThreshold, opts.CircuitBreakerBackoff, opts.CircuitBreakerMaxBackoff, opts.Logger),
+		urlRewrites:            opts.URLRewrites,
+		ownerTeamsEnabled:      opts.ResolveOwnerTeams,
+		ownerTeamsCache:        newOwnerTeamsCache(),
+		singleUseDownloads:     newSingleUseDownloads(),
+		mimeSniffLimit:         opts.MimeSniffLimit,
+		sizeBudgets:            opts.SizeBudgets,
+		sizeBudgetStatus:       opts.SizeBudgetStatus,
+		eventRetention:         opts.EventRetention,
+		buildRetention:         opts.BuildRetention,
+		buildRetentionCount:    opts.BuildRetentionCount,
+		buildRetentionDryRun:   opts.BuildRetentionDryRun,
+		flagsManifest:          opts.FlagsManifest,
+		preferRedirect:         preferRedirect,
+		buildkiteToken:         opts.BuildkiteToken,
+		verifyDownloads:        opts.VerifyDownloads,
+		httpAuths:              opts.HTTPAuths,
+		metrics:                opts.Metrics,
+		buildFeed:              newBuildFeed(),
+		signedURLTTL:           opts.SignedURLTTL,
+		installURLTTL:          opts.InstallURLTTL,
+		publicURL:              strings.TrimSuffix(opts.PublicURL, "/"),
+		notifiers:              newNotifiers(opts),
+		readinessCheckDrivers:  opts.ReadinessCheckDrivers,
+		buildkiteFilter:        newBuildkiteFilter(opts.BuildkitePipelines, opts.BuildkiteBranches),
+		sparkleKey:             sparkleKey,
+		tracerProvider:         tracerProvider,
+		tracer:                 tracerFromProvider(tracerProvider),
 	}, nil
 }
 
@@ -100,4 +360,37 @@ func (o *ServiceOpts) applyDefaults() {
 	if o.ClearCache == nil {
 		o.ClearCache = abool.New()
 	}
+	if len(o.AuthSalts) == 0 {
+		o.AuthSalts = []string{""}
+	}
+	if o.CopyBufferSize == 0 {
+		o.CopyBufferSize = defaultCopyBufferSize
+	}
+	if o.InstallURLTTL == 0 {
+		o.InstallURLTTL = defaultInstallURLTTL
+	}
+	if o.PlistManifestTTL == 0 {
+		o.PlistManifestTTL = defaultPlistManifestTTL
+	}
+	if o.PlistURLTTL == 0 {
+		o.PlistURLTTL = defaultPlistURLTTL
+	}
+	if o.MimeSniffLimit == 0 {
+		o.MimeSniffLimit = defaultMimeSniffLimit
+	}
+	if o.FlagsManifest == "" {
+		o.FlagsManifest = defaultFlagsManifest
+	}
+	if o.Metrics == nil {
+		o.Metrics = NewMetrics()
+	}
+	if o.CircuitBreakerBackoff == 0 {
+		o.CircuitBreakerBackoff = defaultCircuitBreakerBackoff
+	}
+	if o.CircuitBreakerMaxBackoff == 0 {
+		o.CircuitBreakerMaxBackoff = defaultCircuitBreakerMaxBackoff
+	}
+	if o.TracingServiceName == "" {
+		o.TracingServiceName = defaultTracingServiceName
+	}
 }
@@ -0,0 +1,79 @@
+package yolosvc
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+// singleUseDownloads tracks the single-use URLs being downloaded, they are only spent once fully downloaded
+type singleUseDownloads struct {
+	mutex    sync.Mutex
+	inFlight map[string]bool
+}
+
+func newSingleUseDownloads() *singleUseDownloads {
+	return &singleUseDownloads{inFlight: map[string]bool{}}
+}
+
+// singleUseSignature returns the signature of a single-use URL, or an empty string for reusable URLs.
+// the URLs derived from a single-use URL (i.e, the package URLs of a manifest) carry its signature as once value.
+func singleUseSignature(r *http.Request) string {
+	query := r.URL.Query()
+	switch once := query.Get("once"); once {
+	case "":
+		return ""
+	case "1":
+		return query.Get("sign")
+	default:
+		return once
+	}
+}
+
+// beginSingleUse rejects spent signatures and signatures already being downloaded, the caller should call endSingleUse
+func (svc *service) beginSingleUse(w http.ResponseWriter, signature string) bool {
+	spent, err := svc.store.IsSignatureSpent(signature)
+	if err != nil {
+		httpError(w, err, codes.Internal)
+		return false
+	}
+	if spent {
+		httpErrorWithStatus(w, fmt.Errorf("single-use URL already used"), codes.FailedPrecondition, http.StatusGone)
+		return false
+	}
+
+	downloads := svc.singleUseDownloads
+	downloads.mutex.Lock()
+	defer downloads.mutex.Unlock()
+	if downloads.inFlight[signature] {
+		httpError(w, fmt.Errorf("single-use URL already being downloaded"), codes.Aborted)
+		return false
+	}
+	downloads.inFlight[signature] = true
+	return true
+}
+
+func (svc *service) endSingleUse(signature string) {
+	downloads := svc.singleUseDownloads
+	downloads.mutex.Lock()
+	delete(downloads.inFlight, signature)
+	downloads.mutex.Unlock()
+}
+
+// spendSingleUse records the signature of a fully downloaded single-use URL
+func (svc *service) spendSingleUse(r *http.Request, signature string) {
+	spent := yolopb.SpentSignature{ID: signature}
+	if ts, err := strconv.ParseInt(r.URL.Query().Get("expires"), 10, 64); err == nil {
+		expiresAt := time.Unix(ts, 0)
+		spent.ExpiresAt = &expiresAt
+	}
+	if err := svc.store.SpendSignature(&spent); err != nil {
+		svc.logger.Warn("failed to spend single-use signature", zap.Error(err))
+	}
+}
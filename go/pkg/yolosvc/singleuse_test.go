@@ -0,0 +1,41 @@
+package yolosvc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSingleUse(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	svc := api.(*service)
+
+	r := httptest.NewRequest("GET", "/api/artifact-dl/artif1?expires=4102444800&once=1&sign=abcdef", nil)
+	signature := singleUseSignature(r)
+	require.Equal(t, "abcdef", signature)
+	assert.Empty(t, singleUseSignature(httptest.NewRequest("GET", "/api/artifact-dl/artif1?sign=abcdef", nil)))
+	// the URLs derived from a single-use URL share its signature
+	assert.Equal(t, "012345", singleUseSignature(httptest.NewRequest("GET", "/api/artifact-dl/artif1?expires=4102444800&once=012345&sign=abcdef", nil)))
+
+	// concurrent downloads are rejected
+	require.True(t, svc.beginSingleUse(httptest.NewRecorder(), signature))
+	w := httptest.NewRecorder()
+	assert.False(t, svc.beginSingleUse(w, signature))
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	// an aborted download doesn't consume the URL
+	svc.endSingleUse(signature)
+	require.True(t, svc.beginSingleUse(httptest.NewRecorder(), signature))
+
+	// a completed download does
+	svc.spendSingleUse(r, signature)
+	svc.endSingleUse(signature)
+	w = httptest.NewRecorder()
+	assert.False(t, svc.beginSingleUse(w, signature))
+	assert.Equal(t, http.StatusGone, w.Code)
+}
@@ -0,0 +1,131 @@
+package yolosvc
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/google/go-github/v32/github"
+	"go.uber.org/zap"
+)
+
+const sizeBudgetStatusContext = "yolo/size-budget"
+
+// SizeBudget is the maximum file size of the artifacts of a project
+type SizeBudget struct {
+	ProjectID string
+	// Kind restricts the budget to a kind of artifact, all kinds if unset
+	Kind    yolopb.Artifact_Kind
+	MaxSize int64
+}
+
+// ParseSizeBudgets parses a comma-separated list of "[kind|]project=bytes" budgets, i.e, "ipa|berty/berty=157286400"
+func ParseSizeBudgets(input string) ([]SizeBudget, error) {
+	var budgets []SizeBudget
+	for _, def := range strings.Split(input, ",") {
+		def = strings.TrimSpace(def)
+		if def == "" {
+			continue
+		}
+		budget := SizeBudget{}
+		if idx := strings.Index(def, "|"); idx != -1 {
+			kind, err := parseArtifactKind(def[:idx])
+			if err != nil {
+				return nil, err
+			}
+			budget.Kind = kind
+			def = def[idx+1:]
+		}
+		idx := strings.LastIndex(def, "=")
+		if idx < 1 {
+			return nil, fmt.Errorf("invalid size budget: %q, expected [kind|]project=bytes", def)
+		}
+		maxSize, err := strconv.ParseInt(def[idx+1:], 10, 64)
+		if err != nil || maxSize <= 0 {
+			return nil, fmt.Errorf("invalid size budget: %q, the size should be a positive number of bytes", def)
+		}
+		budget.ProjectID, budget.MaxSize = def[:idx], maxSize
+		if strings.Count(budget.ProjectID, "/") == 1 { // i.e, berty/berty -> https://github.com/berty/berty
+			budget.ProjectID = "https://github.com/" + budget.ProjectID
+		}
+		budgets = append(budgets, budget)
+	}
+	return budgets, nil
+}
+
+func parseArtifactKind(name string) (yolopb.Artifact_Kind, error) {
+	for key, value := range yolopb.Artifact_Kind_value {
+		if strings.EqualFold(key, name) && value != int32(yolopb.Artifact_UnknownKind) {
+			return yolopb.Artifact_Kind(value), nil
+		}
+	}
+	return yolopb.Artifact_UnknownKind, fmt.Errorf("unknown artifact kind: %q", name)
+}
+
+// sizeBudget returns the budget of the artifacts of a kind of a project, a kind-specific budget takes precedence
+func sizeBudget(budgets []SizeBudget, projectID string, kind yolopb.Artifact_Kind) (int64, bool) {
+	var (
+		maxSize int64
+		found   bool
+	)
+	for _, budget := range budgets {
+		if budget.ProjectID != projectID {
+			continue
+		}
+		switch budget.Kind {
+		case kind:
+			return budget.MaxSize, true
+		case yolopb.Artifact_UnknownKind:
+			maxSize, found = budget.MaxSize, true
+		}
+	}
+	return maxSize, found
+}
+
+// applySizeBudgets flags the artifacts of the batch exceeding their project budget, and their builds.
+// the project of an artifact is only known if its build is part of the same batch.
+func (svc *service) applySizeBudgets(ctx context.Context, batch *yolopb.Batch) {
+	builds := map[string]*yolopb.Build{}
+	for _, build := range batch.Builds {
+		builds[build.ID] = build
+	}
+	for _, artifact := range batch.Artifacts {
+		build, found := builds[artifact.HasBuildID]
+		if !found {
+			continue
+		}
+		maxSize, found := sizeBudget(svc.sizeBudgets, build.HasProjectID, artifact.Kind)
+		artifact.OverBudget = found && artifact.FileSize > maxSize
+		if !artifact.OverBudget {
+			continue
+		}
+		build.OverBudget = true
+		svc.logger.Debug("artifact over size budget", zap.String("artifact", artifact.ID), zap.Int64("size", artifact.FileSize), zap.Int64("budget", maxSize))
+		if svc.sizeBudgetStatus {
+			svc.postSizeBudgetStatus(ctx, build, artifact, maxSize)
+		}
+	}
+}
+
+// postSizeBudgetStatus marks the commit of the build as failing on GitHub, only once per artifact
+func (svc *service) postSizeBudgetStatus(ctx context.Context, build *yolopb.Build, artifact *yolopb.Artifact, maxSize int64) {
+	owner, repo, ok := githubRepoFromProjectID(build.HasProjectID)
+	if !ok || svc.ghc == nil || build.HasCommitID == "" {
+		return
+	}
+	if _, posted := svc.sizeStatusPosted.LoadOrStore(artifact.ID, true); posted {
+		return
+	}
+	status := &github.RepoStatus{
+		State:       github.String("failure"),
+		Context:     github.String(sizeBudgetStatusContext),
+		Description: github.String(fmt.Sprintf("%s is %d bytes, over the %d bytes budget", path.Base(artifact.LocalPath), artifact.FileSize, maxSize)),
+	}
+	if _, _, err := svc.ghc.Repositories.CreateStatus(ctx, owner, repo, build.HasCommitID, status); err != nil {
+		svc.sizeStatusPosted.Delete(artifact.ID) // retry on next ingestion
+		svc.logger.Warn("post size budget status", zap.String("commit", build.HasCommitID), zap.Error(err))
+	}
+}
@@ -0,0 +1,53 @@
+package yolosvc
+
+import (
+	"context"
+	"testing"
+
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestSizeBudgets(t *testing.T) {
+	budgets, err := ParseSizeBudgets("ipa|berty/berty=100, berty/berty=200, https://gitlab.com/a/b/c=300")
+	require.NoError(t, err)
+	require.Len(t, budgets, 3)
+	assert.Equal(t, "https://github.com/berty/berty", budgets[0].ProjectID)
+	assert.Equal(t, yolopb.Artifact_IPA, budgets[0].Kind)
+	assert.Equal(t, "https://gitlab.com/a/b/c", budgets[2].ProjectID)
+
+	svc := &service{logger: zap.NewNop(), sizeBudgets: budgets}
+	batch := &yolopb.Batch{
+		Builds: []*yolopb.Build{
+			{ID: "b1", HasProjectID: "https://github.com/berty/berty"},
+			{ID: "b2", HasProjectID: "https://github.com/berty/berty"},
+			{ID: "b3", HasProjectID: "https://github.com/berty/yolo"},
+		},
+		Artifacts: []*yolopb.Artifact{
+			{ID: "ipa-ok", HasBuildID: "b1", Kind: yolopb.Artifact_IPA, FileSize: 100},
+			{ID: "apk-ok", HasBuildID: "b1", Kind: yolopb.Artifact_APK, FileSize: 150},
+			{ID: "ipa-over", HasBuildID: "b2", Kind: yolopb.Artifact_IPA, FileSize: 150},
+			{ID: "no-budget", HasBuildID: "b3", Kind: yolopb.Artifact_IPA, FileSize: 1000},
+			{ID: "unknown-build", HasBuildID: "b4", Kind: yolopb.Artifact_IPA, FileSize: 1000},
+		},
+	}
+	svc.applySizeBudgets(context.Background(), batch)
+
+	overBudget := []string{}
+	for _, artifact := range batch.Artifacts {
+		if artifact.OverBudget {
+			overBudget = append(overBudget, artifact.ID)
+		}
+	}
+	assert.Equal(t, []string{"ipa-over"}, overBudget)
+	assert.False(t, batch.Builds[0].OverBudget)
+	assert.True(t, batch.Builds[1].OverBudget)
+	assert.False(t, batch.Builds[2].OverBudget)
+
+	_, err = ParseSizeBudgets("zip|berty/berty=100")
+	assert.Error(t, err)
+	_, err = ParseSizeBudgets("berty/berty=100MB")
+	assert.Error(t, err)
+}
@@ -0,0 +1,133 @@
+package yolosvc
+
+import (
+	"mime"
+	"net/http"
+	"path/filepath"
+
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"go.uber.org/zap"
+)
+
+const defaultMimeSniffLimit = 512 // http.DetectContentType considers at most 512 bytes
+
+// needsMimeSniffing returns true if the mimetype of an artifact can't be trusted
+func needsMimeSniffing(filename, mimetype string) bool {
+	switch mimetype {
+	case "":
+		return true
+	case "application/octet-stream": // fallback of mimetypeByPath, only reliable for the known artifact kinds
+		return artifactKindByPath(filename) == yolopb.Artifact_UnknownKind
+	}
+	return false
+}
+
+// detectMimetype guesses a mimetype from the extension, then from the first bytes of the content
+func detectMimetype(filename string, head []byte) string {
+	if artifactKindByPath(filename) != yolopb.Artifact_UnknownKind {
+		return mimetypeByPath(filename)
+	}
+	if mimetype := mime.TypeByExtension(filepath.Ext(filename)); mimetype != "" {
+		return mimetype
+	}
+	return http.DetectContentType(head)
+}
+
+// resolveMimetypes sets the mimetype of the ingested artifacts from their extension,
+// keeping the ones already sniffed during a previous download.
+func (svc *service) resolveMimetypes(batch *yolopb.Batch) {
+	ids := []string{}
+	for _, artifact := range batch.Artifacts {
+		if artifact.MimeType == "" {
+			artifact.MimeType = mimetypeByPath(artifact.LocalPath)
+		}
+		if needsMimeSniffing(artifact.LocalPath, artifact.MimeType) {
+			ids = append(ids, artifact.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return
+	}
+
+	stored, err := svc.store.GetArtifactMimeTypes(ids)
+	if err != nil {
+		svc.logger.Warn("failed to get stored mimetypes", zap.Error(err))
+		return
+	}
+	for _, artifact := range batch.Artifacts {
+		mimetype, found := stored[artifact.ID]
+		if found && !needsMimeSniffing(artifact.LocalPath, mimetype) {
+			artifact.MimeType = mimetype
+		}
+	}
+}
+
+// saveSniffedMimetype stores the mimetype detected while downloading an artifact, so it is only sniffed once
+func (svc *service) saveSniffedMimetype(artifact *yolopb.Artifact, mimetype string) {
+	if mimetype == "" || mimetype == artifact.MimeType {
+		return
+	}
+	if err := svc.store.SetArtifactMimeType(artifact.ID, mimetype); err != nil {
+		svc.logger.Warn("failed to save sniffed mimetype", zap.String("artifact", artifact.ID), zap.Error(err))
+		return
+	}
+	artifact.MimeType = mimetype
+}
+
+// sniffingResponseWriter holds the first bytes of the response to set its Content-Type,
+// they are then written before the rest of the stream.
+type sniffingResponseWriter struct {
+	http.ResponseWriter
+	filename string
+	limit    int
+	head     []byte
+	sniffed  bool
+}
+
+func newSniffingResponseWriter(w http.ResponseWriter, filename string, limit int) *sniffingResponseWriter {
+	if limit <= 0 {
+		limit = defaultMimeSniffLimit
+	}
+	return &sniffingResponseWriter{
+		ResponseWriter: w,
+		filename:       filename,
+		limit:          limit,
+		head:           make([]byte, 0, limit),
+	}
+}
+
+func (w *sniffingResponseWriter) Write(p []byte) (int, error) {
+	if w.sniffed {
+		return w.ResponseWriter.Write(p)
+	}
+	n := w.limit - len(w.head)
+	if n > len(p) {
+		n = len(p)
+	}
+	w.head = append(w.head, p[:n]...)
+	if len(w.head) < w.limit {
+		return len(p), nil
+	}
+	if err := w.flushHead(); err != nil {
+		return 0, err
+	}
+	if n == len(p) {
+		return n, nil
+	}
+	written, err := w.ResponseWriter.Write(p[n:])
+	return n + written, err
+}
+
+// flushHead sets the Content-Type and writes the sniffed bytes, it should be called once the stream is complete
+func (w *sniffingResponseWriter) flushHead() error {
+	if w.sniffed {
+		return nil
+	}
+	w.sniffed = true
+	if w.Header().Get("Content-Type") == "" {
+		w.Header().Set("Content-Type", detectMimetype(w.filename, w.head))
+	}
+	_, err := w.ResponseWriter.Write(w.head)
+	w.head = nil
+	return err
+}
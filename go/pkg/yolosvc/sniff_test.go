@@ -0,0 +1,85 @@
+package yolosvc
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"net/http/httptest"
+	"testing"
+
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendFileSniffedMimetype(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t), MimeSniffLimit: 64})
+	defer cleanup()
+	svc := api.(*service)
+
+	random := make([]byte, 1000)
+	rand.New(rand.NewSource(42)).Read(random)
+	pdf := append([]byte("%PDF-1.4\n"), random...)
+
+	tests := []struct {
+		name     string
+		filename string
+		mimetype string
+		upstream []byte
+		chunk    int
+		expected string
+	}{
+		{"pdf", "artifact", "", pdf, 7, "application/pdf"},
+		{"pdf-single-write", "artifact", "application/octet-stream", pdf, len(pdf), "application/pdf"},
+		{"shorter-than-limit", "artifact", "", []byte("hello world"), 3, "text/plain; charset=utf-8"},
+		{"empty", "artifact", "", nil, 1, "text/plain; charset=utf-8"},
+		{"known-kind", "Berty.apk", "", pdf, 100, "application/vnd.android.package-archive"},
+		{"trusted", "Berty.ipa", "application/octet-stream", pdf, 100, "application/octet-stream"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			err := svc.sendFileMayCache(tt.filename, tt.name, tt.mimetype, 0, w, func(w io.Writer) error {
+				// stream the upstream content in small chunks, like a provider would
+				for rest := tt.upstream; len(rest) > 0; {
+					n := tt.chunk
+					if n > len(rest) {
+						n = len(rest)
+					}
+					if _, err := w.Write(rest[:n]); err != nil {
+						return err
+					}
+					rest = rest[n:]
+				}
+				return nil
+			})
+			require.NoError(t, err)
+			assert.True(t, bytes.Equal(tt.upstream, w.Body.Bytes()), "downloaded content differs from upstream")
+			assert.Equal(t, tt.expected, w.Header().Get("Content-Type"))
+		})
+	}
+}
+
+func TestResolveMimetypes(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	svc := api.(*service)
+
+	// artif1 was sniffed during a previous download
+	require.NoError(t, svc.store.SetArtifactMimeType("artif1", "application/pdf"))
+
+	batch := &yolopb.Batch{Artifacts: []*yolopb.Artifact{
+		{ID: "artif1", LocalPath: "js/packages/bla", MimeType: "application/octet-stream"},
+		{ID: "artif2", LocalPath: "Berty.apk"},
+		{ID: "artif3", LocalPath: "notes"},
+	}}
+	svc.resolveMimetypes(batch)
+	assert.Equal(t, "application/pdf", batch.Artifacts[0].MimeType)
+	assert.Equal(t, "application/vnd.android.package-archive", batch.Artifacts[1].MimeType)
+	assert.Equal(t, "application/octet-stream", batch.Artifacts[2].MimeType)
+
+	mimetypes, err := svc.store.GetArtifactMimeTypes([]string{"artif1", "unknown"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"artif1": "application/pdf"}, mimetypes)
+}
@@ -0,0 +1,69 @@
+package yolosvc
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/base64"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// checkStaff ensures the caller authenticated with the staff password (basic auth).
+// without a configured staff password, staff methods are only available in dev mode.
+func (svc *service) checkStaff(ctx context.Context) error {
+	if svc.staffPassword == "" {
+		if svc.devMode {
+			return nil
+		}
+		return status.Error(codes.PermissionDenied, "Permission Denied")
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, value := range md.Get("authorization") {
+		if isStaffAuthorization(value, svc.staffPassword) {
+			return nil
+		}
+	}
+	return status.Error(codes.PermissionDenied, "Permission Denied")
+}
+
+// isStaff reports whether the caller would pass checkStaff
+func (svc *service) isStaff(ctx context.Context) bool {
+	return svc.checkStaff(ctx) == nil
+}
+
+// isStaffRequest is like isStaff, for the HTTP handlers
+func (svc *service) isStaffRequest(r *http.Request) bool {
+	if svc.staffPassword == "" {
+		return svc.devMode
+	}
+	return isStaffAuthorization(r.Header.Get("Authorization"), svc.staffPassword)
+}
+
+// incomingAuthorization returns the authorization header of a gRPC call, forwarded by the gateway
+func incomingAuthorization(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get("authorization"); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func isStaffAuthorization(header, staffPassword string) bool {
+	const prefix = "Basic "
+	if !strings.HasPrefix(header, prefix) {
+		return false
+	}
+	decoded, err := base64.StdEncoding.DecodeString(header[len(prefix):])
+	if err != nil {
+		return false
+	}
+	parts := strings.SplitN(string(decoded), ":", 2)
+	if len(parts) != 2 {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(parts[1]), []byte(staffPassword)) == 1
+}
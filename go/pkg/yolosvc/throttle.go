@@ -0,0 +1,147 @@
+package yolosvc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+)
+
+// ParseRateLimitOverrides parses a comma-separated list of "token=bytes-per-second" download rate limits,
+// a zero rate disables the limit for the token
+func ParseRateLimitOverrides(input string) (map[string]int64, error) {
+	overrides := map[string]int64{}
+	for _, def := range strings.Split(input, ",") {
+		def = strings.TrimSpace(def)
+		if def == "" {
+			continue
+		}
+		idx := strings.LastIndex(def, "=")
+		if idx < 1 {
+			return nil, fmt.Errorf("invalid rate limit override: expected token=bytes-per-second")
+		}
+		rate, err := strconv.ParseInt(def[idx+1:], 10, 64)
+		if err != nil || rate < 0 {
+			return nil, fmt.Errorf("invalid rate limit override: %q is not a valid rate", def[idx+1:])
+		}
+		overrides[def[:idx]] = rate
+	}
+	return overrides, nil
+}
+
+// requestDownloadRate returns the bandwidth cap in bytes per second of the request, 0 means unlimited.
+// the signed URLs carry the override of the caller they were generated for, see addDownloadRate.
+func (svc *service) requestDownloadRate(r *http.Request) int64 {
+	if param := r.URL.Query().Get("rate"); param != "" && validSignature(r, svc.authSalts) {
+		if rate, err := strconv.ParseInt(param, 10, 64); err == nil && rate >= 0 {
+			return rate
+		}
+	}
+	if rate, found := svc.downloadRateOverride(r.Header.Get("Authorization")); found {
+		return rate
+	}
+	return svc.downloadRateLimit
+}
+
+// downloadRateOverride returns the download rate override of the token of a basic authorization header
+func (svc *service) downloadRateOverride(authorization string) (int64, bool) {
+	r := http.Request{Header: http.Header{"Authorization": []string{authorization}}}
+	_, token, ok := r.BasicAuth()
+	if !ok {
+		return 0, false
+	}
+	rate, found := svc.downloadRateOverrides[token]
+	return rate, found
+}
+
+// addDownloadRate signs the download rate override of the caller into the download URLs of artifacts,
+// the override applies to the signed URL downloads without basic auth
+func (svc *service) addDownloadRate(artifacts []*yolopb.Artifact, authorization string) error {
+	rate, found := svc.downloadRateOverride(authorization)
+	if !found {
+		return nil
+	}
+	for _, artifact := range artifacts {
+		if err := artifact.AddSignedDownloadRate(svc.authSalt, rate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rateLimiter paces a stream to an average amount of bytes per second
+type rateLimiter struct {
+	rate  int64
+	start time.Time
+	total int64
+}
+
+// wait paces the stream after n more bytes, it returns early with the error of ctx if it is done
+func (l *rateLimiter) wait(ctx context.Context, n int) error {
+	if l.start.IsZero() {
+		l.start = time.Now()
+	}
+	l.total += int64(n)
+	expected := time.Duration(float64(l.total) / float64(l.rate) * float64(time.Second))
+	if delay := expected - time.Since(l.start); delay > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+	return nil
+}
+
+// chunkSize keeps the reads small enough for the stream to stay smooth
+func (l *rateLimiter) chunkSize() int {
+	if size := l.rate / 10; size > 0 {
+		return int(size)
+	}
+	return 1
+}
+
+// throttledReader is a rate-limited io.Reader, interrupted when ctx is done
+type throttledReader struct {
+	io.Reader
+	ctx     context.Context
+	limiter *rateLimiter
+}
+
+func (r *throttledReader) Read(p []byte) (int, error) {
+	if size := r.limiter.chunkSize(); len(p) > size {
+		p = p[:size]
+	}
+	n, err := r.Reader.Read(p)
+	if waitErr := r.limiter.wait(r.ctx, n); waitErr != nil && err == nil {
+		err = waitErr
+	}
+	return n, err
+}
+
+// throttledResponseWriter caps the bandwidth of a response by reading everything written to it through a throttledReader,
+// the writes are interrupted when the client is gone
+type throttledResponseWriter struct {
+	http.ResponseWriter
+	ctx     context.Context
+	limiter *rateLimiter
+}
+
+func newThrottledResponseWriter(ctx context.Context, w http.ResponseWriter, rate int64) *throttledResponseWriter {
+	return &throttledResponseWriter{ResponseWriter: w, ctx: ctx, limiter: &rateLimiter{rate: rate}}
+}
+
+func (w *throttledResponseWriter) ReadFrom(src io.Reader) (int64, error) {
+	return io.Copy(writerOnly{w.ResponseWriter}, &throttledReader{Reader: src, ctx: w.ctx, limiter: w.limiter})
+}
+
+func (w *throttledResponseWriter) Write(p []byte) (int, error) {
+	n, err := w.ReadFrom(bytes.NewReader(p))
+	return int(n), err
+}
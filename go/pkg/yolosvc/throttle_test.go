@@ -0,0 +1,89 @@
+package yolosvc
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestParseRateLimitOverrides(t *testing.T) {
+	overrides, err := ParseRateLimitOverrides("ci-token=0, fast=1000000,")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"ci-token": 0, "fast": 1000000}, overrides)
+
+	for _, input := range []string{"=10", "token", "token=-1", "token=fast"} {
+		_, err := ParseRateLimitOverrides(input)
+		assert.Error(t, err, input)
+	}
+}
+
+func TestThrottledResponseWriterContext(t *testing.T) {
+	// a byte per second, the 10 bytes would take 10s
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	rec := httptest.NewRecorder()
+	w := newThrottledResponseWriter(ctx, rec, 1)
+
+	start := time.Now()
+	_, err := w.ReadFrom(bytes.NewReader([]byte("0123456789")))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 5*time.Second)
+	assert.Less(t, rec.Body.Len(), 10)
+
+	// unthrottled enough to complete
+	rec = httptest.NewRecorder()
+	w = newThrottledResponseWriter(context.Background(), rec, 1000)
+	n, err := io.Copy(w, strings.NewReader("0123456789"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), n)
+	assert.Equal(t, "0123456789", rec.Body.String())
+}
+
+func TestRequestDownloadRate(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{
+		Logger:                testutil.Logger(t),
+		DownloadRateLimit:     100,
+		DownloadRateOverrides: map[string]int64{"bot-token": 5000},
+	})
+	defer cleanup()
+	svc := api.(*service)
+
+	authorization := "Basic " + base64.StdEncoding.EncodeToString([]byte("bot:bot-token"))
+	downloadURL := func(ctx context.Context) string {
+		resp, err := svc.BuildList(ctx, &yolopb.BuildList_Request{})
+		require.NoError(t, err)
+		require.Len(t, resp.Builds, 1)
+		require.Len(t, resp.Builds[0].HasArtifacts, 1)
+		return resp.Builds[0].HasArtifacts[0].DLArtifactSignedURL
+	}
+	rate := func(path, authorization string) int64 {
+		r := httptest.NewRequest("GET", path, nil)
+		if authorization != "" {
+			r.Header.Set("Authorization", authorization)
+		}
+		return svc.requestDownloadRate(r)
+	}
+
+	// the signed URLs carry the override of the caller they were generated for
+	bot := downloadURL(metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", authorization)))
+	assert.Contains(t, bot, "rate=5000")
+	assert.Equal(t, int64(5000), rate(bot, ""))
+	assert.Equal(t, int64(100), rate(strings.Replace(bot, "rate=5000", "rate=0", 1), ""))
+
+	anonymous := downloadURL(context.Background())
+	assert.NotContains(t, anonymous, "rate=")
+	assert.Equal(t, int64(100), rate(anonymous, ""))
+	assert.Equal(t, int64(5000), rate(anonymous, authorization))
+	assert.Equal(t, int64(100), rate(anonymous+"&rate=0", ""))
+}
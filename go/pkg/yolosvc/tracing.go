@@ -0,0 +1,177 @@
+package yolosvc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	defaultTracingServiceName = "yolo"
+	tracerName                = "berty.tech/yolo/v2/go/pkg/yolosvc"
+)
+
+// tracePropagator reads and writes the W3C trace context headers (traceparent and tracestate)
+var tracePropagator = propagation.TraceContext{}
+
+// newTracerProvider returns the provider exporting the spans to the OTLP collector, nil if the tracing is disabled
+func newTracerProvider(opts ServiceOpts) (*sdktrace.TracerProvider, error) {
+	exporter := opts.TracingExporter
+	if exporter == nil {
+		if opts.TracingEndpoint == "" {
+			return nil, nil
+		}
+		clientOpts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(opts.TracingEndpoint)}
+		if opts.TracingInsecure {
+			clientOpts = append(clientOpts, otlptracegrpc.WithInsecure())
+		}
+		// the connection is established in the background, an unreachable collector doesn't prevent the startup
+		var err error
+		exporter, err = otlptracegrpc.New(context.Background(), clientOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("create OTLP exporter: %w", err)
+		}
+	}
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(opts.TracingServiceName))),
+	), nil
+}
+
+// tracerFromProvider returns the tracer of the service spans, a no-op one if the tracing is disabled
+func tracerFromProvider(provider *sdktrace.TracerProvider) trace.Tracer {
+	if provider == nil {
+		return trace.NewNoopTracerProvider().Tracer(tracerName)
+	}
+	return provider.Tracer(tracerName)
+}
+
+// Shutdown flushes the pending spans and stops their exporter
+func (svc *service) Shutdown(ctx context.Context) error {
+	if svc.tracerProvider == nil {
+		return nil
+	}
+	return svc.tracerProvider.Shutdown(ctx)
+}
+
+// endSpan records the error of a span, if any, and ends it
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// traceFetch runs a driver fetch in a span recording the numbers of fetched objects
+func (svc *service) traceFetch(ctx context.Context, driver yolopb.Driver, fetch func(ctx context.Context) (*yolopb.Batch, error), attrs ...attribute.KeyValue) (*yolopb.Batch, error) {
+	ctx, span := svc.tracer.Start(ctx, "fetch "+driver.String(), trace.WithAttributes(append(attrs, attribute.String("yolo.driver", driver.String()))...))
+	batch, err := fetch(ctx)
+	if batch != nil {
+		span.SetAttributes(batchAttributes(batch)...)
+	}
+	endSpan(span, err)
+	return batch, err
+}
+
+// batchAttributes returns the numbers of objects of a batch
+func batchAttributes(batch *yolopb.Batch) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.Int("yolo.builds", len(batch.Builds)),
+		attribute.Int("yolo.artifacts", len(batch.Artifacts)),
+		attribute.Int("yolo.projects", len(batch.Projects)),
+		attribute.Int("yolo.entities", len(batch.Entities)),
+		attribute.Int("yolo.commits", len(batch.Commits)),
+		attribute.Int("yolo.merge_requests", len(batch.MergeRequests)),
+	}
+}
+
+// buildsArtifactsCount returns the number of artifacts of a list of builds
+func buildsArtifactsCount(builds []*yolopb.Build) int {
+	count := 0
+	for _, build := range builds {
+		count += len(build.HasArtifacts)
+	}
+	return count
+}
+
+// injectTraceContext propagates the trace of a request context to the upstream it is sent to
+func injectTraceContext(req *http.Request) {
+	tracePropagator.Inject(req.Context(), propagation.HeaderCarrier(req.Header))
+}
+
+// extractTraceContext continues the traces of the incoming HTTP requests
+func extractTraceContext(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := tracePropagator.Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// metadataCarrier reads the trace context of the gRPC metadata
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	if values := metadata.MD(c).Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func (c metadataCarrier) Set(key, value string) { metadata.MD(c).Set(key, value) }
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func incomingTraceContext(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	return tracePropagator.Extract(ctx, metadataCarrier(md))
+}
+
+// unaryTraceContextInterceptor continues the traces of the gRPC calls, i.e, forwarded by the gateway
+func unaryTraceContextInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	return handler(incomingTraceContext(ctx), req)
+}
+
+// streamTraceContextInterceptor continues the traces of the gRPC streams
+func streamTraceContextInterceptor(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &tracedServerStream{ServerStream: ss, ctx: incomingTraceContext(ss.Context())})
+}
+
+type tracedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *tracedServerStream) Context() context.Context { return s.ctx }
+
+// gatewayHeaderMatcher forwards the trace context headers to the gRPC server, in addition to the default ones
+func gatewayHeaderMatcher(key string) (string, bool) {
+	for _, field := range tracePropagator.Fields() {
+		if http.CanonicalHeaderKey(key) == http.CanonicalHeaderKey(field) {
+			return field, true
+		}
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
@@ -0,0 +1,152 @@
+package yolosvc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestTracing(t *testing.T) {
+	cachePath := t.TempDir()
+	exporter := tracetest.NewInMemoryExporter()
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t), ArtifactsCachePath: cachePath, TracingExporter: exporter})
+	defer cleanup()
+	svc := api.(*service)
+	ctx := context.Background()
+	require.NoError(t, os.WriteFile(filepath.Join(cachePath, "artif1"), []byte("hello"), 0o600))
+
+	// spans returns the ended spans by name, and resets the exporter
+	spans := func() map[string]tracetest.SpanStub {
+		require.NoError(t, svc.tracerProvider.ForceFlush(ctx))
+		byName := map[string]tracetest.SpanStub{}
+		for _, span := range exporter.GetSpans() {
+			byName[span.Name] = span
+		}
+		exporter.Reset()
+		return byName
+	}
+	attrs := func(span tracetest.SpanStub) map[attribute.Key]attribute.Value {
+		values := map[attribute.Key]attribute.Value{}
+		for _, attr := range span.Attributes {
+			values[attr.Key] = attr.Value
+		}
+		return values
+	}
+
+	// API
+	_, err := svc.BuildList(ctx, &yolopb.BuildList_Request{})
+	require.NoError(t, err)
+	got := spans()
+	require.Contains(t, got, "BuildList")
+	require.Contains(t, got, "store.GetBuildList")
+	assert.Equal(t, got["BuildList"].SpanContext.SpanID(), got["store.GetBuildList"].Parent.SpanID())
+	assert.Equal(t, int64(1), attrs(got["BuildList"])["yolo.builds"].AsInt64())
+	assert.Equal(t, int64(1), attrs(got["BuildList"])["yolo.artifacts"].AsInt64())
+
+	_, err = svc.GetBuild(ctx, &yolopb.GetBuild_Request{BuildID: "https://buildkite.com/berty/berty/builds/2738"})
+	require.NoError(t, err)
+	got = spans()
+	require.Contains(t, got, "GetBuild")
+	assert.Equal(t, "Buildkite", attrs(got["GetBuild"])["yolo.driver"].AsString())
+	assert.Equal(t, codes.Unset, got["GetBuild"].Status.Code)
+
+	_, err = svc.GetBuild(ctx, &yolopb.GetBuild_Request{BuildID: "unknown"})
+	require.Error(t, err)
+	assert.Equal(t, codes.Error, spans()["GetBuild"].Status.Code)
+
+	// downloads, continuing the trace of the request
+	parent := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x01},
+		SpanID:     trace.SpanID{0x02},
+		TraceFlags: trace.FlagsSampled,
+	})
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("artifactID", "artif1")
+	r := httptest.NewRequest("GET", "/", nil)
+	r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+	traceparent := "00-" + parent.TraceID().String() + "-" + parent.SpanID().String() + "-01"
+	r.Header.Set("traceparent", traceparent)
+	w := httptest.NewRecorder()
+	extractTraceContext(http.HandlerFunc(svc.ArtifactDownloader)).ServeHTTP(w, r)
+	require.Equal(t, http.StatusOK, w.Code)
+	download := spans()["ArtifactDownloader"]
+	assert.Equal(t, parent.TraceID(), download.SpanContext.TraceID())
+	assert.Equal(t, parent.SpanID(), download.Parent.SpanID())
+	assert.Equal(t, "artif1", attrs(download)["yolo.artifact_id"].AsString())
+	assert.Equal(t, "Buildkite", attrs(download)["yolo.driver"].AsString())
+	assert.Equal(t, int64(5), attrs(download)["yolo.bytes"].AsInt64())
+
+	// the upstream fetch of an uncached artifact is a child span, its trace is propagated to the provider
+	upstreamTrace := make(chan string, 1)
+	provider := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upstreamTrace <- r.Header.Get("traceparent")
+		_, _ = w.Write([]byte("apk content"))
+	}))
+	defer provider.Close()
+	require.NoError(t, svc.store.SaveArtifact(&yolopb.Artifact{ID: "proxied", LocalPath: "app.apk", Driver: yolopb.Driver_HTTP, DownloadURL: provider.URL, HasBuildID: "https://buildkite.com/berty/berty/builds/2738"}))
+	rctx = chi.NewRouteContext()
+	rctx.URLParams.Add("artifactID", "proxied")
+	r = httptest.NewRequest("GET", "/", nil)
+	r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+	w = httptest.NewRecorder()
+	svc.ArtifactDownloader(w, r)
+	require.Equal(t, http.StatusOK, w.Code)
+	got = spans()
+	require.Contains(t, got, "download HTTP")
+	fetched := got["download HTTP"]
+	assert.Equal(t, got["ArtifactDownloader"].SpanContext.SpanID(), fetched.Parent.SpanID())
+	assert.Equal(t, "proxied", attrs(fetched)["yolo.artifact_id"].AsString())
+	assert.Contains(t, <-upstreamTrace, fetched.SpanContext.TraceID().String())
+
+	// gRPC metadata
+	md := metadata.Pairs("traceparent", traceparent)
+	assert.Equal(t, parent.TraceID(), trace.SpanContextFromContext(incomingTraceContext(metadata.NewIncomingContext(ctx, md))).TraceID())
+	key, ok := gatewayHeaderMatcher("Traceparent")
+	assert.True(t, ok)
+	assert.Equal(t, "traceparent", key)
+
+	// driver fetches
+	_, err = svc.traceFetch(ctx, yolopb.Driver_Jenkins, func(ctx context.Context) (*yolopb.Batch, error) {
+		return &yolopb.Batch{Builds: []*yolopb.Build{{}, {}}, Artifacts: []*yolopb.Artifact{{}}}, nil
+	}, attribute.String("yolo.job", "berty/android"))
+	require.NoError(t, err)
+	fetch := spans()["fetch Jenkins"]
+	assert.Equal(t, "Jenkins", attrs(fetch)["yolo.driver"].AsString())
+	assert.Equal(t, "berty/android", attrs(fetch)["yolo.job"].AsString())
+	assert.Equal(t, int64(2), attrs(fetch)["yolo.builds"].AsInt64())
+	assert.Equal(t, int64(1), attrs(fetch)["yolo.artifacts"].AsInt64())
+
+	_, err = svc.traceFetch(ctx, yolopb.Driver_Jenkins, func(ctx context.Context) (*yolopb.Batch, error) {
+		return nil, errors.New("unreachable")
+	})
+	require.Error(t, err)
+	assert.Equal(t, codes.Error, spans()["fetch Jenkins"].Status.Code)
+
+	require.NoError(t, svc.Shutdown(ctx))
+}
+
+func TestTracingDisabled(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	svc := api.(*service)
+
+	assert.Nil(t, svc.tracerProvider)
+	_, err := svc.BuildList(context.Background(), &yolopb.BuildList_Request{})
+	require.NoError(t, err)
+	require.NoError(t, svc.Shutdown(context.Background()))
+}
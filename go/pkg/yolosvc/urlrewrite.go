@@ -0,0 +1,70 @@
+package yolosvc
+
+import (
+	"fmt"
+	"strings"
+
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+)
+
+// URLRewrite replaces the Match prefix of the artifact download URLs with Replacement,
+// i.e, to map an internal hostname returned by a driver to a reachable one
+type URLRewrite struct {
+	// Driver restricts the rule to the artifacts of a driver, all drivers if unset
+	Driver      yolopb.Driver
+	Match       string
+	Replacement string
+}
+
+// ParseURLRewrites parses a comma-separated list of "[driver|]match=>replacement" rules
+func ParseURLRewrites(input string) ([]URLRewrite, error) {
+	var rewrites []URLRewrite
+	for _, def := range strings.Split(input, ",") {
+		def = strings.TrimSpace(def)
+		if def == "" {
+			continue
+		}
+		rewrite := URLRewrite{}
+		if idx := strings.Index(def, "|"); idx != -1 {
+			driver, err := parseDriver(def[:idx])
+			if err != nil {
+				return nil, err
+			}
+			rewrite.Driver = driver
+			def = def[idx+1:]
+		}
+		parts := strings.SplitN(def, "=>", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid URL rewrite: %q, expected [driver|]match=>replacement", def)
+		}
+		rewrite.Match, rewrite.Replacement = parts[0], parts[1]
+		rewrites = append(rewrites, rewrite)
+	}
+	return rewrites, nil
+}
+
+func parseDriver(name string) (yolopb.Driver, error) {
+	for key, value := range yolopb.Driver_value {
+		if strings.EqualFold(key, name) && value != int32(yolopb.Driver_UnknownDriver) {
+			return yolopb.Driver(value), nil
+		}
+	}
+	return yolopb.Driver_UnknownDriver, fmt.Errorf("unknown driver: %q", name)
+}
+
+// rewriteDownloadURL applies the first matching rewrite rule to the download URL of the artifact
+func (svc *service) rewriteDownloadURL(artifact *yolopb.Artifact) string {
+	return rewriteURL(svc.urlRewrites, artifact.Driver, artifact.DownloadURL)
+}
+
+func rewriteURL(rewrites []URLRewrite, driver yolopb.Driver, input string) string {
+	for _, rewrite := range rewrites {
+		if rewrite.Driver != yolopb.Driver_UnknownDriver && rewrite.Driver != driver {
+			continue
+		}
+		if strings.HasPrefix(input, rewrite.Match) {
+			return rewrite.Replacement + strings.TrimPrefix(input, rewrite.Match)
+		}
+	}
+	return input
+}
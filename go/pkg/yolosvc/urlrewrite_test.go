@@ -0,0 +1,39 @@
+package yolosvc
+
+import (
+	"testing"
+
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestURLRewrites(t *testing.T) {
+	rewrites, err := ParseURLRewrites("buildkite|http://s3.internal:9000/=>https://s3.example.com/, http://ci.lan/=>https://ci.example.com/")
+	require.NoError(t, err)
+	require.Len(t, rewrites, 2)
+	assert.Equal(t, yolopb.Driver_Buildkite, rewrites[0].Driver)
+	assert.Equal(t, yolopb.Driver_UnknownDriver, rewrites[1].Driver)
+
+	tests := []struct {
+		name     string
+		driver   yolopb.Driver
+		input    string
+		expected string
+	}{
+		{"buildkite", yolopb.Driver_Buildkite, "http://s3.internal:9000/bucket/a.ipa?sig=1", "https://s3.example.com/bucket/a.ipa?sig=1"},
+		{"other-driver", yolopb.Driver_Bintray, "http://s3.internal:9000/bucket/a.ipa", "http://s3.internal:9000/bucket/a.ipa"},
+		{"any-driver", yolopb.Driver_Bintray, "http://ci.lan/a.apk", "https://ci.example.com/a.apk"},
+		{"no-match", yolopb.Driver_Buildkite, "https://api.buildkite.com/a.ipa", "https://api.buildkite.com/a.ipa"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, rewriteURL(rewrites, tt.driver, tt.input))
+		})
+	}
+
+	_, err = ParseURLRewrites("travis|http://a=>http://b")
+	assert.Error(t, err)
+	_, err = ParseURLRewrites("http://a")
+	assert.Error(t, err)
+}
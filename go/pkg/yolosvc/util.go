@@ -2,18 +2,31 @@ package yolosvc
 
 import (
 	"archive/zip"
+	"bytes"
 	"crypto/md5"
 	"encoding/hex"
 	"fmt"
 	"io"
+	"math/rand"
 	"path/filepath"
 	"regexp"
+	"strings"
+	"time"
 
 	"berty.tech/yolo/v2/go/pkg/yolopb"
 )
 
 var githubMasterMerge = regexp.MustCompile(`Merge pull request #([0-9]+) from (.*)`)
 
+// refreshJitter is the maximum deviation of the refresh intervals, so the instances started together don't poll the CI providers at once
+const refreshJitter = 0.1
+
+// withJitter randomly shortens or lengthens an interval by up to refreshJitter
+func withJitter(interval time.Duration) time.Duration {
+	delta := (rand.Float64()*2 - 1) * refreshJitter * float64(interval)
+	return interval + time.Duration(delta)
+}
+
 func artifactKindByPath(path string) yolopb.Artifact_Kind {
 	switch filepath.Ext(path) {
 	case ".ipa", ".unsigned-ipa", ".dummy-signed-ipa":
@@ -22,10 +35,51 @@ func artifactKindByPath(path string) yolopb.Artifact_Kind {
 		return yolopb.Artifact_DMG
 	case ".apk":
 		return yolopb.Artifact_APK
+	case ".exe":
+		return yolopb.Artifact_EXE
+	case ".msi":
+		return yolopb.Artifact_MSI
 	}
 	return yolopb.Artifact_UnknownKind
 }
 
+// artifactVariantByPath extracts the device model family from paths like "Berty@iPhone10.ipa".
+// an empty string means the artifact is universal.
+func artifactVariantByPath(path string) string {
+	base := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	idx := strings.LastIndex(base, "@")
+	if idx == -1 {
+		return ""
+	}
+	return base[idx+1:]
+}
+
+const (
+	archARM64     = "arm64"
+	archAMD64     = "amd64"
+	archUniversal = "universal"
+)
+
+// artifactArchByPath extracts the CPU architecture of the macOS and Windows artifacts from paths like "Berty-arm64.dmg", "Berty_x86_64.dmg"
+// or "Berty-Setup-x64.exe". an empty string means the architecture is unknown.
+func artifactArchByPath(path string) string {
+	switch artifactKindByPath(path) {
+	case yolopb.Artifact_DMG, yolopb.Artifact_EXE, yolopb.Artifact_MSI:
+	default:
+		return ""
+	}
+	base := strings.ToLower(strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)))
+	switch {
+	case strings.Contains(base, "universal"):
+		return archUniversal
+	case strings.Contains(base, "x86_64"), strings.Contains(base, "amd64"), strings.Contains(base, "intel"), strings.Contains(base, "x64"):
+		return archAMD64
+	case strings.Contains(base, "arm64"), strings.Contains(base, "aarch64"), strings.Contains(base, "apple-silicon"):
+		return archARM64
+	}
+	return ""
+}
+
 func mimetypeByPath(path string) string {
 	switch filepath.Ext(path) {
 	case ".ipa", ".unsigned-ipa", ".dummy-signed-ipa":
@@ -34,6 +88,10 @@ func mimetypeByPath(path string) string {
 		return "application/vnd.android.package-archive"
 	case ".dmg", ".unsigned-dmg", ".dummy-signed-dmg":
 		return "application/x-apple-diskimage"
+	case ".exe":
+		return "application/vnd.microsoft.portable-executable"
+	case ".msi":
+		return "application/x-msi"
 	case ".jar":
 		return "application/java-archive"
 	case ".txt":
@@ -64,12 +122,43 @@ func guessMissingBuildInfo(build *yolopb.Build) {
 			build.HasMergerequestID = fmt.Sprintf("%s/pull/%s", build.HasProjectID, pr)
 		}
 	}
+	// the tag pushes are built with the tag as branch, i.e, on GitHub and Buildkite
+	if build.VCSTag == "" && yolopb.VersionSortKey(build.Branch) != "" {
+		build.VCSTag = build.Branch
+	}
 	if build.VCSTagURL == "" && build.VCSTag != "" && build.HasProjectID != "" {
 		// FIXME: check if the build.project.driver is GitHub
 		build.VCSTagURL = fmt.Sprintf("%s/tree/%s", build.HasProjectID, build.VCSTag)
 	}
 }
 
+// copyArchiveFile sends the content of an artifact archive (.zip) with a single file, i.e, the GitHub and Azure Pipelines artifacts
+func copyArchiveFile(w io.Writer, zipContent []byte) error {
+	zipReader, err := zip.NewReader(bytes.NewReader(zipContent), int64(len(zipContent)))
+	if err != nil {
+		return fmt.Errorf("failed to open artifact archive: %w", err)
+	}
+	// the Azure Pipelines archives have the file in a directory named like the artifact
+	files := []*zip.File{}
+	for _, f := range zipReader.File {
+		if !f.FileInfo().IsDir() {
+			files = append(files, f)
+		}
+	}
+	if len(files) != 1 {
+		return fmt.Errorf("artifact archive should only have 1 file")
+	}
+	fd, err := files[0].Open()
+	if err != nil {
+		return fmt.Errorf("failed to read file from archive: %w", err)
+	}
+	defer fd.Close()
+	if _, err := io.Copy(w, fd); err != nil {
+		return fmt.Errorf("io error while sending content of the artifact: %w", err)
+	}
+	return nil
+}
+
 func readZipFile(zf *zip.File) ([]byte, error) {
 	f, err := zf.Open()
 	if err != nil {
@@ -78,3 +167,21 @@ func readZipFile(zf *zip.File) ([]byte, error) {
 	defer f.Close()
 	return io.ReadAll(f)
 }
+
+// buildConfigFromEnv returns the allow-listed entries of a build environment
+func buildConfigFromEnv(env map[string]string, keys []string) map[string]string {
+	var config map[string]string
+	for _, key := range keys {
+		key = strings.TrimSpace(key)
+		if key == "" {
+			continue
+		}
+		if value, found := env[key]; found {
+			if config == nil {
+				config = map[string]string{}
+			}
+			config[key] = value
+		}
+	}
+	return config
+}
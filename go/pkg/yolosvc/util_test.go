@@ -0,0 +1,40 @@
+package yolosvc
+
+import (
+	"testing"
+	"time"
+
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithJitter(t *testing.T) {
+	const interval = 10 * time.Second
+	spread := map[bool]bool{}
+	for i := 0; i < 100; i++ {
+		jittered := withJitter(interval)
+		assert.GreaterOrEqual(t, int64(jittered), int64(9*time.Second))
+		assert.LessOrEqual(t, int64(jittered), int64(11*time.Second))
+		spread[jittered > interval] = true
+	}
+	assert.Len(t, spread, 2, "the intervals should be shortened and lengthened")
+	assert.Equal(t, time.Duration(0), withJitter(0))
+}
+
+func TestGuessMissingBuildInfoTag(t *testing.T) {
+	build := yolopb.Build{Branch: "v1.2.3", HasProjectID: "https://github.com/berty/berty"}
+	guessMissingBuildInfo(&build)
+	assert.Equal(t, "v1.2.3", build.VCSTag)
+	assert.Equal(t, "https://github.com/berty/berty/tree/v1.2.3", build.VCSTagURL)
+
+	build = yolopb.Build{Branch: "main"}
+	guessMissingBuildInfo(&build)
+	assert.Empty(t, build.VCSTag)
+}
+
+func TestBuildConfigFromEnv(t *testing.T) {
+	env := map[string]string{"API_ENV": "production", "FLAVOR": "", "SECRET_TOKEN": "secret"}
+	assert.Equal(t, map[string]string{"API_ENV": "production", "FLAVOR": ""}, buildConfigFromEnv(env, []string{" API_ENV", "FLAVOR", "MISSING", ""}))
+	assert.Nil(t, buildConfigFromEnv(env, []string{"MISSING"}))
+	assert.Nil(t, buildConfigFromEnv(env, nil))
+}
@@ -0,0 +1,158 @@
+package yolosvc
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/google/go-github/v32/github"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+// refreshedDrivers are the drivers whose workers can be woken up by a webhook
+var refreshedDrivers = []yolopb.Driver{yolopb.Driver_GitHub, yolopb.Driver_CircleCI, yolopb.Driver_Buildkite}
+
+// refreshRequest asks a driver worker to refresh a project ("owner/repo"), or all of them if empty
+type refreshRequest struct {
+	project  string
+	done     chan struct{} // closed once the refresh is saved, if set
+	received func()        // called when the worker picks the request, if set
+}
+
+// receive is called by the worker when it picks the request, before refreshing
+func (r refreshRequest) receive() {
+	if r.received != nil {
+		r.received()
+	}
+}
+
+// finish is called by the worker at the end of the refresh it requested
+func (r refreshRequest) finish() {
+	if r.done != nil {
+		close(r.done)
+	}
+}
+
+func newRefreshRequests() map[yolopb.Driver]chan refreshRequest {
+	requests := map[yolopb.Driver]chan refreshRequest{}
+	for _, driver := range refreshedDrivers {
+		requests[driver] = make(chan refreshRequest, 16)
+	}
+	return requests
+}
+
+// listenRefreshRequests marks the worker of a driver as running, so the admin refreshes wait for it
+func (svc *service) listenRefreshRequests(driver yolopb.Driver) (<-chan refreshRequest, func()) {
+	svc.refreshListeners.Store(driver, true)
+	return svc.refreshRequests[driver], func() { svc.refreshListeners.Delete(driver) }
+}
+
+// pendingRefreshes are the webhook refreshes not picked by their worker yet,
+// the following events of the same project are coalesced with them
+type pendingRefreshes struct {
+	mutex   sync.Mutex
+	pending map[yolopb.Driver]map[string]bool
+}
+
+func newPendingRefreshes() *pendingRefreshes {
+	return &pendingRefreshes{pending: map[yolopb.Driver]map[string]bool{}}
+}
+
+// add returns false if a refresh of the project is already pending
+func (p *pendingRefreshes) add(driver yolopb.Driver, project string) bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.pending[driver][project] {
+		return false
+	}
+	if p.pending[driver] == nil {
+		p.pending[driver] = map[string]bool{}
+	}
+	p.pending[driver][project] = true
+	return true
+}
+
+func (p *pendingRefreshes) remove(driver yolopb.Driver, project string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	delete(p.pending[driver], project)
+}
+
+// projectDrivers returns the drivers with builds of a project ("owner/repo"),
+// or all the refreshed drivers if the project is unknown, i.e, has no builds yet
+func (svc *service) projectDrivers(project string) []yolopb.Driver {
+	drivers, err := svc.store.GetProjectDrivers(
+		"https://github.com/"+project,
+		[]string{"https://circleci.com/gh/" + project + "/"}, // the CircleCI builds have no project
+	)
+	if err != nil {
+		svc.logger.Warn("get project drivers", zap.String("project", project), zap.Error(err))
+	}
+	if len(drivers) == 0 {
+		return refreshedDrivers
+	}
+	return drivers
+}
+
+// triggerRefresh asks the workers of the drivers serving a project ("owner/repo") to refresh it,
+// requests are coalesced with the pending ones of the same project, and dropped if a worker is
+// already late, the periodic refresh acts as a safety net
+func (svc *service) triggerRefresh(project string) {
+	for _, driver := range svc.projectDrivers(project) {
+		requests, ok := svc.refreshRequests[driver]
+		if !ok {
+			continue
+		}
+		if !svc.pendingRefreshes.add(driver, project) {
+			svc.logger.Debug("refresh request coalesced", zap.String("driver", driver.String()), zap.String("project", project))
+			continue
+		}
+		driver := driver
+		request := refreshRequest{project: project, received: func() { svc.pendingRefreshes.remove(driver, project) }}
+		select {
+		case requests <- request:
+		default:
+			svc.pendingRefreshes.remove(driver, project)
+			svc.logger.Debug("refresh request dropped", zap.String("driver", driver.String()), zap.String("project", project))
+		}
+	}
+}
+
+// GitHubWebhook receives the GitHub push and check events, and triggers a refresh of the affected project
+func (svc *service) GitHubWebhook(w http.ResponseWriter, r *http.Request) {
+	if svc.githubWebhookSecret == "" {
+		httpError(w, fmt.Errorf("webhooks are not configured"), codes.Unimplemented)
+		return
+	}
+	payload, err := github.ValidatePayload(r, []byte(svc.githubWebhookSecret))
+	if err != nil {
+		httpError(w, err, codes.Unauthenticated)
+		return
+	}
+	event, err := github.ParseWebHook(github.WebHookType(r), payload)
+	if err != nil {
+		httpError(w, err, codes.InvalidArgument)
+		return
+	}
+
+	var project string
+	switch e := event.(type) {
+	case *github.PushEvent:
+		project = e.GetRepo().GetFullName()
+	case *github.CheckRunEvent:
+		project = e.GetRepo().GetFullName()
+	case *github.CheckSuiteEvent:
+		project = e.GetRepo().GetFullName()
+	}
+	if project == "" { // ping and irrelevant events
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	svc.logger.Debug("github webhook", zap.String("event", github.WebHookType(r)), zap.String("project", project))
+	svc.triggerRefresh(strings.ToLower(project))
+	w.WriteHeader(http.StatusAccepted)
+}
@@ -0,0 +1,87 @@
+package yolosvc
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"berty.tech/yolo/v2/go/pkg/testutil"
+	"berty.tech/yolo/v2/go/pkg/yolopb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitHubWebhook(t *testing.T) {
+	const secret = "webhook-secret"
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t), GithubWebhookSecret: secret})
+	defer cleanup()
+	svc := api.(*service)
+	require.NoError(t, svc.store.SaveBatch(&yolopb.Batch{Builds: []*yolopb.Build{
+		{ID: "https://circleci.com/gh/berty/circle/42", Driver: yolopb.Driver_CircleCI},
+	}}))
+
+	post := func(event, body, key string) int {
+		mac := hmac.New(sha256.New, []byte(key))
+		mac.Write([]byte(body))
+		r := httptest.NewRequest("POST", "/api/webhooks/github", strings.NewReader(body))
+		r.Header.Set("Content-Type", "application/json")
+		r.Header.Set("X-GitHub-Event", event)
+		r.Header.Set("X-Hub-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+		w := httptest.NewRecorder()
+		svc.GitHubWebhook(w, r)
+		return w.Code
+	}
+	push := func(project string) string {
+		return `{"ref":"refs/heads/main","repository":{"full_name":"` + project + `"}}`
+	}
+	// pending returns the projects queued for each driver, and marks them as received
+	pending := func() map[yolopb.Driver][]string {
+		queued := map[yolopb.Driver][]string{}
+		for driver, requests := range svc.refreshRequests {
+			for len(requests) > 0 {
+				refresh := <-requests
+				refresh.receive()
+				queued[driver] = append(queued[driver], refresh.project)
+			}
+		}
+		return queued
+	}
+
+	// signature
+	assert.Equal(t, http.StatusUnauthorized, post("push", push("berty/berty"), "invalid"))
+	assert.Equal(t, http.StatusNoContent, post("ping", `{"zen":"hello"}`, secret))
+	assert.Empty(t, pending())
+
+	// only the drivers with builds of the project are woken up, the events are coalesced until received
+	assert.Equal(t, http.StatusAccepted, post("push", push("Berty/Berty"), secret))
+	assert.Equal(t, http.StatusAccepted, post("check_suite", `{"action":"completed","repository":{"full_name":"berty/berty"}}`, secret))
+	assert.Equal(t, map[yolopb.Driver][]string{yolopb.Driver_Buildkite: {"berty/berty"}}, pending())
+	assert.Equal(t, http.StatusAccepted, post("push", push("berty/berty"), secret))
+	assert.Equal(t, map[yolopb.Driver][]string{yolopb.Driver_Buildkite: {"berty/berty"}}, pending())
+
+	// the CircleCI builds are matched by their URL
+	assert.Equal(t, http.StatusAccepted, post("push", push("berty/circle"), secret))
+	assert.Equal(t, map[yolopb.Driver][]string{yolopb.Driver_CircleCI: {"berty/circle"}}, pending())
+
+	// the unknown projects wake all the drivers
+	assert.Equal(t, http.StatusAccepted, post("push", push("berty/new"), secret))
+	assert.Equal(t, map[yolopb.Driver][]string{
+		yolopb.Driver_GitHub:    {"berty/new"},
+		yolopb.Driver_CircleCI:  {"berty/new"},
+		yolopb.Driver_Buildkite: {"berty/new"},
+	}, pending())
+}
+
+func TestGitHubWebhookNotConfigured(t *testing.T) {
+	api, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	svc := api.(*service)
+
+	w := httptest.NewRecorder()
+	svc.GitHubWebhook(w, httptest.NewRequest("POST", "/api/webhooks/github", strings.NewReader("{}")))
+	assert.Equal(t, http.StatusNotImplemented, w.Code)
+}
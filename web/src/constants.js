@@ -1,6 +1,9 @@
 export const USERAGENT = {
   iOS: "iOS",
   Android: "Android",
+  "Mac OS": "Mac OS",
+  Windows: "Windows",
+  Linux: "Linux",
   "Unknown OS": "Unknown OS",
 };
 
@@ -8,6 +11,8 @@ export const KIND_TO_PLATFORM = {
   IPA: "iOS",
   APK: "Android",
   DMG: "Mac OS",
+  EXE: "Windows",
+  MSI: "Windows",
   UNKNOWN: "Unknown OS",
 };
 
@@ -16,6 +21,7 @@ export const PLATFORM_TO_ARTIFACT_KIND = {
   iOS: "1",
   Android: "2",
   "Mac OS": "3",
+  Windows: "4",
 };
 
 export const ARTIFACT_KIND_VALUE = {
@@ -23,6 +29,8 @@ export const ARTIFACT_KIND_VALUE = {
   IPA: "1",
   APK: "2",
   DMG: "3",
+  EXE: "4",
+  MSI: "5",
 };
 
 export const ARTIFACT_KIND_TO_PLATFORM = {
@@ -30,6 +38,8 @@ export const ARTIFACT_KIND_TO_PLATFORM = {
   1: "iOS",
   2: "Android",
   3: "Mac OS",
+  4: "Windows",
+  5: "Windows",
 };
 
 export const ARTIFACT_VALUE_KIND = {
@@ -37,6 +47,8 @@ export const ARTIFACT_VALUE_KIND = {
   1: "IPA",
   2: "APK",
   3: "DMG",
+  4: "EXE",
+  5: "MSI",
 };
 
 export const ARTIFACT_KIND_NAMES = {
@@ -44,6 +56,8 @@ export const ARTIFACT_KIND_NAMES = {
   IPA: "IPA",
   APK: "APK",
   DMG: "DMG",
+  EXE: "EXE",
+  MSI: "MSI",
 };
 
 export const ARTIFACT_KINDS = Object.values(ARTIFACT_KIND_VALUE).map((kind) =>
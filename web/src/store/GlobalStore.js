@@ -12,7 +12,7 @@
 
 import cloneDeep from "lodash/cloneDeep";
 import React, { useReducer } from "react";
-import { ARTIFACT_KIND_VALUE, PROJECT } from "../constants";
+import { ARTIFACT_KIND_VALUE, PROJECT, USERAGENT } from "../constants";
 import { getMobileOperatingSystem } from "../util/browser";
 import Cookies from "js-cookie";
 
@@ -25,12 +25,14 @@ export const GlobalContext = React.createContext();
 
 export const userAgent = getMobileOperatingSystem();
 
+// the platforms without artifacts of their own (Linux, unknown) list every kind
 const defaultArtifactKinds =
-  userAgent === "iOS" || userAgent === "Unknown OS"
-    ? [ARTIFACT_KIND_VALUE.IPA]
-    : userAgent === "Android"
-    ? [ARTIFACT_KIND_VALUE.APK]
-    : [];
+  {
+    [USERAGENT.iOS]: [ARTIFACT_KIND_VALUE.IPA],
+    [USERAGENT.Android]: [ARTIFACT_KIND_VALUE.APK],
+    [USERAGENT["Mac OS"]]: [ARTIFACT_KIND_VALUE.DMG],
+    [USERAGENT.Windows]: [ARTIFACT_KIND_VALUE.EXE, ARTIFACT_KIND_VALUE.MSI],
+  }[userAgent] || [];
 
 export const INITIAL_STATE = {
   authIsPending: false,
@@ -1,5 +1,9 @@
 import React from "react";
-import { faAndroid, faApple } from "@fortawesome/free-brands-svg-icons";
+import {
+  faAndroid,
+  faApple,
+  faWindows,
+} from "@fortawesome/free-brands-svg-icons";
 import { faQuestionCircle } from "@fortawesome/free-solid-svg-icons";
 import { FontAwesomeIcon } from "@fortawesome/react-fontawesome";
 import { Code, GitBranch, GitCommit, GitMerge } from "react-feather";
@@ -26,6 +30,8 @@ const artifactKindComponents = {
   [ARTIFACT_KIND_VALUE.IPA]: <FontAwesomeIcon icon={faApple} />,
   [ARTIFACT_KIND_VALUE.APK]: <FontAwesomeIcon icon={faAndroid} />,
   [ARTIFACT_KIND_VALUE.DMG]: <IconOsx />,
+  [ARTIFACT_KIND_VALUE.EXE]: <FontAwesomeIcon icon={faWindows} />,
+  [ARTIFACT_KIND_VALUE.MSI]: <FontAwesomeIcon icon={faWindows} />,
   default: <FontAwesomeIcon icon={faQuestionCircle} />,
 };
 
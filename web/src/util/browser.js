@@ -1,23 +1,44 @@
 /**
  * https://stackoverflow.com/a/21742107
  *
- * Determine the mobile operating system.
- * This function returns one of 'iOS', 'Android', 'Windows Phone', or 'unknown'.
+ * Determine the operating system from a user agent.
+ * This function returns one of 'iOS', 'Android', 'Mac OS', 'Windows', 'Linux', or 'Unknown OS'.
+ * The iPads report a macOS user agent, they are told apart by their touch screen (maxTouchPoints).
  */
-export const getMobileOperatingSystem = () => {
-  const userAgent = navigator.userAgent || navigator.vendor || window.opera;
+export const operatingSystemFromUserAgent = (
+  userAgent = "",
+  { maxTouchPoints = 0, msStream = false } = {}
+) => {
   if (/android/i.test(userAgent)) {
     return "Android";
   }
 
   // iOS detection from: http://stackoverflow.com/a/9039885/177710
-  if (/iPad|iPhone|iPod/.test(userAgent) && !window.MSStream) {
+  if (/iPad|iPhone|iPod/.test(userAgent) && !msStream) {
     return "iOS";
   }
 
+  if (/Macintosh|Mac OS X/.test(userAgent)) {
+    return maxTouchPoints > 1 ? "iOS" : "Mac OS";
+  }
+
+  if (/Windows NT/.test(userAgent)) {
+    return "Windows";
+  }
+
+  if (/Linux|X11/.test(userAgent)) {
+    return "Linux";
+  }
+
   return "Unknown OS";
 };
 
+export const getMobileOperatingSystem = () =>
+  operatingSystemFromUserAgent(
+    navigator.userAgent || navigator.vendor || window.opera,
+    { maxTouchPoints: navigator.maxTouchPoints, msStream: !!window.MSStream }
+  );
+
 export const onAccessibleClickHandler = (onClick) =>
   !onClick
     ? undefined
@@ -0,0 +1,49 @@
+import { operatingSystemFromUserAgent } from "./browser";
+
+const userAgents = {
+  iPhone:
+    "Mozilla/5.0 (iPhone; CPU iPhone OS 16_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/16.0 Mobile/15E148 Safari/604.1",
+  iPadOS:
+    "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/16.0 Safari/605.1.15",
+  android:
+    "Mozilla/5.0 (Linux; Android 13; Pixel 7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/106.0.0.0 Mobile Safari/537.36",
+  mac:
+    "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/106.0.0.0 Safari/537.36",
+  windows:
+    "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/106.0.0.0 Safari/537.36 Edg/106.0.1370.42",
+  windowsPhone:
+    "Mozilla/5.0 (Windows Phone 10.0; Android 6.0.1; Microsoft; Lumia 950) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/52.0.2743.116 Mobile Safari/537.36 Edge/15.14977",
+  linux:
+    "Mozilla/5.0 (X11; Ubuntu; Linux x86_64; rv:105.0) Gecko/20100101 Firefox/105.0",
+  curl: "curl/7.85.0",
+};
+
+describe("detects the operating system from the user agent", () => {
+  it("detects the mobile platforms", () => {
+    expect(operatingSystemFromUserAgent(userAgents.iPhone)).toEqual("iOS");
+    expect(operatingSystemFromUserAgent(userAgents.android)).toEqual(
+      "Android"
+    );
+    expect(operatingSystemFromUserAgent(userAgents.windowsPhone)).toEqual(
+      "Android"
+    );
+  });
+  it("tells the iPads apart from the Macs by their touch screen", () => {
+    expect(
+      operatingSystemFromUserAgent(userAgents.iPadOS, { maxTouchPoints: 5 })
+    ).toEqual("iOS");
+    expect(operatingSystemFromUserAgent(userAgents.mac)).toEqual("Mac OS");
+  });
+  it("detects the desktop platforms", () => {
+    expect(operatingSystemFromUserAgent(userAgents.windows)).toEqual(
+      "Windows"
+    );
+    expect(operatingSystemFromUserAgent(userAgents.linux)).toEqual("Linux");
+  });
+  it("does not fall back to iOS", () => {
+    expect(operatingSystemFromUserAgent(userAgents.curl)).toEqual(
+      "Unknown OS"
+    );
+    expect(operatingSystemFromUserAgent(undefined)).toEqual("Unknown OS");
+  });
+});
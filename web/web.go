@@ -0,0 +1,22 @@
+// Package web embeds the build of the web UI.
+//
+// The dist directory is filled with the output of `yarn build` before building
+// the server, it only contains a placeholder in the source tree.
+package web
+
+import (
+	"embed"
+	"io/fs"
+)
+
+//go:embed all:dist
+var dist embed.FS
+
+// FS returns the static files of the web UI
+func FS() fs.FS {
+	static, err := fs.Sub(dist, "dist")
+	if err != nil { // only fails with an invalid path
+		panic(err)
+	}
+	return static
+}